@@ -12,16 +12,29 @@ import (
 	"github.com/NickDubelman/fantasy-bball/auth"
 	"github.com/NickDubelman/fantasy-bball/config"
 	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/draft"
+	"github.com/NickDubelman/fantasy-bball/settlement"
 )
 
-// Load initializes the API routes, middlewares, context, etc...
-func Load() (*gin.Engine, error) {
+// draftClockInterval is how often we check for drafts where someone's pick clock has
+// run out
+const draftClockInterval = 5 * time.Second
+
+// settlementInterval is how often we check for contests whose games are all over
+const settlementInterval = time.Minute
+
+// tokenCleanupInterval is how often expired refresh tokens and auth codes are deleted
+const tokenCleanupInterval = time.Hour
+
+// Load initializes the API routes, middlewares, context, etc... It also returns the
+// app database client, for background work that runs alongside the API
+func Load() (*gin.Engine, *db.Client, error) {
 	router := gin.Default()
 
 	// Connect to app database
 	driver, err := sql.Open("mysql", config.Get().Database.DSN())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	appDB := driver.DB()
@@ -36,13 +49,32 @@ func Load() (*gin.Engine, error) {
 		log.Fatalf("failed creating schema resources: %v", err)
 	}
 
+	// Make automatic picks for users who run out of time in a draft. The pick clock
+	// is derived from timestamps in the db, so nothing is lost across restarts
+	go draft.RunClock(context.Background(), client, draftClockInterval)
+
+	// Decide the winners of contests once all of their games are over
+	go settlement.Run(context.Background(), client, settlementInterval)
+
+	// Delete refresh tokens and auth codes that can't be used anymore, outside of any
+	// request
+	go auth.RunCleanup(context.Background(), client, tokenCleanupInterval)
+
 	// Middleware to make db client accessible via request context
 	router.Use(func(c *gin.Context) {
 		ctx := db.NewContext(c.Request.Context(), client)
 		c.Request = c.Request.WithContext(ctx)
 	})
 
-	router.Use(auth.GoogleAuthFromConfig()) // Auth handlers
+	// Middleware to make the user making the request accessible via request context
+	router.Use(Authenticate(client))
+
+	router.Use(auth.HandlersFromConfig()) // Auth handlers
+
+	// GraphQL API. Everything in it needs a logged in user, so requests without a valid
+	// access token get a 401 that tells the client to refresh its tokens or log in
+	router.GET(PathGraphQL, RequireAuth(), graphqlHandler())
+	router.POST(PathGraphQL, RequireAuth(), graphqlHandler())
 
-	return router, nil
+	return router, client, nil
 }
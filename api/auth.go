@@ -0,0 +1,141 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/NickDubelman/fantasy-bball/auth"
+	"github.com/NickDubelman/fantasy-bball/db"
+)
+
+// lastActiveInterval is how often a user's lastActive is updated, at most. Updating it
+// on every request would mean a write for every read
+const lastActiveInterval = time.Minute
+
+// realm is the realm in WWW-Authenticate headers
+const realm = "fantasy-bball"
+
+// Authenticate is middleware that validates the access token in the request's
+// "Authorization: Bearer <token>" header, if there is one, and attaches the token and
+// its user to the request context for auth.UserFromContext. Requests without a valid
+// token are let through, so that whatever handles them can decide what anonymous
+// users may do. It also keeps track of when users were last active
+func Authenticate(client *db.Client) gin.HandlerFunc {
+	activity := newActivityTracker(client, lastActiveInterval)
+
+	return func(c *gin.Context) {
+		token := bearerToken(c.Request)
+		if token == "" {
+			return
+		}
+
+		ctx, user, err := auth.Authenticate(c.Request.Context(), token)
+		c.Request = c.Request.WithContext(ctx)
+
+		if err == nil {
+			activity.seen(user.ID(), time.Now())
+		}
+	}
+}
+
+// RequireAuth is middleware that only lets through requests that Authenticate found a
+// valid access token on. Anything else gets a 401 with a WWW-Authenticate header
+// (RFC 6750) and a JSON error like the auth endpoints'
+func RequireAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		_, err := auth.UserFromContext(c.Request.Context())
+		if err == nil {
+			return
+		}
+
+		errType := auth.ErrorTypeNotAuthorized
+		challenge := fmt.Sprintf("Bearer realm=%q", realm)
+		switch err.(type) {
+		case auth.NotAuthorized:
+			// No token at all doesn't get an error code, just the challenge
+			if _, tokenErr := auth.AccessTokenFromContext(c.Request.Context()); tokenErr == nil {
+				challenge += `, error="invalid_token"`
+			}
+		case auth.TokenExpired:
+			errType = auth.ErrorTypeTokenExpired
+			challenge += `, error="invalid_token", error_description="the access token expired"`
+		default:
+			log.Println(err)
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error": auth.ErrorResponse{Type: auth.ErrorTypeInternal, Message: err.Error()},
+			})
+			return
+		}
+
+		c.Header("WWW-Authenticate", challenge)
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+			"error": auth.ErrorResponse{Type: errType, Message: err.Error()},
+		})
+	}
+}
+
+// bearerToken extracts the token from a request's "Authorization: Bearer <token>"
+// header. It returns an empty string if there is no such header
+func bearerToken(r *http.Request) string {
+	const prefix = "Bearer "
+
+	header := r.Header.Get("Authorization")
+	if len(header) < len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return ""
+	}
+	return strings.TrimSpace(header[len(prefix):])
+}
+
+// activityTracker updates users' lastActive, at most once per interval for each user
+type activityTracker struct {
+	client   *db.Client
+	interval time.Duration
+
+	mu      sync.Mutex
+	updated map[int]time.Time // when each user's lastActive was last updated
+}
+
+func newActivityTracker(client *db.Client, interval time.Duration) *activityTracker {
+	return &activityTracker{
+		client:   client,
+		interval: interval,
+		updated:  map[int]time.Time{},
+	}
+}
+
+// seen records that the user was active at now. The update happens in the
+// background, so that requests don't wait on it
+func (t *activityTracker) seen(userID int, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if now.Sub(t.updated[userID]) < t.interval {
+		return
+	}
+
+	// Forget users who haven't been seen in a while, so that the map doesn't grow
+	// forever
+	for id, updated := range t.updated {
+		if now.Sub(updated) >= t.interval {
+			delete(t.updated, id)
+		}
+	}
+	t.updated[userID] = now
+
+	go func() {
+		err := t.client.User.
+			UpdateOneID(userID).
+			SetLastActive(now).
+			Exec(context.Background())
+		if err != nil && !db.IsNotFound(err) {
+			log.Printf("updating lastActive of user %d: %v", userID, err)
+		}
+	}()
+}
@@ -0,0 +1,88 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/gin-gonic/gin"
+
+	"github.com/NickDubelman/fantasy-bball/auth"
+)
+
+func TestRequireAuth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// loggedIn stands in for Authenticate having found a valid token
+	loggedIn := func(c *gin.Context) {
+		user := auth.UserInfo{StandardClaims: jwt.StandardClaims{Subject: "7"}}
+		c.Request = c.Request.WithContext(auth.NewContext(c.Request.Context(), user))
+	}
+
+	tests := []struct {
+		name          string
+		authenticate  gin.HandlerFunc
+		header        string
+		wantStatus    int
+		wantChallenge string
+		wantType      string
+	}{
+		{
+			name:          "no token",
+			authenticate:  Authenticate(nil),
+			wantStatus:    http.StatusUnauthorized,
+			wantChallenge: `Bearer realm="fantasy-bball"`,
+			wantType:      auth.ErrorTypeNotAuthorized,
+		},
+		{
+			name:          "invalid token",
+			authenticate:  Authenticate(nil),
+			header:        "Bearer not-a-jwt",
+			wantStatus:    http.StatusUnauthorized,
+			wantChallenge: `Bearer realm="fantasy-bball", error="invalid_token"`,
+			wantType:      auth.ErrorTypeNotAuthorized,
+		},
+		{
+			name:         "logged in",
+			authenticate: loggedIn,
+			wantStatus:   http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(tt.authenticate)
+			router.GET("/", RequireAuth(), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			if got := w.Header().Get("WWW-Authenticate"); got != tt.wantChallenge {
+				t.Errorf("WWW-Authenticate = %q, want %q", got, tt.wantChallenge)
+			}
+			if tt.wantType == "" {
+				return
+			}
+
+			var body struct{ Error auth.ErrorResponse }
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatal(err)
+			}
+			if body.Error.Type != tt.wantType {
+				t.Errorf("error type = %q, want %q", body.Error.Type, tt.wantType)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package api
+
+import (
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/gin-gonic/gin"
+
+	"github.com/NickDubelman/fantasy-bball/graph"
+	"github.com/NickDubelman/fantasy-bball/graph/generated"
+)
+
+// PathGraphQL is the path that serves the GraphQL API
+const PathGraphQL = "/api/graphql"
+
+// graphqlHandler returns a handler that executes GraphQL requests against the schema
+// defined in schema/*.graphql
+func graphqlHandler() gin.HandlerFunc {
+	srv := handler.NewDefaultServer(
+		generated.NewExecutableSchema(generated.Config{
+			Resolvers:  &graph.Resolver{},
+			Directives: generated.DirectiveRoot{Auth: graph.AuthDirective},
+		}),
+	)
+
+	// The caller's user is made available to resolvers by the Authenticate middleware
+	return func(c *gin.Context) {
+		srv.ServeHTTP(c.Writer, c.Request)
+	}
+}
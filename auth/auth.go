@@ -2,42 +2,41 @@ package auth
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"log"
 	"net/http"
-	"net/url"
-	"time"
 
 	"github.com/NickDubelman/fantasy-bball/config"
 	"github.com/NickDubelman/fantasy-bball/db"
-	"github.com/NickDubelman/fantasy-bball/db/user"
 	"github.com/gin-gonic/gin"
-	"golang.org/x/oauth2"
-	"golang.org/x/oauth2/google"
 )
 
 const (
-	// PathLogin is the path to handle logins
+	// PathLogin is the path to handle logins. Users choose which provider to log in
+	// with here, unless there is only one. Each provider has its own login and
+	// callback paths under /auth/{name}/
 	PathLogin = "/auth/login"
 
-	// PathCallback is the path to handle the callback from OAuth backend (Google)
-	PathCallback = "/auth/google/callback"
-
 	// PathError is redirected to when the user has an auth error
 	PathError = "/auth/error"
 
+	// PathToken is the path to exchange an auth code for tokens
+	PathToken = "/auth/token"
+
+	// PathRefresh is the path to get new tokens with a refresh token
+	PathRefresh = "/auth/refresh"
+
+	// PathLogout is the path to revoke a refresh token
+	PathLogout = "/auth/logout"
+
+	// PathJWKS is the path to the public keys that tokens can be verified with
+	PathJWKS = "/.well-known/jwks.json"
+
 	codeRedirect = http.StatusFound
-)
 
-// GoogleUserInfo represents a response from the Google userinfo API
-type GoogleUserInfo struct {
-	Name    string `json:"name"`
-	Email   string `json:"email"`
-	Picture string `json:"picture"`
-	Locale  string `json:"locale"`
-}
+	// errorMessage is shown when logging in fails for reasons the user can't fix
+	errorMessage = "Something went wrong while logging you in."
+)
 
 // NotAuthorized is an error for when a user is not authorized to do something
 type NotAuthorized struct{}
@@ -53,35 +52,63 @@ func (e TokenExpired) Error() string {
 	return "access token is expired"
 }
 
-// GoogleAuthFromConfig returns handlers that can be used for OAuth with Google
-func GoogleAuthFromConfig() gin.HandlerFunc {
-	config, err := getGoogleAuthConfig(config.Get().OAuthConfigPath)
+// HandlersFromConfig returns handlers for logging in with the providers in the
+// config, and for the other auth endpoints
+func HandlersFromConfig() gin.HandlerFunc {
+	providers, err := providersFromConfig(config.Get())
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	keys := getKeySet() // fail on startup rather than on the first login
+
+	logins := map[string]Provider{}
+	callbacks := map[string]Provider{}
+	authorizers := map[string]authorizer{}
+	for _, p := range providers {
+		logins[loginPath(p)] = p
+		callbacks[callbackPath(p)] = p
+		if a, ok := p.(authorizer); ok {
+			authorizers[authorizePath(p)] = a
+		}
+	}
+
 	return func(c *gin.Context) {
 		if c.Request.Method == "GET" {
-			switch c.Request.URL.Path {
+			path := c.Request.URL.Path
+
+			if p, ok := logins[path]; ok {
+				handleLogin(p, c)
+			} else if p, ok := callbacks[path]; ok {
+				// User succesfully authenticated with the provider
+				handleOAuth2Callback(p, c)
+			} else if a, ok := authorizers[path]; ok {
+				a.authorize(c)
+			}
+
+			switch path {
 			case PathLogin:
-				// Redirect the user to Google to authenticate
-				http.Redirect(
-					c.Writer,
-					c.Request,
-					config.AuthCodeURL(
-						extractPath(c.Request.URL.Query().Get("next")),
-						oauth2.SetAuthURLParam("prompt", "login"),
-					),
-					codeRedirect,
-				)
-
-			case PathCallback:
-				// User succesfully authenticated with Google
-				handleOAuth2Callback(config, c)
+				chooseProvider(c, providers)
 
 			case PathError:
-				c.String(http.StatusInternalServerError, "Error logging in")
+				errorPage(c, http.StatusInternalServerError, errorMessage)
+
+			case PathJWKS:
+				handleJWKS(c, keys)
+
+			}
+		}
+
+		if c.Request.Method == "POST" {
+			switch c.Request.URL.Path {
+			case PathToken:
+				handleTokenExchange(c)
 
+			case PathRefresh:
+				handleRefresh(c)
+
+			case PathLogout:
+				handleLogout(c)
 			}
 		}
 	}
@@ -90,26 +117,59 @@ func GoogleAuthFromConfig() gin.HandlerFunc {
 // UserFromContext takes a context and returns the UserInfo for the user making the
 // request
 func UserFromContext(ctx context.Context) (UserInfo, error) {
+	// The token was already validated
+	if a, ok := ctx.Value(contextKey{"authentication"}).(authentication); ok {
+		return a.user, a.err
+	}
+
 	tokenStr, err := AccessTokenFromContext(ctx)
 	if err != nil {
 		return UserInfo{}, err
 	}
 
+	return validateAccessToken(tokenStr)
+}
+
+// Authenticate validates an access token, and returns a new context with the token
+// and the result attached. UserFromContext returns the same result without validating
+// the token again
+func Authenticate(ctx context.Context, token string) (context.Context, UserInfo, error) {
+	user, err := validateAccessToken(token)
+
+	ctx = ContextWithAccessToken(ctx, token)
+	ctx = context.WithValue(ctx, contextKey{"authentication"}, authentication{user, err})
+	return ctx, user, err
+}
+
+// NewContext returns a new context with an already authenticated user attached, for
+// callers that don't have an access token, like tests
+func NewContext(parent context.Context, user UserInfo) context.Context {
+	return context.WithValue(parent, contextKey{"authentication"}, authentication{user, nil})
+}
+
+// authentication is the result of validating a request's access token
+type authentication struct {
+	user UserInfo
+	err  error
+}
+
+// validateAccessToken checks that an access token is ours and hasn't expired, and
+// returns its claims
+func validateAccessToken(tokenStr string) (UserInfo, error) {
 	claims := &UserInfo{}
-	tkn, err := parseToken(tokenStr, claims)
-	if err != nil {
-		return UserInfo{}, err
+	_, err := parseToken(tokenStr, claims)
+	if err != nil && !onlyExpired(err) {
+		return UserInfo{}, NotAuthorized{} // malformed or not signed by us
 	}
 
-	now := time.Now()
-	expires := time.Unix(claims.IssuedAt, 0).Add(accessTokenDuration)
-
-	if !tkn.Valid {
-		return UserInfo{}, NotAuthorized{} // Token invalid
+	if !claims.VerifyIssuer(tokenIssuer, true) ||
+		!claims.VerifyAudience(accessTokenAudience, true) ||
+		claims.ExpiresAt == 0 {
+		return UserInfo{}, NotAuthorized{} // not one of our access tokens
 	}
 
-	if now.After(expires) {
-		return UserInfo{}, TokenExpired{} // Token expired
+	if err != nil {
+		return UserInfo{}, TokenExpired{}
 	}
 
 	return *claims, nil
@@ -136,156 +196,146 @@ func AccessTokenFromContext(ctx context.Context) (string, error) {
 	return accessToken, nil
 }
 
-// handleOAuth2Callback will be executed after the user authenticates with Google and
-// consents to the scopes our app requires. We will retrieve their name, email, and
-// picture from the Google userinfo endpoint. Lastly, we generate an access token and
-// a refresh token for the user (both are JWTs)
-func handleOAuth2Callback(cfg *oauth2.Config, ginCtx *gin.Context) {
-	handleErr := func(err error) {
+// handleLogin sends the user to the provider to log in
+func handleLogin(p Provider, c *gin.Context) {
+	// The state carries where to send the user afterwards, and its nonce ties it to
+	// this browser
+	state, nonce, err := newState(p.Name(), c.Request.URL.Query().Get("next"))
+	if err != nil {
 		log.Println(err)
-		ginCtx.Redirect(http.StatusFound, PathError)
+		errorPage(c, http.StatusInternalServerError, errorMessage)
+		return
 	}
 
-	code := ginCtx.Request.URL.Query().Get("code")
-	t, err := cfg.Exchange(oauth2.NoContext, code)
+	authURL, err := p.AuthURL(c.Request.Context(), state)
 	if err != nil {
-		handleErr(err)
+		log.Println(err)
+		errorPage(c, http.StatusInternalServerError, errorMessage)
 		return
 	}
 
-	client := cfg.Client(oauth2.NoContext, t)
-	userinfo, err := client.Get("https://www.googleapis.com/oauth2/v3/userinfo")
-	if err != nil {
-		handleErr(err)
-		return
+	setStateCookie(c, nonce, int(stateDuration.Seconds()))
+	http.Redirect(c.Writer, c.Request, authURL, codeRedirect)
+}
+
+// handleOAuth2Callback will be executed after the user logs in with the provider. We
+// find or create the user for the account they logged in with, and then generate an
+// access token and a refresh token for them
+func handleOAuth2Callback(p Provider, ginCtx *gin.Context) {
+	handleErr := func(err error) {
+		log.Println(err)
+		ginCtx.Redirect(http.StatusFound, PathError)
 	}
-	defer userinfo.Body.Close()
 
-	data, err := ioutil.ReadAll(userinfo.Body)
+	// Make sure that this browser is the one that started logging in before doing
+	// anything with the code
+	nonce, _ := ginCtx.Cookie(stateCookie)
+	setStateCookie(ginCtx, "", -1) // each state can only be used once
+
+	query := ginCtx.Request.URL.Query()
+	next, err := verifyState(query.Get("state"), nonce, p.Name())
 	if err != nil {
-		handleErr(err)
+		log.Println(err)
+		errorPage(
+			ginCtx,
+			http.StatusBadRequest,
+			"Your login expired or didn't start in this browser. Please try again.",
+		)
 		return
 	}
 
-	userInfo := GoogleUserInfo{}
-	if err := json.Unmarshal(data, &userInfo); err != nil {
-		handleErr(err)
+	// The user cancelled, or the provider wouldn't log them in
+	if errCode := query.Get("error"); errCode != "" {
+		log.Printf("logging in with %s: %s", p.Name(), errCode)
+		errorPage(
+			ginCtx,
+			http.StatusBadRequest,
+			fmt.Sprintf("You weren't logged in with %s.", p.DisplayName()),
+		)
 		return
 	}
 
 	ctx := ginCtx.Request.Context()
 
-	dbClient := db.FromContext(ctx)
-	if dbClient == nil {
-		err := fmt.Errorf("could not retrieve db client from context")
+	account, err := p.Exchange(ctx, query.Get("code"))
+	if err != nil {
 		handleErr(err)
 		return
 	}
 
-	// Check if the user exists in our database
-	var userID int
-
-	authUser, err := dbClient.User.
-		Query().
-		Where(
-			user.Email(userInfo.Email),
-		).
-		Only(ctx)
-
-	if err != nil {
-		if _, notFoundErr := err.(*db.NotFoundError); notFoundErr {
-			// If user that is logging in is not yet in our db, add them
-			authUser, err := dbClient.User.
-				Create().
-				SetName(userInfo.Name).
-				SetEmail(userInfo.Email).
-				SetPicture(userInfo.Picture).
-				Save(ctx)
-			if err != nil {
-				log.Println(err)
-				ginCtx.AbortWithError(http.StatusBadRequest, err)
-				return
-			}
-
-			userID = authUser.ID
-		} else {
-			handleErr(err)
-			return
-		}
-	}
-
-	// If user that is logging in already exists in our db, just update their info
-	if authUser != nil {
-		userID = authUser.ID
-		_, err = authUser.
-			Update().
-			SetName(userInfo.Name).
-			SetPicture(userInfo.Picture).
-			SetLastActive(time.Now()).
-			Save(ctx)
-		if err != nil {
-			handleErr(err)
-			return
-		}
+	if account.Email == "" || !account.EmailVerified {
+		errorPage(
+			ginCtx,
+			http.StatusForbidden,
+			fmt.Sprintf(
+				"Your %s account needs a verified email address to log in.",
+				p.DisplayName(),
+			),
+		)
+		return
 	}
 
-	// Generate an access token
-	accessToken, err := createAccessToken(ctx, userID, userInfo)
-	if err != nil {
+	dbClient := db.FromContext(ctx)
+	if dbClient == nil {
+		err := fmt.Errorf("could not retrieve db client from context")
 		handleErr(err)
 		return
 	}
 
-	// Generate a refresh token
-	refreshToken, err := createRefreshToken(userID)
+	authUser, err := loginUser(ctx, dbClient, p.Name(), account)
 	if err != nil {
 		handleErr(err)
 		return
 	}
 
-	state := ginCtx.Request.URL.Query().Get("state")
-
-	nextURL, err := url.Parse("http://localhost:3000/login-callback")
+	nextURL, err := frontendCallbackURL()
 	if err != nil {
 		handleErr(err)
 		return
 	}
 
-	// We need to add the user's tokens to the redirect URL so that the frontend can
-	// pluck it from the URL and store the tokens in localStorage
-	query := nextURL.Query()
-	query.Add("accessToken", accessToken)
-	query.Add("refreshToken", refreshToken)
-	query.Add("state", state)
-	nextURL.RawQuery = query.Encode()
-
-	http.Redirect(ginCtx.Writer, ginCtx.Request, nextURL.String(), codeRedirect)
-}
+	nextQuery := nextURL.Query()
+	if config.Get().TokensInRedirect {
+		// Deprecated: the tokens end up in browser history, proxy logs, etc... This is
+		// only kept around until the frontend exchanges codes instead
+		tokens, err := createTokens(ctx, dbClient, authUser, "")
+		if err != nil {
+			handleErr(err)
+			return
+		}
 
-// getGoogleAuthConfig attempts to read from a given oauthConfigPath and return a
-// corresponding *auth2.Config. The config file should be obtained from the Google
-// Developers Console's "Credentials" page
-func getGoogleAuthConfig(oauthConfigPath string) (*oauth2.Config, error) {
-	jsonKey, err := ioutil.ReadFile(oauthConfigPath)
-	if err != nil {
-		return nil, err
-	}
+		nextQuery.Add("accessToken", tokens.AccessToken)
+		nextQuery.Add("refreshToken", tokens.RefreshToken)
+	} else {
+		// The frontend's server exchanges the code for the user's tokens (see
+		// PathToken), so the tokens never appear in a URL
+		code, err := createAuthCode(ctx, dbClient, authUser.ID)
+		if err != nil {
+			handleErr(err)
+			return
+		}
 
-	conf, err := google.ConfigFromJSON(jsonKey, "profile")
-	if err != nil {
-		return nil, err
+		nextQuery.Add("code", code)
 	}
+	nextQuery.Add("state", next)
+	nextURL.RawQuery = nextQuery.Encode()
 
-	conf.Scopes = []string{"profile", "email"} // the scopes we need for our app
-	return conf, nil
+	http.Redirect(ginCtx.Writer, ginCtx.Request, nextURL.String(), codeRedirect)
 }
 
-func extractPath(next string) string {
-	nextURL, err := url.Parse(next)
-	if err != nil {
-		return "/"
-	}
-	return nextURL.Path
+// setStateCookie stores the nonce of the login in progress in the user's browser. A
+// negative maxAge deletes it
+func setStateCookie(c *gin.Context, nonce string, maxAge int) {
+	c.SetSameSite(http.SameSiteLaxMode) // sent along with the redirect back from the provider
+	c.SetCookie(
+		stateCookie,
+		nonce,
+		maxAge,
+		"/auth",
+		"",
+		config.Get().Environment == config.EnvProduction, // https only
+		true,
+	)
 }
 
 type contextKey struct{ name string }
@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/authcode"
+	"github.com/NickDubelman/fantasy-bball/db/refreshtoken"
+)
+
+// cleanupBatchSize is how many rows DeleteExpired deletes at a time, so that it never
+// holds locks on a large part of a table
+const cleanupBatchSize = 1000
+
+// DeleteExpired deletes the refresh tokens and auth codes that expired before now.
+// Rotated refresh tokens are kept until they expire, so that reusing them can be
+// detected, and codes that were never used are kept until then too
+func DeleteExpired(ctx context.Context, client *db.Client, now time.Time) error {
+	err := deleteInBatches(
+		func() ([]int, error) {
+			return client.RefreshToken.
+				Query().
+				Where(refreshtoken.ExpiresLT(now)).
+				Limit(cleanupBatchSize).
+				IDs(ctx)
+		},
+		func(ids []int) error {
+			_, err := client.RefreshToken.
+				Delete().
+				Where(refreshtoken.IDIn(ids...)).
+				Exec(ctx)
+			return err
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return deleteInBatches(
+		func() ([]int, error) {
+			return client.AuthCode.
+				Query().
+				Where(authcode.ExpiresLT(now)).
+				Limit(cleanupBatchSize).
+				IDs(ctx)
+		},
+		func(ids []int) error {
+			_, err := client.AuthCode.
+				Delete().
+				Where(authcode.IDIn(ids...)).
+				Exec(ctx)
+			return err
+		},
+	)
+}
+
+// deleteInBatches deletes the IDs returned by next until it doesn't return any more
+func deleteInBatches(next func() ([]int, error), del func(ids []int) error) error {
+	for {
+		ids, err := next()
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		if err := del(ids); err != nil {
+			return err
+		}
+	}
+}
+
+// RunCleanup calls DeleteExpired every interval, until ctx is done
+func RunCleanup(ctx context.Context, client *db.Client, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := DeleteExpired(ctx, client, time.Now()); err != nil {
+				log.Printf("deleting expired tokens: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/NickDubelman/fantasy-bball/db/enttest"
+)
+
+func TestDeleteExpired(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:cleanup?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	u := client.User.Create().SetName("Kobe").SetEmail("kobe@example.com").SaveX(ctx)
+	now := time.Now().UTC()
+
+	newRefreshToken := func(name string, expires time.Time, rotated bool) {
+		create := client.RefreshToken.
+			Create().
+			SetTokenHash(HashToken(name)).
+			SetFamily("family").
+			SetCreated(expires.Add(-refreshTokenDuration)).
+			SetExpires(expires).
+			SetUserID(u.ID)
+		if rotated {
+			create.SetRotated(now.Add(-time.Hour))
+		}
+		create.SaveX(ctx)
+	}
+	newRefreshToken("expired", now.Add(-time.Hour), false)
+	newRefreshToken("expired and rotated", now.Add(-time.Hour), true)
+	newRefreshToken("rotated", now.Add(time.Hour), true)
+	newRefreshToken("valid", now.Add(time.Hour), false)
+
+	newAuthCode := func(name string, expires time.Time) {
+		client.AuthCode.
+			Create().
+			SetCodeHash(HashToken(name)).
+			SetExpires(expires).
+			SetUserID(u.ID).
+			SaveX(ctx)
+	}
+	newAuthCode("expired", now.Add(-time.Hour))
+	newAuthCode("valid", now.Add(time.Hour))
+
+	if err := DeleteExpired(ctx, client, now); err != nil {
+		t.Fatal(err)
+	}
+
+	var refreshTokens []string
+	for _, rt := range client.RefreshToken.Query().AllX(ctx) {
+		refreshTokens = append(refreshTokens, rt.TokenHash)
+	}
+	sort.Strings(refreshTokens)
+	want := []string{HashToken("rotated"), HashToken("valid")}
+	sort.Strings(want)
+	if !reflect.DeepEqual(refreshTokens, want) {
+		t.Errorf("refresh tokens left = %v, want the rotated and valid ones %v", refreshTokens, want)
+	}
+
+	codes := client.AuthCode.Query().AllX(ctx)
+	if len(codes) != 1 || codes[0].CodeHash != HashToken("valid") {
+		t.Errorf("auth codes left = %v, want only the valid one", codes)
+	}
+}
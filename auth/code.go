@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/authcode"
+)
+
+// codeDuration is how long the frontend has to exchange an auth code for tokens
+const codeDuration = time.Minute
+
+// createAuthCode creates a single use code that can be exchanged for the user's
+// tokens within codeDuration
+func createAuthCode(ctx context.Context, client *db.Client, userID int) (string, error) {
+	code, err := RandomToken()
+	if err != nil {
+		return "", err
+	}
+
+	_, err = client.AuthCode.
+		Create().
+		SetCodeHash(HashToken(code)).
+		SetExpires(time.Now().Add(codeDuration)).
+		SetUserID(userID).
+		Save(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return code, nil
+}
+
+// exchangeAuthCode uses up an auth code and returns new tokens for its user
+func exchangeAuthCode(ctx context.Context, client *db.Client, code string) (TokenPair, error) {
+	var u *db.User
+
+	err := client.WithTx(ctx, func(tx *db.Tx) error {
+		c, err := tx.AuthCode.
+			Query().
+			Where(authcode.CodeHash(HashToken(code))).
+			WithUser().
+			Only(ctx)
+		if db.IsNotFound(err) {
+			return NotAuthorized{}
+		}
+		if err != nil {
+			return err
+		}
+
+		// Deleting the code is what makes it single use: if two requests race to use
+		// it, only one of them gets to delete it
+		if err := tx.AuthCode.DeleteOne(c).Exec(ctx); err != nil {
+			if db.IsNotFound(err) {
+				return NotAuthorized{}
+			}
+			return err
+		}
+
+		if time.Now().After(c.Expires) {
+			return TokenExpired{}
+		}
+
+		u = c.Edges.User
+		return nil
+	})
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	return createTokens(ctx, client, u, "")
+}
@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/NickDubelman/fantasy-bball/db/enttest"
+)
+
+func TestExchangeAuthCode(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:codes?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	u := client.User.Create().SetName("Kobe").SetEmail("kobe@example.com").SaveX(ctx)
+
+	code, err := createAuthCode(ctx, client, u.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tokens, err := exchangeAuthCode(ctx, client, code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	user, err := validateAccessToken(tokens.AccessToken)
+	if err != nil || user.ID() != u.ID {
+		t.Errorf("access token is for user %d (error %v), want user %d", user.ID(), err, u.ID)
+	}
+
+	// Codes are single use
+	if _, err := exchangeAuthCode(ctx, client, code); err != (NotAuthorized{}) {
+		t.Errorf("second exchangeAuthCode() error = %v, want NotAuthorized", err)
+	}
+
+	expired := "expired-code"
+	client.AuthCode.
+		Create().
+		SetCodeHash(HashToken(expired)).
+		SetExpires(time.Now().Add(-time.Hour)).
+		SetUserID(u.ID).
+		SaveX(ctx)
+	if _, err := exchangeAuthCode(ctx, client, expired); err != (TokenExpired{}) {
+		t.Errorf("exchangeAuthCode() with an expired code error = %v, want TokenExpired", err)
+	}
+}
@@ -0,0 +1,121 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+	"github.com/gin-gonic/gin"
+)
+
+// devUserLimit is how many users the dev login page lists
+const devUserLimit = 50
+
+// devProvider is a Provider for development that logs users in as whoever they say
+// they are, without a password or anything external. The code it sends to the
+// callback is just the user's email, so end-to-end tests can skip its page. They still
+// have to start at /auth/dev/login with a cookie jar, since the callback checks the
+// state against the oauth_state cookie set there, and then request
+// /auth/dev/callback?state=...&code=someone@example.com with the state it redirected to
+type devProvider struct{}
+
+var _ Provider = devProvider{}
+
+func (devProvider) Name() string {
+	return "dev"
+}
+
+func (devProvider) DisplayName() string {
+	return "a development account"
+}
+
+// AuthURL returns the provider's authorize page, which plays the part of the login
+// page of an actual provider
+func (p devProvider) AuthURL(ctx context.Context, state string) (string, error) {
+	return authorizePath(p) + "?" + url.Values{"state": {state}}.Encode(), nil
+}
+
+// Exchange takes the email of the user to log in as, who is created if they don't
+// exist yet
+func (devProvider) Exchange(ctx context.Context, code string) (Account, error) {
+	email := strings.TrimSpace(code)
+	if !strings.Contains(email, "@") {
+		return Account{}, fmt.Errorf("%q is not an email", code)
+	}
+
+	account := Account{
+		Subject:       strings.ToLower(email),
+		EmailVerified: true,
+		Profile:       Profile{Name: email[:strings.Index(email, "@")], Email: email},
+	}
+
+	// Existing users keep their name and picture
+	if client := db.FromContext(ctx); client != nil {
+		u, err := client.User.Query().Where(user.EmailEqualFold(email)).Only(ctx)
+		if err == nil {
+			account.Profile = Profile{Name: u.Name, Email: u.Email, Picture: u.Picture}
+		} else if !db.IsNotFound(err) {
+			return Account{}, err
+		}
+	}
+
+	return account, nil
+}
+
+var devLoginPageTemplate = template.Must(template.New("dev").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>Development login</title>
+</head>
+<body>
+  <h1>Development login</h1>
+  <form action="{{.CallbackPath}}" method="get">
+    <input type="hidden" name="state" value="{{.State}}">
+    {{range .Users}}<p><button name="code" value="{{.Email}}">{{.Name}} ({{.Email}})</button></p>
+    {{end}}
+  </form>
+  <form action="{{.CallbackPath}}" method="get">
+    <input type="hidden" name="state" value="{{.State}}">
+    <p>
+      <input type="email" name="code" placeholder="Email" required>
+      <button>Log in or create user</button>
+    </p>
+  </form>
+</body>
+</html>
+`))
+
+// authorize lets the user choose who to log in as
+func (p devProvider) authorize(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var users []*db.User
+	if client := db.FromContext(ctx); client != nil {
+		var err error
+		users, err = client.User.
+			Query().
+			Order(db.Desc(user.FieldLastActive)).
+			Limit(devUserLimit).
+			All(ctx)
+		if err != nil {
+			log.Println(err)
+			errorPage(c, http.StatusInternalServerError, errorMessage)
+			return
+		}
+	}
+
+	c.Status(http.StatusOK)
+	c.Header("Content-Type", "text/html; charset=utf-8")
+	devLoginPageTemplate.Execute(c.Writer, struct {
+		CallbackPath string
+		State        string
+		Users        []*db.User
+	}{callbackPath(p), c.Query("state"), users})
+}
@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"html/template"
+
+	"github.com/NickDubelman/fantasy-bball/config"
+	"github.com/gin-gonic/gin"
+)
+
+var errorPageTemplate = template.Must(template.New("error").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>Error logging in</title>
+</head>
+<body>
+  <h1>Error logging in</h1>
+  <p>{{.Message}}</p>
+  <p><a href="{{.LoginPath}}">Try again</a> or go <a href="{{.FrontendURL}}">back</a></p>
+</body>
+</html>
+`))
+
+// errorPage responds with a page explaining that logging in failed
+func errorPage(c *gin.Context, status int, message string) {
+	c.Status(status)
+	c.Header("Content-Type", "text/html; charset=utf-8")
+
+	errorPageTemplate.Execute(c.Writer, struct {
+		Message     string
+		LoginPath   string
+		FrontendURL string
+	}{message, PathLogin, config.Get().Frontend.BaseURL})
+}
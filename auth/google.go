@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"io/ioutil"
+
+	"golang.org/x/oauth2/google"
+)
+
+// googleIssuer is Google's OpenID Connect issuer
+const googleIssuer = "https://accounts.google.com"
+
+// newGoogleProvider returns a Provider for Google, using the OAuth client JSON at
+// oauthConfigPath. The file should be obtained from the Google Developers Console's
+// "Credentials" page, with /auth/google/callback as its first redirect URI
+func newGoogleProvider(oauthConfigPath string) (*oidcProvider, error) {
+	jsonKey, err := ioutil.ReadFile(oauthConfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	conf, err := google.ConfigFromJSON(jsonKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return newOIDCProvider(OIDCConfig{
+		Name:         "google",
+		DisplayName:  "Google",
+		Issuer:       googleIssuer,
+		ClientID:     conf.ClientID,
+		ClientSecret: conf.ClientSecret,
+		RedirectURL:  conf.RedirectURL,
+	}), nil
+}
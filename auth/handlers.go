@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/gin-gonic/gin"
+)
+
+// Error types in the JSON error responses of the auth endpoints
+const (
+	ErrorTypeBadRequest    = "BadRequest"
+	ErrorTypeNotAuthorized = "NotAuthorized" // the user has to log in again
+	ErrorTypeTokenExpired  = "TokenExpired"  // the token used was valid, but expired
+	ErrorTypeInternal      = "Internal"
+)
+
+// ErrorResponse is the JSON body of an auth endpoint's error response
+type ErrorResponse struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+// respondError responds with a JSON error whose type and status match err
+func respondError(c *gin.Context, err error) {
+	status, typ := http.StatusInternalServerError, ErrorTypeInternal
+	switch err.(type) {
+	case NotAuthorized:
+		status, typ = http.StatusUnauthorized, ErrorTypeNotAuthorized
+	case TokenExpired:
+		status, typ = http.StatusUnauthorized, ErrorTypeTokenExpired
+	}
+
+	c.JSON(status, gin.H{"error": ErrorResponse{Type: typ, Message: err.Error()}})
+}
+
+// bindJSON binds the request body to v, responding with an error if it can't
+func bindJSON(c *gin.Context, v interface{}) bool {
+	if err := c.ShouldBindJSON(v); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": ErrorResponse{Type: ErrorTypeBadRequest, Message: err.Error()},
+		})
+		return false
+	}
+	return true
+}
+
+// dbFromGinContext returns the db client attached to the request context, responding
+// with an error if there isn't one
+func dbFromGinContext(c *gin.Context) (*db.Client, bool) {
+	client := db.FromContext(c.Request.Context())
+	if client == nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": ErrorResponse{
+				Type:    ErrorTypeInternal,
+				Message: "could not retrieve db client from context",
+			},
+		})
+		return nil, false
+	}
+	return client, true
+}
+
+// handleTokenExchange exchanges the auth code in the request body for the user's
+// tokens. This is meant to be called by the frontend's server, so that the tokens
+// never pass through the user's browser history
+func handleTokenExchange(c *gin.Context) {
+	var body struct {
+		Code string `json:"code" binding:"required"`
+	}
+	if !bindJSON(c, &body) {
+		return
+	}
+
+	client, ok := dbFromGinContext(c)
+	if !ok {
+		return
+	}
+
+	tokens, err := exchangeAuthCode(c.Request.Context(), client, body.Code)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, tokens)
+}
+
+// handleRefresh takes a user's token pair and responds with a new one. The refresh
+// token is rotated along with the access token
+func handleRefresh(c *gin.Context) {
+	var body struct {
+		AccessToken  string `json:"accessToken" binding:"required"`
+		RefreshToken string `json:"refreshToken" binding:"required"`
+	}
+	if !bindJSON(c, &body) {
+		return
+	}
+
+	tokens, err := RefreshTokens(c.Request.Context(), body.AccessToken, body.RefreshToken)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, tokens)
+}
+
+// handleLogout revokes the refresh token in the request body, along with every token
+// descended from the same login. It succeeds even if the token is unknown or was
+// already revoked, so that logging out never fails
+func handleLogout(c *gin.Context) {
+	var body struct {
+		RefreshToken string `json:"refreshToken" binding:"required"`
+	}
+	if !bindJSON(c, &body) {
+		return
+	}
+
+	client, ok := dbFromGinContext(c)
+	if !ok {
+		return
+	}
+
+	if err := Logout(c.Request.Context(), client, body.RefreshToken); err != nil {
+		respondError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// handleJWKS responds with the public keys that tokens can be verified with. Other
+// services should cache them, but not for so long that they miss a new key
+func handleJWKS(c *gin.Context, keys *keySet) {
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, keys.jwks())
+}
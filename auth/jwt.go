@@ -5,7 +5,6 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/NickDubelman/fantasy-bball/config"
 	"github.com/dgrijalva/jwt-go"
 )
 
@@ -14,9 +13,25 @@ const (
 	refreshTokenDuration = 7 * 24 * time.Hour // 7 days
 )
 
+const (
+	// tokenIssuer is the iss of our access tokens
+	tokenIssuer = "fantasy-bball"
+
+	// accessTokenAudience is the aud of our access tokens. It keeps other tokens
+	// signed with the same keys from being used as access tokens
+	accessTokenAudience = "fantasy-bball-api"
+)
+
+// Profile is what an access token says about its user
+type Profile struct {
+	Name    string `json:"name"`
+	Email   string `json:"email"`
+	Picture string `json:"picture"`
+}
+
 // UserInfo represents the claims contained within an access token
 type UserInfo struct {
-	GoogleUserInfo
+	Profile
 	jwt.StandardClaims
 }
 
@@ -29,100 +44,38 @@ func (ui UserInfo) ID() int {
 	return id
 }
 
-// refreshClaims represents the claims contained within a refresh token
-type refreshClaims struct {
-	jwt.StandardClaims
-}
-
-// RefreshAccessToken takes a user's accessToken and their refreshToken and generates
-// a new accessToken for the user
-func RefreshAccessToken(
-	ctx context.Context,
-	accessToken string,
-	refreshToken string,
-) (string, error) {
-	// Parse the access token
-	aClaims := &UserInfo{}
-	tkn, err := parseToken(accessToken, aClaims)
-	if err != nil {
-		return "", err
-	}
-
-	// Ensure the access token is valid
-	if !tkn.Valid {
-		return "", NotAuthorized{}
-	}
-
-	// Parse the refresh token
-	rClaims := &refreshClaims{}
-	tkn, err = parseToken(refreshToken, rClaims)
-	if err != nil {
-		return "", err
-	}
-
-	now := time.Now()
-	expires := time.Unix(rClaims.IssuedAt, 0).Add(refreshTokenDuration)
-
-	// Ensure the refresh token is valid
-	if !tkn.Valid {
-		return "", NotAuthorized{}
-	}
-
-	// Ensure the refresh token isnt expired
-	if now.After(expires) {
-		return "", TokenExpired{}
-	}
-
-	userID, err := strconv.Atoi(aClaims.Subject)
-	if err != nil {
-		return "", err
-	}
-
-	// Generate a new token with the same claims
-	return createAccessToken(ctx, userID, aClaims.GoogleUserInfo)
+// parseToken parses a token signed with one of our keys into claims
+func parseToken(tokenStr string, claims jwt.Claims) (*jwt.Token, error) {
+	return jwt.ParseWithClaims(tokenStr, claims, getKeySet().keyFunc)
 }
 
-func parseToken(tokenStr string, claims jwt.Claims) (*jwt.Token, error) {
-	return jwt.ParseWithClaims(
-		tokenStr,
-		claims,
-		func(token *jwt.Token) (interface{}, error) {
-			return []byte(config.Get().AuthSecret), nil
-		},
-	)
+// onlyExpired returns whether an error from parseToken means that the token is
+// expired and that there is nothing else wrong with it
+func onlyExpired(err error) bool {
+	vErr, ok := err.(*jwt.ValidationError)
+	return ok && vErr.Errors == jwt.ValidationErrorExpired
 }
 
-// createAccessToken takes some userInfo and creates a JWT access token
+// createAccessToken takes a user's profile and creates a JWT access token
 func createAccessToken(
 	ctx context.Context,
 	userID int,
-	userInfo GoogleUserInfo,
+	profile Profile,
 ) (string, error) {
+	now := time.Now()
 	claims := jwt.MapClaims{
 		// Reserved claims
+		"iss": tokenIssuer,
+		"aud": accessTokenAudience,
 		"sub": strconv.Itoa(userID),
-		"iat": time.Now().Unix(),
+		"iat": now.Unix(),
+		"exp": now.Add(accessTokenDuration).Unix(),
 
 		// Our claims
-		"name":    userInfo.Name,
-		"email":   userInfo.Email,
-		"picture": userInfo.Picture,
-	}
-
-	return jwt.
-		NewWithClaims(jwt.SigningMethodHS256, claims).
-		SignedString([]byte(config.Get().AuthSecret))
-}
-
-// createRefreshToken takes a userID and creates a JWT refresh token
-func createRefreshToken(userID int) (string, error) {
-	claims := jwt.MapClaims{
-		// Reserved claims
-		"sub": strconv.Itoa(userID),
-		"iat": time.Now().Unix(),
+		"name":    profile.Name,
+		"email":   profile.Email,
+		"picture": profile.Picture,
 	}
 
-	return jwt.
-		NewWithClaims(jwt.SigningMethodHS256, claims).
-		SignedString([]byte(config.Get().AuthSecret))
+	return getKeySet().sign(claims)
 }
@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+func TestValidateAccessToken(t *testing.T) {
+	token, err := createAccessToken(context.Background(), 7, Profile{Name: "Kobe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	claims := func(modify func(c jwt.MapClaims)) jwt.MapClaims {
+		c := jwt.MapClaims{
+			"iss": tokenIssuer,
+			"aud": accessTokenAudience,
+			"sub": "7",
+			"iat": now.Unix(),
+			"exp": now.Add(accessTokenDuration).Unix(),
+		}
+		modify(c)
+		return c
+	}
+	sign := func(c jwt.MapClaims) string {
+		s, err := getKeySet().sign(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{name: "valid", token: token},
+		{
+			name: "expired",
+			token: sign(claims(func(c jwt.MapClaims) {
+				c["iat"] = now.Add(-2 * accessTokenDuration).Unix()
+				c["exp"] = now.Add(-accessTokenDuration).Unix()
+			})),
+			wantErr: TokenExpired{},
+		},
+		{
+			name:    "no expiry",
+			token:   sign(claims(func(c jwt.MapClaims) { delete(c, "exp") })),
+			wantErr: NotAuthorized{},
+		},
+		{
+			name:    "wrong issuer",
+			token:   sign(claims(func(c jwt.MapClaims) { c["iss"] = "someone-else" })),
+			wantErr: NotAuthorized{},
+		},
+		{
+			name:    "wrong audience",
+			token:   sign(claims(func(c jwt.MapClaims) { c["aud"] = "oauth-state" })),
+			wantErr: NotAuthorized{},
+		},
+		{
+			name: "expired with the wrong audience",
+			token: sign(claims(func(c jwt.MapClaims) {
+				c["aud"] = "oauth-state"
+				c["exp"] = now.Add(-time.Minute).Unix()
+			})),
+			wantErr: NotAuthorized{},
+		},
+		{name: "tampered", token: token + "x", wantErr: NotAuthorized{}},
+		{name: "garbage", token: "not a token", wantErr: NotAuthorized{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ui, err := validateAccessToken(tt.token)
+			if err != tt.wantErr {
+				t.Fatalf("validateAccessToken() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && ui.ID() != 7 {
+				t.Errorf("ID() = %d, want 7", ui.ID())
+			}
+		})
+	}
+}
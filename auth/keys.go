@@ -0,0 +1,254 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"math/big"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/NickDubelman/fantasy-bball/config"
+	"github.com/dgrijalva/jwt-go"
+)
+
+// minRSABits is the smallest RSA key that tokens can be signed or verified with
+const minRSABits = 2048
+
+// signingKey is a key that tokens are verified with. Keys that are being rotated out
+// only have a public key, the others can sign tokens too
+type signingKey struct {
+	id      string
+	method  jwt.SigningMethod
+	public  crypto.PublicKey
+	private crypto.Signer // nil if the key can't sign
+}
+
+// keySet is every key that tokens can be verified with, one of which signs new tokens.
+// Having more than one key lets them be rotated without logging everyone out: tokens
+// signed with the old key keep working while new ones are signed with the new key
+type keySet struct {
+	signing *signingKey
+	keys    map[string]*signingKey // by ID
+}
+
+var (
+	keysOnce sync.Once
+	keys     *keySet
+)
+
+// getKeySet returns the keys from config.Get().Keys, loading them the first time
+func getKeySet() *keySet {
+	keysOnce.Do(func() {
+		c := config.Get().Keys
+
+		var err error
+		if c.Dir == "" {
+			keys, err = generateKeySet()
+		} else {
+			keys, err = loadKeySet(c.Dir, c.SigningKeyID)
+		}
+		if err != nil {
+			log.Fatalf("Could not load signing keys: %v", err)
+		}
+	})
+	return keys
+}
+
+// loadKeySet reads the *.pem files in dir, which are named after their key ID. The
+// key with signingKeyID signs new tokens. It can be empty if dir only has one private
+// key
+func loadKeySet(dir, signingKeyID string) (*keySet, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+
+	ks := &keySet{keys: map[string]*signingKey{}}
+	var private []string
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		id := strings.TrimSuffix(filepath.Base(path), ".pem")
+		k, err := parseKey(id, data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		ks.keys[id] = k
+		if k.private != nil {
+			private = append(private, id)
+		}
+	}
+
+	if signingKeyID == "" {
+		if len(private) != 1 {
+			return nil, fmt.Errorf(
+				"%s has %d private keys, set BBALL_SIGNING_KEY_ID to the one that signs tokens",
+				dir,
+				len(private),
+			)
+		}
+		signingKeyID = private[0]
+	}
+
+	ks.signing = ks.keys[signingKeyID]
+	if ks.signing == nil || ks.signing.private == nil {
+		return nil, fmt.Errorf("%s has no private key %q", dir, signingKeyID)
+	}
+
+	return ks, nil
+}
+
+// generateKeySet returns a key set with a new Ed25519 key. Tokens signed with it stop
+// working once the server restarts, so it's only meant for development
+func generateKeySet() (*keySet, error) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := RandomToken()
+	if err != nil {
+		return nil, err
+	}
+
+	k := &signingKey{
+		id:      id[:8],
+		method:  signingMethodEd25519,
+		public:  public,
+		private: private,
+	}
+	return &keySet{signing: k, keys: map[string]*signingKey{k.id: k}}, nil
+}
+
+// parseKey parses a PEM encoded private key (PKCS #1 or PKCS #8) or public key (PKIX)
+func parseKey(id string, data []byte) (*signingKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data")
+	}
+
+	var parsed interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	k := &signingKey{id: id}
+	if signer, ok := parsed.(crypto.Signer); ok {
+		k.private = signer
+		parsed = signer.Public()
+	}
+
+	switch public := parsed.(type) {
+	case *rsa.PublicKey:
+		if public.N.BitLen() < minRSABits {
+			return nil, fmt.Errorf("RSA keys must have at least %d bits", minRSABits)
+		}
+		k.method = jwt.SigningMethodRS256
+	case ed25519.PublicKey:
+		k.method = signingMethodEd25519
+	default:
+		return nil, fmt.Errorf("unsupported key type %T, use RSA or Ed25519", parsed)
+	}
+	k.public = parsed
+
+	return k, nil
+}
+
+// sign signs claims with the signing key, and says which key that was in the kid
+// header
+func (ks *keySet) sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(ks.signing.method, claims)
+	token.Header["kid"] = ks.signing.id
+	return token.SignedString(ks.signing.private)
+}
+
+// keyFunc returns the public key to verify a token with, going by its kid header. The
+// token must use the same algorithm as the key, so that e.g. a public key can't be
+// passed off as an HMAC secret
+func (ks *keySet) keyFunc(token *jwt.Token) (interface{}, error) {
+	id, _ := token.Header["kid"].(string)
+	k, ok := ks.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", id)
+	}
+
+	if token.Method.Alg() != k.method.Alg() {
+		return nil, fmt.Errorf("key %q can't verify %s tokens", id, token.Method.Alg())
+	}
+
+	return k.public, nil
+}
+
+// JWK is a public key in the JSON Web Key format (RFC 7517)
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+
+	// RSA keys
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+
+	// Ed25519 keys
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+}
+
+// JWKS is a JSON Web Key Set
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// jwks returns the public keys of every key in the set, so that other services can
+// verify tokens themselves
+func (ks *keySet) jwks() JWKS {
+	set := JWKS{Keys: []JWK{}}
+	for _, k := range ks.keys {
+		jwk := JWK{KeyID: k.id, Use: "sig", Algorithm: k.method.Alg()}
+
+		encode := base64.RawURLEncoding.EncodeToString
+		switch public := k.public.(type) {
+		case *rsa.PublicKey:
+			jwk.KeyType = "RSA"
+			jwk.N = encode(public.N.Bytes())
+			jwk.E = encode(big.NewInt(int64(public.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.KeyType = "OKP"
+			jwk.Curve = "Ed25519"
+			jwk.X = encode(public)
+		}
+
+		set.Keys = append(set.Keys, jwk)
+	}
+
+	sort.Slice(set.Keys, func(i, j int) bool {
+		return set.Keys[i].KeyID < set.Keys[j].KeyID
+	})
+	return set
+}
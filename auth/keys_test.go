@@ -0,0 +1,194 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+// writeKey writes a key to dir/<id>.pem, as a PKCS #8 private key or a PKIX public key
+func writeKey(t *testing.T, dir, id string, key interface{}) {
+	t.Helper()
+
+	var block *pem.Block
+	switch key.(type) {
+	case *rsa.PrivateKey, ed25519.PrivateKey:
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		block = &pem.Block{Type: "PRIVATE KEY", Bytes: der}
+	default:
+		der, err := x509.MarshalPKIXPublicKey(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		block = &pem.Block{Type: "PUBLIC KEY", Bytes: der}
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, id+".pem"), pem.EncodeToMemory(block), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestKeyRotation(t *testing.T) {
+	oldKey, err := rsa.GenerateKey(rand.Reader, minRSABits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newPublic, newKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims := jwt.MapClaims{"sub": "7"}
+
+	// Before the rotation, the old key is the only one and signs everything
+	before := t.TempDir()
+	writeKey(t, before, "2021-01", oldKey)
+	ks, err := loadKeySet(before, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldToken, err := ks.sign(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// While both keys have their private key, the signing key has to be picked
+	both := t.TempDir()
+	writeKey(t, both, "2021-01", oldKey)
+	writeKey(t, both, "2021-06", newKey)
+	if _, err := loadKeySet(both, ""); err == nil {
+		t.Error("loadKeySet() with two private keys and no signing key ID succeeded, want an error")
+	}
+	ks, err = loadKeySet(both, "2021-06")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ks.signing.id != "2021-06" {
+		t.Errorf("signing key = %q, want %q", ks.signing.id, "2021-06")
+	}
+
+	// Once the old key is retired, only its public key is published
+	after := t.TempDir()
+	writeKey(t, after, "2021-01", &oldKey.PublicKey)
+	writeKey(t, after, "2021-06", newKey)
+	if _, err := loadKeySet(after, "2021-01"); err == nil {
+		t.Error("loadKeySet() with a public signing key succeeded, want an error")
+	}
+	ks, err = loadKeySet(after, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newToken, err := ks.sign(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := jwt.Parse(newToken, ks.keyFunc)
+	if err != nil {
+		t.Fatalf("token signed with the new key: %v", err)
+	}
+	if kid := parsed.Header["kid"]; kid != "2021-06" {
+		t.Errorf("kid = %v, want %q", kid, "2021-06")
+	}
+
+	// Tokens signed before the rotation keep working until they expire
+	if _, err := jwt.Parse(oldToken, ks.keyFunc); err != nil {
+		t.Errorf("token signed with the retired key: %v", err)
+	}
+
+	// Tokens signed with keys that aren't in the set don't
+	other, err := generateKeySet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherToken, err := other.sign(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := jwt.Parse(otherToken, ks.keyFunc); err == nil {
+		t.Error("token signed with an unknown key was accepted")
+	}
+
+	// Neither do tokens that use a different algorithm than their kid's key
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	forged.Header["kid"] = "2021-01"
+	forgedToken, err := forged.SignedString(x509.MarshalPKCS1PublicKey(&oldKey.PublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := jwt.Parse(forgedToken, ks.keyFunc); err == nil {
+		t.Error("HS256 token with an RSA key's kid was accepted")
+	}
+
+	encode := base64.RawURLEncoding.EncodeToString
+	want := JWKS{Keys: []JWK{
+		{
+			KeyType:   "RSA",
+			KeyID:     "2021-01",
+			Use:       "sig",
+			Algorithm: "RS256",
+			N:         encode(oldKey.N.Bytes()),
+			E:         "AQAB",
+		},
+		{
+			KeyType:   "OKP",
+			KeyID:     "2021-06",
+			Use:       "sig",
+			Algorithm: "EdDSA",
+			Curve:     "Ed25519",
+			X:         encode(newPublic),
+		},
+	}}
+	if got := ks.jwks(); !reflect.DeepEqual(got, want) {
+		t.Errorf("jwks() = %+v, want %+v", got, want)
+	}
+}
+
+func TestLoadKeySetErrors(t *testing.T) {
+	small, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		write func(dir string)
+	}{
+		{"no keys", func(dir string) {}},
+		{"only public keys", func(dir string) {
+			public, _, err := ed25519.GenerateKey(rand.Reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+			writeKey(t, dir, "public", public)
+		}},
+		{"small RSA key", func(dir string) { writeKey(t, dir, "small", small) }},
+		{"not PEM", func(dir string) {
+			if err := os.WriteFile(filepath.Join(dir, "garbage.pem"), []byte("garbage"), 0600); err != nil {
+				t.Fatal(err)
+			}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			tt.write(dir)
+
+			if _, err := loadKeySet(dir, ""); err == nil {
+				t.Error("loadKeySet() succeeded, want an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"html/template"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+var loginPageTemplate = template.Must(template.New("login").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>Log in</title>
+</head>
+<body>
+  <h1>Log in</h1>
+  <ul>
+    {{range .}}<li><a href="{{.URL}}">Log in with {{.Name}}</a></li>
+    {{end}}
+  </ul>
+</body>
+</html>
+`))
+
+// chooseProvider lets the user choose which provider to log in with. If there's only
+// one, they are sent straight to it
+func chooseProvider(c *gin.Context, providers []Provider) {
+	// Pass along where to send the user afterwards
+	withQuery := func(path string) string {
+		if c.Request.URL.RawQuery == "" {
+			return path
+		}
+		return path + "?" + c.Request.URL.RawQuery
+	}
+
+	if len(providers) == 1 {
+		http.Redirect(c.Writer, c.Request, withQuery(loginPath(providers[0])), codeRedirect)
+		return
+	}
+
+	type link struct{ Name, URL string }
+	links := make([]link, len(providers))
+	for i, p := range providers {
+		links[i] = link{p.DisplayName(), withQuery(loginPath(p))}
+	}
+
+	c.Status(http.StatusOK)
+	c.Header("Content-Type", "text/html; charset=utf-8")
+	loginPageTemplate.Execute(c.Writer, links)
+}
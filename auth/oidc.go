@@ -0,0 +1,257 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"golang.org/x/oauth2"
+)
+
+// OIDCConfig configures an OpenID Connect provider. Everything else about it is read
+// from its discovery document at {issuer}/.well-known/openid-configuration
+type OIDCConfig struct {
+	Name         string   `json:"name"`        // used in its paths, e.g. /auth/{name}/login
+	DisplayName  string   `json:"displayName"` // defaults to the name
+	Issuer       string   `json:"issuer"`      // e.g. https://accounts.google.com
+	ClientID     string   `json:"clientID"`
+	ClientSecret string   `json:"clientSecret"`
+	RedirectURL  string   `json:"redirectURL"` // must end with /auth/{name}/callback
+	Scopes       []string `json:"scopes"`      // defaults to openid, profile and email
+}
+
+// oidcDiscovery is the part of an OpenID Connect discovery document we use
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+// oidcProvider is a Provider that logs users in with OpenID Connect's authorization
+// code flow
+type oidcProvider struct {
+	config OIDCConfig
+
+	mu        sync.Mutex
+	discovery *oidcDiscovery // fetched the first time someone logs in
+}
+
+var _ Provider = (*oidcProvider)(nil)
+
+func newOIDCProvider(c OIDCConfig) *oidcProvider {
+	if c.DisplayName == "" {
+		c.DisplayName = c.Name
+	}
+	if len(c.Scopes) == 0 {
+		c.Scopes = []string{"openid", "profile", "email"}
+	}
+	c.Issuer = strings.TrimSuffix(c.Issuer, "/")
+	return &oidcProvider{config: c}
+}
+
+func (p *oidcProvider) Name() string {
+	return p.config.Name
+}
+
+func (p *oidcProvider) DisplayName() string {
+	return p.config.DisplayName
+}
+
+func (p *oidcProvider) AuthURL(ctx context.Context, state string) (string, error) {
+	cfg, _, err := p.oauth2Config(ctx)
+	if err != nil {
+		return "", err
+	}
+	return cfg.AuthCodeURL(state, oauth2.SetAuthURLParam("prompt", "login")), nil
+}
+
+func (p *oidcProvider) Exchange(ctx context.Context, code string) (Account, error) {
+	cfg, d, err := p.oauth2Config(ctx)
+	if err != nil {
+		return Account{}, err
+	}
+
+	t, err := cfg.Exchange(ctx, code)
+	if err != nil {
+		return Account{}, err
+	}
+
+	rawIDToken, _ := t.Extra("id_token").(string)
+	if rawIDToken == "" {
+		return Account{}, fmt.Errorf("%s did not return an ID token", p.config.Issuer)
+	}
+
+	// The ID token came straight from the token endpoint over TLS, so its signature
+	// doesn't need to be checked (OpenID Connect Core 3.1.3.7). Its claims still do
+	claims := &oidcClaims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(rawIDToken, claims); err != nil {
+		return Account{}, err
+	}
+	if claims.Issuer != d.Issuer ||
+		!claims.Audience.contains(cfg.ClientID) ||
+		time.Now().Unix() > claims.ExpiresAt ||
+		claims.Subject == "" {
+		return Account{}, fmt.Errorf("invalid ID token from %s", p.config.Issuer)
+	}
+
+	// ID tokens don't have to include the user's profile, but the userinfo endpoint
+	// does
+	if d.UserinfoEndpoint != "" {
+		sub := claims.Subject
+		if err := p.userinfo(ctx, cfg.Client(ctx, t), d.UserinfoEndpoint, claims); err != nil {
+			return Account{}, err
+		}
+		if claims.Subject != sub {
+			return Account{}, fmt.Errorf("userinfo from %s is for someone else", p.config.Issuer)
+		}
+	}
+
+	return Account{
+		Subject:       claims.Subject,
+		EmailVerified: claims.EmailVerified.verified(),
+		Profile: Profile{
+			Name:    claims.Name,
+			Email:   claims.Email,
+			Picture: claims.Picture,
+		},
+	}, nil
+}
+
+// userinfo decodes the response of the userinfo endpoint into claims
+func (p *oidcProvider) userinfo(ctx context.Context, client *http.Client, endpoint string, claims *oidcClaims) error {
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("getting userinfo from %s: %s", p.config.Issuer, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(claims)
+}
+
+// oauth2Config returns the provider's OAuth2 config along with its discovery document,
+// which is fetched the first time
+func (p *oidcProvider) oauth2Config(ctx context.Context) (*oauth2.Config, *oidcDiscovery, error) {
+	d, err := p.discover(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &oauth2.Config{
+		ClientID:     p.config.ClientID,
+		ClientSecret: p.config.ClientSecret,
+		RedirectURL:  p.config.RedirectURL,
+		Scopes:       p.config.Scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  d.AuthorizationEndpoint,
+			TokenURL: d.TokenEndpoint,
+		},
+	}, d, nil
+}
+
+// discover fetches the provider's discovery document, unless it already has. Failures
+// aren't remembered, so the provider being down doesn't break logins until a restart
+func (p *oidcProvider) discover(ctx context.Context) (*oidcDiscovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	url := p.config.Issuer + "/.well-known/openid-configuration"
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("getting %s: %s", url, resp.Status)
+	}
+
+	d := &oidcDiscovery{}
+	if err := json.NewDecoder(resp.Body).Decode(d); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", url, err)
+	}
+
+	// The issuer has to match exactly, or ID tokens from it won't be accepted
+	if strings.TrimSuffix(d.Issuer, "/") != p.config.Issuer ||
+		d.AuthorizationEndpoint == "" ||
+		d.TokenEndpoint == "" {
+		return nil, fmt.Errorf("%s is not a valid discovery document for %s", url, p.config.Issuer)
+	}
+
+	p.discovery = d
+	return d, nil
+}
+
+// oidcClaims are the claims of an ID token, and of a userinfo response
+type oidcClaims struct {
+	Issuer    string       `json:"iss"`
+	Subject   string       `json:"sub"`
+	Audience  oidcAudience `json:"aud"`
+	ExpiresAt int64        `json:"exp"`
+
+	Name          string        `json:"name"`
+	Email         string        `json:"email"`
+	EmailVerified oidcBoolClaim `json:"email_verified"`
+	Picture       string        `json:"picture"`
+}
+
+// Valid is checked by Exchange, which knows what to check it against
+func (c *oidcClaims) Valid() error {
+	return nil
+}
+
+// oidcAudience is an aud claim, which can be a string or an array of strings
+type oidcAudience []string
+
+func (a *oidcAudience) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*a = oidcAudience{s}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(a))
+}
+
+func (a oidcAudience) contains(clientID string) bool {
+	for _, aud := range a {
+		if aud == clientID {
+			return true
+		}
+	}
+	return false
+}
+
+// oidcBoolClaim is a boolean claim. Some providers send booleans as strings
+type oidcBoolClaim json.RawMessage
+
+func (b *oidcBoolClaim) UnmarshalJSON(data []byte) error {
+	*b = append((*b)[:0], data...)
+	return nil
+}
+
+func (b oidcBoolClaim) verified() bool {
+	s := string(b)
+	return s == "true" || s == `"true"`
+}
@@ -0,0 +1,147 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/NickDubelman/fantasy-bball/config"
+	"github.com/gin-gonic/gin"
+)
+
+// Provider is an identity provider that users can log in with, e.g. Google. Each one
+// has its own login and callback paths
+type Provider interface {
+	// Name identifies the provider in its paths, e.g. google in /auth/google/login
+	Name() string
+
+	// DisplayName is shown to users choosing how to log in
+	DisplayName() string
+
+	// AuthURL returns the URL to send the user to so that they log in with the
+	// provider. The provider sends them back to the provider's callback path with the
+	// given state and a code
+	AuthURL(ctx context.Context, state string) (string, error)
+
+	// Exchange exchanges the code sent back to the callback for the account the user
+	// logged in with
+	Exchange(ctx context.Context, code string) (Account, error)
+}
+
+// authorizer is a Provider that serves its own login page at its authorize path,
+// instead of sending users to someone else's
+type authorizer interface {
+	Provider
+	authorize(c *gin.Context)
+}
+
+// authorizePath is the path of an authorizer's login page
+func authorizePath(p Provider) string {
+	return "/auth/" + p.Name() + "/authorize"
+}
+
+// Account is a user's account with a Provider
+type Account struct {
+	// The provider's ID for the account, which never changes
+	Subject string
+
+	// Whether the provider has verified that the account owns its email. Accounts
+	// are linked to users by email, so unverified emails can't be trusted
+	EmailVerified bool
+
+	Profile
+}
+
+// providerNamePattern is what provider names look like, since they end up in paths
+var providerNamePattern = regexp.MustCompile(`^[a-z0-9-]+$`)
+
+// loginPath is the path that starts logging in with the provider
+func loginPath(p Provider) string {
+	return "/auth/" + p.Name() + "/login"
+}
+
+// callbackPath is the path that the provider sends users back to after they log in
+func callbackPath(p Provider) string {
+	return "/auth/" + p.Name() + "/callback"
+}
+
+// providersFromConfig returns the providers users can log in with: Google, if its
+// client JSON exists, followed by the OpenID Connect providers in the providers file
+// and the dev provider, if it's enabled
+func providersFromConfig(c config.Configuration) ([]Provider, error) {
+	var providers []Provider
+
+	if _, err := os.Stat(c.OAuthConfigPath); err == nil {
+		google, err := newGoogleProvider(c.OAuthConfigPath)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", c.OAuthConfigPath, err)
+		}
+		providers = append(providers, google)
+	}
+
+	oidcConfigs, err := readOIDCConfigs(c.ProvidersPath)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", c.ProvidersPath, err)
+	}
+	for _, oc := range oidcConfigs {
+		providers = append(providers, newOIDCProvider(oc))
+	}
+
+	if c.DevLogin {
+		providers = append(providers, devProvider{})
+	}
+
+	names := map[string]bool{}
+	for _, p := range providers {
+		if !providerNamePattern.MatchString(p.Name()) {
+			return nil, fmt.Errorf("provider name %q must match %s", p.Name(), providerNamePattern)
+		}
+		if names[p.Name()] {
+			return nil, fmt.Errorf("there is more than one provider named %q", p.Name())
+		}
+		names[p.Name()] = true
+	}
+
+	if len(providers) == 0 {
+		return nil, fmt.Errorf(
+			"no login providers, add %s or %s, or set BBALL_DEV_LOGIN=true in development",
+			c.OAuthConfigPath,
+			c.ProvidersPath,
+		)
+	}
+
+	return providers, nil
+}
+
+// readOIDCConfigs reads the providers file, which is a JSON array of OIDCConfigs. A
+// missing file means there are none
+func readOIDCConfigs(path string) ([]OIDCConfig, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var configs []OIDCConfig
+	if err := json.NewDecoder(f).Decode(&configs); err != nil {
+		return nil, err
+	}
+
+	for i, oc := range configs {
+		if oc.Name == "" {
+			return nil, fmt.Errorf("provider %d has no name", i)
+		}
+		if oc.Issuer == "" || oc.ClientID == "" || oc.RedirectURL == "" {
+			return nil, fmt.Errorf(
+				"provider %q needs an issuer, clientID and redirectURL",
+				oc.Name,
+			)
+		}
+	}
+	return configs, nil
+}
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/NickDubelman/fantasy-bball/config"
+)
+
+// frontendCallbackPath is the page on the frontend that finishes logging in
+const frontendCallbackPath = "/login-callback"
+
+// frontendCallbackURL returns the URL of the frontend page that finishes logging in
+func frontendCallbackURL() (*url.URL, error) {
+	return url.Parse(config.Get().Frontend.BaseURL + frontendCallbackPath)
+}
+
+// safeRedirect returns next if it is safe to send a user to after they log in,
+// otherwise "/". It is safe if it is a path on the frontend, or a URL on one of the
+// allowed origins in the config. Anything else, including protocol-relative URLs
+// like //example.com, could be used for an open redirect
+func safeRedirect(next string) string {
+	u, err := url.Parse(next)
+	if err != nil {
+		return "/"
+	}
+
+	if u.Scheme != "" || u.Host != "" {
+		if u.User == nil && allowedOrigin(u.Scheme+"://"+strings.ToLower(u.Host)) {
+			return u.String()
+		}
+		return "/"
+	}
+
+	// Browsers treat backslashes like slashes, so /\example.com is like //example.com.
+	// The decoded path is checked, so that encoded slashes and backslashes are caught
+	// too, in case whatever follows the redirect decodes them
+	if !strings.HasPrefix(u.Path, "/") ||
+		strings.HasPrefix(u.Path, "//") ||
+		strings.Contains(u.Path, `\`) {
+		return "/"
+	}
+
+	// Rebuild the path so that nothing but the path, query and fragment survive
+	return (&url.URL{
+		Path:     u.Path,
+		RawPath:  u.RawPath,
+		RawQuery: u.RawQuery,
+		Fragment: u.Fragment,
+	}).String()
+}
+
+func allowedOrigin(origin string) bool {
+	for _, allowed := range config.Get().Frontend.AllowedRedirects {
+		if origin == allowed {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/NickDubelman/fantasy-bball/config"
+)
+
+func TestSafeRedirect(t *testing.T) {
+	// The frontend's origin is always allowed
+	frontend := config.Get().Frontend.AllowedRedirects[0]
+
+	tests := []struct {
+		name string
+		next string
+		want string
+	}{
+		{"empty", "", "/"},
+		{"root", "/", "/"},
+		{"path", "/leagues/1", "/leagues/1"},
+		{"path with query and fragment", "/leagues/1?tab=members#top", "/leagues/1?tab=members#top"},
+		{"relative path", "leagues/1", "/"},
+		{"protocol-relative", "//evil.com", "/"},
+		{"protocol-relative with path", "//evil.com/leagues", "/"},
+		{"backslash", `/\evil.com`, "/"},
+		{"backslashes", `\\evil.com`, "/"},
+		{"other origin", "https://evil.com", "/"},
+		{"other origin with path", "https://evil.com/leagues/1", "/"},
+		{"javascript", "javascript:alert(document.cookie)", "/"},
+		{"data", "data:text/html,<script>alert(1)</script>", "/"},
+		{"user info on an allowed origin", strings.Replace(frontend, "://", "://user@", 1), "/"},
+		{"allowed origin as user info", frontend + "@evil.com", "/"},
+		{"allowed origin as a subdomain", frontend + ".evil.com", "/"},
+		{"percent-encoded slashes", "/%2F%2Fevil.com", "/"},
+		{"percent-encoded slash in a path", "/leagues%2F1", "/leagues%2F1"},
+		{"percent-encoded slashes without a leading slash", "%2F%2Fevil.com", "/"},
+		{"percent-encoded backslash", "/%5Cevil.com", "/"},
+		{"allowed origin", frontend, frontend},
+		{"allowed origin with path", frontend + "/leagues/1?tab=members", frontend + "/leagues/1?tab=members"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := safeRedirect(tt.next); got != tt.want {
+				t.Errorf("safeRedirect(%q) = %q, want %q", tt.next, got, tt.want)
+			}
+		})
+	}
+}
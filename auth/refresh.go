@@ -0,0 +1,193 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/refreshtoken"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+)
+
+// TokenPair is a user's access token and refresh token
+type TokenPair struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+// RefreshTokens takes a user's accessToken and their refreshToken and generates a
+// new pair of tokens for the user. The refresh token is rotated: it can't be used
+// again, and if it is, someone must have stolen it, so every token descended from
+// the same login is revoked
+func RefreshTokens(ctx context.Context, accessToken, refreshToken string) (TokenPair, error) {
+	client := db.FromContext(ctx)
+	if client == nil {
+		return TokenPair{}, fmt.Errorf("could not retrieve db client from context")
+	}
+
+	// Parse the access token. It is allowed to be expired, that's the whole point
+	aClaims := &UserInfo{}
+	_, err := parseToken(accessToken, aClaims)
+	if err != nil && !onlyExpired(err) {
+		return TokenPair{}, NotAuthorized{} // malformed or not signed by us
+	}
+
+	var (
+		tokens TokenPair
+		reused bool
+	)
+
+	err = client.WithTx(ctx, func(tx *db.Tx) error {
+		rt, err := tx.RefreshToken.
+			Query().
+			Where(refreshtoken.TokenHash(HashToken(refreshToken))).
+			WithUser().
+			Only(ctx)
+		if db.IsNotFound(err) {
+			return NotAuthorized{}
+		}
+		if err != nil {
+			return err
+		}
+
+		// Ensure both tokens belong to the same user
+		if rt.Edges.User.ID != aClaims.ID() {
+			return NotAuthorized{}
+		}
+
+		if rt.Revoked != nil {
+			return NotAuthorized{}
+		}
+
+		now := time.Now()
+
+		// The token was already rotated, so this is either an attacker or the user
+		// racing an attacker. Either way, nobody gets to keep using this login. The
+		// revocation must be committed, so the error is returned after the transaction
+		if rt.Rotated != nil {
+			reused = true
+			return revokeFamily(ctx, tx.Client(), rt.Family, now)
+		}
+
+		// Ensure the refresh token isnt expired
+		if now.After(rt.Expires) {
+			return TokenExpired{}
+		}
+
+		// Rotating is conditional on the token not having been rotated already, in
+		// case the same token is used twice at the same time
+		n, err := tx.RefreshToken.
+			Update().
+			Where(refreshtoken.ID(rt.ID), refreshtoken.RotatedIsNil()).
+			SetRotated(now).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return NotAuthorized{}
+		}
+
+		tokens, err = createTokens(ctx, tx.Client(), rt.Edges.User, rt.Family)
+		return err
+	})
+	if err != nil {
+		return TokenPair{}, err
+	}
+	if reused {
+		return TokenPair{}, NotAuthorized{}
+	}
+
+	return tokens, nil
+}
+
+// Logout revokes the given refresh token, along with every token descended from the
+// same login. Unknown tokens are ignored
+func Logout(ctx context.Context, client *db.Client, refreshToken string) error {
+	rt, err := client.RefreshToken.
+		Query().
+		Where(refreshtoken.TokenHash(HashToken(refreshToken))).
+		Only(ctx)
+	if db.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return revokeFamily(ctx, client, rt.Family, time.Now())
+}
+
+// SignOutEverywhere revokes all of the user's refresh tokens. Access tokens that were
+// already issued keep working until they expire, which takes at most
+// accessTokenDuration
+func SignOutEverywhere(ctx context.Context, client *db.Client, userID int) error {
+	_, err := client.RefreshToken.
+		Update().
+		Where(
+			refreshtoken.HasUserWith(user.ID(userID)),
+			refreshtoken.RevokedIsNil(),
+		).
+		SetRevoked(time.Now()).
+		Save(ctx)
+	return err
+}
+
+// createTokens generates a new access token and refresh token for the user. The
+// refresh token joins the given family, or starts a new one if family is empty
+func createTokens(ctx context.Context, client *db.Client, u *db.User, family string) (TokenPair, error) {
+	profile := Profile{Name: u.Name, Email: u.Email, Picture: u.Picture}
+
+	accessToken, err := createAccessToken(ctx, u.ID, profile)
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	refreshToken, err := createRefreshToken(ctx, client, u.ID, family)
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	return TokenPair{AccessToken: accessToken, RefreshToken: refreshToken}, nil
+}
+
+// createRefreshToken creates and stores a refresh token for the user. The token joins
+// the given family, or starts a new one if family is empty
+func createRefreshToken(ctx context.Context, client *db.Client, userID int, family string) (string, error) {
+	token, err := RandomToken()
+	if err != nil {
+		return "", err
+	}
+
+	if family == "" {
+		if family, err = RandomToken(); err != nil {
+			return "", err
+		}
+	}
+
+	now := time.Now()
+	_, err = client.RefreshToken.
+		Create().
+		SetTokenHash(HashToken(token)).
+		SetFamily(family).
+		SetCreated(now).
+		SetExpires(now.Add(refreshTokenDuration)).
+		SetUserID(userID).
+		Save(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// revokeFamily revokes every token in the family that isn't already revoked
+func revokeFamily(ctx context.Context, client *db.Client, family string, now time.Time) error {
+	_, err := client.RefreshToken.
+		Update().
+		Where(refreshtoken.Family(family), refreshtoken.RevokedIsNil()).
+		SetRevoked(now).
+		Save(ctx)
+	return err
+}
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/enttest"
+	"github.com/NickDubelman/fantasy-bball/db/refreshtoken"
+)
+
+func TestRefreshTokens(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:refresh?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := db.NewContext(context.Background(), client)
+
+	u := client.User.Create().SetName("Kobe").SetEmail("kobe@example.com").SaveX(ctx)
+	other := client.User.Create().SetName("Shaq").SetEmail("shaq@example.com").SaveX(ctx)
+
+	login, err := createTokens(ctx, client, u, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	family := client.RefreshToken.
+		Query().
+		Where(refreshtoken.TokenHash(HashToken(login.RefreshToken))).
+		OnlyX(ctx).
+		Family
+
+	// Someone else's access token can't be used with the refresh token
+	otherAccessToken, err := createAccessToken(ctx, other.ID, Profile{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := RefreshTokens(ctx, otherAccessToken, login.RefreshToken); err != (NotAuthorized{}) {
+		t.Errorf("RefreshTokens() with another user's access token error = %v, want NotAuthorized", err)
+	}
+
+	rotated, err := RefreshTokens(ctx, login.AccessToken, login.RefreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rotated.RefreshToken == login.RefreshToken {
+		t.Error("RefreshTokens() returned the same refresh token, want a new one")
+	}
+
+	tokens := client.RefreshToken.Query().Where(refreshtoken.Family(family)).AllX(ctx)
+	if len(tokens) != 2 {
+		t.Fatalf("family has %d tokens, want the old and the new one", len(tokens))
+	}
+	for _, rt := range tokens {
+		wantRotated := rt.TokenHash == HashToken(login.RefreshToken)
+		if (rt.Rotated != nil) != wantRotated {
+			t.Errorf("token %d rotated = %v, want rotated = %v", rt.ID, rt.Rotated, wantRotated)
+		}
+	}
+
+	// Replaying the used refresh token revokes the whole family, including the token
+	// that replaced it
+	if _, err := RefreshTokens(ctx, login.AccessToken, login.RefreshToken); err != (NotAuthorized{}) {
+		t.Errorf("RefreshTokens() with a used token error = %v, want NotAuthorized", err)
+	}
+	if _, err := RefreshTokens(ctx, rotated.AccessToken, rotated.RefreshToken); err != (NotAuthorized{}) {
+		t.Errorf("RefreshTokens() after a replay error = %v, want NotAuthorized", err)
+	}
+
+	active := client.RefreshToken.
+		Query().
+		Where(refreshtoken.Family(family), refreshtoken.RevokedIsNil()).
+		CountX(ctx)
+	if active != 0 {
+		t.Errorf("%d tokens in the family aren't revoked, want all of them revoked", active)
+	}
+}
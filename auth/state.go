@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/NickDubelman/fantasy-bball/config"
+	"github.com/dgrijalva/jwt-go"
+)
+
+const (
+	// stateDuration is how long a user has to log in with the OAuth provider
+	stateDuration = 10 * time.Minute
+
+	// stateCookie holds the nonce of the login in progress in the user's browser
+	stateCookie = "oauth_state"
+
+	// stateAudience keeps other tokens signed with the same secret from being used as
+	// a state
+	stateAudience = "oauth-state"
+)
+
+// InvalidState is an error for when the OAuth state sent back to the callback is
+// forged, expired or from a different browser
+type InvalidState struct{}
+
+func (e InvalidState) Error() string {
+	return "invalid login state"
+}
+
+// stateClaims are the claims of an OAuth state. The state is a JWT, so it can't be
+// tampered with, and its nonce must match the one stored in the browser that started
+// logging in, so it can't be used to log someone else in (CSRF)
+type stateClaims struct {
+	Nonce    string `json:"nonce"`
+	Provider string `json:"provider"` // the name of the provider the user is logging in with
+	Next     string `json:"next"`     // where to send the user once they are logged in
+	jwt.StandardClaims
+}
+
+// newState returns a signed state for a login with the provider that should end up at
+// next, along with its nonce, which must be stored in the user's browser
+func newState(provider, next string) (state, nonce string, err error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	nonce = base64.RawURLEncoding.EncodeToString(b)
+
+	now := time.Now()
+	claims := stateClaims{
+		Nonce:    nonce,
+		Provider: provider,
+		Next:     safeRedirect(next),
+		StandardClaims: jwt.StandardClaims{
+			Audience:  stateAudience,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(stateDuration).Unix(),
+		},
+	}
+
+	state, err = jwt.
+		NewWithClaims(jwt.SigningMethodHS256, claims).
+		SignedString([]byte(config.Get().AuthSecret))
+	if err != nil {
+		return "", "", err
+	}
+
+	return state, nonce, nil
+}
+
+// verifyState checks a state sent back to the provider's callback against the nonce
+// stored in the user's browser, and returns where to send the user once they are
+// logged in
+func verifyState(state, nonce, provider string) (next string, err error) {
+	claims := &stateClaims{}
+	tkn, err := jwt.ParseWithClaims(
+		state,
+		claims,
+		func(token *jwt.Token) (interface{}, error) {
+			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, InvalidState{}
+			}
+			return []byte(config.Get().AuthSecret), nil
+		},
+	)
+	if err != nil || !tkn.Valid {
+		return "", InvalidState{} // bad signature or expired
+	}
+
+	if !claims.VerifyAudience(stateAudience, true) ||
+		nonce == "" ||
+		claims.Nonce != nonce ||
+		claims.Provider != provider {
+		return "", InvalidState{}
+	}
+
+	return safeRedirect(claims.Next), nil
+}
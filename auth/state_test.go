@@ -0,0 +1,144 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NickDubelman/fantasy-bball/config"
+	"github.com/dgrijalva/jwt-go"
+)
+
+// signState signs state claims like newState does, with the given secret
+func signState(t *testing.T, claims stateClaims, secret string) string {
+	t.Helper()
+
+	state, err := jwt.
+		NewWithClaims(jwt.SigningMethodHS256, claims).
+		SignedString([]byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return state
+}
+
+func TestVerifyState(t *testing.T) {
+	state, nonce, err := newState("google", "/leagues/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret := config.Get().AuthSecret
+	now := time.Now()
+	claims := func(modify func(c *stateClaims)) stateClaims {
+		c := stateClaims{
+			Nonce:    nonce,
+			Provider: "google",
+			Next:     "/leagues/1",
+			StandardClaims: jwt.StandardClaims{
+				Audience:  stateAudience,
+				IssuedAt:  now.Unix(),
+				ExpiresAt: now.Add(stateDuration).Unix(),
+			},
+		}
+		modify(&c)
+		return c
+	}
+
+	// Change a character in the middle of the signature. The last one might only
+	// hold padding bits
+	tampered := []byte(state)
+	i := len(tampered) - 10
+	if tampered[i] == 'A' {
+		tampered[i] = 'B'
+	} else {
+		tampered[i] = 'A'
+	}
+
+	unsigned, err := jwt.
+		NewWithClaims(jwt.SigningMethodNone, claims(func(*stateClaims) {})).
+		SignedString(jwt.UnsafeAllowNoneSignatureType)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		state    string
+		nonce    string
+		provider string
+		wantNext string
+		wantErr  bool
+	}{
+		{name: "valid", state: state, nonce: nonce, provider: "google", wantNext: "/leagues/1"},
+		{
+			name:     "unsafe next",
+			state:    signState(t, claims(func(c *stateClaims) { c.Next = "//evil.com" }), secret),
+			nonce:    nonce,
+			provider: "google",
+			wantNext: "/",
+		},
+		{name: "tampered signature", state: string(tampered), nonce: nonce, provider: "google", wantErr: true},
+		{
+			name:     "other secret",
+			state:    signState(t, claims(func(*stateClaims) {}), "not the secret"),
+			nonce:    nonce,
+			provider: "google",
+			wantErr:  true,
+		},
+		{name: "unsigned", state: unsigned, nonce: nonce, provider: "google", wantErr: true},
+		{name: "garbage", state: "not a state", nonce: nonce, provider: "google", wantErr: true},
+		{
+			name: "expired",
+			state: signState(t, claims(func(c *stateClaims) {
+				c.IssuedAt = now.Add(-2 * stateDuration).Unix()
+				c.ExpiresAt = now.Add(-stateDuration).Unix()
+			}), secret),
+			nonce:    nonce,
+			provider: "google",
+			wantErr:  true,
+		},
+		{
+			name:     "wrong audience",
+			state:    signState(t, claims(func(c *stateClaims) { c.Audience = "something-else" }), secret),
+			nonce:    nonce,
+			provider: "google",
+			wantErr:  true,
+		},
+		{
+			name:     "no audience",
+			state:    signState(t, claims(func(c *stateClaims) { c.Audience = "" }), secret),
+			nonce:    nonce,
+			provider: "google",
+			wantErr:  true,
+		},
+		{name: "wrong provider", state: state, nonce: nonce, provider: "dev", wantErr: true},
+		{name: "nonce mismatch", state: state, nonce: nonce + "x", provider: "google", wantErr: true},
+		{name: "empty cookie nonce", state: state, nonce: "", provider: "google", wantErr: true},
+		{
+			name:     "empty nonces",
+			state:    signState(t, claims(func(c *stateClaims) { c.Nonce = "" }), secret),
+			nonce:    "",
+			provider: "google",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next, err := verifyState(tt.state, tt.nonce, tt.provider)
+			if tt.wantErr {
+				if _, ok := err.(InvalidState); !ok {
+					t.Errorf("verifyState() = %q, %v, want InvalidState", next, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("verifyState() error = %v", err)
+			}
+			if next != tt.wantNext {
+				t.Errorf("verifyState() = %q, want %q", next, tt.wantNext)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/identity"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+)
+
+// loginUser returns the user that the account belongs to, creating them if needed.
+// An account that isn't linked to a user yet gets linked to the user with the same
+// email, so that logging in with another provider doesn't create a second user. The
+// account's email must be verified, or anyone could take over a user by signing up
+// with their email somewhere
+func loginUser(ctx context.Context, client *db.Client, provider string, account Account) (*db.User, error) {
+	var userID int
+	err := client.WithTx(ctx, func(tx *db.Tx) error {
+		id, err := tx.Identity.
+			Query().
+			Where(identity.Provider(provider), identity.Subject(account.Subject)).
+			WithUser().
+			Only(ctx)
+		if err == nil {
+			userID = id.Edges.User.ID
+			return id.Update().SetEmail(account.Email).Exec(ctx)
+		}
+		if !db.IsNotFound(err) {
+			return err
+		}
+
+		u, err := tx.User.Query().Where(user.EmailEqualFold(account.Email)).Only(ctx)
+		if db.IsNotFound(err) {
+			// If user that is logging in is not yet in our db, add them
+			u, err = tx.User.
+				Create().
+				SetName(account.Name).
+				SetEmail(account.Email).
+				SetPicture(account.Picture).
+				Save(ctx)
+		}
+		if err != nil {
+			return err
+		}
+		userID = u.ID
+
+		_, err = tx.Identity.
+			Create().
+			SetProvider(provider).
+			SetSubject(account.Subject).
+			SetEmail(account.Email).
+			SetUserID(u.ID).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the user's info up to date with whichever provider they used last
+	update := client.User.UpdateOneID(userID).SetLastActive(time.Now())
+	if account.Name != "" {
+		update.SetName(account.Name)
+	}
+	if account.Picture != "" {
+		update.SetPicture(account.Picture)
+	}
+	return update.Save(ctx)
+}
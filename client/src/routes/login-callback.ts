@@ -1,12 +1,62 @@
 import type polka from 'polka'
-import type http from 'http'
+import http from 'http'
+import https from 'https'
 import type session from 'express-session'
 
+// The API that issues the tokens. The server talks to it directly, so this has to be
+// reachable from the server rather than the browser
+const apiURL = process.env.API_URL || 'http://localhost:8080'
+
 interface UserSession extends session.Session {
   accessToken?: string
   refreshToken?: string
 }
 
+interface TokenPair {
+  accessToken: string
+  refreshToken: string
+}
+
+// Exchanges the single use code from the login redirect for the user's tokens
+function exchangeCode(code: string): Promise<TokenPair> {
+  const url = new URL('/auth/token', apiURL)
+  const body = JSON.stringify({ code })
+  const request = url.protocol === 'https:' ? https.request : http.request
+
+  return new Promise((resolve, reject) => {
+    const req = request(
+      url,
+      {
+        method: 'POST',
+        headers: {
+          'Content-Type': 'application/json',
+          'Content-Length': Buffer.byteLength(body),
+        },
+      },
+      (res) => {
+        let data = ''
+        res.setEncoding('utf8')
+        res.on('data', (chunk) => (data += chunk))
+        res.on('end', () => {
+          if (res.statusCode !== 200) {
+            reject(new Error(`exchanging login code: ${res.statusCode} ${data}`))
+            return
+          }
+
+          try {
+            resolve(JSON.parse(data))
+          } catch (err) {
+            reject(err)
+          }
+        })
+      }
+    )
+
+    req.on('error', reject)
+    req.end(body)
+  })
+}
+
 export async function get(
   request: polka.Request & { session: UserSession },
   response: http.ServerResponse,
@@ -14,8 +64,28 @@ export async function get(
 ) {
   const params = new URLSearchParams(request.search)
 
-  request.session.accessToken = params.get('accessToken')
-  request.session.refreshToken = params.get('refreshToken')
+  // The API sends a code to exchange for the tokens, unless it was started with
+  // BBALL_TOKENS_IN_REDIRECT (deprecated), in which case the tokens are in the URL
+  let tokens: TokenPair
+  const code = params.get('code')
+  if (code) {
+    try {
+      tokens = await exchangeCode(code)
+    } catch (err) {
+      console.error(err)
+      response.writeHead(302, { Location: '/auth/error' })
+      response.end()
+      return
+    }
+  } else {
+    tokens = {
+      accessToken: params.get('accessToken'),
+      refreshToken: params.get('refreshToken'),
+    }
+  }
+
+  request.session.accessToken = tokens.accessToken
+  request.session.refreshToken = tokens.refreshToken
   const nextURLEncoded = params.get('state')
 
   // Redirect user to where they were originally trying to go
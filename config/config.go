@@ -3,8 +3,17 @@ package config
 import (
 	"fmt"
 	"log"
+	"net/url"
 	"os"
 	"strconv"
+	"strings"
+	"time"
+)
+
+// Environments the app can run in
+const (
+	EnvDevelopment = "development"
+	EnvProduction  = "production"
 )
 
 func init() {
@@ -22,9 +31,83 @@ func init() {
 		log.Fatal("Could not parse BBALL_DB_PORT as int")
 	}
 
+	tokensInRedirect, err := strconv.ParseBool(getenv("BBALL_TOKENS_IN_REDIRECT", "false"))
+	if err != nil {
+		log.Fatal("Could not parse BBALL_TOKENS_IN_REDIRECT as bool")
+	}
+
+	devLogin, err := strconv.ParseBool(getenv("BBALL_DEV_LOGIN", "false"))
+	if err != nil {
+		log.Fatal("Could not parse BBALL_DEV_LOGIN as bool")
+	}
+
+	statsInterval, err := time.ParseDuration(getenv("BBALL_STATS_INTERVAL", "10m"))
+	if err != nil {
+		log.Fatal("Could not parse BBALL_STATS_INTERVAL as duration")
+	}
+
+	env := getenv("BBALL_ENV", EnvDevelopment)
+
+	// The frontend runs locally during development. Everywhere else, it has to be
+	// configured explicitly
+	var frontendURL string
+	switch env {
+	case EnvDevelopment:
+		frontendURL = getenv("FRONTEND_URL", "http://localhost:3000")
+	case EnvProduction:
+		frontendURL = getenv("FRONTEND_URL", "")
+		if frontendURL == "" {
+			log.Fatal("FRONTEND_URL must be set in production")
+		}
+	default:
+		log.Fatalf("Unknown BBALL_ENV %q", env)
+	}
+
+	if devLogin && env != EnvDevelopment {
+		log.Fatal("BBALL_DEV_LOGIN can only be used in development")
+	}
+
+	// Tokens are signed with keys generated on startup during development, which is
+	// only fine as long as nobody minds logging in again after a restart
+	keysDir := getenv("BBALL_KEYS_DIR", "")
+	if keysDir == "" && env == EnvProduction {
+		log.Fatal("BBALL_KEYS_DIR must be set in production")
+	}
+
+	authSecret := getenv("ACCESS_SECRET", "")
+	if authSecret == "" {
+		if env == EnvProduction {
+			log.Fatal("ACCESS_SECRET must be set in production")
+		}
+		authSecret = "Go Lakers! Very nice i like!"
+	}
+
+	frontendOrigin, err := origin(frontendURL)
+	if err != nil {
+		log.Fatalf("Could not parse FRONTEND_URL: %v", err)
+	}
+
+	// Other origins that users can be sent to after logging in, e.g. a second
+	// frontend deployment
+	allowedRedirects := []string{frontendOrigin}
+	for _, o := range strings.Split(getenv("BBALL_ALLOWED_REDIRECTS", ""), ",") {
+		if o = strings.TrimSpace(o); o == "" {
+			continue
+		}
+		allowed, err := origin(o)
+		if err != nil {
+			log.Fatalf("Could not parse BBALL_ALLOWED_REDIRECTS: %v", err)
+		}
+		allowedRedirects = append(allowedRedirects, allowed)
+	}
+
 	c = Configuration{
-		AuthSecret:      getenv("ACCESS_SECRET", "Go Lakers! Very nice i like!"),
-		OAuthConfigPath: getenv("OAUTH_CONFIG_PATH", "oauth-config.json"),
+		Environment:      env,
+		AuthSecret:       authSecret,
+		OAuthConfigPath:  getenv("OAUTH_CONFIG_PATH", "oauth-config.json"),
+		ProvidersPath:    getenv("BBALL_AUTH_PROVIDERS_PATH", "auth-providers.json"),
+		DevLogin:         devLogin,
+		TokensInRedirect: tokensInRedirect,
 		Database: databaseConfiguration{
 			User:     getenv("BBALL_DB_USER", "root"),
 			Password: getenv("BBALL_DB_PASSWORD", ""),
@@ -32,6 +115,18 @@ func init() {
 			Port:     port,
 			DBName:   getenv("BBALL_DB_DBNAME", "fantasy"),
 		},
+		Keys: keysConfiguration{
+			Dir:          keysDir,
+			SigningKeyID: getenv("BBALL_SIGNING_KEY_ID", ""),
+		},
+		Frontend: frontendConfiguration{
+			BaseURL:          strings.TrimSuffix(frontendURL, "/"),
+			AllowedRedirects: allowedRedirects,
+		},
+		Stats: statsConfiguration{
+			Dir:      getenv("BBALL_STATS_DIR", ""),
+			Interval: statsInterval,
+		},
 	}
 
 }
@@ -43,9 +138,31 @@ func Get() Configuration {
 }
 
 type Configuration struct {
-	AuthSecret      string
+	Environment string // EnvDevelopment or EnvProduction
+
+	// Signs the OAuth state during logins. Tokens are signed with Keys instead
+	AuthSecret string
+
+	// The Google OAuth client JSON, from the Google Developers Console's
+	// "Credentials" page. Logging in with Google is disabled if it doesn't exist
 	OAuthConfigPath string
-	Database        databaseConfiguration
+
+	// A JSON file of other OpenID Connect providers to log in with (see
+	// auth.OIDCConfig). It is optional
+	ProvidersPath string
+
+	// Whether anyone can log in as any user without a password, so that everything
+	// can run offline. Only allowed in development
+	DevLogin bool
+
+	// Whether to put users' tokens in the URL they are redirected to after logging
+	// in, instead of a single use code. Deprecated, only for migrating the frontend
+	TokensInRedirect bool
+
+	Database databaseConfiguration
+	Keys     keysConfiguration
+	Frontend frontendConfiguration
+	Stats    statsConfiguration
 }
 
 type databaseConfiguration struct {
@@ -55,9 +172,52 @@ type databaseConfiguration struct {
 	DBName         string
 }
 
+type keysConfiguration struct {
+	// Directory of PEM files of the keys that tokens are signed and verified with.
+	// Each file is named after its key ID, e.g. 2021-01.pem, and holds an RSA or
+	// Ed25519 private key, or just a public key for keys that only verify tokens
+	// anymore. If it is empty, keys are generated on startup
+	Dir string
+
+	// The ID of the key that signs new tokens. It can be left empty if there is only
+	// one private key
+	SigningKeyID string
+}
+
+type frontendConfiguration struct {
+	// Where the frontend is served from, e.g. https://fantasy.example.com. Users are
+	// sent here after logging in
+	BaseURL string
+
+	// The origins (scheme://host[:port]) that users may be redirected to after
+	// logging in. The frontend's own origin is always allowed
+	AllowedRedirects []string
+}
+
+type statsConfiguration struct {
+	// Directory of fixtures to ingest NBA data from (see stats.FileProvider). If it
+	// is empty, no data is ingested
+	Dir string
+
+	// How often to ingest
+	Interval time.Duration
+}
+
 func (c databaseConfiguration) DSN() string {
 	return fmt.Sprintf(
 		"%s:%s@tcp(%s:%d)/%s?parseTime=true",
 		c.User, c.Password, c.Host, c.Port, c.DBName,
 	)
 }
+
+// origin returns the scheme://host[:port] of an absolute http(s) URL
+func origin(rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("%q is not an absolute http(s) URL", rawURL)
+	}
+	return u.Scheme + "://" + strings.ToLower(u.Host), nil
+}
@@ -0,0 +1,153 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/NickDubelman/fantasy-bball/db/authcode"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+)
+
+// AuthCode is the model entity for the AuthCode schema.
+type AuthCode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CodeHash holds the value of the "codeHash" field.
+	CodeHash string `json:"-"`
+	// Expires holds the value of the "expires" field.
+	Expires time.Time `json:"expires,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AuthCodeQuery when eager-loading is set.
+	Edges           AuthCodeEdges `json:"edges"`
+	user_auth_codes *int
+}
+
+// AuthCodeEdges holds the relations/edges for other nodes in the graph.
+type AuthCodeEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AuthCodeEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// The edge user was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuthCode) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case authcode.FieldID:
+			values[i] = &sql.NullInt64{}
+		case authcode.FieldCodeHash:
+			values[i] = &sql.NullString{}
+		case authcode.FieldExpires:
+			values[i] = &sql.NullTime{}
+		case authcode.ForeignKeys[0]: // user_auth_codes
+			values[i] = &sql.NullInt64{}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type AuthCode", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuthCode fields.
+func (ac *AuthCode) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case authcode.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ac.ID = int(value.Int64)
+		case authcode.FieldCodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field codeHash", values[i])
+			} else if value.Valid {
+				ac.CodeHash = value.String
+			}
+		case authcode.FieldExpires:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires", values[i])
+			} else if value.Valid {
+				ac.Expires = value.Time
+			}
+		case authcode.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_auth_codes", value)
+			} else if value.Valid {
+				ac.user_auth_codes = new(int)
+				*ac.user_auth_codes = int(value.Int64)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryUser queries the "user" edge of the AuthCode entity.
+func (ac *AuthCode) QueryUser() *UserQuery {
+	return (&AuthCodeClient{config: ac.config}).QueryUser(ac)
+}
+
+// Update returns a builder for updating this AuthCode.
+// Note that you need to call AuthCode.Unwrap() before calling this method if this AuthCode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ac *AuthCode) Update() *AuthCodeUpdateOne {
+	return (&AuthCodeClient{config: ac.config}).UpdateOne(ac)
+}
+
+// Unwrap unwraps the AuthCode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ac *AuthCode) Unwrap() *AuthCode {
+	tx, ok := ac.config.driver.(*txDriver)
+	if !ok {
+		panic("db: AuthCode is not a transactional entity")
+	}
+	ac.config.driver = tx.drv
+	return ac
+}
+
+// String implements the fmt.Stringer.
+func (ac *AuthCode) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuthCode(")
+	builder.WriteString(fmt.Sprintf("id=%v", ac.ID))
+	builder.WriteString(", codeHash=<sensitive>")
+	builder.WriteString(", expires=")
+	builder.WriteString(ac.Expires.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuthCodes is a parsable slice of AuthCode.
+type AuthCodes []*AuthCode
+
+func (ac AuthCodes) config(cfg config) {
+	for _i := range ac {
+		ac[_i].config = cfg
+	}
+}
@@ -0,0 +1,53 @@
+// Code generated by entc, DO NOT EDIT.
+
+package authcode
+
+const (
+	// Label holds the string label denoting the authcode type in the database.
+	Label = "auth_code"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCodeHash holds the string denoting the codehash field in the database.
+	FieldCodeHash = "code_hash"
+	// FieldExpires holds the string denoting the expires field in the database.
+	FieldExpires = "expires"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the authcode in the database.
+	Table = "auth_codes"
+	// UserTable is the table the holds the user relation/edge.
+	UserTable = "auth_codes"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_auth_codes"
+)
+
+// Columns holds all SQL columns for authcode fields.
+var Columns = []string{
+	FieldID,
+	FieldCodeHash,
+	FieldExpires,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "auth_codes"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_auth_codes",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,355 @@
+// Code generated by entc, DO NOT EDIT.
+
+package authcode
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// CodeHash applies equality check predicate on the "codeHash" field. It's identical to CodeHashEQ.
+func CodeHash(v string) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCodeHash), v))
+	})
+}
+
+// Expires applies equality check predicate on the "expires" field. It's identical to ExpiresEQ.
+func Expires(v time.Time) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpires), v))
+	})
+}
+
+// CodeHashEQ applies the EQ predicate on the "codeHash" field.
+func CodeHashEQ(v string) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCodeHash), v))
+	})
+}
+
+// CodeHashNEQ applies the NEQ predicate on the "codeHash" field.
+func CodeHashNEQ(v string) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCodeHash), v))
+	})
+}
+
+// CodeHashIn applies the In predicate on the "codeHash" field.
+func CodeHashIn(vs ...string) predicate.AuthCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuthCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCodeHash), v...))
+	})
+}
+
+// CodeHashNotIn applies the NotIn predicate on the "codeHash" field.
+func CodeHashNotIn(vs ...string) predicate.AuthCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuthCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCodeHash), v...))
+	})
+}
+
+// CodeHashGT applies the GT predicate on the "codeHash" field.
+func CodeHashGT(v string) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCodeHash), v))
+	})
+}
+
+// CodeHashGTE applies the GTE predicate on the "codeHash" field.
+func CodeHashGTE(v string) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCodeHash), v))
+	})
+}
+
+// CodeHashLT applies the LT predicate on the "codeHash" field.
+func CodeHashLT(v string) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCodeHash), v))
+	})
+}
+
+// CodeHashLTE applies the LTE predicate on the "codeHash" field.
+func CodeHashLTE(v string) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCodeHash), v))
+	})
+}
+
+// CodeHashContains applies the Contains predicate on the "codeHash" field.
+func CodeHashContains(v string) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCodeHash), v))
+	})
+}
+
+// CodeHashHasPrefix applies the HasPrefix predicate on the "codeHash" field.
+func CodeHashHasPrefix(v string) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCodeHash), v))
+	})
+}
+
+// CodeHashHasSuffix applies the HasSuffix predicate on the "codeHash" field.
+func CodeHashHasSuffix(v string) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCodeHash), v))
+	})
+}
+
+// CodeHashEqualFold applies the EqualFold predicate on the "codeHash" field.
+func CodeHashEqualFold(v string) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCodeHash), v))
+	})
+}
+
+// CodeHashContainsFold applies the ContainsFold predicate on the "codeHash" field.
+func CodeHashContainsFold(v string) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCodeHash), v))
+	})
+}
+
+// ExpiresEQ applies the EQ predicate on the "expires" field.
+func ExpiresEQ(v time.Time) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresNEQ applies the NEQ predicate on the "expires" field.
+func ExpiresNEQ(v time.Time) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresIn applies the In predicate on the "expires" field.
+func ExpiresIn(vs ...time.Time) predicate.AuthCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuthCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExpires), v...))
+	})
+}
+
+// ExpiresNotIn applies the NotIn predicate on the "expires" field.
+func ExpiresNotIn(vs ...time.Time) predicate.AuthCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuthCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExpires), v...))
+	})
+}
+
+// ExpiresGT applies the GT predicate on the "expires" field.
+func ExpiresGT(v time.Time) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresGTE applies the GTE predicate on the "expires" field.
+func ExpiresGTE(v time.Time) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresLT applies the LT predicate on the "expires" field.
+func ExpiresLT(v time.Time) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresLTE applies the LTE predicate on the "expires" field.
+func ExpiresLTE(v time.Time) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExpires), v))
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AuthCode) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AuthCode) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AuthCode) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,237 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/authcode"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+)
+
+// AuthCodeCreate is the builder for creating a AuthCode entity.
+type AuthCodeCreate struct {
+	config
+	mutation *AuthCodeMutation
+	hooks    []Hook
+}
+
+// SetCodeHash sets the "codeHash" field.
+func (acc *AuthCodeCreate) SetCodeHash(s string) *AuthCodeCreate {
+	acc.mutation.SetCodeHash(s)
+	return acc
+}
+
+// SetExpires sets the "expires" field.
+func (acc *AuthCodeCreate) SetExpires(t time.Time) *AuthCodeCreate {
+	acc.mutation.SetExpires(t)
+	return acc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (acc *AuthCodeCreate) SetUserID(id int) *AuthCodeCreate {
+	acc.mutation.SetUserID(id)
+	return acc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (acc *AuthCodeCreate) SetUser(u *User) *AuthCodeCreate {
+	return acc.SetUserID(u.ID)
+}
+
+// Mutation returns the AuthCodeMutation object of the builder.
+func (acc *AuthCodeCreate) Mutation() *AuthCodeMutation {
+	return acc.mutation
+}
+
+// Save creates the AuthCode in the database.
+func (acc *AuthCodeCreate) Save(ctx context.Context) (*AuthCode, error) {
+	var (
+		err  error
+		node *AuthCode
+	)
+	if len(acc.hooks) == 0 {
+		if err = acc.check(); err != nil {
+			return nil, err
+		}
+		node, err = acc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuthCodeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = acc.check(); err != nil {
+				return nil, err
+			}
+			acc.mutation = mutation
+			node, err = acc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(acc.hooks) - 1; i >= 0; i-- {
+			mut = acc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, acc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (acc *AuthCodeCreate) SaveX(ctx context.Context) *AuthCode {
+	v, err := acc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (acc *AuthCodeCreate) check() error {
+	if _, ok := acc.mutation.CodeHash(); !ok {
+		return &ValidationError{Name: "codeHash", err: errors.New("db: missing required field \"codeHash\"")}
+	}
+	if _, ok := acc.mutation.Expires(); !ok {
+		return &ValidationError{Name: "expires", err: errors.New("db: missing required field \"expires\"")}
+	}
+	if _, ok := acc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New("db: missing required edge \"user\"")}
+	}
+	return nil
+}
+
+func (acc *AuthCodeCreate) sqlSave(ctx context.Context) (*AuthCode, error) {
+	_node, _spec := acc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, acc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (acc *AuthCodeCreate) createSpec() (*AuthCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuthCode{config: acc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: authcode.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: authcode.FieldID,
+			},
+		}
+	)
+	if value, ok := acc.mutation.CodeHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: authcode.FieldCodeHash,
+		})
+		_node.CodeHash = value
+	}
+	if value, ok := acc.mutation.Expires(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: authcode.FieldExpires,
+		})
+		_node.Expires = value
+	}
+	if nodes := acc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authcode.UserTable,
+			Columns: []string{authcode.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_auth_codes = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AuthCodeCreateBulk is the builder for creating many AuthCode entities in bulk.
+type AuthCodeCreateBulk struct {
+	config
+	builders []*AuthCodeCreate
+}
+
+// Save creates the AuthCode entities in the database.
+func (accb *AuthCodeCreateBulk) Save(ctx context.Context) ([]*AuthCode, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(accb.builders))
+	nodes := make([]*AuthCode, len(accb.builders))
+	mutators := make([]Mutator, len(accb.builders))
+	for i := range accb.builders {
+		func(i int, root context.Context) {
+			builder := accb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuthCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, accb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, accb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, accb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (accb *AuthCodeCreateBulk) SaveX(ctx context.Context) []*AuthCode {
+	v, err := accb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
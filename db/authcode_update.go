@@ -0,0 +1,346 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/authcode"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+)
+
+// AuthCodeUpdate is the builder for updating AuthCode entities.
+type AuthCodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuthCodeMutation
+}
+
+// Where adds a new predicate for the AuthCodeUpdate builder.
+func (acu *AuthCodeUpdate) Where(ps ...predicate.AuthCode) *AuthCodeUpdate {
+	acu.mutation.predicates = append(acu.mutation.predicates, ps...)
+	return acu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (acu *AuthCodeUpdate) SetUserID(id int) *AuthCodeUpdate {
+	acu.mutation.SetUserID(id)
+	return acu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (acu *AuthCodeUpdate) SetUser(u *User) *AuthCodeUpdate {
+	return acu.SetUserID(u.ID)
+}
+
+// Mutation returns the AuthCodeMutation object of the builder.
+func (acu *AuthCodeUpdate) Mutation() *AuthCodeMutation {
+	return acu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (acu *AuthCodeUpdate) ClearUser() *AuthCodeUpdate {
+	acu.mutation.ClearUser()
+	return acu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (acu *AuthCodeUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(acu.hooks) == 0 {
+		if err = acu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = acu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuthCodeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = acu.check(); err != nil {
+				return 0, err
+			}
+			acu.mutation = mutation
+			affected, err = acu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(acu.hooks) - 1; i >= 0; i-- {
+			mut = acu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, acu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acu *AuthCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := acu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (acu *AuthCodeUpdate) Exec(ctx context.Context) error {
+	_, err := acu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acu *AuthCodeUpdate) ExecX(ctx context.Context) {
+	if err := acu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (acu *AuthCodeUpdate) check() error {
+	if _, ok := acu.mutation.UserID(); acu.mutation.UserCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"user\"")
+	}
+	return nil
+}
+
+func (acu *AuthCodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   authcode.Table,
+			Columns: authcode.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: authcode.FieldID,
+			},
+		},
+	}
+	if ps := acu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if acu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authcode.UserTable,
+			Columns: []string{authcode.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authcode.UserTable,
+			Columns: []string{authcode.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, acu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{authcode.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// AuthCodeUpdateOne is the builder for updating a single AuthCode entity.
+type AuthCodeUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *AuthCodeMutation
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (acuo *AuthCodeUpdateOne) SetUserID(id int) *AuthCodeUpdateOne {
+	acuo.mutation.SetUserID(id)
+	return acuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (acuo *AuthCodeUpdateOne) SetUser(u *User) *AuthCodeUpdateOne {
+	return acuo.SetUserID(u.ID)
+}
+
+// Mutation returns the AuthCodeMutation object of the builder.
+func (acuo *AuthCodeUpdateOne) Mutation() *AuthCodeMutation {
+	return acuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (acuo *AuthCodeUpdateOne) ClearUser() *AuthCodeUpdateOne {
+	acuo.mutation.ClearUser()
+	return acuo
+}
+
+// Save executes the query and returns the updated AuthCode entity.
+func (acuo *AuthCodeUpdateOne) Save(ctx context.Context) (*AuthCode, error) {
+	var (
+		err  error
+		node *AuthCode
+	)
+	if len(acuo.hooks) == 0 {
+		if err = acuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = acuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuthCodeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = acuo.check(); err != nil {
+				return nil, err
+			}
+			acuo.mutation = mutation
+			node, err = acuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(acuo.hooks) - 1; i >= 0; i-- {
+			mut = acuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, acuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acuo *AuthCodeUpdateOne) SaveX(ctx context.Context) *AuthCode {
+	node, err := acuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (acuo *AuthCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := acuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acuo *AuthCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := acuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (acuo *AuthCodeUpdateOne) check() error {
+	if _, ok := acuo.mutation.UserID(); acuo.mutation.UserCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"user\"")
+	}
+	return nil
+}
+
+func (acuo *AuthCodeUpdateOne) sqlSave(ctx context.Context) (_node *AuthCode, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   authcode.Table,
+			Columns: authcode.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: authcode.FieldID,
+			},
+		},
+	}
+	id, ok := acuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing AuthCode.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := acuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if acuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authcode.UserTable,
+			Columns: []string{authcode.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authcode.UserTable,
+			Columns: []string{authcode.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AuthCode{config: acuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, acuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{authcode.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -9,10 +9,28 @@ import (
 
 	"github.com/NickDubelman/fantasy-bball/db/migrate"
 
+	"github.com/NickDubelman/fantasy-bball/db/authcode"
+	"github.com/NickDubelman/fantasy-bball/db/contest"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraft"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraftpick"
+	"github.com/NickDubelman/fantasy-bball/db/contestentry"
+	"github.com/NickDubelman/fantasy-bball/db/contestentrycorrection"
+	"github.com/NickDubelman/fantasy-bball/db/game"
+	"github.com/NickDubelman/fantasy-bball/db/gameresult"
+	"github.com/NickDubelman/fantasy-bball/db/identity"
+	"github.com/NickDubelman/fantasy-bball/db/ingestionrun"
+	"github.com/NickDubelman/fantasy-bball/db/invitation"
+	"github.com/NickDubelman/fantasy-bball/db/league"
+	"github.com/NickDubelman/fantasy-bball/db/leaguemembership"
+	"github.com/NickDubelman/fantasy-bball/db/player"
+	"github.com/NickDubelman/fantasy-bball/db/playerperformance"
+	"github.com/NickDubelman/fantasy-bball/db/refreshtoken"
+	"github.com/NickDubelman/fantasy-bball/db/team"
 	"github.com/NickDubelman/fantasy-bball/db/user"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // Client is the client that holds all ent builders.
@@ -20,6 +38,40 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AuthCode is the client for interacting with the AuthCode builders.
+	AuthCode *AuthCodeClient
+	// Contest is the client for interacting with the Contest builders.
+	Contest *ContestClient
+	// ContestDraft is the client for interacting with the ContestDraft builders.
+	ContestDraft *ContestDraftClient
+	// ContestDraftPick is the client for interacting with the ContestDraftPick builders.
+	ContestDraftPick *ContestDraftPickClient
+	// ContestEntry is the client for interacting with the ContestEntry builders.
+	ContestEntry *ContestEntryClient
+	// ContestEntryCorrection is the client for interacting with the ContestEntryCorrection builders.
+	ContestEntryCorrection *ContestEntryCorrectionClient
+	// Game is the client for interacting with the Game builders.
+	Game *GameClient
+	// GameResult is the client for interacting with the GameResult builders.
+	GameResult *GameResultClient
+	// Identity is the client for interacting with the Identity builders.
+	Identity *IdentityClient
+	// IngestionRun is the client for interacting with the IngestionRun builders.
+	IngestionRun *IngestionRunClient
+	// Invitation is the client for interacting with the Invitation builders.
+	Invitation *InvitationClient
+	// League is the client for interacting with the League builders.
+	League *LeagueClient
+	// LeagueMembership is the client for interacting with the LeagueMembership builders.
+	LeagueMembership *LeagueMembershipClient
+	// Player is the client for interacting with the Player builders.
+	Player *PlayerClient
+	// PlayerPerformance is the client for interacting with the PlayerPerformance builders.
+	PlayerPerformance *PlayerPerformanceClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
+	// Team is the client for interacting with the Team builders.
+	Team *TeamClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 }
@@ -35,6 +87,23 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AuthCode = NewAuthCodeClient(c.config)
+	c.Contest = NewContestClient(c.config)
+	c.ContestDraft = NewContestDraftClient(c.config)
+	c.ContestDraftPick = NewContestDraftPickClient(c.config)
+	c.ContestEntry = NewContestEntryClient(c.config)
+	c.ContestEntryCorrection = NewContestEntryCorrectionClient(c.config)
+	c.Game = NewGameClient(c.config)
+	c.GameResult = NewGameResultClient(c.config)
+	c.Identity = NewIdentityClient(c.config)
+	c.IngestionRun = NewIngestionRunClient(c.config)
+	c.Invitation = NewInvitationClient(c.config)
+	c.League = NewLeagueClient(c.config)
+	c.LeagueMembership = NewLeagueMembershipClient(c.config)
+	c.Player = NewPlayerClient(c.config)
+	c.PlayerPerformance = NewPlayerPerformanceClient(c.config)
+	c.RefreshToken = NewRefreshTokenClient(c.config)
+	c.Team = NewTeamClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -67,9 +136,26 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		User:   NewUserClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		AuthCode:               NewAuthCodeClient(cfg),
+		Contest:                NewContestClient(cfg),
+		ContestDraft:           NewContestDraftClient(cfg),
+		ContestDraftPick:       NewContestDraftPickClient(cfg),
+		ContestEntry:           NewContestEntryClient(cfg),
+		ContestEntryCorrection: NewContestEntryCorrectionClient(cfg),
+		Game:                   NewGameClient(cfg),
+		GameResult:             NewGameResultClient(cfg),
+		Identity:               NewIdentityClient(cfg),
+		IngestionRun:           NewIngestionRunClient(cfg),
+		Invitation:             NewInvitationClient(cfg),
+		League:                 NewLeagueClient(cfg),
+		LeagueMembership:       NewLeagueMembershipClient(cfg),
+		Player:                 NewPlayerClient(cfg),
+		PlayerPerformance:      NewPlayerPerformanceClient(cfg),
+		RefreshToken:           NewRefreshTokenClient(cfg),
+		Team:                   NewTeamClient(cfg),
+		User:                   NewUserClient(cfg),
 	}, nil
 }
 
@@ -87,38 +173,2207 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		config: cfg,
-		User:   NewUserClient(cfg),
+		config:                 cfg,
+		AuthCode:               NewAuthCodeClient(cfg),
+		Contest:                NewContestClient(cfg),
+		ContestDraft:           NewContestDraftClient(cfg),
+		ContestDraftPick:       NewContestDraftPickClient(cfg),
+		ContestEntry:           NewContestEntryClient(cfg),
+		ContestEntryCorrection: NewContestEntryCorrectionClient(cfg),
+		Game:                   NewGameClient(cfg),
+		GameResult:             NewGameResultClient(cfg),
+		Identity:               NewIdentityClient(cfg),
+		IngestionRun:           NewIngestionRunClient(cfg),
+		Invitation:             NewInvitationClient(cfg),
+		League:                 NewLeagueClient(cfg),
+		LeagueMembership:       NewLeagueMembershipClient(cfg),
+		Player:                 NewPlayerClient(cfg),
+		PlayerPerformance:      NewPlayerPerformanceClient(cfg),
+		RefreshToken:           NewRefreshTokenClient(cfg),
+		Team:                   NewTeamClient(cfg),
+		User:                   NewUserClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		User.
+//		AuthCode.
 //		Query().
 //		Count(ctx)
-//
 func (c *Client) Debug() *Client {
 	if c.debug {
 		return c
 	}
-	cfg := c.config
-	cfg.driver = dialect.Debug(c.driver, c.log)
-	client := &Client{config: cfg}
-	client.init()
-	return client
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
+// Close closes the database connection and prevents new queries from starting.
+func (c *Client) Close() error {
+	return c.driver.Close()
+}
+
+// Use adds the mutation hooks to all the entity clients.
+// In order to add hooks to a specific client, call: `client.Node.Use(...)`.
+func (c *Client) Use(hooks ...Hook) {
+	c.AuthCode.Use(hooks...)
+	c.Contest.Use(hooks...)
+	c.ContestDraft.Use(hooks...)
+	c.ContestDraftPick.Use(hooks...)
+	c.ContestEntry.Use(hooks...)
+	c.ContestEntryCorrection.Use(hooks...)
+	c.Game.Use(hooks...)
+	c.GameResult.Use(hooks...)
+	c.Identity.Use(hooks...)
+	c.IngestionRun.Use(hooks...)
+	c.Invitation.Use(hooks...)
+	c.League.Use(hooks...)
+	c.LeagueMembership.Use(hooks...)
+	c.Player.Use(hooks...)
+	c.PlayerPerformance.Use(hooks...)
+	c.RefreshToken.Use(hooks...)
+	c.Team.Use(hooks...)
+	c.User.Use(hooks...)
+}
+
+// AuthCodeClient is a client for the AuthCode schema.
+type AuthCodeClient struct {
+	config
+}
+
+// NewAuthCodeClient returns a client for the AuthCode from the given config.
+func NewAuthCodeClient(c config) *AuthCodeClient {
+	return &AuthCodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `authcode.Hooks(f(g(h())))`.
+func (c *AuthCodeClient) Use(hooks ...Hook) {
+	c.hooks.AuthCode = append(c.hooks.AuthCode, hooks...)
+}
+
+// Create returns a create builder for AuthCode.
+func (c *AuthCodeClient) Create() *AuthCodeCreate {
+	mutation := newAuthCodeMutation(c.config, OpCreate)
+	return &AuthCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuthCode entities.
+func (c *AuthCodeClient) CreateBulk(builders ...*AuthCodeCreate) *AuthCodeCreateBulk {
+	return &AuthCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuthCode.
+func (c *AuthCodeClient) Update() *AuthCodeUpdate {
+	mutation := newAuthCodeMutation(c.config, OpUpdate)
+	return &AuthCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuthCodeClient) UpdateOne(ac *AuthCode) *AuthCodeUpdateOne {
+	mutation := newAuthCodeMutation(c.config, OpUpdateOne, withAuthCode(ac))
+	return &AuthCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuthCodeClient) UpdateOneID(id int) *AuthCodeUpdateOne {
+	mutation := newAuthCodeMutation(c.config, OpUpdateOne, withAuthCodeID(id))
+	return &AuthCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuthCode.
+func (c *AuthCodeClient) Delete() *AuthCodeDelete {
+	mutation := newAuthCodeMutation(c.config, OpDelete)
+	return &AuthCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *AuthCodeClient) DeleteOne(ac *AuthCode) *AuthCodeDeleteOne {
+	return c.DeleteOneID(ac.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *AuthCodeClient) DeleteOneID(id int) *AuthCodeDeleteOne {
+	builder := c.Delete().Where(authcode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuthCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for AuthCode.
+func (c *AuthCodeClient) Query() *AuthCodeQuery {
+	return &AuthCodeQuery{config: c.config}
+}
+
+// Get returns a AuthCode entity by its id.
+func (c *AuthCodeClient) Get(ctx context.Context, id int) (*AuthCode, error) {
+	return c.Query().Where(authcode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuthCodeClient) GetX(ctx context.Context, id int) *AuthCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a AuthCode.
+func (c *AuthCodeClient) QueryUser(ac *AuthCode) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ac.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(authcode.Table, authcode.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, authcode.UserTable, authcode.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(ac.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AuthCodeClient) Hooks() []Hook {
+	return c.hooks.AuthCode
+}
+
+// ContestClient is a client for the Contest schema.
+type ContestClient struct {
+	config
+}
+
+// NewContestClient returns a client for the Contest from the given config.
+func NewContestClient(c config) *ContestClient {
+	return &ContestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `contest.Hooks(f(g(h())))`.
+func (c *ContestClient) Use(hooks ...Hook) {
+	c.hooks.Contest = append(c.hooks.Contest, hooks...)
+}
+
+// Create returns a create builder for Contest.
+func (c *ContestClient) Create() *ContestCreate {
+	mutation := newContestMutation(c.config, OpCreate)
+	return &ContestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Contest entities.
+func (c *ContestClient) CreateBulk(builders ...*ContestCreate) *ContestCreateBulk {
+	return &ContestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Contest.
+func (c *ContestClient) Update() *ContestUpdate {
+	mutation := newContestMutation(c.config, OpUpdate)
+	return &ContestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ContestClient) UpdateOne(co *Contest) *ContestUpdateOne {
+	mutation := newContestMutation(c.config, OpUpdateOne, withContest(co))
+	return &ContestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ContestClient) UpdateOneID(id int) *ContestUpdateOne {
+	mutation := newContestMutation(c.config, OpUpdateOne, withContestID(id))
+	return &ContestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Contest.
+func (c *ContestClient) Delete() *ContestDelete {
+	mutation := newContestMutation(c.config, OpDelete)
+	return &ContestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *ContestClient) DeleteOne(co *Contest) *ContestDeleteOne {
+	return c.DeleteOneID(co.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *ContestClient) DeleteOneID(id int) *ContestDeleteOne {
+	builder := c.Delete().Where(contest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ContestDeleteOne{builder}
+}
+
+// Query returns a query builder for Contest.
+func (c *ContestClient) Query() *ContestQuery {
+	return &ContestQuery{config: c.config}
+}
+
+// Get returns a Contest entity by its id.
+func (c *ContestClient) Get(ctx context.Context, id int) (*Contest, error) {
+	return c.Query().Where(contest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ContestClient) GetX(ctx context.Context, id int) *Contest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLeague queries the league edge of a Contest.
+func (c *ContestClient) QueryLeague(co *Contest) *LeagueQuery {
+	query := &LeagueQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contest.Table, contest.FieldID, id),
+			sqlgraph.To(league.Table, league.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contest.LeagueTable, contest.LeagueColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWinner queries the winner edge of a Contest.
+func (c *ContestClient) QueryWinner(co *Contest) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contest.Table, contest.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contest.WinnerTable, contest.WinnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDraft queries the draft edge of a Contest.
+func (c *ContestClient) QueryDraft(co *Contest) *ContestDraftQuery {
+	query := &ContestDraftQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contest.Table, contest.FieldID, id),
+			sqlgraph.To(contestdraft.Table, contestdraft.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, contest.DraftTable, contest.DraftColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEntries queries the entries edge of a Contest.
+func (c *ContestClient) QueryEntries(co *Contest) *ContestEntryQuery {
+	query := &ContestEntryQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contest.Table, contest.FieldID, id),
+			sqlgraph.To(contestentry.Table, contestentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, contest.EntriesTable, contest.EntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ContestClient) Hooks() []Hook {
+	return c.hooks.Contest
+}
+
+// ContestDraftClient is a client for the ContestDraft schema.
+type ContestDraftClient struct {
+	config
+}
+
+// NewContestDraftClient returns a client for the ContestDraft from the given config.
+func NewContestDraftClient(c config) *ContestDraftClient {
+	return &ContestDraftClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `contestdraft.Hooks(f(g(h())))`.
+func (c *ContestDraftClient) Use(hooks ...Hook) {
+	c.hooks.ContestDraft = append(c.hooks.ContestDraft, hooks...)
+}
+
+// Create returns a create builder for ContestDraft.
+func (c *ContestDraftClient) Create() *ContestDraftCreate {
+	mutation := newContestDraftMutation(c.config, OpCreate)
+	return &ContestDraftCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ContestDraft entities.
+func (c *ContestDraftClient) CreateBulk(builders ...*ContestDraftCreate) *ContestDraftCreateBulk {
+	return &ContestDraftCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ContestDraft.
+func (c *ContestDraftClient) Update() *ContestDraftUpdate {
+	mutation := newContestDraftMutation(c.config, OpUpdate)
+	return &ContestDraftUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ContestDraftClient) UpdateOne(cd *ContestDraft) *ContestDraftUpdateOne {
+	mutation := newContestDraftMutation(c.config, OpUpdateOne, withContestDraft(cd))
+	return &ContestDraftUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ContestDraftClient) UpdateOneID(id int) *ContestDraftUpdateOne {
+	mutation := newContestDraftMutation(c.config, OpUpdateOne, withContestDraftID(id))
+	return &ContestDraftUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ContestDraft.
+func (c *ContestDraftClient) Delete() *ContestDraftDelete {
+	mutation := newContestDraftMutation(c.config, OpDelete)
+	return &ContestDraftDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *ContestDraftClient) DeleteOne(cd *ContestDraft) *ContestDraftDeleteOne {
+	return c.DeleteOneID(cd.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *ContestDraftClient) DeleteOneID(id int) *ContestDraftDeleteOne {
+	builder := c.Delete().Where(contestdraft.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ContestDraftDeleteOne{builder}
+}
+
+// Query returns a query builder for ContestDraft.
+func (c *ContestDraftClient) Query() *ContestDraftQuery {
+	return &ContestDraftQuery{config: c.config}
+}
+
+// Get returns a ContestDraft entity by its id.
+func (c *ContestDraftClient) Get(ctx context.Context, id int) (*ContestDraft, error) {
+	return c.Query().Where(contestdraft.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ContestDraftClient) GetX(ctx context.Context, id int) *ContestDraft {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryContest queries the contest edge of a ContestDraft.
+func (c *ContestDraftClient) QueryContest(cd *ContestDraft) *ContestQuery {
+	query := &ContestQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contestdraft.Table, contestdraft.FieldID, id),
+			sqlgraph.To(contest.Table, contest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, contestdraft.ContestTable, contestdraft.ContestColumn),
+		)
+		fromV = sqlgraph.Neighbors(cd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPicks queries the picks edge of a ContestDraft.
+func (c *ContestDraftClient) QueryPicks(cd *ContestDraft) *ContestDraftPickQuery {
+	query := &ContestDraftPickQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contestdraft.Table, contestdraft.FieldID, id),
+			sqlgraph.To(contestdraftpick.Table, contestdraftpick.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, contestdraft.PicksTable, contestdraft.PicksColumn),
+		)
+		fromV = sqlgraph.Neighbors(cd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ContestDraftClient) Hooks() []Hook {
+	return c.hooks.ContestDraft
+}
+
+// ContestDraftPickClient is a client for the ContestDraftPick schema.
+type ContestDraftPickClient struct {
+	config
+}
+
+// NewContestDraftPickClient returns a client for the ContestDraftPick from the given config.
+func NewContestDraftPickClient(c config) *ContestDraftPickClient {
+	return &ContestDraftPickClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `contestdraftpick.Hooks(f(g(h())))`.
+func (c *ContestDraftPickClient) Use(hooks ...Hook) {
+	c.hooks.ContestDraftPick = append(c.hooks.ContestDraftPick, hooks...)
+}
+
+// Create returns a create builder for ContestDraftPick.
+func (c *ContestDraftPickClient) Create() *ContestDraftPickCreate {
+	mutation := newContestDraftPickMutation(c.config, OpCreate)
+	return &ContestDraftPickCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ContestDraftPick entities.
+func (c *ContestDraftPickClient) CreateBulk(builders ...*ContestDraftPickCreate) *ContestDraftPickCreateBulk {
+	return &ContestDraftPickCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ContestDraftPick.
+func (c *ContestDraftPickClient) Update() *ContestDraftPickUpdate {
+	mutation := newContestDraftPickMutation(c.config, OpUpdate)
+	return &ContestDraftPickUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ContestDraftPickClient) UpdateOne(cdp *ContestDraftPick) *ContestDraftPickUpdateOne {
+	mutation := newContestDraftPickMutation(c.config, OpUpdateOne, withContestDraftPick(cdp))
+	return &ContestDraftPickUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ContestDraftPickClient) UpdateOneID(id int) *ContestDraftPickUpdateOne {
+	mutation := newContestDraftPickMutation(c.config, OpUpdateOne, withContestDraftPickID(id))
+	return &ContestDraftPickUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ContestDraftPick.
+func (c *ContestDraftPickClient) Delete() *ContestDraftPickDelete {
+	mutation := newContestDraftPickMutation(c.config, OpDelete)
+	return &ContestDraftPickDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *ContestDraftPickClient) DeleteOne(cdp *ContestDraftPick) *ContestDraftPickDeleteOne {
+	return c.DeleteOneID(cdp.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *ContestDraftPickClient) DeleteOneID(id int) *ContestDraftPickDeleteOne {
+	builder := c.Delete().Where(contestdraftpick.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ContestDraftPickDeleteOne{builder}
+}
+
+// Query returns a query builder for ContestDraftPick.
+func (c *ContestDraftPickClient) Query() *ContestDraftPickQuery {
+	return &ContestDraftPickQuery{config: c.config}
+}
+
+// Get returns a ContestDraftPick entity by its id.
+func (c *ContestDraftPickClient) Get(ctx context.Context, id int) (*ContestDraftPick, error) {
+	return c.Query().Where(contestdraftpick.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ContestDraftPickClient) GetX(ctx context.Context, id int) *ContestDraftPick {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDraft queries the draft edge of a ContestDraftPick.
+func (c *ContestDraftPickClient) QueryDraft(cdp *ContestDraftPick) *ContestDraftQuery {
+	query := &ContestDraftQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cdp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contestdraftpick.Table, contestdraftpick.FieldID, id),
+			sqlgraph.To(contestdraft.Table, contestdraft.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contestdraftpick.DraftTable, contestdraftpick.DraftColumn),
+		)
+		fromV = sqlgraph.Neighbors(cdp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a ContestDraftPick.
+func (c *ContestDraftPickClient) QueryUser(cdp *ContestDraftPick) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cdp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contestdraftpick.Table, contestdraftpick.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contestdraftpick.UserTable, contestdraftpick.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(cdp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPlayer queries the player edge of a ContestDraftPick.
+func (c *ContestDraftPickClient) QueryPlayer(cdp *ContestDraftPick) *PlayerQuery {
+	query := &PlayerQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cdp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contestdraftpick.Table, contestdraftpick.FieldID, id),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contestdraftpick.PlayerTable, contestdraftpick.PlayerColumn),
+		)
+		fromV = sqlgraph.Neighbors(cdp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ContestDraftPickClient) Hooks() []Hook {
+	return c.hooks.ContestDraftPick
+}
+
+// ContestEntryClient is a client for the ContestEntry schema.
+type ContestEntryClient struct {
+	config
+}
+
+// NewContestEntryClient returns a client for the ContestEntry from the given config.
+func NewContestEntryClient(c config) *ContestEntryClient {
+	return &ContestEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `contestentry.Hooks(f(g(h())))`.
+func (c *ContestEntryClient) Use(hooks ...Hook) {
+	c.hooks.ContestEntry = append(c.hooks.ContestEntry, hooks...)
+}
+
+// Create returns a create builder for ContestEntry.
+func (c *ContestEntryClient) Create() *ContestEntryCreate {
+	mutation := newContestEntryMutation(c.config, OpCreate)
+	return &ContestEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ContestEntry entities.
+func (c *ContestEntryClient) CreateBulk(builders ...*ContestEntryCreate) *ContestEntryCreateBulk {
+	return &ContestEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ContestEntry.
+func (c *ContestEntryClient) Update() *ContestEntryUpdate {
+	mutation := newContestEntryMutation(c.config, OpUpdate)
+	return &ContestEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ContestEntryClient) UpdateOne(ce *ContestEntry) *ContestEntryUpdateOne {
+	mutation := newContestEntryMutation(c.config, OpUpdateOne, withContestEntry(ce))
+	return &ContestEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ContestEntryClient) UpdateOneID(id int) *ContestEntryUpdateOne {
+	mutation := newContestEntryMutation(c.config, OpUpdateOne, withContestEntryID(id))
+	return &ContestEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ContestEntry.
+func (c *ContestEntryClient) Delete() *ContestEntryDelete {
+	mutation := newContestEntryMutation(c.config, OpDelete)
+	return &ContestEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *ContestEntryClient) DeleteOne(ce *ContestEntry) *ContestEntryDeleteOne {
+	return c.DeleteOneID(ce.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *ContestEntryClient) DeleteOneID(id int) *ContestEntryDeleteOne {
+	builder := c.Delete().Where(contestentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ContestEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for ContestEntry.
+func (c *ContestEntryClient) Query() *ContestEntryQuery {
+	return &ContestEntryQuery{config: c.config}
+}
+
+// Get returns a ContestEntry entity by its id.
+func (c *ContestEntryClient) Get(ctx context.Context, id int) (*ContestEntry, error) {
+	return c.Query().Where(contestentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ContestEntryClient) GetX(ctx context.Context, id int) *ContestEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryContest queries the contest edge of a ContestEntry.
+func (c *ContestEntryClient) QueryContest(ce *ContestEntry) *ContestQuery {
+	query := &ContestQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ce.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contestentry.Table, contestentry.FieldID, id),
+			sqlgraph.To(contest.Table, contest.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contestentry.ContestTable, contestentry.ContestColumn),
+		)
+		fromV = sqlgraph.Neighbors(ce.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a ContestEntry.
+func (c *ContestEntryClient) QueryUser(ce *ContestEntry) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ce.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contestentry.Table, contestentry.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contestentry.UserTable, contestentry.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(ce.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCorrections queries the corrections edge of a ContestEntry.
+func (c *ContestEntryClient) QueryCorrections(ce *ContestEntry) *ContestEntryCorrectionQuery {
+	query := &ContestEntryCorrectionQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ce.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contestentry.Table, contestentry.FieldID, id),
+			sqlgraph.To(contestentrycorrection.Table, contestentrycorrection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, contestentry.CorrectionsTable, contestentry.CorrectionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ce.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ContestEntryClient) Hooks() []Hook {
+	return c.hooks.ContestEntry
+}
+
+// ContestEntryCorrectionClient is a client for the ContestEntryCorrection schema.
+type ContestEntryCorrectionClient struct {
+	config
+}
+
+// NewContestEntryCorrectionClient returns a client for the ContestEntryCorrection from the given config.
+func NewContestEntryCorrectionClient(c config) *ContestEntryCorrectionClient {
+	return &ContestEntryCorrectionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `contestentrycorrection.Hooks(f(g(h())))`.
+func (c *ContestEntryCorrectionClient) Use(hooks ...Hook) {
+	c.hooks.ContestEntryCorrection = append(c.hooks.ContestEntryCorrection, hooks...)
+}
+
+// Create returns a create builder for ContestEntryCorrection.
+func (c *ContestEntryCorrectionClient) Create() *ContestEntryCorrectionCreate {
+	mutation := newContestEntryCorrectionMutation(c.config, OpCreate)
+	return &ContestEntryCorrectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ContestEntryCorrection entities.
+func (c *ContestEntryCorrectionClient) CreateBulk(builders ...*ContestEntryCorrectionCreate) *ContestEntryCorrectionCreateBulk {
+	return &ContestEntryCorrectionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ContestEntryCorrection.
+func (c *ContestEntryCorrectionClient) Update() *ContestEntryCorrectionUpdate {
+	mutation := newContestEntryCorrectionMutation(c.config, OpUpdate)
+	return &ContestEntryCorrectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ContestEntryCorrectionClient) UpdateOne(cec *ContestEntryCorrection) *ContestEntryCorrectionUpdateOne {
+	mutation := newContestEntryCorrectionMutation(c.config, OpUpdateOne, withContestEntryCorrection(cec))
+	return &ContestEntryCorrectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ContestEntryCorrectionClient) UpdateOneID(id int) *ContestEntryCorrectionUpdateOne {
+	mutation := newContestEntryCorrectionMutation(c.config, OpUpdateOne, withContestEntryCorrectionID(id))
+	return &ContestEntryCorrectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ContestEntryCorrection.
+func (c *ContestEntryCorrectionClient) Delete() *ContestEntryCorrectionDelete {
+	mutation := newContestEntryCorrectionMutation(c.config, OpDelete)
+	return &ContestEntryCorrectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *ContestEntryCorrectionClient) DeleteOne(cec *ContestEntryCorrection) *ContestEntryCorrectionDeleteOne {
+	return c.DeleteOneID(cec.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *ContestEntryCorrectionClient) DeleteOneID(id int) *ContestEntryCorrectionDeleteOne {
+	builder := c.Delete().Where(contestentrycorrection.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ContestEntryCorrectionDeleteOne{builder}
+}
+
+// Query returns a query builder for ContestEntryCorrection.
+func (c *ContestEntryCorrectionClient) Query() *ContestEntryCorrectionQuery {
+	return &ContestEntryCorrectionQuery{config: c.config}
+}
+
+// Get returns a ContestEntryCorrection entity by its id.
+func (c *ContestEntryCorrectionClient) Get(ctx context.Context, id int) (*ContestEntryCorrection, error) {
+	return c.Query().Where(contestentrycorrection.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ContestEntryCorrectionClient) GetX(ctx context.Context, id int) *ContestEntryCorrection {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEntry queries the entry edge of a ContestEntryCorrection.
+func (c *ContestEntryCorrectionClient) QueryEntry(cec *ContestEntryCorrection) *ContestEntryQuery {
+	query := &ContestEntryQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cec.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contestentrycorrection.Table, contestentrycorrection.FieldID, id),
+			sqlgraph.To(contestentry.Table, contestentry.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contestentrycorrection.EntryTable, contestentrycorrection.EntryColumn),
+		)
+		fromV = sqlgraph.Neighbors(cec.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ContestEntryCorrectionClient) Hooks() []Hook {
+	return c.hooks.ContestEntryCorrection
+}
+
+// GameClient is a client for the Game schema.
+type GameClient struct {
+	config
+}
+
+// NewGameClient returns a client for the Game from the given config.
+func NewGameClient(c config) *GameClient {
+	return &GameClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `game.Hooks(f(g(h())))`.
+func (c *GameClient) Use(hooks ...Hook) {
+	c.hooks.Game = append(c.hooks.Game, hooks...)
+}
+
+// Create returns a create builder for Game.
+func (c *GameClient) Create() *GameCreate {
+	mutation := newGameMutation(c.config, OpCreate)
+	return &GameCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Game entities.
+func (c *GameClient) CreateBulk(builders ...*GameCreate) *GameCreateBulk {
+	return &GameCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Game.
+func (c *GameClient) Update() *GameUpdate {
+	mutation := newGameMutation(c.config, OpUpdate)
+	return &GameUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GameClient) UpdateOne(ga *Game) *GameUpdateOne {
+	mutation := newGameMutation(c.config, OpUpdateOne, withGame(ga))
+	return &GameUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GameClient) UpdateOneID(id int) *GameUpdateOne {
+	mutation := newGameMutation(c.config, OpUpdateOne, withGameID(id))
+	return &GameUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Game.
+func (c *GameClient) Delete() *GameDelete {
+	mutation := newGameMutation(c.config, OpDelete)
+	return &GameDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *GameClient) DeleteOne(ga *Game) *GameDeleteOne {
+	return c.DeleteOneID(ga.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *GameClient) DeleteOneID(id int) *GameDeleteOne {
+	builder := c.Delete().Where(game.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GameDeleteOne{builder}
+}
+
+// Query returns a query builder for Game.
+func (c *GameClient) Query() *GameQuery {
+	return &GameQuery{config: c.config}
+}
+
+// Get returns a Game entity by its id.
+func (c *GameClient) Get(ctx context.Context, id int) (*Game, error) {
+	return c.Query().Where(game.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GameClient) GetX(ctx context.Context, id int) *Game {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryHomeTeam queries the homeTeam edge of a Game.
+func (c *GameClient) QueryHomeTeam(ga *Game) *TeamQuery {
+	query := &TeamQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ga.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, id),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, game.HomeTeamTable, game.HomeTeamColumn),
+		)
+		fromV = sqlgraph.Neighbors(ga.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAwayTeam queries the awayTeam edge of a Game.
+func (c *GameClient) QueryAwayTeam(ga *Game) *TeamQuery {
+	query := &TeamQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ga.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, id),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, game.AwayTeamTable, game.AwayTeamColumn),
+		)
+		fromV = sqlgraph.Neighbors(ga.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResult queries the result edge of a Game.
+func (c *GameClient) QueryResult(ga *Game) *GameResultQuery {
+	query := &GameResultQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ga.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, id),
+			sqlgraph.To(gameresult.Table, gameresult.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, game.ResultTable, game.ResultColumn),
+		)
+		fromV = sqlgraph.Neighbors(ga.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPerformances queries the performances edge of a Game.
+func (c *GameClient) QueryPerformances(ga *Game) *PlayerPerformanceQuery {
+	query := &PlayerPerformanceQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ga.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, id),
+			sqlgraph.To(playerperformance.Table, playerperformance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, game.PerformancesTable, game.PerformancesColumn),
+		)
+		fromV = sqlgraph.Neighbors(ga.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GameClient) Hooks() []Hook {
+	return c.hooks.Game
+}
+
+// GameResultClient is a client for the GameResult schema.
+type GameResultClient struct {
+	config
+}
+
+// NewGameResultClient returns a client for the GameResult from the given config.
+func NewGameResultClient(c config) *GameResultClient {
+	return &GameResultClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `gameresult.Hooks(f(g(h())))`.
+func (c *GameResultClient) Use(hooks ...Hook) {
+	c.hooks.GameResult = append(c.hooks.GameResult, hooks...)
+}
+
+// Create returns a create builder for GameResult.
+func (c *GameResultClient) Create() *GameResultCreate {
+	mutation := newGameResultMutation(c.config, OpCreate)
+	return &GameResultCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GameResult entities.
+func (c *GameResultClient) CreateBulk(builders ...*GameResultCreate) *GameResultCreateBulk {
+	return &GameResultCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GameResult.
+func (c *GameResultClient) Update() *GameResultUpdate {
+	mutation := newGameResultMutation(c.config, OpUpdate)
+	return &GameResultUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GameResultClient) UpdateOne(gr *GameResult) *GameResultUpdateOne {
+	mutation := newGameResultMutation(c.config, OpUpdateOne, withGameResult(gr))
+	return &GameResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GameResultClient) UpdateOneID(id int) *GameResultUpdateOne {
+	mutation := newGameResultMutation(c.config, OpUpdateOne, withGameResultID(id))
+	return &GameResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GameResult.
+func (c *GameResultClient) Delete() *GameResultDelete {
+	mutation := newGameResultMutation(c.config, OpDelete)
+	return &GameResultDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *GameResultClient) DeleteOne(gr *GameResult) *GameResultDeleteOne {
+	return c.DeleteOneID(gr.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *GameResultClient) DeleteOneID(id int) *GameResultDeleteOne {
+	builder := c.Delete().Where(gameresult.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GameResultDeleteOne{builder}
+}
+
+// Query returns a query builder for GameResult.
+func (c *GameResultClient) Query() *GameResultQuery {
+	return &GameResultQuery{config: c.config}
+}
+
+// Get returns a GameResult entity by its id.
+func (c *GameResultClient) Get(ctx context.Context, id int) (*GameResult, error) {
+	return c.Query().Where(gameresult.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GameResultClient) GetX(ctx context.Context, id int) *GameResult {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGame queries the game edge of a GameResult.
+func (c *GameResultClient) QueryGame(gr *GameResult) *GameQuery {
+	query := &GameQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := gr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gameresult.Table, gameresult.FieldID, id),
+			sqlgraph.To(game.Table, game.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, gameresult.GameTable, gameresult.GameColumn),
+		)
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWinner queries the winner edge of a GameResult.
+func (c *GameResultClient) QueryWinner(gr *GameResult) *TeamQuery {
+	query := &TeamQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := gr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gameresult.Table, gameresult.FieldID, id),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, gameresult.WinnerTable, gameresult.WinnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GameResultClient) Hooks() []Hook {
+	return c.hooks.GameResult
+}
+
+// IdentityClient is a client for the Identity schema.
+type IdentityClient struct {
+	config
+}
+
+// NewIdentityClient returns a client for the Identity from the given config.
+func NewIdentityClient(c config) *IdentityClient {
+	return &IdentityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `identity.Hooks(f(g(h())))`.
+func (c *IdentityClient) Use(hooks ...Hook) {
+	c.hooks.Identity = append(c.hooks.Identity, hooks...)
+}
+
+// Create returns a create builder for Identity.
+func (c *IdentityClient) Create() *IdentityCreate {
+	mutation := newIdentityMutation(c.config, OpCreate)
+	return &IdentityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Identity entities.
+func (c *IdentityClient) CreateBulk(builders ...*IdentityCreate) *IdentityCreateBulk {
+	return &IdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Identity.
+func (c *IdentityClient) Update() *IdentityUpdate {
+	mutation := newIdentityMutation(c.config, OpUpdate)
+	return &IdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdentityClient) UpdateOne(i *Identity) *IdentityUpdateOne {
+	mutation := newIdentityMutation(c.config, OpUpdateOne, withIdentity(i))
+	return &IdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IdentityClient) UpdateOneID(id int) *IdentityUpdateOne {
+	mutation := newIdentityMutation(c.config, OpUpdateOne, withIdentityID(id))
+	return &IdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Identity.
+func (c *IdentityClient) Delete() *IdentityDelete {
+	mutation := newIdentityMutation(c.config, OpDelete)
+	return &IdentityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *IdentityClient) DeleteOne(i *Identity) *IdentityDeleteOne {
+	return c.DeleteOneID(i.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *IdentityClient) DeleteOneID(id int) *IdentityDeleteOne {
+	builder := c.Delete().Where(identity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IdentityDeleteOne{builder}
+}
+
+// Query returns a query builder for Identity.
+func (c *IdentityClient) Query() *IdentityQuery {
+	return &IdentityQuery{config: c.config}
+}
+
+// Get returns a Identity entity by its id.
+func (c *IdentityClient) Get(ctx context.Context, id int) (*Identity, error) {
+	return c.Query().Where(identity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IdentityClient) GetX(ctx context.Context, id int) *Identity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Identity.
+func (c *IdentityClient) QueryUser(i *Identity) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(identity.Table, identity.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, identity.UserTable, identity.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *IdentityClient) Hooks() []Hook {
+	return c.hooks.Identity
+}
+
+// IngestionRunClient is a client for the IngestionRun schema.
+type IngestionRunClient struct {
+	config
+}
+
+// NewIngestionRunClient returns a client for the IngestionRun from the given config.
+func NewIngestionRunClient(c config) *IngestionRunClient {
+	return &IngestionRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ingestionrun.Hooks(f(g(h())))`.
+func (c *IngestionRunClient) Use(hooks ...Hook) {
+	c.hooks.IngestionRun = append(c.hooks.IngestionRun, hooks...)
+}
+
+// Create returns a create builder for IngestionRun.
+func (c *IngestionRunClient) Create() *IngestionRunCreate {
+	mutation := newIngestionRunMutation(c.config, OpCreate)
+	return &IngestionRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IngestionRun entities.
+func (c *IngestionRunClient) CreateBulk(builders ...*IngestionRunCreate) *IngestionRunCreateBulk {
+	return &IngestionRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IngestionRun.
+func (c *IngestionRunClient) Update() *IngestionRunUpdate {
+	mutation := newIngestionRunMutation(c.config, OpUpdate)
+	return &IngestionRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IngestionRunClient) UpdateOne(ir *IngestionRun) *IngestionRunUpdateOne {
+	mutation := newIngestionRunMutation(c.config, OpUpdateOne, withIngestionRun(ir))
+	return &IngestionRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IngestionRunClient) UpdateOneID(id int) *IngestionRunUpdateOne {
+	mutation := newIngestionRunMutation(c.config, OpUpdateOne, withIngestionRunID(id))
+	return &IngestionRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IngestionRun.
+func (c *IngestionRunClient) Delete() *IngestionRunDelete {
+	mutation := newIngestionRunMutation(c.config, OpDelete)
+	return &IngestionRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *IngestionRunClient) DeleteOne(ir *IngestionRun) *IngestionRunDeleteOne {
+	return c.DeleteOneID(ir.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *IngestionRunClient) DeleteOneID(id int) *IngestionRunDeleteOne {
+	builder := c.Delete().Where(ingestionrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IngestionRunDeleteOne{builder}
+}
+
+// Query returns a query builder for IngestionRun.
+func (c *IngestionRunClient) Query() *IngestionRunQuery {
+	return &IngestionRunQuery{config: c.config}
+}
+
+// Get returns a IngestionRun entity by its id.
+func (c *IngestionRunClient) Get(ctx context.Context, id int) (*IngestionRun, error) {
+	return c.Query().Where(ingestionrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IngestionRunClient) GetX(ctx context.Context, id int) *IngestionRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *IngestionRunClient) Hooks() []Hook {
+	return c.hooks.IngestionRun
+}
+
+// InvitationClient is a client for the Invitation schema.
+type InvitationClient struct {
+	config
+}
+
+// NewInvitationClient returns a client for the Invitation from the given config.
+func NewInvitationClient(c config) *InvitationClient {
+	return &InvitationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invitation.Hooks(f(g(h())))`.
+func (c *InvitationClient) Use(hooks ...Hook) {
+	c.hooks.Invitation = append(c.hooks.Invitation, hooks...)
+}
+
+// Create returns a create builder for Invitation.
+func (c *InvitationClient) Create() *InvitationCreate {
+	mutation := newInvitationMutation(c.config, OpCreate)
+	return &InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Invitation entities.
+func (c *InvitationClient) CreateBulk(builders ...*InvitationCreate) *InvitationCreateBulk {
+	return &InvitationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Invitation.
+func (c *InvitationClient) Update() *InvitationUpdate {
+	mutation := newInvitationMutation(c.config, OpUpdate)
+	return &InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvitationClient) UpdateOne(i *Invitation) *InvitationUpdateOne {
+	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitation(i))
+	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvitationClient) UpdateOneID(id int) *InvitationUpdateOne {
+	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitationID(id))
+	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Invitation.
+func (c *InvitationClient) Delete() *InvitationDelete {
+	mutation := newInvitationMutation(c.config, OpDelete)
+	return &InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *InvitationClient) DeleteOne(i *Invitation) *InvitationDeleteOne {
+	return c.DeleteOneID(i.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *InvitationClient) DeleteOneID(id int) *InvitationDeleteOne {
+	builder := c.Delete().Where(invitation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvitationDeleteOne{builder}
+}
+
+// Query returns a query builder for Invitation.
+func (c *InvitationClient) Query() *InvitationQuery {
+	return &InvitationQuery{config: c.config}
+}
+
+// Get returns a Invitation entity by its id.
+func (c *InvitationClient) Get(ctx context.Context, id int) (*Invitation, error) {
+	return c.Query().Where(invitation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvitationClient) GetX(ctx context.Context, id int) *Invitation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLeague queries the league edge of a Invitation.
+func (c *InvitationClient) QueryLeague(i *Invitation) *LeagueQuery {
+	query := &LeagueQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invitation.Table, invitation.FieldID, id),
+			sqlgraph.To(league.Table, league.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invitation.LeagueTable, invitation.LeagueColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInviter queries the inviter edge of a Invitation.
+func (c *InvitationClient) QueryInviter(i *Invitation) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invitation.Table, invitation.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invitation.InviterTable, invitation.InviterColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *InvitationClient) Hooks() []Hook {
+	return c.hooks.Invitation
+}
+
+// LeagueClient is a client for the League schema.
+type LeagueClient struct {
+	config
+}
+
+// NewLeagueClient returns a client for the League from the given config.
+func NewLeagueClient(c config) *LeagueClient {
+	return &LeagueClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `league.Hooks(f(g(h())))`.
+func (c *LeagueClient) Use(hooks ...Hook) {
+	c.hooks.League = append(c.hooks.League, hooks...)
+}
+
+// Create returns a create builder for League.
+func (c *LeagueClient) Create() *LeagueCreate {
+	mutation := newLeagueMutation(c.config, OpCreate)
+	return &LeagueCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of League entities.
+func (c *LeagueClient) CreateBulk(builders ...*LeagueCreate) *LeagueCreateBulk {
+	return &LeagueCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for League.
+func (c *LeagueClient) Update() *LeagueUpdate {
+	mutation := newLeagueMutation(c.config, OpUpdate)
+	return &LeagueUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeagueClient) UpdateOne(l *League) *LeagueUpdateOne {
+	mutation := newLeagueMutation(c.config, OpUpdateOne, withLeague(l))
+	return &LeagueUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeagueClient) UpdateOneID(id int) *LeagueUpdateOne {
+	mutation := newLeagueMutation(c.config, OpUpdateOne, withLeagueID(id))
+	return &LeagueUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for League.
+func (c *LeagueClient) Delete() *LeagueDelete {
+	mutation := newLeagueMutation(c.config, OpDelete)
+	return &LeagueDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *LeagueClient) DeleteOne(l *League) *LeagueDeleteOne {
+	return c.DeleteOneID(l.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *LeagueClient) DeleteOneID(id int) *LeagueDeleteOne {
+	builder := c.Delete().Where(league.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeagueDeleteOne{builder}
+}
+
+// Query returns a query builder for League.
+func (c *LeagueClient) Query() *LeagueQuery {
+	return &LeagueQuery{config: c.config}
+}
+
+// Get returns a League entity by its id.
+func (c *LeagueClient) Get(ctx context.Context, id int) (*League, error) {
+	return c.Query().Where(league.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeagueClient) GetX(ctx context.Context, id int) *League {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMemberships queries the memberships edge of a League.
+func (c *LeagueClient) QueryMemberships(l *League) *LeagueMembershipQuery {
+	query := &LeagueMembershipQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(league.Table, league.FieldID, id),
+			sqlgraph.To(leaguemembership.Table, leaguemembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, league.MembershipsTable, league.MembershipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvitations queries the invitations edge of a League.
+func (c *LeagueClient) QueryInvitations(l *League) *InvitationQuery {
+	query := &InvitationQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(league.Table, league.FieldID, id),
+			sqlgraph.To(invitation.Table, invitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, league.InvitationsTable, league.InvitationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryContests queries the contests edge of a League.
+func (c *LeagueClient) QueryContests(l *League) *ContestQuery {
+	query := &ContestQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(league.Table, league.FieldID, id),
+			sqlgraph.To(contest.Table, contest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, league.ContestsTable, league.ContestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LeagueClient) Hooks() []Hook {
+	return c.hooks.League
+}
+
+// LeagueMembershipClient is a client for the LeagueMembership schema.
+type LeagueMembershipClient struct {
+	config
+}
+
+// NewLeagueMembershipClient returns a client for the LeagueMembership from the given config.
+func NewLeagueMembershipClient(c config) *LeagueMembershipClient {
+	return &LeagueMembershipClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `leaguemembership.Hooks(f(g(h())))`.
+func (c *LeagueMembershipClient) Use(hooks ...Hook) {
+	c.hooks.LeagueMembership = append(c.hooks.LeagueMembership, hooks...)
+}
+
+// Create returns a create builder for LeagueMembership.
+func (c *LeagueMembershipClient) Create() *LeagueMembershipCreate {
+	mutation := newLeagueMembershipMutation(c.config, OpCreate)
+	return &LeagueMembershipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LeagueMembership entities.
+func (c *LeagueMembershipClient) CreateBulk(builders ...*LeagueMembershipCreate) *LeagueMembershipCreateBulk {
+	return &LeagueMembershipCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LeagueMembership.
+func (c *LeagueMembershipClient) Update() *LeagueMembershipUpdate {
+	mutation := newLeagueMembershipMutation(c.config, OpUpdate)
+	return &LeagueMembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeagueMembershipClient) UpdateOne(lm *LeagueMembership) *LeagueMembershipUpdateOne {
+	mutation := newLeagueMembershipMutation(c.config, OpUpdateOne, withLeagueMembership(lm))
+	return &LeagueMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeagueMembershipClient) UpdateOneID(id int) *LeagueMembershipUpdateOne {
+	mutation := newLeagueMembershipMutation(c.config, OpUpdateOne, withLeagueMembershipID(id))
+	return &LeagueMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LeagueMembership.
+func (c *LeagueMembershipClient) Delete() *LeagueMembershipDelete {
+	mutation := newLeagueMembershipMutation(c.config, OpDelete)
+	return &LeagueMembershipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *LeagueMembershipClient) DeleteOne(lm *LeagueMembership) *LeagueMembershipDeleteOne {
+	return c.DeleteOneID(lm.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *LeagueMembershipClient) DeleteOneID(id int) *LeagueMembershipDeleteOne {
+	builder := c.Delete().Where(leaguemembership.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeagueMembershipDeleteOne{builder}
+}
+
+// Query returns a query builder for LeagueMembership.
+func (c *LeagueMembershipClient) Query() *LeagueMembershipQuery {
+	return &LeagueMembershipQuery{config: c.config}
+}
+
+// Get returns a LeagueMembership entity by its id.
+func (c *LeagueMembershipClient) Get(ctx context.Context, id int) (*LeagueMembership, error) {
+	return c.Query().Where(leaguemembership.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeagueMembershipClient) GetX(ctx context.Context, id int) *LeagueMembership {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a LeagueMembership.
+func (c *LeagueMembershipClient) QueryUser(lm *LeagueMembership) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := lm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leaguemembership.Table, leaguemembership.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leaguemembership.UserTable, leaguemembership.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(lm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// Close closes the database connection and prevents new queries from starting.
-func (c *Client) Close() error {
-	return c.driver.Close()
+// QueryLeague queries the league edge of a LeagueMembership.
+func (c *LeagueMembershipClient) QueryLeague(lm *LeagueMembership) *LeagueQuery {
+	query := &LeagueQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := lm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leaguemembership.Table, leaguemembership.FieldID, id),
+			sqlgraph.To(league.Table, league.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leaguemembership.LeagueTable, leaguemembership.LeagueColumn),
+		)
+		fromV = sqlgraph.Neighbors(lm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// Use adds the mutation hooks to all the entity clients.
-// In order to add hooks to a specific client, call: `client.Node.Use(...)`.
-func (c *Client) Use(hooks ...Hook) {
-	c.User.Use(hooks...)
+// Hooks returns the client hooks.
+func (c *LeagueMembershipClient) Hooks() []Hook {
+	return c.hooks.LeagueMembership
+}
+
+// PlayerClient is a client for the Player schema.
+type PlayerClient struct {
+	config
+}
+
+// NewPlayerClient returns a client for the Player from the given config.
+func NewPlayerClient(c config) *PlayerClient {
+	return &PlayerClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `player.Hooks(f(g(h())))`.
+func (c *PlayerClient) Use(hooks ...Hook) {
+	c.hooks.Player = append(c.hooks.Player, hooks...)
+}
+
+// Create returns a create builder for Player.
+func (c *PlayerClient) Create() *PlayerCreate {
+	mutation := newPlayerMutation(c.config, OpCreate)
+	return &PlayerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Player entities.
+func (c *PlayerClient) CreateBulk(builders ...*PlayerCreate) *PlayerCreateBulk {
+	return &PlayerCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Player.
+func (c *PlayerClient) Update() *PlayerUpdate {
+	mutation := newPlayerMutation(c.config, OpUpdate)
+	return &PlayerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PlayerClient) UpdateOne(pl *Player) *PlayerUpdateOne {
+	mutation := newPlayerMutation(c.config, OpUpdateOne, withPlayer(pl))
+	return &PlayerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PlayerClient) UpdateOneID(id int) *PlayerUpdateOne {
+	mutation := newPlayerMutation(c.config, OpUpdateOne, withPlayerID(id))
+	return &PlayerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Player.
+func (c *PlayerClient) Delete() *PlayerDelete {
+	mutation := newPlayerMutation(c.config, OpDelete)
+	return &PlayerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *PlayerClient) DeleteOne(pl *Player) *PlayerDeleteOne {
+	return c.DeleteOneID(pl.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *PlayerClient) DeleteOneID(id int) *PlayerDeleteOne {
+	builder := c.Delete().Where(player.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PlayerDeleteOne{builder}
+}
+
+// Query returns a query builder for Player.
+func (c *PlayerClient) Query() *PlayerQuery {
+	return &PlayerQuery{config: c.config}
+}
+
+// Get returns a Player entity by its id.
+func (c *PlayerClient) Get(ctx context.Context, id int) (*Player, error) {
+	return c.Query().Where(player.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PlayerClient) GetX(ctx context.Context, id int) *Player {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTeam queries the team edge of a Player.
+func (c *PlayerClient) QueryTeam(pl *Player) *TeamQuery {
+	query := &TeamQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := pl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, id),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, player.TeamTable, player.TeamColumn),
+		)
+		fromV = sqlgraph.Neighbors(pl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPerformances queries the performances edge of a Player.
+func (c *PlayerClient) QueryPerformances(pl *Player) *PlayerPerformanceQuery {
+	query := &PlayerPerformanceQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := pl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, id),
+			sqlgraph.To(playerperformance.Table, playerperformance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.PerformancesTable, player.PerformancesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDraftPicks queries the draftPicks edge of a Player.
+func (c *PlayerClient) QueryDraftPicks(pl *Player) *ContestDraftPickQuery {
+	query := &ContestDraftPickQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := pl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, id),
+			sqlgraph.To(contestdraftpick.Table, contestdraftpick.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.DraftPicksTable, player.DraftPicksColumn),
+		)
+		fromV = sqlgraph.Neighbors(pl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PlayerClient) Hooks() []Hook {
+	return c.hooks.Player
+}
+
+// PlayerPerformanceClient is a client for the PlayerPerformance schema.
+type PlayerPerformanceClient struct {
+	config
+}
+
+// NewPlayerPerformanceClient returns a client for the PlayerPerformance from the given config.
+func NewPlayerPerformanceClient(c config) *PlayerPerformanceClient {
+	return &PlayerPerformanceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `playerperformance.Hooks(f(g(h())))`.
+func (c *PlayerPerformanceClient) Use(hooks ...Hook) {
+	c.hooks.PlayerPerformance = append(c.hooks.PlayerPerformance, hooks...)
+}
+
+// Create returns a create builder for PlayerPerformance.
+func (c *PlayerPerformanceClient) Create() *PlayerPerformanceCreate {
+	mutation := newPlayerPerformanceMutation(c.config, OpCreate)
+	return &PlayerPerformanceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PlayerPerformance entities.
+func (c *PlayerPerformanceClient) CreateBulk(builders ...*PlayerPerformanceCreate) *PlayerPerformanceCreateBulk {
+	return &PlayerPerformanceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PlayerPerformance.
+func (c *PlayerPerformanceClient) Update() *PlayerPerformanceUpdate {
+	mutation := newPlayerPerformanceMutation(c.config, OpUpdate)
+	return &PlayerPerformanceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PlayerPerformanceClient) UpdateOne(pp *PlayerPerformance) *PlayerPerformanceUpdateOne {
+	mutation := newPlayerPerformanceMutation(c.config, OpUpdateOne, withPlayerPerformance(pp))
+	return &PlayerPerformanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PlayerPerformanceClient) UpdateOneID(id int) *PlayerPerformanceUpdateOne {
+	mutation := newPlayerPerformanceMutation(c.config, OpUpdateOne, withPlayerPerformanceID(id))
+	return &PlayerPerformanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PlayerPerformance.
+func (c *PlayerPerformanceClient) Delete() *PlayerPerformanceDelete {
+	mutation := newPlayerPerformanceMutation(c.config, OpDelete)
+	return &PlayerPerformanceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *PlayerPerformanceClient) DeleteOne(pp *PlayerPerformance) *PlayerPerformanceDeleteOne {
+	return c.DeleteOneID(pp.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *PlayerPerformanceClient) DeleteOneID(id int) *PlayerPerformanceDeleteOne {
+	builder := c.Delete().Where(playerperformance.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PlayerPerformanceDeleteOne{builder}
+}
+
+// Query returns a query builder for PlayerPerformance.
+func (c *PlayerPerformanceClient) Query() *PlayerPerformanceQuery {
+	return &PlayerPerformanceQuery{config: c.config}
+}
+
+// Get returns a PlayerPerformance entity by its id.
+func (c *PlayerPerformanceClient) Get(ctx context.Context, id int) (*PlayerPerformance, error) {
+	return c.Query().Where(playerperformance.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PlayerPerformanceClient) GetX(ctx context.Context, id int) *PlayerPerformance {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlayer queries the player edge of a PlayerPerformance.
+func (c *PlayerPerformanceClient) QueryPlayer(pp *PlayerPerformance) *PlayerQuery {
+	query := &PlayerQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playerperformance.Table, playerperformance.FieldID, id),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, playerperformance.PlayerTable, playerperformance.PlayerColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGame queries the game edge of a PlayerPerformance.
+func (c *PlayerPerformanceClient) QueryGame(pp *PlayerPerformance) *GameQuery {
+	query := &GameQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playerperformance.Table, playerperformance.FieldID, id),
+			sqlgraph.To(game.Table, game.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, playerperformance.GameTable, playerperformance.GameColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTeam queries the team edge of a PlayerPerformance.
+func (c *PlayerPerformanceClient) QueryTeam(pp *PlayerPerformance) *TeamQuery {
+	query := &TeamQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playerperformance.Table, playerperformance.FieldID, id),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, playerperformance.TeamTable, playerperformance.TeamColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PlayerPerformanceClient) Hooks() []Hook {
+	return c.hooks.PlayerPerformance
+}
+
+// RefreshTokenClient is a client for the RefreshToken schema.
+type RefreshTokenClient struct {
+	config
+}
+
+// NewRefreshTokenClient returns a client for the RefreshToken from the given config.
+func NewRefreshTokenClient(c config) *RefreshTokenClient {
+	return &RefreshTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `refreshtoken.Hooks(f(g(h())))`.
+func (c *RefreshTokenClient) Use(hooks ...Hook) {
+	c.hooks.RefreshToken = append(c.hooks.RefreshToken, hooks...)
+}
+
+// Create returns a create builder for RefreshToken.
+func (c *RefreshTokenClient) Create() *RefreshTokenCreate {
+	mutation := newRefreshTokenMutation(c.config, OpCreate)
+	return &RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RefreshToken entities.
+func (c *RefreshTokenClient) CreateBulk(builders ...*RefreshTokenCreate) *RefreshTokenCreateBulk {
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RefreshToken.
+func (c *RefreshTokenClient) Update() *RefreshTokenUpdate {
+	mutation := newRefreshTokenMutation(c.config, OpUpdate)
+	return &RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RefreshTokenClient) UpdateOne(rt *RefreshToken) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshToken(rt))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RefreshTokenClient) UpdateOneID(id int) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshTokenID(id))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RefreshToken.
+func (c *RefreshTokenClient) Delete() *RefreshTokenDelete {
+	mutation := newRefreshTokenMutation(c.config, OpDelete)
+	return &RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *RefreshTokenClient) DeleteOne(rt *RefreshToken) *RefreshTokenDeleteOne {
+	return c.DeleteOneID(rt.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *RefreshTokenClient) DeleteOneID(id int) *RefreshTokenDeleteOne {
+	builder := c.Delete().Where(refreshtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RefreshTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for RefreshToken.
+func (c *RefreshTokenClient) Query() *RefreshTokenQuery {
+	return &RefreshTokenQuery{config: c.config}
+}
+
+// Get returns a RefreshToken entity by its id.
+func (c *RefreshTokenClient) Get(ctx context.Context, id int) (*RefreshToken, error) {
+	return c.Query().Where(refreshtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RefreshTokenClient) GetX(ctx context.Context, id int) *RefreshToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a RefreshToken.
+func (c *RefreshTokenClient) QueryUser(rt *RefreshToken) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := rt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(refreshtoken.Table, refreshtoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, refreshtoken.UserTable, refreshtoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(rt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RefreshTokenClient) Hooks() []Hook {
+	return c.hooks.RefreshToken
+}
+
+// TeamClient is a client for the Team schema.
+type TeamClient struct {
+	config
+}
+
+// NewTeamClient returns a client for the Team from the given config.
+func NewTeamClient(c config) *TeamClient {
+	return &TeamClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `team.Hooks(f(g(h())))`.
+func (c *TeamClient) Use(hooks ...Hook) {
+	c.hooks.Team = append(c.hooks.Team, hooks...)
+}
+
+// Create returns a create builder for Team.
+func (c *TeamClient) Create() *TeamCreate {
+	mutation := newTeamMutation(c.config, OpCreate)
+	return &TeamCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Team entities.
+func (c *TeamClient) CreateBulk(builders ...*TeamCreate) *TeamCreateBulk {
+	return &TeamCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Team.
+func (c *TeamClient) Update() *TeamUpdate {
+	mutation := newTeamMutation(c.config, OpUpdate)
+	return &TeamUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TeamClient) UpdateOne(t *Team) *TeamUpdateOne {
+	mutation := newTeamMutation(c.config, OpUpdateOne, withTeam(t))
+	return &TeamUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TeamClient) UpdateOneID(id int) *TeamUpdateOne {
+	mutation := newTeamMutation(c.config, OpUpdateOne, withTeamID(id))
+	return &TeamUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Team.
+func (c *TeamClient) Delete() *TeamDelete {
+	mutation := newTeamMutation(c.config, OpDelete)
+	return &TeamDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *TeamClient) DeleteOne(t *Team) *TeamDeleteOne {
+	return c.DeleteOneID(t.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *TeamClient) DeleteOneID(id int) *TeamDeleteOne {
+	builder := c.Delete().Where(team.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TeamDeleteOne{builder}
+}
+
+// Query returns a query builder for Team.
+func (c *TeamClient) Query() *TeamQuery {
+	return &TeamQuery{config: c.config}
+}
+
+// Get returns a Team entity by its id.
+func (c *TeamClient) Get(ctx context.Context, id int) (*Team, error) {
+	return c.Query().Where(team.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TeamClient) GetX(ctx context.Context, id int) *Team {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlayers queries the players edge of a Team.
+func (c *TeamClient) QueryPlayers(t *Team) *PlayerQuery {
+	query := &PlayerQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, id),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, team.PlayersTable, team.PlayersColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHomeGames queries the homeGames edge of a Team.
+func (c *TeamClient) QueryHomeGames(t *Team) *GameQuery {
+	query := &GameQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, id),
+			sqlgraph.To(game.Table, game.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, team.HomeGamesTable, team.HomeGamesColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAwayGames queries the awayGames edge of a Team.
+func (c *TeamClient) QueryAwayGames(t *Team) *GameQuery {
+	query := &GameQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, id),
+			sqlgraph.To(game.Table, game.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, team.AwayGamesTable, team.AwayGamesColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWonGames queries the wonGames edge of a Team.
+func (c *TeamClient) QueryWonGames(t *Team) *GameResultQuery {
+	query := &GameResultQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, id),
+			sqlgraph.To(gameresult.Table, gameresult.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, team.WonGamesTable, team.WonGamesColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPerformances queries the performances edge of a Team.
+func (c *TeamClient) QueryPerformances(t *Team) *PlayerPerformanceQuery {
+	query := &PlayerPerformanceQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, id),
+			sqlgraph.To(playerperformance.Table, playerperformance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, team.PerformancesTable, team.PerformancesColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TeamClient) Hooks() []Hook {
+	return c.hooks.Team
 }
 
 // UserClient is a client for the User schema.
@@ -204,6 +2459,134 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 	return obj
 }
 
+// QueryMemberships queries the memberships edge of a User.
+func (c *UserClient) QueryMemberships(u *User) *LeagueMembershipQuery {
+	query := &LeagueMembershipQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(leaguemembership.Table, leaguemembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.MembershipsTable, user.MembershipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySentInvitations queries the sentInvitations edge of a User.
+func (c *UserClient) QuerySentInvitations(u *User) *InvitationQuery {
+	query := &InvitationQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(invitation.Table, invitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SentInvitationsTable, user.SentInvitationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIdentities queries the identities edge of a User.
+func (c *UserClient) QueryIdentities(u *User) *IdentityQuery {
+	query := &IdentityQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(identity.Table, identity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.IdentitiesTable, user.IdentitiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAuthCodes queries the authCodes edge of a User.
+func (c *UserClient) QueryAuthCodes(u *User) *AuthCodeQuery {
+	query := &AuthCodeQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(authcode.Table, authcode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AuthCodesTable, user.AuthCodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRefreshTokens queries the refreshTokens edge of a User.
+func (c *UserClient) QueryRefreshTokens(u *User) *RefreshTokenQuery {
+	query := &RefreshTokenQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(refreshtoken.Table, refreshtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RefreshTokensTable, user.RefreshTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDraftPicks queries the draftPicks edge of a User.
+func (c *UserClient) QueryDraftPicks(u *User) *ContestDraftPickQuery {
+	query := &ContestDraftPickQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(contestdraftpick.Table, contestdraftpick.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.DraftPicksTable, user.DraftPicksColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryContestEntries queries the contestEntries edge of a User.
+func (c *UserClient) QueryContestEntries(u *User) *ContestEntryQuery {
+	query := &ContestEntryQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(contestentry.Table, contestentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ContestEntriesTable, user.ContestEntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWonContests queries the wonContests edge of a User.
+func (c *UserClient) QueryWonContests(u *User) *ContestQuery {
+	query := &ContestQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(contest.Table, contest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.WonContestsTable, user.WonContestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
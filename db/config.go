@@ -24,7 +24,24 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	User []ent.Hook
+	AuthCode               []ent.Hook
+	Contest                []ent.Hook
+	ContestDraft           []ent.Hook
+	ContestDraftPick       []ent.Hook
+	ContestEntry           []ent.Hook
+	ContestEntryCorrection []ent.Hook
+	Game                   []ent.Hook
+	GameResult             []ent.Hook
+	Identity               []ent.Hook
+	IngestionRun           []ent.Hook
+	Invitation             []ent.Hook
+	League                 []ent.Hook
+	LeagueMembership       []ent.Hook
+	Player                 []ent.Hook
+	PlayerPerformance      []ent.Hook
+	RefreshToken           []ent.Hook
+	Team                   []ent.Hook
+	User                   []ent.Hook
 }
 
 // Options applies the options on the config object.
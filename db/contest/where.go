@@ -0,0 +1,418 @@
+// Code generated by entc, DO NOT EDIT.
+
+package contest
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Day applies equality check predicate on the "day" field. It's identical to DayEQ.
+func Day(v time.Time) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDay), v))
+	})
+}
+
+// Closed applies equality check predicate on the "closed" field. It's identical to ClosedEQ.
+func Closed(v time.Time) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldClosed), v))
+	})
+}
+
+// DayEQ applies the EQ predicate on the "day" field.
+func DayEQ(v time.Time) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDay), v))
+	})
+}
+
+// DayNEQ applies the NEQ predicate on the "day" field.
+func DayNEQ(v time.Time) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDay), v))
+	})
+}
+
+// DayIn applies the In predicate on the "day" field.
+func DayIn(vs ...time.Time) predicate.Contest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Contest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDay), v...))
+	})
+}
+
+// DayNotIn applies the NotIn predicate on the "day" field.
+func DayNotIn(vs ...time.Time) predicate.Contest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Contest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDay), v...))
+	})
+}
+
+// DayGT applies the GT predicate on the "day" field.
+func DayGT(v time.Time) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDay), v))
+	})
+}
+
+// DayGTE applies the GTE predicate on the "day" field.
+func DayGTE(v time.Time) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDay), v))
+	})
+}
+
+// DayLT applies the LT predicate on the "day" field.
+func DayLT(v time.Time) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDay), v))
+	})
+}
+
+// DayLTE applies the LTE predicate on the "day" field.
+func DayLTE(v time.Time) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDay), v))
+	})
+}
+
+// ClosedEQ applies the EQ predicate on the "closed" field.
+func ClosedEQ(v time.Time) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldClosed), v))
+	})
+}
+
+// ClosedNEQ applies the NEQ predicate on the "closed" field.
+func ClosedNEQ(v time.Time) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldClosed), v))
+	})
+}
+
+// ClosedIn applies the In predicate on the "closed" field.
+func ClosedIn(vs ...time.Time) predicate.Contest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Contest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldClosed), v...))
+	})
+}
+
+// ClosedNotIn applies the NotIn predicate on the "closed" field.
+func ClosedNotIn(vs ...time.Time) predicate.Contest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Contest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldClosed), v...))
+	})
+}
+
+// ClosedGT applies the GT predicate on the "closed" field.
+func ClosedGT(v time.Time) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldClosed), v))
+	})
+}
+
+// ClosedGTE applies the GTE predicate on the "closed" field.
+func ClosedGTE(v time.Time) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldClosed), v))
+	})
+}
+
+// ClosedLT applies the LT predicate on the "closed" field.
+func ClosedLT(v time.Time) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldClosed), v))
+	})
+}
+
+// ClosedLTE applies the LTE predicate on the "closed" field.
+func ClosedLTE(v time.Time) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldClosed), v))
+	})
+}
+
+// ClosedIsNil applies the IsNil predicate on the "closed" field.
+func ClosedIsNil() predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldClosed)))
+	})
+}
+
+// ClosedNotNil applies the NotNil predicate on the "closed" field.
+func ClosedNotNil() predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldClosed)))
+	})
+}
+
+// HasLeague applies the HasEdge predicate on the "league" edge.
+func HasLeague() predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(LeagueTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LeagueTable, LeagueColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLeagueWith applies the HasEdge predicate on the "league" edge with a given conditions (other predicates).
+func HasLeagueWith(preds ...predicate.League) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(LeagueInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LeagueTable, LeagueColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWinner applies the HasEdge predicate on the "winner" edge.
+func HasWinner() predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WinnerTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WinnerTable, WinnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWinnerWith applies the HasEdge predicate on the "winner" edge with a given conditions (other predicates).
+func HasWinnerWith(preds ...predicate.User) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WinnerInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WinnerTable, WinnerColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDraft applies the HasEdge predicate on the "draft" edge.
+func HasDraft() predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(DraftTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, DraftTable, DraftColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDraftWith applies the HasEdge predicate on the "draft" edge with a given conditions (other predicates).
+func HasDraftWith(preds ...predicate.ContestDraft) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(DraftInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, DraftTable, DraftColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEntries applies the HasEdge predicate on the "entries" edge.
+func HasEntries() predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(EntriesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EntriesTable, EntriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEntriesWith applies the HasEdge predicate on the "entries" edge with a given conditions (other predicates).
+func HasEntriesWith(preds ...predicate.ContestEntry) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(EntriesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EntriesTable, EntriesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Contest) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Contest) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Contest) predicate.Contest {
+	return predicate.Contest(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,356 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/contest"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraft"
+	"github.com/NickDubelman/fantasy-bball/db/contestentry"
+	"github.com/NickDubelman/fantasy-bball/db/league"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+)
+
+// ContestCreate is the builder for creating a Contest entity.
+type ContestCreate struct {
+	config
+	mutation *ContestMutation
+	hooks    []Hook
+}
+
+// SetDay sets the "day" field.
+func (cc *ContestCreate) SetDay(t time.Time) *ContestCreate {
+	cc.mutation.SetDay(t)
+	return cc
+}
+
+// SetClosed sets the "closed" field.
+func (cc *ContestCreate) SetClosed(t time.Time) *ContestCreate {
+	cc.mutation.SetClosed(t)
+	return cc
+}
+
+// SetNillableClosed sets the "closed" field if the given value is not nil.
+func (cc *ContestCreate) SetNillableClosed(t *time.Time) *ContestCreate {
+	if t != nil {
+		cc.SetClosed(*t)
+	}
+	return cc
+}
+
+// SetLeagueID sets the "league" edge to the League entity by ID.
+func (cc *ContestCreate) SetLeagueID(id int) *ContestCreate {
+	cc.mutation.SetLeagueID(id)
+	return cc
+}
+
+// SetLeague sets the "league" edge to the League entity.
+func (cc *ContestCreate) SetLeague(l *League) *ContestCreate {
+	return cc.SetLeagueID(l.ID)
+}
+
+// SetWinnerID sets the "winner" edge to the User entity by ID.
+func (cc *ContestCreate) SetWinnerID(id int) *ContestCreate {
+	cc.mutation.SetWinnerID(id)
+	return cc
+}
+
+// SetNillableWinnerID sets the "winner" edge to the User entity by ID if the given value is not nil.
+func (cc *ContestCreate) SetNillableWinnerID(id *int) *ContestCreate {
+	if id != nil {
+		cc = cc.SetWinnerID(*id)
+	}
+	return cc
+}
+
+// SetWinner sets the "winner" edge to the User entity.
+func (cc *ContestCreate) SetWinner(u *User) *ContestCreate {
+	return cc.SetWinnerID(u.ID)
+}
+
+// SetDraftID sets the "draft" edge to the ContestDraft entity by ID.
+func (cc *ContestCreate) SetDraftID(id int) *ContestCreate {
+	cc.mutation.SetDraftID(id)
+	return cc
+}
+
+// SetNillableDraftID sets the "draft" edge to the ContestDraft entity by ID if the given value is not nil.
+func (cc *ContestCreate) SetNillableDraftID(id *int) *ContestCreate {
+	if id != nil {
+		cc = cc.SetDraftID(*id)
+	}
+	return cc
+}
+
+// SetDraft sets the "draft" edge to the ContestDraft entity.
+func (cc *ContestCreate) SetDraft(c *ContestDraft) *ContestCreate {
+	return cc.SetDraftID(c.ID)
+}
+
+// AddEntryIDs adds the "entries" edge to the ContestEntry entity by IDs.
+func (cc *ContestCreate) AddEntryIDs(ids ...int) *ContestCreate {
+	cc.mutation.AddEntryIDs(ids...)
+	return cc
+}
+
+// AddEntries adds the "entries" edges to the ContestEntry entity.
+func (cc *ContestCreate) AddEntries(c ...*ContestEntry) *ContestCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddEntryIDs(ids...)
+}
+
+// Mutation returns the ContestMutation object of the builder.
+func (cc *ContestCreate) Mutation() *ContestMutation {
+	return cc.mutation
+}
+
+// Save creates the Contest in the database.
+func (cc *ContestCreate) Save(ctx context.Context) (*Contest, error) {
+	var (
+		err  error
+		node *Contest
+	)
+	if len(cc.hooks) == 0 {
+		if err = cc.check(); err != nil {
+			return nil, err
+		}
+		node, err = cc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ContestMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cc.check(); err != nil {
+				return nil, err
+			}
+			cc.mutation = mutation
+			node, err = cc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cc.hooks) - 1; i >= 0; i-- {
+			mut = cc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *ContestCreate) SaveX(ctx context.Context) *Contest {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *ContestCreate) check() error {
+	if _, ok := cc.mutation.Day(); !ok {
+		return &ValidationError{Name: "day", err: errors.New("db: missing required field \"day\"")}
+	}
+	if _, ok := cc.mutation.LeagueID(); !ok {
+		return &ValidationError{Name: "league", err: errors.New("db: missing required edge \"league\"")}
+	}
+	return nil
+}
+
+func (cc *ContestCreate) sqlSave(ctx context.Context) (*Contest, error) {
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (cc *ContestCreate) createSpec() (*Contest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Contest{config: cc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: contest.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: contest.FieldID,
+			},
+		}
+	)
+	if value, ok := cc.mutation.Day(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: contest.FieldDay,
+		})
+		_node.Day = value
+	}
+	if value, ok := cc.mutation.Closed(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: contest.FieldClosed,
+		})
+		_node.Closed = &value
+	}
+	if nodes := cc.mutation.LeagueIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contest.LeagueTable,
+			Columns: []string{contest.LeagueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: league.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.league_contests = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.WinnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contest.WinnerTable,
+			Columns: []string{contest.WinnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_won_contests = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.DraftIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   contest.DraftTable,
+			Columns: []string{contest.DraftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraft.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.EntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contest.EntriesTable,
+			Columns: []string{contest.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ContestCreateBulk is the builder for creating many Contest entities in bulk.
+type ContestCreateBulk struct {
+	config
+	builders []*ContestCreate
+}
+
+// Save creates the Contest entities in the database.
+func (ccb *ContestCreateBulk) Save(ctx context.Context) ([]*Contest, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Contest, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ContestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *ContestCreateBulk) SaveX(ctx context.Context) []*Contest {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,836 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/contest"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraft"
+	"github.com/NickDubelman/fantasy-bball/db/contestentry"
+	"github.com/NickDubelman/fantasy-bball/db/league"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+)
+
+// ContestUpdate is the builder for updating Contest entities.
+type ContestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ContestMutation
+}
+
+// Where adds a new predicate for the ContestUpdate builder.
+func (cu *ContestUpdate) Where(ps ...predicate.Contest) *ContestUpdate {
+	cu.mutation.predicates = append(cu.mutation.predicates, ps...)
+	return cu
+}
+
+// SetClosed sets the "closed" field.
+func (cu *ContestUpdate) SetClosed(t time.Time) *ContestUpdate {
+	cu.mutation.SetClosed(t)
+	return cu
+}
+
+// SetNillableClosed sets the "closed" field if the given value is not nil.
+func (cu *ContestUpdate) SetNillableClosed(t *time.Time) *ContestUpdate {
+	if t != nil {
+		cu.SetClosed(*t)
+	}
+	return cu
+}
+
+// ClearClosed clears the value of the "closed" field.
+func (cu *ContestUpdate) ClearClosed() *ContestUpdate {
+	cu.mutation.ClearClosed()
+	return cu
+}
+
+// SetLeagueID sets the "league" edge to the League entity by ID.
+func (cu *ContestUpdate) SetLeagueID(id int) *ContestUpdate {
+	cu.mutation.SetLeagueID(id)
+	return cu
+}
+
+// SetLeague sets the "league" edge to the League entity.
+func (cu *ContestUpdate) SetLeague(l *League) *ContestUpdate {
+	return cu.SetLeagueID(l.ID)
+}
+
+// SetWinnerID sets the "winner" edge to the User entity by ID.
+func (cu *ContestUpdate) SetWinnerID(id int) *ContestUpdate {
+	cu.mutation.SetWinnerID(id)
+	return cu
+}
+
+// SetNillableWinnerID sets the "winner" edge to the User entity by ID if the given value is not nil.
+func (cu *ContestUpdate) SetNillableWinnerID(id *int) *ContestUpdate {
+	if id != nil {
+		cu = cu.SetWinnerID(*id)
+	}
+	return cu
+}
+
+// SetWinner sets the "winner" edge to the User entity.
+func (cu *ContestUpdate) SetWinner(u *User) *ContestUpdate {
+	return cu.SetWinnerID(u.ID)
+}
+
+// SetDraftID sets the "draft" edge to the ContestDraft entity by ID.
+func (cu *ContestUpdate) SetDraftID(id int) *ContestUpdate {
+	cu.mutation.SetDraftID(id)
+	return cu
+}
+
+// SetNillableDraftID sets the "draft" edge to the ContestDraft entity by ID if the given value is not nil.
+func (cu *ContestUpdate) SetNillableDraftID(id *int) *ContestUpdate {
+	if id != nil {
+		cu = cu.SetDraftID(*id)
+	}
+	return cu
+}
+
+// SetDraft sets the "draft" edge to the ContestDraft entity.
+func (cu *ContestUpdate) SetDraft(c *ContestDraft) *ContestUpdate {
+	return cu.SetDraftID(c.ID)
+}
+
+// AddEntryIDs adds the "entries" edge to the ContestEntry entity by IDs.
+func (cu *ContestUpdate) AddEntryIDs(ids ...int) *ContestUpdate {
+	cu.mutation.AddEntryIDs(ids...)
+	return cu
+}
+
+// AddEntries adds the "entries" edges to the ContestEntry entity.
+func (cu *ContestUpdate) AddEntries(c ...*ContestEntry) *ContestUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.AddEntryIDs(ids...)
+}
+
+// Mutation returns the ContestMutation object of the builder.
+func (cu *ContestUpdate) Mutation() *ContestMutation {
+	return cu.mutation
+}
+
+// ClearLeague clears the "league" edge to the League entity.
+func (cu *ContestUpdate) ClearLeague() *ContestUpdate {
+	cu.mutation.ClearLeague()
+	return cu
+}
+
+// ClearWinner clears the "winner" edge to the User entity.
+func (cu *ContestUpdate) ClearWinner() *ContestUpdate {
+	cu.mutation.ClearWinner()
+	return cu
+}
+
+// ClearDraft clears the "draft" edge to the ContestDraft entity.
+func (cu *ContestUpdate) ClearDraft() *ContestUpdate {
+	cu.mutation.ClearDraft()
+	return cu
+}
+
+// ClearEntries clears all "entries" edges to the ContestEntry entity.
+func (cu *ContestUpdate) ClearEntries() *ContestUpdate {
+	cu.mutation.ClearEntries()
+	return cu
+}
+
+// RemoveEntryIDs removes the "entries" edge to ContestEntry entities by IDs.
+func (cu *ContestUpdate) RemoveEntryIDs(ids ...int) *ContestUpdate {
+	cu.mutation.RemoveEntryIDs(ids...)
+	return cu
+}
+
+// RemoveEntries removes "entries" edges to ContestEntry entities.
+func (cu *ContestUpdate) RemoveEntries(c ...*ContestEntry) *ContestUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemoveEntryIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *ContestUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(cu.hooks) == 0 {
+		if err = cu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = cu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ContestMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cu.check(); err != nil {
+				return 0, err
+			}
+			cu.mutation = mutation
+			affected, err = cu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cu.hooks) - 1; i >= 0; i-- {
+			mut = cu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *ContestUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *ContestUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *ContestUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *ContestUpdate) check() error {
+	if _, ok := cu.mutation.LeagueID(); cu.mutation.LeagueCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"league\"")
+	}
+	return nil
+}
+
+func (cu *ContestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   contest.Table,
+			Columns: contest.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: contest.FieldID,
+			},
+		},
+	}
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.Closed(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: contest.FieldClosed,
+		})
+	}
+	if cu.mutation.ClosedCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: contest.FieldClosed,
+		})
+	}
+	if cu.mutation.LeagueCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contest.LeagueTable,
+			Columns: []string{contest.LeagueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: league.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.LeagueIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contest.LeagueTable,
+			Columns: []string{contest.LeagueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: league.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.WinnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contest.WinnerTable,
+			Columns: []string{contest.WinnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.WinnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contest.WinnerTable,
+			Columns: []string{contest.WinnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.DraftCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   contest.DraftTable,
+			Columns: []string{contest.DraftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraft.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.DraftIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   contest.DraftTable,
+			Columns: []string{contest.DraftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraft.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contest.EntriesTable,
+			Columns: []string{contest.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentry.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedEntriesIDs(); len(nodes) > 0 && !cu.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contest.EntriesTable,
+			Columns: []string{contest.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.EntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contest.EntriesTable,
+			Columns: []string{contest.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contest.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ContestUpdateOne is the builder for updating a single Contest entity.
+type ContestUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *ContestMutation
+}
+
+// SetClosed sets the "closed" field.
+func (cuo *ContestUpdateOne) SetClosed(t time.Time) *ContestUpdateOne {
+	cuo.mutation.SetClosed(t)
+	return cuo
+}
+
+// SetNillableClosed sets the "closed" field if the given value is not nil.
+func (cuo *ContestUpdateOne) SetNillableClosed(t *time.Time) *ContestUpdateOne {
+	if t != nil {
+		cuo.SetClosed(*t)
+	}
+	return cuo
+}
+
+// ClearClosed clears the value of the "closed" field.
+func (cuo *ContestUpdateOne) ClearClosed() *ContestUpdateOne {
+	cuo.mutation.ClearClosed()
+	return cuo
+}
+
+// SetLeagueID sets the "league" edge to the League entity by ID.
+func (cuo *ContestUpdateOne) SetLeagueID(id int) *ContestUpdateOne {
+	cuo.mutation.SetLeagueID(id)
+	return cuo
+}
+
+// SetLeague sets the "league" edge to the League entity.
+func (cuo *ContestUpdateOne) SetLeague(l *League) *ContestUpdateOne {
+	return cuo.SetLeagueID(l.ID)
+}
+
+// SetWinnerID sets the "winner" edge to the User entity by ID.
+func (cuo *ContestUpdateOne) SetWinnerID(id int) *ContestUpdateOne {
+	cuo.mutation.SetWinnerID(id)
+	return cuo
+}
+
+// SetNillableWinnerID sets the "winner" edge to the User entity by ID if the given value is not nil.
+func (cuo *ContestUpdateOne) SetNillableWinnerID(id *int) *ContestUpdateOne {
+	if id != nil {
+		cuo = cuo.SetWinnerID(*id)
+	}
+	return cuo
+}
+
+// SetWinner sets the "winner" edge to the User entity.
+func (cuo *ContestUpdateOne) SetWinner(u *User) *ContestUpdateOne {
+	return cuo.SetWinnerID(u.ID)
+}
+
+// SetDraftID sets the "draft" edge to the ContestDraft entity by ID.
+func (cuo *ContestUpdateOne) SetDraftID(id int) *ContestUpdateOne {
+	cuo.mutation.SetDraftID(id)
+	return cuo
+}
+
+// SetNillableDraftID sets the "draft" edge to the ContestDraft entity by ID if the given value is not nil.
+func (cuo *ContestUpdateOne) SetNillableDraftID(id *int) *ContestUpdateOne {
+	if id != nil {
+		cuo = cuo.SetDraftID(*id)
+	}
+	return cuo
+}
+
+// SetDraft sets the "draft" edge to the ContestDraft entity.
+func (cuo *ContestUpdateOne) SetDraft(c *ContestDraft) *ContestUpdateOne {
+	return cuo.SetDraftID(c.ID)
+}
+
+// AddEntryIDs adds the "entries" edge to the ContestEntry entity by IDs.
+func (cuo *ContestUpdateOne) AddEntryIDs(ids ...int) *ContestUpdateOne {
+	cuo.mutation.AddEntryIDs(ids...)
+	return cuo
+}
+
+// AddEntries adds the "entries" edges to the ContestEntry entity.
+func (cuo *ContestUpdateOne) AddEntries(c ...*ContestEntry) *ContestUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddEntryIDs(ids...)
+}
+
+// Mutation returns the ContestMutation object of the builder.
+func (cuo *ContestUpdateOne) Mutation() *ContestMutation {
+	return cuo.mutation
+}
+
+// ClearLeague clears the "league" edge to the League entity.
+func (cuo *ContestUpdateOne) ClearLeague() *ContestUpdateOne {
+	cuo.mutation.ClearLeague()
+	return cuo
+}
+
+// ClearWinner clears the "winner" edge to the User entity.
+func (cuo *ContestUpdateOne) ClearWinner() *ContestUpdateOne {
+	cuo.mutation.ClearWinner()
+	return cuo
+}
+
+// ClearDraft clears the "draft" edge to the ContestDraft entity.
+func (cuo *ContestUpdateOne) ClearDraft() *ContestUpdateOne {
+	cuo.mutation.ClearDraft()
+	return cuo
+}
+
+// ClearEntries clears all "entries" edges to the ContestEntry entity.
+func (cuo *ContestUpdateOne) ClearEntries() *ContestUpdateOne {
+	cuo.mutation.ClearEntries()
+	return cuo
+}
+
+// RemoveEntryIDs removes the "entries" edge to ContestEntry entities by IDs.
+func (cuo *ContestUpdateOne) RemoveEntryIDs(ids ...int) *ContestUpdateOne {
+	cuo.mutation.RemoveEntryIDs(ids...)
+	return cuo
+}
+
+// RemoveEntries removes "entries" edges to ContestEntry entities.
+func (cuo *ContestUpdateOne) RemoveEntries(c ...*ContestEntry) *ContestUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemoveEntryIDs(ids...)
+}
+
+// Save executes the query and returns the updated Contest entity.
+func (cuo *ContestUpdateOne) Save(ctx context.Context) (*Contest, error) {
+	var (
+		err  error
+		node *Contest
+	)
+	if len(cuo.hooks) == 0 {
+		if err = cuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = cuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ContestMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cuo.check(); err != nil {
+				return nil, err
+			}
+			cuo.mutation = mutation
+			node, err = cuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cuo.hooks) - 1; i >= 0; i-- {
+			mut = cuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *ContestUpdateOne) SaveX(ctx context.Context) *Contest {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *ContestUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *ContestUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *ContestUpdateOne) check() error {
+	if _, ok := cuo.mutation.LeagueID(); cuo.mutation.LeagueCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"league\"")
+	}
+	return nil
+}
+
+func (cuo *ContestUpdateOne) sqlSave(ctx context.Context) (_node *Contest, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   contest.Table,
+			Columns: contest.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: contest.FieldID,
+			},
+		},
+	}
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Contest.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.Closed(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: contest.FieldClosed,
+		})
+	}
+	if cuo.mutation.ClosedCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: contest.FieldClosed,
+		})
+	}
+	if cuo.mutation.LeagueCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contest.LeagueTable,
+			Columns: []string{contest.LeagueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: league.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.LeagueIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contest.LeagueTable,
+			Columns: []string{contest.LeagueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: league.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.WinnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contest.WinnerTable,
+			Columns: []string{contest.WinnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.WinnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contest.WinnerTable,
+			Columns: []string{contest.WinnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.DraftCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   contest.DraftTable,
+			Columns: []string{contest.DraftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraft.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.DraftIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   contest.DraftTable,
+			Columns: []string{contest.DraftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraft.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contest.EntriesTable,
+			Columns: []string{contest.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentry.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedEntriesIDs(); len(nodes) > 0 && !cuo.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contest.EntriesTable,
+			Columns: []string{contest.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.EntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contest.EntriesTable,
+			Columns: []string{contest.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Contest{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contest.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,191 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/NickDubelman/fantasy-bball/db/contest"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraft"
+)
+
+// ContestDraft is the model entity for the ContestDraft schema.
+type ContestDraft struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Rounds holds the value of the "rounds" field.
+	Rounds int `json:"rounds,omitempty"`
+	// PickTimeLimit holds the value of the "pickTimeLimit" field.
+	PickTimeLimit int `json:"pickTimeLimit,omitempty"`
+	// Started holds the value of the "started" field.
+	Started time.Time `json:"started,omitempty"`
+	// Completed holds the value of the "completed" field.
+	Completed *time.Time `json:"completed,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ContestDraftQuery when eager-loading is set.
+	Edges         ContestDraftEdges `json:"edges"`
+	contest_draft *int
+}
+
+// ContestDraftEdges holds the relations/edges for other nodes in the graph.
+type ContestDraftEdges struct {
+	// Contest holds the value of the contest edge.
+	Contest *Contest `json:"contest,omitempty"`
+	// Picks holds the value of the picks edge.
+	Picks []*ContestDraftPick `json:"picks,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ContestOrErr returns the Contest value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ContestDraftEdges) ContestOrErr() (*Contest, error) {
+	if e.loadedTypes[0] {
+		if e.Contest == nil {
+			// The edge contest was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: contest.Label}
+		}
+		return e.Contest, nil
+	}
+	return nil, &NotLoadedError{edge: "contest"}
+}
+
+// PicksOrErr returns the Picks value or an error if the edge
+// was not loaded in eager-loading.
+func (e ContestDraftEdges) PicksOrErr() ([]*ContestDraftPick, error) {
+	if e.loadedTypes[1] {
+		return e.Picks, nil
+	}
+	return nil, &NotLoadedError{edge: "picks"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ContestDraft) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case contestdraft.FieldID, contestdraft.FieldRounds, contestdraft.FieldPickTimeLimit:
+			values[i] = &sql.NullInt64{}
+		case contestdraft.FieldStarted, contestdraft.FieldCompleted:
+			values[i] = &sql.NullTime{}
+		case contestdraft.ForeignKeys[0]: // contest_draft
+			values[i] = &sql.NullInt64{}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ContestDraft", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ContestDraft fields.
+func (cd *ContestDraft) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case contestdraft.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cd.ID = int(value.Int64)
+		case contestdraft.FieldRounds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rounds", values[i])
+			} else if value.Valid {
+				cd.Rounds = int(value.Int64)
+			}
+		case contestdraft.FieldPickTimeLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pickTimeLimit", values[i])
+			} else if value.Valid {
+				cd.PickTimeLimit = int(value.Int64)
+			}
+		case contestdraft.FieldStarted:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started", values[i])
+			} else if value.Valid {
+				cd.Started = value.Time
+			}
+		case contestdraft.FieldCompleted:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed", values[i])
+			} else if value.Valid {
+				cd.Completed = new(time.Time)
+				*cd.Completed = value.Time
+			}
+		case contestdraft.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field contest_draft", value)
+			} else if value.Valid {
+				cd.contest_draft = new(int)
+				*cd.contest_draft = int(value.Int64)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryContest queries the "contest" edge of the ContestDraft entity.
+func (cd *ContestDraft) QueryContest() *ContestQuery {
+	return (&ContestDraftClient{config: cd.config}).QueryContest(cd)
+}
+
+// QueryPicks queries the "picks" edge of the ContestDraft entity.
+func (cd *ContestDraft) QueryPicks() *ContestDraftPickQuery {
+	return (&ContestDraftClient{config: cd.config}).QueryPicks(cd)
+}
+
+// Update returns a builder for updating this ContestDraft.
+// Note that you need to call ContestDraft.Unwrap() before calling this method if this ContestDraft
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cd *ContestDraft) Update() *ContestDraftUpdateOne {
+	return (&ContestDraftClient{config: cd.config}).UpdateOne(cd)
+}
+
+// Unwrap unwraps the ContestDraft entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cd *ContestDraft) Unwrap() *ContestDraft {
+	tx, ok := cd.config.driver.(*txDriver)
+	if !ok {
+		panic("db: ContestDraft is not a transactional entity")
+	}
+	cd.config.driver = tx.drv
+	return cd
+}
+
+// String implements the fmt.Stringer.
+func (cd *ContestDraft) String() string {
+	var builder strings.Builder
+	builder.WriteString("ContestDraft(")
+	builder.WriteString(fmt.Sprintf("id=%v", cd.ID))
+	builder.WriteString(", rounds=")
+	builder.WriteString(fmt.Sprintf("%v", cd.Rounds))
+	builder.WriteString(", pickTimeLimit=")
+	builder.WriteString(fmt.Sprintf("%v", cd.PickTimeLimit))
+	builder.WriteString(", started=")
+	builder.WriteString(cd.Started.Format(time.ANSIC))
+	if v := cd.Completed; v != nil {
+		builder.WriteString(", completed=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ContestDrafts is a parsable slice of ContestDraft.
+type ContestDrafts []*ContestDraft
+
+func (cd ContestDrafts) config(cfg config) {
+	for _i := range cd {
+		cd[_i].config = cfg
+	}
+}
@@ -0,0 +1,75 @@
+// Code generated by entc, DO NOT EDIT.
+
+package contestdraft
+
+const (
+	// Label holds the string label denoting the contestdraft type in the database.
+	Label = "contest_draft"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRounds holds the string denoting the rounds field in the database.
+	FieldRounds = "rounds"
+	// FieldPickTimeLimit holds the string denoting the picktimelimit field in the database.
+	FieldPickTimeLimit = "pick_time_limit"
+	// FieldStarted holds the string denoting the started field in the database.
+	FieldStarted = "started"
+	// FieldCompleted holds the string denoting the completed field in the database.
+	FieldCompleted = "completed"
+	// EdgeContest holds the string denoting the contest edge name in mutations.
+	EdgeContest = "contest"
+	// EdgePicks holds the string denoting the picks edge name in mutations.
+	EdgePicks = "picks"
+	// Table holds the table name of the contestdraft in the database.
+	Table = "contest_drafts"
+	// ContestTable is the table the holds the contest relation/edge.
+	ContestTable = "contest_drafts"
+	// ContestInverseTable is the table name for the Contest entity.
+	// It exists in this package in order to avoid circular dependency with the "contest" package.
+	ContestInverseTable = "contests"
+	// ContestColumn is the table column denoting the contest relation/edge.
+	ContestColumn = "contest_draft"
+	// PicksTable is the table the holds the picks relation/edge.
+	PicksTable = "contest_draft_picks"
+	// PicksInverseTable is the table name for the ContestDraftPick entity.
+	// It exists in this package in order to avoid circular dependency with the "contestdraftpick" package.
+	PicksInverseTable = "contest_draft_picks"
+	// PicksColumn is the table column denoting the picks relation/edge.
+	PicksColumn = "contest_draft_picks"
+)
+
+// Columns holds all SQL columns for contestdraft fields.
+var Columns = []string{
+	FieldID,
+	FieldRounds,
+	FieldPickTimeLimit,
+	FieldStarted,
+	FieldCompleted,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "contest_drafts"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"contest_draft",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// RoundsValidator is a validator for the "rounds" field. It is called by the builders before save.
+	RoundsValidator func(int) error
+	// PickTimeLimitValidator is a validator for the "pickTimeLimit" field. It is called by the builders before save.
+	PickTimeLimitValidator func(int) error
+)
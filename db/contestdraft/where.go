@@ -0,0 +1,528 @@
+// Code generated by entc, DO NOT EDIT.
+
+package contestdraft
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Rounds applies equality check predicate on the "rounds" field. It's identical to RoundsEQ.
+func Rounds(v int) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRounds), v))
+	})
+}
+
+// PickTimeLimit applies equality check predicate on the "pickTimeLimit" field. It's identical to PickTimeLimitEQ.
+func PickTimeLimit(v int) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPickTimeLimit), v))
+	})
+}
+
+// Started applies equality check predicate on the "started" field. It's identical to StartedEQ.
+func Started(v time.Time) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStarted), v))
+	})
+}
+
+// Completed applies equality check predicate on the "completed" field. It's identical to CompletedEQ.
+func Completed(v time.Time) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCompleted), v))
+	})
+}
+
+// RoundsEQ applies the EQ predicate on the "rounds" field.
+func RoundsEQ(v int) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRounds), v))
+	})
+}
+
+// RoundsNEQ applies the NEQ predicate on the "rounds" field.
+func RoundsNEQ(v int) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRounds), v))
+	})
+}
+
+// RoundsIn applies the In predicate on the "rounds" field.
+func RoundsIn(vs ...int) predicate.ContestDraft {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRounds), v...))
+	})
+}
+
+// RoundsNotIn applies the NotIn predicate on the "rounds" field.
+func RoundsNotIn(vs ...int) predicate.ContestDraft {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRounds), v...))
+	})
+}
+
+// RoundsGT applies the GT predicate on the "rounds" field.
+func RoundsGT(v int) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRounds), v))
+	})
+}
+
+// RoundsGTE applies the GTE predicate on the "rounds" field.
+func RoundsGTE(v int) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRounds), v))
+	})
+}
+
+// RoundsLT applies the LT predicate on the "rounds" field.
+func RoundsLT(v int) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRounds), v))
+	})
+}
+
+// RoundsLTE applies the LTE predicate on the "rounds" field.
+func RoundsLTE(v int) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRounds), v))
+	})
+}
+
+// PickTimeLimitEQ applies the EQ predicate on the "pickTimeLimit" field.
+func PickTimeLimitEQ(v int) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPickTimeLimit), v))
+	})
+}
+
+// PickTimeLimitNEQ applies the NEQ predicate on the "pickTimeLimit" field.
+func PickTimeLimitNEQ(v int) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPickTimeLimit), v))
+	})
+}
+
+// PickTimeLimitIn applies the In predicate on the "pickTimeLimit" field.
+func PickTimeLimitIn(vs ...int) predicate.ContestDraft {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPickTimeLimit), v...))
+	})
+}
+
+// PickTimeLimitNotIn applies the NotIn predicate on the "pickTimeLimit" field.
+func PickTimeLimitNotIn(vs ...int) predicate.ContestDraft {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPickTimeLimit), v...))
+	})
+}
+
+// PickTimeLimitGT applies the GT predicate on the "pickTimeLimit" field.
+func PickTimeLimitGT(v int) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPickTimeLimit), v))
+	})
+}
+
+// PickTimeLimitGTE applies the GTE predicate on the "pickTimeLimit" field.
+func PickTimeLimitGTE(v int) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPickTimeLimit), v))
+	})
+}
+
+// PickTimeLimitLT applies the LT predicate on the "pickTimeLimit" field.
+func PickTimeLimitLT(v int) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPickTimeLimit), v))
+	})
+}
+
+// PickTimeLimitLTE applies the LTE predicate on the "pickTimeLimit" field.
+func PickTimeLimitLTE(v int) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPickTimeLimit), v))
+	})
+}
+
+// StartedEQ applies the EQ predicate on the "started" field.
+func StartedEQ(v time.Time) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStarted), v))
+	})
+}
+
+// StartedNEQ applies the NEQ predicate on the "started" field.
+func StartedNEQ(v time.Time) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStarted), v))
+	})
+}
+
+// StartedIn applies the In predicate on the "started" field.
+func StartedIn(vs ...time.Time) predicate.ContestDraft {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStarted), v...))
+	})
+}
+
+// StartedNotIn applies the NotIn predicate on the "started" field.
+func StartedNotIn(vs ...time.Time) predicate.ContestDraft {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStarted), v...))
+	})
+}
+
+// StartedGT applies the GT predicate on the "started" field.
+func StartedGT(v time.Time) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStarted), v))
+	})
+}
+
+// StartedGTE applies the GTE predicate on the "started" field.
+func StartedGTE(v time.Time) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStarted), v))
+	})
+}
+
+// StartedLT applies the LT predicate on the "started" field.
+func StartedLT(v time.Time) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStarted), v))
+	})
+}
+
+// StartedLTE applies the LTE predicate on the "started" field.
+func StartedLTE(v time.Time) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStarted), v))
+	})
+}
+
+// CompletedEQ applies the EQ predicate on the "completed" field.
+func CompletedEQ(v time.Time) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCompleted), v))
+	})
+}
+
+// CompletedNEQ applies the NEQ predicate on the "completed" field.
+func CompletedNEQ(v time.Time) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCompleted), v))
+	})
+}
+
+// CompletedIn applies the In predicate on the "completed" field.
+func CompletedIn(vs ...time.Time) predicate.ContestDraft {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCompleted), v...))
+	})
+}
+
+// CompletedNotIn applies the NotIn predicate on the "completed" field.
+func CompletedNotIn(vs ...time.Time) predicate.ContestDraft {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCompleted), v...))
+	})
+}
+
+// CompletedGT applies the GT predicate on the "completed" field.
+func CompletedGT(v time.Time) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCompleted), v))
+	})
+}
+
+// CompletedGTE applies the GTE predicate on the "completed" field.
+func CompletedGTE(v time.Time) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCompleted), v))
+	})
+}
+
+// CompletedLT applies the LT predicate on the "completed" field.
+func CompletedLT(v time.Time) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCompleted), v))
+	})
+}
+
+// CompletedLTE applies the LTE predicate on the "completed" field.
+func CompletedLTE(v time.Time) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCompleted), v))
+	})
+}
+
+// CompletedIsNil applies the IsNil predicate on the "completed" field.
+func CompletedIsNil() predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCompleted)))
+	})
+}
+
+// CompletedNotNil applies the NotNil predicate on the "completed" field.
+func CompletedNotNil() predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCompleted)))
+	})
+}
+
+// HasContest applies the HasEdge predicate on the "contest" edge.
+func HasContest() predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ContestTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, ContestTable, ContestColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasContestWith applies the HasEdge predicate on the "contest" edge with a given conditions (other predicates).
+func HasContestWith(preds ...predicate.Contest) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ContestInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, ContestTable, ContestColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPicks applies the HasEdge predicate on the "picks" edge.
+func HasPicks() predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PicksTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PicksTable, PicksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPicksWith applies the HasEdge predicate on the "picks" edge with a given conditions (other predicates).
+func HasPicksWith(preds ...predicate.ContestDraftPick) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PicksInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PicksTable, PicksColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ContestDraft) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ContestDraft) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ContestDraft) predicate.ContestDraft {
+	return predicate.ContestDraft(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
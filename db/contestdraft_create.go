@@ -0,0 +1,321 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/contest"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraft"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraftpick"
+)
+
+// ContestDraftCreate is the builder for creating a ContestDraft entity.
+type ContestDraftCreate struct {
+	config
+	mutation *ContestDraftMutation
+	hooks    []Hook
+}
+
+// SetRounds sets the "rounds" field.
+func (cdc *ContestDraftCreate) SetRounds(i int) *ContestDraftCreate {
+	cdc.mutation.SetRounds(i)
+	return cdc
+}
+
+// SetPickTimeLimit sets the "pickTimeLimit" field.
+func (cdc *ContestDraftCreate) SetPickTimeLimit(i int) *ContestDraftCreate {
+	cdc.mutation.SetPickTimeLimit(i)
+	return cdc
+}
+
+// SetStarted sets the "started" field.
+func (cdc *ContestDraftCreate) SetStarted(t time.Time) *ContestDraftCreate {
+	cdc.mutation.SetStarted(t)
+	return cdc
+}
+
+// SetCompleted sets the "completed" field.
+func (cdc *ContestDraftCreate) SetCompleted(t time.Time) *ContestDraftCreate {
+	cdc.mutation.SetCompleted(t)
+	return cdc
+}
+
+// SetNillableCompleted sets the "completed" field if the given value is not nil.
+func (cdc *ContestDraftCreate) SetNillableCompleted(t *time.Time) *ContestDraftCreate {
+	if t != nil {
+		cdc.SetCompleted(*t)
+	}
+	return cdc
+}
+
+// SetContestID sets the "contest" edge to the Contest entity by ID.
+func (cdc *ContestDraftCreate) SetContestID(id int) *ContestDraftCreate {
+	cdc.mutation.SetContestID(id)
+	return cdc
+}
+
+// SetContest sets the "contest" edge to the Contest entity.
+func (cdc *ContestDraftCreate) SetContest(c *Contest) *ContestDraftCreate {
+	return cdc.SetContestID(c.ID)
+}
+
+// AddPickIDs adds the "picks" edge to the ContestDraftPick entity by IDs.
+func (cdc *ContestDraftCreate) AddPickIDs(ids ...int) *ContestDraftCreate {
+	cdc.mutation.AddPickIDs(ids...)
+	return cdc
+}
+
+// AddPicks adds the "picks" edges to the ContestDraftPick entity.
+func (cdc *ContestDraftCreate) AddPicks(c ...*ContestDraftPick) *ContestDraftCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cdc.AddPickIDs(ids...)
+}
+
+// Mutation returns the ContestDraftMutation object of the builder.
+func (cdc *ContestDraftCreate) Mutation() *ContestDraftMutation {
+	return cdc.mutation
+}
+
+// Save creates the ContestDraft in the database.
+func (cdc *ContestDraftCreate) Save(ctx context.Context) (*ContestDraft, error) {
+	var (
+		err  error
+		node *ContestDraft
+	)
+	if len(cdc.hooks) == 0 {
+		if err = cdc.check(); err != nil {
+			return nil, err
+		}
+		node, err = cdc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ContestDraftMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cdc.check(); err != nil {
+				return nil, err
+			}
+			cdc.mutation = mutation
+			node, err = cdc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cdc.hooks) - 1; i >= 0; i-- {
+			mut = cdc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cdc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cdc *ContestDraftCreate) SaveX(ctx context.Context) *ContestDraft {
+	v, err := cdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cdc *ContestDraftCreate) check() error {
+	if _, ok := cdc.mutation.Rounds(); !ok {
+		return &ValidationError{Name: "rounds", err: errors.New("db: missing required field \"rounds\"")}
+	}
+	if v, ok := cdc.mutation.Rounds(); ok {
+		if err := contestdraft.RoundsValidator(v); err != nil {
+			return &ValidationError{Name: "rounds", err: fmt.Errorf("db: validator failed for field \"rounds\": %w", err)}
+		}
+	}
+	if _, ok := cdc.mutation.PickTimeLimit(); !ok {
+		return &ValidationError{Name: "pickTimeLimit", err: errors.New("db: missing required field \"pickTimeLimit\"")}
+	}
+	if v, ok := cdc.mutation.PickTimeLimit(); ok {
+		if err := contestdraft.PickTimeLimitValidator(v); err != nil {
+			return &ValidationError{Name: "pickTimeLimit", err: fmt.Errorf("db: validator failed for field \"pickTimeLimit\": %w", err)}
+		}
+	}
+	if _, ok := cdc.mutation.Started(); !ok {
+		return &ValidationError{Name: "started", err: errors.New("db: missing required field \"started\"")}
+	}
+	if _, ok := cdc.mutation.ContestID(); !ok {
+		return &ValidationError{Name: "contest", err: errors.New("db: missing required edge \"contest\"")}
+	}
+	return nil
+}
+
+func (cdc *ContestDraftCreate) sqlSave(ctx context.Context) (*ContestDraft, error) {
+	_node, _spec := cdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cdc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (cdc *ContestDraftCreate) createSpec() (*ContestDraft, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ContestDraft{config: cdc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: contestdraft.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: contestdraft.FieldID,
+			},
+		}
+	)
+	if value, ok := cdc.mutation.Rounds(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: contestdraft.FieldRounds,
+		})
+		_node.Rounds = value
+	}
+	if value, ok := cdc.mutation.PickTimeLimit(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: contestdraft.FieldPickTimeLimit,
+		})
+		_node.PickTimeLimit = value
+	}
+	if value, ok := cdc.mutation.Started(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: contestdraft.FieldStarted,
+		})
+		_node.Started = value
+	}
+	if value, ok := cdc.mutation.Completed(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: contestdraft.FieldCompleted,
+		})
+		_node.Completed = &value
+	}
+	if nodes := cdc.mutation.ContestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   contestdraft.ContestTable,
+			Columns: []string{contestdraft.ContestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contest.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.contest_draft = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cdc.mutation.PicksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contestdraft.PicksTable,
+			Columns: []string{contestdraft.PicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraftpick.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ContestDraftCreateBulk is the builder for creating many ContestDraft entities in bulk.
+type ContestDraftCreateBulk struct {
+	config
+	builders []*ContestDraftCreate
+}
+
+// Save creates the ContestDraft entities in the database.
+func (cdcb *ContestDraftCreateBulk) Save(ctx context.Context) ([]*ContestDraft, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(cdcb.builders))
+	nodes := make([]*ContestDraft, len(cdcb.builders))
+	mutators := make([]Mutator, len(cdcb.builders))
+	for i := range cdcb.builders {
+		func(i int, root context.Context) {
+			builder := cdcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ContestDraftMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cdcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cdcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cdcb *ContestDraftCreateBulk) SaveX(ctx context.Context) []*ContestDraft {
+	v, err := cdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,1036 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/contest"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraft"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraftpick"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+)
+
+// ContestDraftQuery is the builder for querying ContestDraft entities.
+type ContestDraftQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ContestDraft
+	// eager-loading edges.
+	withContest *ContestQuery
+	withPicks   *ContestDraftPickQuery
+	withFKs     bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ContestDraftQuery builder.
+func (cdq *ContestDraftQuery) Where(ps ...predicate.ContestDraft) *ContestDraftQuery {
+	cdq.predicates = append(cdq.predicates, ps...)
+	return cdq
+}
+
+// Limit adds a limit step to the query.
+func (cdq *ContestDraftQuery) Limit(limit int) *ContestDraftQuery {
+	cdq.limit = &limit
+	return cdq
+}
+
+// Offset adds an offset step to the query.
+func (cdq *ContestDraftQuery) Offset(offset int) *ContestDraftQuery {
+	cdq.offset = &offset
+	return cdq
+}
+
+// Order adds an order step to the query.
+func (cdq *ContestDraftQuery) Order(o ...OrderFunc) *ContestDraftQuery {
+	cdq.order = append(cdq.order, o...)
+	return cdq
+}
+
+// QueryContest chains the current query on the "contest" edge.
+func (cdq *ContestDraftQuery) QueryContest() *ContestQuery {
+	query := &ContestQuery{config: cdq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cdq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contestdraft.Table, contestdraft.FieldID, selector),
+			sqlgraph.To(contest.Table, contest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, contestdraft.ContestTable, contestdraft.ContestColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cdq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPicks chains the current query on the "picks" edge.
+func (cdq *ContestDraftQuery) QueryPicks() *ContestDraftPickQuery {
+	query := &ContestDraftPickQuery{config: cdq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cdq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contestdraft.Table, contestdraft.FieldID, selector),
+			sqlgraph.To(contestdraftpick.Table, contestdraftpick.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, contestdraft.PicksTable, contestdraft.PicksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cdq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ContestDraft entity from the query.
+// Returns a *NotFoundError when no ContestDraft was found.
+func (cdq *ContestDraftQuery) First(ctx context.Context) (*ContestDraft, error) {
+	nodes, err := cdq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{contestdraft.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cdq *ContestDraftQuery) FirstX(ctx context.Context) *ContestDraft {
+	node, err := cdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ContestDraft ID from the query.
+// Returns a *NotFoundError when no ContestDraft ID was found.
+func (cdq *ContestDraftQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cdq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{contestdraft.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cdq *ContestDraftQuery) FirstIDX(ctx context.Context) int {
+	id, err := cdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ContestDraft entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one ContestDraft entity is not found.
+// Returns a *NotFoundError when no ContestDraft entities are found.
+func (cdq *ContestDraftQuery) Only(ctx context.Context) (*ContestDraft, error) {
+	nodes, err := cdq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{contestdraft.Label}
+	default:
+		return nil, &NotSingularError{contestdraft.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cdq *ContestDraftQuery) OnlyX(ctx context.Context) *ContestDraft {
+	node, err := cdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ContestDraft ID in the query.
+// Returns a *NotSingularError when exactly one ContestDraft ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (cdq *ContestDraftQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cdq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{contestdraft.Label}
+	default:
+		err = &NotSingularError{contestdraft.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cdq *ContestDraftQuery) OnlyIDX(ctx context.Context) int {
+	id, err := cdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ContestDrafts.
+func (cdq *ContestDraftQuery) All(ctx context.Context) ([]*ContestDraft, error) {
+	if err := cdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return cdq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cdq *ContestDraftQuery) AllX(ctx context.Context) []*ContestDraft {
+	nodes, err := cdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ContestDraft IDs.
+func (cdq *ContestDraftQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := cdq.Select(contestdraft.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cdq *ContestDraftQuery) IDsX(ctx context.Context) []int {
+	ids, err := cdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cdq *ContestDraftQuery) Count(ctx context.Context) (int, error) {
+	if err := cdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return cdq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cdq *ContestDraftQuery) CountX(ctx context.Context) int {
+	count, err := cdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cdq *ContestDraftQuery) Exist(ctx context.Context) (bool, error) {
+	if err := cdq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return cdq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cdq *ContestDraftQuery) ExistX(ctx context.Context) bool {
+	exist, err := cdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ContestDraftQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cdq *ContestDraftQuery) Clone() *ContestDraftQuery {
+	if cdq == nil {
+		return nil
+	}
+	return &ContestDraftQuery{
+		config:      cdq.config,
+		limit:       cdq.limit,
+		offset:      cdq.offset,
+		order:       append([]OrderFunc{}, cdq.order...),
+		predicates:  append([]predicate.ContestDraft{}, cdq.predicates...),
+		withContest: cdq.withContest.Clone(),
+		withPicks:   cdq.withPicks.Clone(),
+		// clone intermediate query.
+		sql:  cdq.sql.Clone(),
+		path: cdq.path,
+	}
+}
+
+// WithContest tells the query-builder to eager-load the nodes that are connected to
+// the "contest" edge. The optional arguments are used to configure the query builder of the edge.
+func (cdq *ContestDraftQuery) WithContest(opts ...func(*ContestQuery)) *ContestDraftQuery {
+	query := &ContestQuery{config: cdq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cdq.withContest = query
+	return cdq
+}
+
+// WithPicks tells the query-builder to eager-load the nodes that are connected to
+// the "picks" edge. The optional arguments are used to configure the query builder of the edge.
+func (cdq *ContestDraftQuery) WithPicks(opts ...func(*ContestDraftPickQuery)) *ContestDraftQuery {
+	query := &ContestDraftPickQuery{config: cdq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cdq.withPicks = query
+	return cdq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Rounds int `json:"rounds,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ContestDraft.Query().
+//		GroupBy(contestdraft.FieldRounds).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (cdq *ContestDraftQuery) GroupBy(field string, fields ...string) *ContestDraftGroupBy {
+	group := &ContestDraftGroupBy{config: cdq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := cdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return cdq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Rounds int `json:"rounds,omitempty"`
+//	}
+//
+//	client.ContestDraft.Query().
+//		Select(contestdraft.FieldRounds).
+//		Scan(ctx, &v)
+func (cdq *ContestDraftQuery) Select(field string, fields ...string) *ContestDraftSelect {
+	cdq.fields = append([]string{field}, fields...)
+	return &ContestDraftSelect{ContestDraftQuery: cdq}
+}
+
+func (cdq *ContestDraftQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range cdq.fields {
+		if !contestdraft.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if cdq.path != nil {
+		prev, err := cdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cdq.sql = prev
+	}
+	return nil
+}
+
+func (cdq *ContestDraftQuery) sqlAll(ctx context.Context) ([]*ContestDraft, error) {
+	var (
+		nodes       = []*ContestDraft{}
+		withFKs     = cdq.withFKs
+		_spec       = cdq.querySpec()
+		loadedTypes = [2]bool{
+			cdq.withContest != nil,
+			cdq.withPicks != nil,
+		}
+	)
+	if cdq.withContest != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, contestdraft.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &ContestDraft{config: cdq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("db: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := cdq.withContest; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*ContestDraft)
+		for i := range nodes {
+			fk := nodes[i].contest_draft
+			if fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(contest.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "contest_draft" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Contest = n
+			}
+		}
+	}
+
+	if query := cdq.withPicks; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*ContestDraft)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Picks = []*ContestDraftPick{}
+		}
+		query.withFKs = true
+		query.Where(predicate.ContestDraftPick(func(s *sql.Selector) {
+			s.Where(sql.InValues(contestdraft.PicksColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.contest_draft_picks
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "contest_draft_picks" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "contest_draft_picks" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Picks = append(node.Edges.Picks, n)
+		}
+	}
+
+	return nodes, nil
+}
+
+func (cdq *ContestDraftQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cdq.querySpec()
+	return sqlgraph.CountNodes(ctx, cdq.driver, _spec)
+}
+
+func (cdq *ContestDraftQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := cdq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("db: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (cdq *ContestDraftQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   contestdraft.Table,
+			Columns: contestdraft.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: contestdraft.FieldID,
+			},
+		},
+		From:   cdq.sql,
+		Unique: true,
+	}
+	if fields := cdq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contestdraft.FieldID)
+		for i := range fields {
+			if fields[i] != contestdraft.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := cdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cdq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cdq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, contestdraft.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cdq *ContestDraftQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cdq.driver.Dialect())
+	t1 := builder.Table(contestdraft.Table)
+	selector := builder.Select(t1.Columns(contestdraft.Columns...)...).From(t1)
+	if cdq.sql != nil {
+		selector = cdq.sql
+		selector.Select(selector.Columns(contestdraft.Columns...)...)
+	}
+	for _, p := range cdq.predicates {
+		p(selector)
+	}
+	for _, p := range cdq.order {
+		p(selector, contestdraft.ValidColumn)
+	}
+	if offset := cdq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cdq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ContestDraftGroupBy is the group-by builder for ContestDraft entities.
+type ContestDraftGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cdgb *ContestDraftGroupBy) Aggregate(fns ...AggregateFunc) *ContestDraftGroupBy {
+	cdgb.fns = append(cdgb.fns, fns...)
+	return cdgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (cdgb *ContestDraftGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := cdgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	cdgb.sql = query
+	return cdgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (cdgb *ContestDraftGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := cdgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cdgb *ContestDraftGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(cdgb.fields) > 1 {
+		return nil, errors.New("db: ContestDraftGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := cdgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (cdgb *ContestDraftGroupBy) StringsX(ctx context.Context) []string {
+	v, err := cdgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cdgb *ContestDraftGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = cdgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestdraft.Label}
+	default:
+		err = fmt.Errorf("db: ContestDraftGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (cdgb *ContestDraftGroupBy) StringX(ctx context.Context) string {
+	v, err := cdgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cdgb *ContestDraftGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(cdgb.fields) > 1 {
+		return nil, errors.New("db: ContestDraftGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := cdgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (cdgb *ContestDraftGroupBy) IntsX(ctx context.Context) []int {
+	v, err := cdgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cdgb *ContestDraftGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = cdgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestdraft.Label}
+	default:
+		err = fmt.Errorf("db: ContestDraftGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (cdgb *ContestDraftGroupBy) IntX(ctx context.Context) int {
+	v, err := cdgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cdgb *ContestDraftGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(cdgb.fields) > 1 {
+		return nil, errors.New("db: ContestDraftGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := cdgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (cdgb *ContestDraftGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := cdgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cdgb *ContestDraftGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = cdgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestdraft.Label}
+	default:
+		err = fmt.Errorf("db: ContestDraftGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (cdgb *ContestDraftGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := cdgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cdgb *ContestDraftGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(cdgb.fields) > 1 {
+		return nil, errors.New("db: ContestDraftGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := cdgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (cdgb *ContestDraftGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := cdgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cdgb *ContestDraftGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = cdgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestdraft.Label}
+	default:
+		err = fmt.Errorf("db: ContestDraftGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (cdgb *ContestDraftGroupBy) BoolX(ctx context.Context) bool {
+	v, err := cdgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (cdgb *ContestDraftGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range cdgb.fields {
+		if !contestdraft.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := cdgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cdgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (cdgb *ContestDraftGroupBy) sqlQuery() *sql.Selector {
+	selector := cdgb.sql
+	columns := make([]string, 0, len(cdgb.fields)+len(cdgb.fns))
+	columns = append(columns, cdgb.fields...)
+	for _, fn := range cdgb.fns {
+		columns = append(columns, fn(selector, contestdraft.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(cdgb.fields...)
+}
+
+// ContestDraftSelect is the builder for selecting fields of ContestDraft entities.
+type ContestDraftSelect struct {
+	*ContestDraftQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cds *ContestDraftSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := cds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	cds.sql = cds.ContestDraftQuery.sqlQuery(ctx)
+	return cds.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (cds *ContestDraftSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := cds.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (cds *ContestDraftSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(cds.fields) > 1 {
+		return nil, errors.New("db: ContestDraftSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := cds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (cds *ContestDraftSelect) StringsX(ctx context.Context) []string {
+	v, err := cds.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (cds *ContestDraftSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = cds.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestdraft.Label}
+	default:
+		err = fmt.Errorf("db: ContestDraftSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (cds *ContestDraftSelect) StringX(ctx context.Context) string {
+	v, err := cds.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (cds *ContestDraftSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(cds.fields) > 1 {
+		return nil, errors.New("db: ContestDraftSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := cds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (cds *ContestDraftSelect) IntsX(ctx context.Context) []int {
+	v, err := cds.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (cds *ContestDraftSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = cds.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestdraft.Label}
+	default:
+		err = fmt.Errorf("db: ContestDraftSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (cds *ContestDraftSelect) IntX(ctx context.Context) int {
+	v, err := cds.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (cds *ContestDraftSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(cds.fields) > 1 {
+		return nil, errors.New("db: ContestDraftSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := cds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (cds *ContestDraftSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := cds.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (cds *ContestDraftSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = cds.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestdraft.Label}
+	default:
+		err = fmt.Errorf("db: ContestDraftSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (cds *ContestDraftSelect) Float64X(ctx context.Context) float64 {
+	v, err := cds.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (cds *ContestDraftSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(cds.fields) > 1 {
+		return nil, errors.New("db: ContestDraftSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := cds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (cds *ContestDraftSelect) BoolsX(ctx context.Context) []bool {
+	v, err := cds.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (cds *ContestDraftSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = cds.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestdraft.Label}
+	default:
+		err = fmt.Errorf("db: ContestDraftSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (cds *ContestDraftSelect) BoolX(ctx context.Context) bool {
+	v, err := cds.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (cds *ContestDraftSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := cds.sqlQuery().Query()
+	if err := cds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (cds *ContestDraftSelect) sqlQuery() sql.Querier {
+	selector := cds.sql
+	selector.Select(selector.Columns(cds.fields...)...)
+	return selector
+}
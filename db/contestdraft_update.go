@@ -0,0 +1,594 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/contest"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraft"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraftpick"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+)
+
+// ContestDraftUpdate is the builder for updating ContestDraft entities.
+type ContestDraftUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ContestDraftMutation
+}
+
+// Where adds a new predicate for the ContestDraftUpdate builder.
+func (cdu *ContestDraftUpdate) Where(ps ...predicate.ContestDraft) *ContestDraftUpdate {
+	cdu.mutation.predicates = append(cdu.mutation.predicates, ps...)
+	return cdu
+}
+
+// SetCompleted sets the "completed" field.
+func (cdu *ContestDraftUpdate) SetCompleted(t time.Time) *ContestDraftUpdate {
+	cdu.mutation.SetCompleted(t)
+	return cdu
+}
+
+// SetNillableCompleted sets the "completed" field if the given value is not nil.
+func (cdu *ContestDraftUpdate) SetNillableCompleted(t *time.Time) *ContestDraftUpdate {
+	if t != nil {
+		cdu.SetCompleted(*t)
+	}
+	return cdu
+}
+
+// ClearCompleted clears the value of the "completed" field.
+func (cdu *ContestDraftUpdate) ClearCompleted() *ContestDraftUpdate {
+	cdu.mutation.ClearCompleted()
+	return cdu
+}
+
+// SetContestID sets the "contest" edge to the Contest entity by ID.
+func (cdu *ContestDraftUpdate) SetContestID(id int) *ContestDraftUpdate {
+	cdu.mutation.SetContestID(id)
+	return cdu
+}
+
+// SetContest sets the "contest" edge to the Contest entity.
+func (cdu *ContestDraftUpdate) SetContest(c *Contest) *ContestDraftUpdate {
+	return cdu.SetContestID(c.ID)
+}
+
+// AddPickIDs adds the "picks" edge to the ContestDraftPick entity by IDs.
+func (cdu *ContestDraftUpdate) AddPickIDs(ids ...int) *ContestDraftUpdate {
+	cdu.mutation.AddPickIDs(ids...)
+	return cdu
+}
+
+// AddPicks adds the "picks" edges to the ContestDraftPick entity.
+func (cdu *ContestDraftUpdate) AddPicks(c ...*ContestDraftPick) *ContestDraftUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cdu.AddPickIDs(ids...)
+}
+
+// Mutation returns the ContestDraftMutation object of the builder.
+func (cdu *ContestDraftUpdate) Mutation() *ContestDraftMutation {
+	return cdu.mutation
+}
+
+// ClearContest clears the "contest" edge to the Contest entity.
+func (cdu *ContestDraftUpdate) ClearContest() *ContestDraftUpdate {
+	cdu.mutation.ClearContest()
+	return cdu
+}
+
+// ClearPicks clears all "picks" edges to the ContestDraftPick entity.
+func (cdu *ContestDraftUpdate) ClearPicks() *ContestDraftUpdate {
+	cdu.mutation.ClearPicks()
+	return cdu
+}
+
+// RemovePickIDs removes the "picks" edge to ContestDraftPick entities by IDs.
+func (cdu *ContestDraftUpdate) RemovePickIDs(ids ...int) *ContestDraftUpdate {
+	cdu.mutation.RemovePickIDs(ids...)
+	return cdu
+}
+
+// RemovePicks removes "picks" edges to ContestDraftPick entities.
+func (cdu *ContestDraftUpdate) RemovePicks(c ...*ContestDraftPick) *ContestDraftUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cdu.RemovePickIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cdu *ContestDraftUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(cdu.hooks) == 0 {
+		if err = cdu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = cdu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ContestDraftMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cdu.check(); err != nil {
+				return 0, err
+			}
+			cdu.mutation = mutation
+			affected, err = cdu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cdu.hooks) - 1; i >= 0; i-- {
+			mut = cdu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cdu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cdu *ContestDraftUpdate) SaveX(ctx context.Context) int {
+	affected, err := cdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cdu *ContestDraftUpdate) Exec(ctx context.Context) error {
+	_, err := cdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdu *ContestDraftUpdate) ExecX(ctx context.Context) {
+	if err := cdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cdu *ContestDraftUpdate) check() error {
+	if _, ok := cdu.mutation.ContestID(); cdu.mutation.ContestCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"contest\"")
+	}
+	return nil
+}
+
+func (cdu *ContestDraftUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   contestdraft.Table,
+			Columns: contestdraft.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: contestdraft.FieldID,
+			},
+		},
+	}
+	if ps := cdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cdu.mutation.Completed(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: contestdraft.FieldCompleted,
+		})
+	}
+	if cdu.mutation.CompletedCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: contestdraft.FieldCompleted,
+		})
+	}
+	if cdu.mutation.ContestCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   contestdraft.ContestTable,
+			Columns: []string{contestdraft.ContestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contest.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cdu.mutation.ContestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   contestdraft.ContestTable,
+			Columns: []string{contestdraft.ContestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contest.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cdu.mutation.PicksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contestdraft.PicksTable,
+			Columns: []string{contestdraft.PicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraftpick.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cdu.mutation.RemovedPicksIDs(); len(nodes) > 0 && !cdu.mutation.PicksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contestdraft.PicksTable,
+			Columns: []string{contestdraft.PicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraftpick.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cdu.mutation.PicksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contestdraft.PicksTable,
+			Columns: []string{contestdraft.PicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraftpick.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contestdraft.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ContestDraftUpdateOne is the builder for updating a single ContestDraft entity.
+type ContestDraftUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *ContestDraftMutation
+}
+
+// SetCompleted sets the "completed" field.
+func (cduo *ContestDraftUpdateOne) SetCompleted(t time.Time) *ContestDraftUpdateOne {
+	cduo.mutation.SetCompleted(t)
+	return cduo
+}
+
+// SetNillableCompleted sets the "completed" field if the given value is not nil.
+func (cduo *ContestDraftUpdateOne) SetNillableCompleted(t *time.Time) *ContestDraftUpdateOne {
+	if t != nil {
+		cduo.SetCompleted(*t)
+	}
+	return cduo
+}
+
+// ClearCompleted clears the value of the "completed" field.
+func (cduo *ContestDraftUpdateOne) ClearCompleted() *ContestDraftUpdateOne {
+	cduo.mutation.ClearCompleted()
+	return cduo
+}
+
+// SetContestID sets the "contest" edge to the Contest entity by ID.
+func (cduo *ContestDraftUpdateOne) SetContestID(id int) *ContestDraftUpdateOne {
+	cduo.mutation.SetContestID(id)
+	return cduo
+}
+
+// SetContest sets the "contest" edge to the Contest entity.
+func (cduo *ContestDraftUpdateOne) SetContest(c *Contest) *ContestDraftUpdateOne {
+	return cduo.SetContestID(c.ID)
+}
+
+// AddPickIDs adds the "picks" edge to the ContestDraftPick entity by IDs.
+func (cduo *ContestDraftUpdateOne) AddPickIDs(ids ...int) *ContestDraftUpdateOne {
+	cduo.mutation.AddPickIDs(ids...)
+	return cduo
+}
+
+// AddPicks adds the "picks" edges to the ContestDraftPick entity.
+func (cduo *ContestDraftUpdateOne) AddPicks(c ...*ContestDraftPick) *ContestDraftUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cduo.AddPickIDs(ids...)
+}
+
+// Mutation returns the ContestDraftMutation object of the builder.
+func (cduo *ContestDraftUpdateOne) Mutation() *ContestDraftMutation {
+	return cduo.mutation
+}
+
+// ClearContest clears the "contest" edge to the Contest entity.
+func (cduo *ContestDraftUpdateOne) ClearContest() *ContestDraftUpdateOne {
+	cduo.mutation.ClearContest()
+	return cduo
+}
+
+// ClearPicks clears all "picks" edges to the ContestDraftPick entity.
+func (cduo *ContestDraftUpdateOne) ClearPicks() *ContestDraftUpdateOne {
+	cduo.mutation.ClearPicks()
+	return cduo
+}
+
+// RemovePickIDs removes the "picks" edge to ContestDraftPick entities by IDs.
+func (cduo *ContestDraftUpdateOne) RemovePickIDs(ids ...int) *ContestDraftUpdateOne {
+	cduo.mutation.RemovePickIDs(ids...)
+	return cduo
+}
+
+// RemovePicks removes "picks" edges to ContestDraftPick entities.
+func (cduo *ContestDraftUpdateOne) RemovePicks(c ...*ContestDraftPick) *ContestDraftUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cduo.RemovePickIDs(ids...)
+}
+
+// Save executes the query and returns the updated ContestDraft entity.
+func (cduo *ContestDraftUpdateOne) Save(ctx context.Context) (*ContestDraft, error) {
+	var (
+		err  error
+		node *ContestDraft
+	)
+	if len(cduo.hooks) == 0 {
+		if err = cduo.check(); err != nil {
+			return nil, err
+		}
+		node, err = cduo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ContestDraftMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cduo.check(); err != nil {
+				return nil, err
+			}
+			cduo.mutation = mutation
+			node, err = cduo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cduo.hooks) - 1; i >= 0; i-- {
+			mut = cduo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cduo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cduo *ContestDraftUpdateOne) SaveX(ctx context.Context) *ContestDraft {
+	node, err := cduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cduo *ContestDraftUpdateOne) Exec(ctx context.Context) error {
+	_, err := cduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cduo *ContestDraftUpdateOne) ExecX(ctx context.Context) {
+	if err := cduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cduo *ContestDraftUpdateOne) check() error {
+	if _, ok := cduo.mutation.ContestID(); cduo.mutation.ContestCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"contest\"")
+	}
+	return nil
+}
+
+func (cduo *ContestDraftUpdateOne) sqlSave(ctx context.Context) (_node *ContestDraft, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   contestdraft.Table,
+			Columns: contestdraft.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: contestdraft.FieldID,
+			},
+		},
+	}
+	id, ok := cduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing ContestDraft.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := cduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cduo.mutation.Completed(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: contestdraft.FieldCompleted,
+		})
+	}
+	if cduo.mutation.CompletedCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: contestdraft.FieldCompleted,
+		})
+	}
+	if cduo.mutation.ContestCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   contestdraft.ContestTable,
+			Columns: []string{contestdraft.ContestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contest.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cduo.mutation.ContestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   contestdraft.ContestTable,
+			Columns: []string{contestdraft.ContestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contest.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cduo.mutation.PicksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contestdraft.PicksTable,
+			Columns: []string{contestdraft.PicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraftpick.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cduo.mutation.RemovedPicksIDs(); len(nodes) > 0 && !cduo.mutation.PicksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contestdraft.PicksTable,
+			Columns: []string{contestdraft.PicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraftpick.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cduo.mutation.PicksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contestdraft.PicksTable,
+			Columns: []string{contestdraft.PicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraftpick.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ContestDraft{config: cduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contestdraft.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,397 @@
+// Code generated by entc, DO NOT EDIT.
+
+package contestdraftpick
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Round applies equality check predicate on the "round" field. It's identical to RoundEQ.
+func Round(v int) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRound), v))
+	})
+}
+
+// Created applies equality check predicate on the "created" field. It's identical to CreatedEQ.
+func Created(v time.Time) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreated), v))
+	})
+}
+
+// Auto applies equality check predicate on the "auto" field. It's identical to AutoEQ.
+func Auto(v bool) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAuto), v))
+	})
+}
+
+// RoundEQ applies the EQ predicate on the "round" field.
+func RoundEQ(v int) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRound), v))
+	})
+}
+
+// RoundNEQ applies the NEQ predicate on the "round" field.
+func RoundNEQ(v int) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRound), v))
+	})
+}
+
+// RoundIn applies the In predicate on the "round" field.
+func RoundIn(vs ...int) predicate.ContestDraftPick {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRound), v...))
+	})
+}
+
+// RoundNotIn applies the NotIn predicate on the "round" field.
+func RoundNotIn(vs ...int) predicate.ContestDraftPick {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRound), v...))
+	})
+}
+
+// RoundGT applies the GT predicate on the "round" field.
+func RoundGT(v int) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRound), v))
+	})
+}
+
+// RoundGTE applies the GTE predicate on the "round" field.
+func RoundGTE(v int) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRound), v))
+	})
+}
+
+// RoundLT applies the LT predicate on the "round" field.
+func RoundLT(v int) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRound), v))
+	})
+}
+
+// RoundLTE applies the LTE predicate on the "round" field.
+func RoundLTE(v int) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRound), v))
+	})
+}
+
+// CreatedEQ applies the EQ predicate on the "created" field.
+func CreatedEQ(v time.Time) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedNEQ applies the NEQ predicate on the "created" field.
+func CreatedNEQ(v time.Time) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedIn applies the In predicate on the "created" field.
+func CreatedIn(vs ...time.Time) predicate.ContestDraftPick {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreated), v...))
+	})
+}
+
+// CreatedNotIn applies the NotIn predicate on the "created" field.
+func CreatedNotIn(vs ...time.Time) predicate.ContestDraftPick {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreated), v...))
+	})
+}
+
+// CreatedGT applies the GT predicate on the "created" field.
+func CreatedGT(v time.Time) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedGTE applies the GTE predicate on the "created" field.
+func CreatedGTE(v time.Time) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedLT applies the LT predicate on the "created" field.
+func CreatedLT(v time.Time) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedLTE applies the LTE predicate on the "created" field.
+func CreatedLTE(v time.Time) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreated), v))
+	})
+}
+
+// AutoEQ applies the EQ predicate on the "auto" field.
+func AutoEQ(v bool) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAuto), v))
+	})
+}
+
+// AutoNEQ applies the NEQ predicate on the "auto" field.
+func AutoNEQ(v bool) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAuto), v))
+	})
+}
+
+// HasDraft applies the HasEdge predicate on the "draft" edge.
+func HasDraft() predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(DraftTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DraftTable, DraftColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDraftWith applies the HasEdge predicate on the "draft" edge with a given conditions (other predicates).
+func HasDraftWith(preds ...predicate.ContestDraft) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(DraftInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DraftTable, DraftColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPlayer applies the HasEdge predicate on the "player" edge.
+func HasPlayer() predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PlayerTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PlayerTable, PlayerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlayerWith applies the HasEdge predicate on the "player" edge with a given conditions (other predicates).
+func HasPlayerWith(preds ...predicate.Player) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PlayerInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PlayerTable, PlayerColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ContestDraftPick) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ContestDraftPick) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ContestDraftPick) predicate.ContestDraftPick {
+	return predicate.ContestDraftPick(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
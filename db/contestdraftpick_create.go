@@ -0,0 +1,364 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraft"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraftpick"
+	"github.com/NickDubelman/fantasy-bball/db/player"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+)
+
+// ContestDraftPickCreate is the builder for creating a ContestDraftPick entity.
+type ContestDraftPickCreate struct {
+	config
+	mutation *ContestDraftPickMutation
+	hooks    []Hook
+}
+
+// SetRound sets the "round" field.
+func (cdpc *ContestDraftPickCreate) SetRound(i int) *ContestDraftPickCreate {
+	cdpc.mutation.SetRound(i)
+	return cdpc
+}
+
+// SetCreated sets the "created" field.
+func (cdpc *ContestDraftPickCreate) SetCreated(t time.Time) *ContestDraftPickCreate {
+	cdpc.mutation.SetCreated(t)
+	return cdpc
+}
+
+// SetNillableCreated sets the "created" field if the given value is not nil.
+func (cdpc *ContestDraftPickCreate) SetNillableCreated(t *time.Time) *ContestDraftPickCreate {
+	if t != nil {
+		cdpc.SetCreated(*t)
+	}
+	return cdpc
+}
+
+// SetAuto sets the "auto" field.
+func (cdpc *ContestDraftPickCreate) SetAuto(b bool) *ContestDraftPickCreate {
+	cdpc.mutation.SetAuto(b)
+	return cdpc
+}
+
+// SetNillableAuto sets the "auto" field if the given value is not nil.
+func (cdpc *ContestDraftPickCreate) SetNillableAuto(b *bool) *ContestDraftPickCreate {
+	if b != nil {
+		cdpc.SetAuto(*b)
+	}
+	return cdpc
+}
+
+// SetDraftID sets the "draft" edge to the ContestDraft entity by ID.
+func (cdpc *ContestDraftPickCreate) SetDraftID(id int) *ContestDraftPickCreate {
+	cdpc.mutation.SetDraftID(id)
+	return cdpc
+}
+
+// SetDraft sets the "draft" edge to the ContestDraft entity.
+func (cdpc *ContestDraftPickCreate) SetDraft(c *ContestDraft) *ContestDraftPickCreate {
+	return cdpc.SetDraftID(c.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (cdpc *ContestDraftPickCreate) SetUserID(id int) *ContestDraftPickCreate {
+	cdpc.mutation.SetUserID(id)
+	return cdpc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (cdpc *ContestDraftPickCreate) SetUser(u *User) *ContestDraftPickCreate {
+	return cdpc.SetUserID(u.ID)
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by ID.
+func (cdpc *ContestDraftPickCreate) SetPlayerID(id int) *ContestDraftPickCreate {
+	cdpc.mutation.SetPlayerID(id)
+	return cdpc
+}
+
+// SetNillablePlayerID sets the "player" edge to the Player entity by ID if the given value is not nil.
+func (cdpc *ContestDraftPickCreate) SetNillablePlayerID(id *int) *ContestDraftPickCreate {
+	if id != nil {
+		cdpc = cdpc.SetPlayerID(*id)
+	}
+	return cdpc
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (cdpc *ContestDraftPickCreate) SetPlayer(p *Player) *ContestDraftPickCreate {
+	return cdpc.SetPlayerID(p.ID)
+}
+
+// Mutation returns the ContestDraftPickMutation object of the builder.
+func (cdpc *ContestDraftPickCreate) Mutation() *ContestDraftPickMutation {
+	return cdpc.mutation
+}
+
+// Save creates the ContestDraftPick in the database.
+func (cdpc *ContestDraftPickCreate) Save(ctx context.Context) (*ContestDraftPick, error) {
+	var (
+		err  error
+		node *ContestDraftPick
+	)
+	cdpc.defaults()
+	if len(cdpc.hooks) == 0 {
+		if err = cdpc.check(); err != nil {
+			return nil, err
+		}
+		node, err = cdpc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ContestDraftPickMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cdpc.check(); err != nil {
+				return nil, err
+			}
+			cdpc.mutation = mutation
+			node, err = cdpc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cdpc.hooks) - 1; i >= 0; i-- {
+			mut = cdpc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cdpc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cdpc *ContestDraftPickCreate) SaveX(ctx context.Context) *ContestDraftPick {
+	v, err := cdpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (cdpc *ContestDraftPickCreate) defaults() {
+	if _, ok := cdpc.mutation.Created(); !ok {
+		v := contestdraftpick.DefaultCreated()
+		cdpc.mutation.SetCreated(v)
+	}
+	if _, ok := cdpc.mutation.Auto(); !ok {
+		v := contestdraftpick.DefaultAuto
+		cdpc.mutation.SetAuto(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cdpc *ContestDraftPickCreate) check() error {
+	if _, ok := cdpc.mutation.Round(); !ok {
+		return &ValidationError{Name: "round", err: errors.New("db: missing required field \"round\"")}
+	}
+	if v, ok := cdpc.mutation.Round(); ok {
+		if err := contestdraftpick.RoundValidator(v); err != nil {
+			return &ValidationError{Name: "round", err: fmt.Errorf("db: validator failed for field \"round\": %w", err)}
+		}
+	}
+	if _, ok := cdpc.mutation.Created(); !ok {
+		return &ValidationError{Name: "created", err: errors.New("db: missing required field \"created\"")}
+	}
+	if _, ok := cdpc.mutation.Auto(); !ok {
+		return &ValidationError{Name: "auto", err: errors.New("db: missing required field \"auto\"")}
+	}
+	if _, ok := cdpc.mutation.DraftID(); !ok {
+		return &ValidationError{Name: "draft", err: errors.New("db: missing required edge \"draft\"")}
+	}
+	if _, ok := cdpc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New("db: missing required edge \"user\"")}
+	}
+	return nil
+}
+
+func (cdpc *ContestDraftPickCreate) sqlSave(ctx context.Context) (*ContestDraftPick, error) {
+	_node, _spec := cdpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cdpc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (cdpc *ContestDraftPickCreate) createSpec() (*ContestDraftPick, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ContestDraftPick{config: cdpc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: contestdraftpick.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: contestdraftpick.FieldID,
+			},
+		}
+	)
+	if value, ok := cdpc.mutation.Round(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: contestdraftpick.FieldRound,
+		})
+		_node.Round = value
+	}
+	if value, ok := cdpc.mutation.Created(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: contestdraftpick.FieldCreated,
+		})
+		_node.Created = value
+	}
+	if value, ok := cdpc.mutation.Auto(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: contestdraftpick.FieldAuto,
+		})
+		_node.Auto = value
+	}
+	if nodes := cdpc.mutation.DraftIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestdraftpick.DraftTable,
+			Columns: []string{contestdraftpick.DraftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraft.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.contest_draft_picks = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cdpc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestdraftpick.UserTable,
+			Columns: []string{contestdraftpick.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_draft_picks = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cdpc.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestdraftpick.PlayerTable,
+			Columns: []string{contestdraftpick.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.player_draft_picks = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ContestDraftPickCreateBulk is the builder for creating many ContestDraftPick entities in bulk.
+type ContestDraftPickCreateBulk struct {
+	config
+	builders []*ContestDraftPickCreate
+}
+
+// Save creates the ContestDraftPick entities in the database.
+func (cdpcb *ContestDraftPickCreateBulk) Save(ctx context.Context) ([]*ContestDraftPick, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(cdpcb.builders))
+	nodes := make([]*ContestDraftPick, len(cdpcb.builders))
+	mutators := make([]Mutator, len(cdpcb.builders))
+	for i := range cdpcb.builders {
+		func(i int, root context.Context) {
+			builder := cdpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ContestDraftPickMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cdpcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cdpcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cdpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cdpcb *ContestDraftPickCreateBulk) SaveX(ctx context.Context) []*ContestDraftPick {
+	v, err := cdpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
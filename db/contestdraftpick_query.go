@@ -0,0 +1,1095 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraft"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraftpick"
+	"github.com/NickDubelman/fantasy-bball/db/player"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+)
+
+// ContestDraftPickQuery is the builder for querying ContestDraftPick entities.
+type ContestDraftPickQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ContestDraftPick
+	// eager-loading edges.
+	withDraft  *ContestDraftQuery
+	withUser   *UserQuery
+	withPlayer *PlayerQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ContestDraftPickQuery builder.
+func (cdpq *ContestDraftPickQuery) Where(ps ...predicate.ContestDraftPick) *ContestDraftPickQuery {
+	cdpq.predicates = append(cdpq.predicates, ps...)
+	return cdpq
+}
+
+// Limit adds a limit step to the query.
+func (cdpq *ContestDraftPickQuery) Limit(limit int) *ContestDraftPickQuery {
+	cdpq.limit = &limit
+	return cdpq
+}
+
+// Offset adds an offset step to the query.
+func (cdpq *ContestDraftPickQuery) Offset(offset int) *ContestDraftPickQuery {
+	cdpq.offset = &offset
+	return cdpq
+}
+
+// Order adds an order step to the query.
+func (cdpq *ContestDraftPickQuery) Order(o ...OrderFunc) *ContestDraftPickQuery {
+	cdpq.order = append(cdpq.order, o...)
+	return cdpq
+}
+
+// QueryDraft chains the current query on the "draft" edge.
+func (cdpq *ContestDraftPickQuery) QueryDraft() *ContestDraftQuery {
+	query := &ContestDraftQuery{config: cdpq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cdpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cdpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contestdraftpick.Table, contestdraftpick.FieldID, selector),
+			sqlgraph.To(contestdraft.Table, contestdraft.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contestdraftpick.DraftTable, contestdraftpick.DraftColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cdpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (cdpq *ContestDraftPickQuery) QueryUser() *UserQuery {
+	query := &UserQuery{config: cdpq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cdpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cdpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contestdraftpick.Table, contestdraftpick.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contestdraftpick.UserTable, contestdraftpick.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cdpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPlayer chains the current query on the "player" edge.
+func (cdpq *ContestDraftPickQuery) QueryPlayer() *PlayerQuery {
+	query := &PlayerQuery{config: cdpq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cdpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cdpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contestdraftpick.Table, contestdraftpick.FieldID, selector),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contestdraftpick.PlayerTable, contestdraftpick.PlayerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cdpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ContestDraftPick entity from the query.
+// Returns a *NotFoundError when no ContestDraftPick was found.
+func (cdpq *ContestDraftPickQuery) First(ctx context.Context) (*ContestDraftPick, error) {
+	nodes, err := cdpq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{contestdraftpick.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cdpq *ContestDraftPickQuery) FirstX(ctx context.Context) *ContestDraftPick {
+	node, err := cdpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ContestDraftPick ID from the query.
+// Returns a *NotFoundError when no ContestDraftPick ID was found.
+func (cdpq *ContestDraftPickQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cdpq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{contestdraftpick.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cdpq *ContestDraftPickQuery) FirstIDX(ctx context.Context) int {
+	id, err := cdpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ContestDraftPick entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one ContestDraftPick entity is not found.
+// Returns a *NotFoundError when no ContestDraftPick entities are found.
+func (cdpq *ContestDraftPickQuery) Only(ctx context.Context) (*ContestDraftPick, error) {
+	nodes, err := cdpq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{contestdraftpick.Label}
+	default:
+		return nil, &NotSingularError{contestdraftpick.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cdpq *ContestDraftPickQuery) OnlyX(ctx context.Context) *ContestDraftPick {
+	node, err := cdpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ContestDraftPick ID in the query.
+// Returns a *NotSingularError when exactly one ContestDraftPick ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (cdpq *ContestDraftPickQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cdpq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{contestdraftpick.Label}
+	default:
+		err = &NotSingularError{contestdraftpick.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cdpq *ContestDraftPickQuery) OnlyIDX(ctx context.Context) int {
+	id, err := cdpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ContestDraftPicks.
+func (cdpq *ContestDraftPickQuery) All(ctx context.Context) ([]*ContestDraftPick, error) {
+	if err := cdpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return cdpq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cdpq *ContestDraftPickQuery) AllX(ctx context.Context) []*ContestDraftPick {
+	nodes, err := cdpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ContestDraftPick IDs.
+func (cdpq *ContestDraftPickQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := cdpq.Select(contestdraftpick.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cdpq *ContestDraftPickQuery) IDsX(ctx context.Context) []int {
+	ids, err := cdpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cdpq *ContestDraftPickQuery) Count(ctx context.Context) (int, error) {
+	if err := cdpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return cdpq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cdpq *ContestDraftPickQuery) CountX(ctx context.Context) int {
+	count, err := cdpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cdpq *ContestDraftPickQuery) Exist(ctx context.Context) (bool, error) {
+	if err := cdpq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return cdpq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cdpq *ContestDraftPickQuery) ExistX(ctx context.Context) bool {
+	exist, err := cdpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ContestDraftPickQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cdpq *ContestDraftPickQuery) Clone() *ContestDraftPickQuery {
+	if cdpq == nil {
+		return nil
+	}
+	return &ContestDraftPickQuery{
+		config:     cdpq.config,
+		limit:      cdpq.limit,
+		offset:     cdpq.offset,
+		order:      append([]OrderFunc{}, cdpq.order...),
+		predicates: append([]predicate.ContestDraftPick{}, cdpq.predicates...),
+		withDraft:  cdpq.withDraft.Clone(),
+		withUser:   cdpq.withUser.Clone(),
+		withPlayer: cdpq.withPlayer.Clone(),
+		// clone intermediate query.
+		sql:  cdpq.sql.Clone(),
+		path: cdpq.path,
+	}
+}
+
+// WithDraft tells the query-builder to eager-load the nodes that are connected to
+// the "draft" edge. The optional arguments are used to configure the query builder of the edge.
+func (cdpq *ContestDraftPickQuery) WithDraft(opts ...func(*ContestDraftQuery)) *ContestDraftPickQuery {
+	query := &ContestDraftQuery{config: cdpq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cdpq.withDraft = query
+	return cdpq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (cdpq *ContestDraftPickQuery) WithUser(opts ...func(*UserQuery)) *ContestDraftPickQuery {
+	query := &UserQuery{config: cdpq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cdpq.withUser = query
+	return cdpq
+}
+
+// WithPlayer tells the query-builder to eager-load the nodes that are connected to
+// the "player" edge. The optional arguments are used to configure the query builder of the edge.
+func (cdpq *ContestDraftPickQuery) WithPlayer(opts ...func(*PlayerQuery)) *ContestDraftPickQuery {
+	query := &PlayerQuery{config: cdpq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cdpq.withPlayer = query
+	return cdpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Round int `json:"round,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ContestDraftPick.Query().
+//		GroupBy(contestdraftpick.FieldRound).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (cdpq *ContestDraftPickQuery) GroupBy(field string, fields ...string) *ContestDraftPickGroupBy {
+	group := &ContestDraftPickGroupBy{config: cdpq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := cdpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return cdpq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Round int `json:"round,omitempty"`
+//	}
+//
+//	client.ContestDraftPick.Query().
+//		Select(contestdraftpick.FieldRound).
+//		Scan(ctx, &v)
+func (cdpq *ContestDraftPickQuery) Select(field string, fields ...string) *ContestDraftPickSelect {
+	cdpq.fields = append([]string{field}, fields...)
+	return &ContestDraftPickSelect{ContestDraftPickQuery: cdpq}
+}
+
+func (cdpq *ContestDraftPickQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range cdpq.fields {
+		if !contestdraftpick.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if cdpq.path != nil {
+		prev, err := cdpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cdpq.sql = prev
+	}
+	return nil
+}
+
+func (cdpq *ContestDraftPickQuery) sqlAll(ctx context.Context) ([]*ContestDraftPick, error) {
+	var (
+		nodes       = []*ContestDraftPick{}
+		withFKs     = cdpq.withFKs
+		_spec       = cdpq.querySpec()
+		loadedTypes = [3]bool{
+			cdpq.withDraft != nil,
+			cdpq.withUser != nil,
+			cdpq.withPlayer != nil,
+		}
+	)
+	if cdpq.withDraft != nil || cdpq.withUser != nil || cdpq.withPlayer != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, contestdraftpick.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &ContestDraftPick{config: cdpq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("db: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cdpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := cdpq.withDraft; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*ContestDraftPick)
+		for i := range nodes {
+			fk := nodes[i].contest_draft_picks
+			if fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(contestdraft.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "contest_draft_picks" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Draft = n
+			}
+		}
+	}
+
+	if query := cdpq.withUser; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*ContestDraftPick)
+		for i := range nodes {
+			fk := nodes[i].user_draft_picks
+			if fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(user.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_draft_picks" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.User = n
+			}
+		}
+	}
+
+	if query := cdpq.withPlayer; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*ContestDraftPick)
+		for i := range nodes {
+			fk := nodes[i].player_draft_picks
+			if fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(player.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "player_draft_picks" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Player = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (cdpq *ContestDraftPickQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cdpq.querySpec()
+	return sqlgraph.CountNodes(ctx, cdpq.driver, _spec)
+}
+
+func (cdpq *ContestDraftPickQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := cdpq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("db: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (cdpq *ContestDraftPickQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   contestdraftpick.Table,
+			Columns: contestdraftpick.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: contestdraftpick.FieldID,
+			},
+		},
+		From:   cdpq.sql,
+		Unique: true,
+	}
+	if fields := cdpq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contestdraftpick.FieldID)
+		for i := range fields {
+			if fields[i] != contestdraftpick.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := cdpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cdpq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cdpq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cdpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, contestdraftpick.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cdpq *ContestDraftPickQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cdpq.driver.Dialect())
+	t1 := builder.Table(contestdraftpick.Table)
+	selector := builder.Select(t1.Columns(contestdraftpick.Columns...)...).From(t1)
+	if cdpq.sql != nil {
+		selector = cdpq.sql
+		selector.Select(selector.Columns(contestdraftpick.Columns...)...)
+	}
+	for _, p := range cdpq.predicates {
+		p(selector)
+	}
+	for _, p := range cdpq.order {
+		p(selector, contestdraftpick.ValidColumn)
+	}
+	if offset := cdpq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cdpq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ContestDraftPickGroupBy is the group-by builder for ContestDraftPick entities.
+type ContestDraftPickGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cdpgb *ContestDraftPickGroupBy) Aggregate(fns ...AggregateFunc) *ContestDraftPickGroupBy {
+	cdpgb.fns = append(cdpgb.fns, fns...)
+	return cdpgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (cdpgb *ContestDraftPickGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := cdpgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	cdpgb.sql = query
+	return cdpgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (cdpgb *ContestDraftPickGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := cdpgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cdpgb *ContestDraftPickGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(cdpgb.fields) > 1 {
+		return nil, errors.New("db: ContestDraftPickGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := cdpgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (cdpgb *ContestDraftPickGroupBy) StringsX(ctx context.Context) []string {
+	v, err := cdpgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cdpgb *ContestDraftPickGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = cdpgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestdraftpick.Label}
+	default:
+		err = fmt.Errorf("db: ContestDraftPickGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (cdpgb *ContestDraftPickGroupBy) StringX(ctx context.Context) string {
+	v, err := cdpgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cdpgb *ContestDraftPickGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(cdpgb.fields) > 1 {
+		return nil, errors.New("db: ContestDraftPickGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := cdpgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (cdpgb *ContestDraftPickGroupBy) IntsX(ctx context.Context) []int {
+	v, err := cdpgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cdpgb *ContestDraftPickGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = cdpgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestdraftpick.Label}
+	default:
+		err = fmt.Errorf("db: ContestDraftPickGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (cdpgb *ContestDraftPickGroupBy) IntX(ctx context.Context) int {
+	v, err := cdpgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cdpgb *ContestDraftPickGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(cdpgb.fields) > 1 {
+		return nil, errors.New("db: ContestDraftPickGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := cdpgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (cdpgb *ContestDraftPickGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := cdpgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cdpgb *ContestDraftPickGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = cdpgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestdraftpick.Label}
+	default:
+		err = fmt.Errorf("db: ContestDraftPickGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (cdpgb *ContestDraftPickGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := cdpgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cdpgb *ContestDraftPickGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(cdpgb.fields) > 1 {
+		return nil, errors.New("db: ContestDraftPickGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := cdpgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (cdpgb *ContestDraftPickGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := cdpgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cdpgb *ContestDraftPickGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = cdpgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestdraftpick.Label}
+	default:
+		err = fmt.Errorf("db: ContestDraftPickGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (cdpgb *ContestDraftPickGroupBy) BoolX(ctx context.Context) bool {
+	v, err := cdpgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (cdpgb *ContestDraftPickGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range cdpgb.fields {
+		if !contestdraftpick.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := cdpgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cdpgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (cdpgb *ContestDraftPickGroupBy) sqlQuery() *sql.Selector {
+	selector := cdpgb.sql
+	columns := make([]string, 0, len(cdpgb.fields)+len(cdpgb.fns))
+	columns = append(columns, cdpgb.fields...)
+	for _, fn := range cdpgb.fns {
+		columns = append(columns, fn(selector, contestdraftpick.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(cdpgb.fields...)
+}
+
+// ContestDraftPickSelect is the builder for selecting fields of ContestDraftPick entities.
+type ContestDraftPickSelect struct {
+	*ContestDraftPickQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cdps *ContestDraftPickSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := cdps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	cdps.sql = cdps.ContestDraftPickQuery.sqlQuery(ctx)
+	return cdps.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (cdps *ContestDraftPickSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := cdps.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (cdps *ContestDraftPickSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(cdps.fields) > 1 {
+		return nil, errors.New("db: ContestDraftPickSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := cdps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (cdps *ContestDraftPickSelect) StringsX(ctx context.Context) []string {
+	v, err := cdps.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (cdps *ContestDraftPickSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = cdps.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestdraftpick.Label}
+	default:
+		err = fmt.Errorf("db: ContestDraftPickSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (cdps *ContestDraftPickSelect) StringX(ctx context.Context) string {
+	v, err := cdps.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (cdps *ContestDraftPickSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(cdps.fields) > 1 {
+		return nil, errors.New("db: ContestDraftPickSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := cdps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (cdps *ContestDraftPickSelect) IntsX(ctx context.Context) []int {
+	v, err := cdps.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (cdps *ContestDraftPickSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = cdps.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestdraftpick.Label}
+	default:
+		err = fmt.Errorf("db: ContestDraftPickSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (cdps *ContestDraftPickSelect) IntX(ctx context.Context) int {
+	v, err := cdps.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (cdps *ContestDraftPickSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(cdps.fields) > 1 {
+		return nil, errors.New("db: ContestDraftPickSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := cdps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (cdps *ContestDraftPickSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := cdps.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (cdps *ContestDraftPickSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = cdps.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestdraftpick.Label}
+	default:
+		err = fmt.Errorf("db: ContestDraftPickSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (cdps *ContestDraftPickSelect) Float64X(ctx context.Context) float64 {
+	v, err := cdps.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (cdps *ContestDraftPickSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(cdps.fields) > 1 {
+		return nil, errors.New("db: ContestDraftPickSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := cdps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (cdps *ContestDraftPickSelect) BoolsX(ctx context.Context) []bool {
+	v, err := cdps.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (cdps *ContestDraftPickSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = cdps.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestdraftpick.Label}
+	default:
+		err = fmt.Errorf("db: ContestDraftPickSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (cdps *ContestDraftPickSelect) BoolX(ctx context.Context) bool {
+	v, err := cdps.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (cdps *ContestDraftPickSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := cdps.sqlQuery().Query()
+	if err := cdps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (cdps *ContestDraftPickSelect) sqlQuery() sql.Querier {
+	selector := cdps.sql
+	selector.Select(selector.Columns(cdps.fields...)...)
+	return selector
+}
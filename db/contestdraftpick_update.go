@@ -0,0 +1,578 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraft"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraftpick"
+	"github.com/NickDubelman/fantasy-bball/db/player"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+)
+
+// ContestDraftPickUpdate is the builder for updating ContestDraftPick entities.
+type ContestDraftPickUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ContestDraftPickMutation
+}
+
+// Where adds a new predicate for the ContestDraftPickUpdate builder.
+func (cdpu *ContestDraftPickUpdate) Where(ps ...predicate.ContestDraftPick) *ContestDraftPickUpdate {
+	cdpu.mutation.predicates = append(cdpu.mutation.predicates, ps...)
+	return cdpu
+}
+
+// SetDraftID sets the "draft" edge to the ContestDraft entity by ID.
+func (cdpu *ContestDraftPickUpdate) SetDraftID(id int) *ContestDraftPickUpdate {
+	cdpu.mutation.SetDraftID(id)
+	return cdpu
+}
+
+// SetDraft sets the "draft" edge to the ContestDraft entity.
+func (cdpu *ContestDraftPickUpdate) SetDraft(c *ContestDraft) *ContestDraftPickUpdate {
+	return cdpu.SetDraftID(c.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (cdpu *ContestDraftPickUpdate) SetUserID(id int) *ContestDraftPickUpdate {
+	cdpu.mutation.SetUserID(id)
+	return cdpu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (cdpu *ContestDraftPickUpdate) SetUser(u *User) *ContestDraftPickUpdate {
+	return cdpu.SetUserID(u.ID)
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by ID.
+func (cdpu *ContestDraftPickUpdate) SetPlayerID(id int) *ContestDraftPickUpdate {
+	cdpu.mutation.SetPlayerID(id)
+	return cdpu
+}
+
+// SetNillablePlayerID sets the "player" edge to the Player entity by ID if the given value is not nil.
+func (cdpu *ContestDraftPickUpdate) SetNillablePlayerID(id *int) *ContestDraftPickUpdate {
+	if id != nil {
+		cdpu = cdpu.SetPlayerID(*id)
+	}
+	return cdpu
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (cdpu *ContestDraftPickUpdate) SetPlayer(p *Player) *ContestDraftPickUpdate {
+	return cdpu.SetPlayerID(p.ID)
+}
+
+// Mutation returns the ContestDraftPickMutation object of the builder.
+func (cdpu *ContestDraftPickUpdate) Mutation() *ContestDraftPickMutation {
+	return cdpu.mutation
+}
+
+// ClearDraft clears the "draft" edge to the ContestDraft entity.
+func (cdpu *ContestDraftPickUpdate) ClearDraft() *ContestDraftPickUpdate {
+	cdpu.mutation.ClearDraft()
+	return cdpu
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (cdpu *ContestDraftPickUpdate) ClearUser() *ContestDraftPickUpdate {
+	cdpu.mutation.ClearUser()
+	return cdpu
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (cdpu *ContestDraftPickUpdate) ClearPlayer() *ContestDraftPickUpdate {
+	cdpu.mutation.ClearPlayer()
+	return cdpu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cdpu *ContestDraftPickUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(cdpu.hooks) == 0 {
+		if err = cdpu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = cdpu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ContestDraftPickMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cdpu.check(); err != nil {
+				return 0, err
+			}
+			cdpu.mutation = mutation
+			affected, err = cdpu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cdpu.hooks) - 1; i >= 0; i-- {
+			mut = cdpu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cdpu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cdpu *ContestDraftPickUpdate) SaveX(ctx context.Context) int {
+	affected, err := cdpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cdpu *ContestDraftPickUpdate) Exec(ctx context.Context) error {
+	_, err := cdpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdpu *ContestDraftPickUpdate) ExecX(ctx context.Context) {
+	if err := cdpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cdpu *ContestDraftPickUpdate) check() error {
+	if _, ok := cdpu.mutation.DraftID(); cdpu.mutation.DraftCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"draft\"")
+	}
+	if _, ok := cdpu.mutation.UserID(); cdpu.mutation.UserCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"user\"")
+	}
+	return nil
+}
+
+func (cdpu *ContestDraftPickUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   contestdraftpick.Table,
+			Columns: contestdraftpick.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: contestdraftpick.FieldID,
+			},
+		},
+	}
+	if ps := cdpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if cdpu.mutation.DraftCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestdraftpick.DraftTable,
+			Columns: []string{contestdraftpick.DraftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraft.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cdpu.mutation.DraftIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestdraftpick.DraftTable,
+			Columns: []string{contestdraftpick.DraftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraft.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cdpu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestdraftpick.UserTable,
+			Columns: []string{contestdraftpick.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cdpu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestdraftpick.UserTable,
+			Columns: []string{contestdraftpick.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cdpu.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestdraftpick.PlayerTable,
+			Columns: []string{contestdraftpick.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cdpu.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestdraftpick.PlayerTable,
+			Columns: []string{contestdraftpick.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cdpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contestdraftpick.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ContestDraftPickUpdateOne is the builder for updating a single ContestDraftPick entity.
+type ContestDraftPickUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *ContestDraftPickMutation
+}
+
+// SetDraftID sets the "draft" edge to the ContestDraft entity by ID.
+func (cdpuo *ContestDraftPickUpdateOne) SetDraftID(id int) *ContestDraftPickUpdateOne {
+	cdpuo.mutation.SetDraftID(id)
+	return cdpuo
+}
+
+// SetDraft sets the "draft" edge to the ContestDraft entity.
+func (cdpuo *ContestDraftPickUpdateOne) SetDraft(c *ContestDraft) *ContestDraftPickUpdateOne {
+	return cdpuo.SetDraftID(c.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (cdpuo *ContestDraftPickUpdateOne) SetUserID(id int) *ContestDraftPickUpdateOne {
+	cdpuo.mutation.SetUserID(id)
+	return cdpuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (cdpuo *ContestDraftPickUpdateOne) SetUser(u *User) *ContestDraftPickUpdateOne {
+	return cdpuo.SetUserID(u.ID)
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by ID.
+func (cdpuo *ContestDraftPickUpdateOne) SetPlayerID(id int) *ContestDraftPickUpdateOne {
+	cdpuo.mutation.SetPlayerID(id)
+	return cdpuo
+}
+
+// SetNillablePlayerID sets the "player" edge to the Player entity by ID if the given value is not nil.
+func (cdpuo *ContestDraftPickUpdateOne) SetNillablePlayerID(id *int) *ContestDraftPickUpdateOne {
+	if id != nil {
+		cdpuo = cdpuo.SetPlayerID(*id)
+	}
+	return cdpuo
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (cdpuo *ContestDraftPickUpdateOne) SetPlayer(p *Player) *ContestDraftPickUpdateOne {
+	return cdpuo.SetPlayerID(p.ID)
+}
+
+// Mutation returns the ContestDraftPickMutation object of the builder.
+func (cdpuo *ContestDraftPickUpdateOne) Mutation() *ContestDraftPickMutation {
+	return cdpuo.mutation
+}
+
+// ClearDraft clears the "draft" edge to the ContestDraft entity.
+func (cdpuo *ContestDraftPickUpdateOne) ClearDraft() *ContestDraftPickUpdateOne {
+	cdpuo.mutation.ClearDraft()
+	return cdpuo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (cdpuo *ContestDraftPickUpdateOne) ClearUser() *ContestDraftPickUpdateOne {
+	cdpuo.mutation.ClearUser()
+	return cdpuo
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (cdpuo *ContestDraftPickUpdateOne) ClearPlayer() *ContestDraftPickUpdateOne {
+	cdpuo.mutation.ClearPlayer()
+	return cdpuo
+}
+
+// Save executes the query and returns the updated ContestDraftPick entity.
+func (cdpuo *ContestDraftPickUpdateOne) Save(ctx context.Context) (*ContestDraftPick, error) {
+	var (
+		err  error
+		node *ContestDraftPick
+	)
+	if len(cdpuo.hooks) == 0 {
+		if err = cdpuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = cdpuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ContestDraftPickMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cdpuo.check(); err != nil {
+				return nil, err
+			}
+			cdpuo.mutation = mutation
+			node, err = cdpuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cdpuo.hooks) - 1; i >= 0; i-- {
+			mut = cdpuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cdpuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cdpuo *ContestDraftPickUpdateOne) SaveX(ctx context.Context) *ContestDraftPick {
+	node, err := cdpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cdpuo *ContestDraftPickUpdateOne) Exec(ctx context.Context) error {
+	_, err := cdpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdpuo *ContestDraftPickUpdateOne) ExecX(ctx context.Context) {
+	if err := cdpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cdpuo *ContestDraftPickUpdateOne) check() error {
+	if _, ok := cdpuo.mutation.DraftID(); cdpuo.mutation.DraftCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"draft\"")
+	}
+	if _, ok := cdpuo.mutation.UserID(); cdpuo.mutation.UserCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"user\"")
+	}
+	return nil
+}
+
+func (cdpuo *ContestDraftPickUpdateOne) sqlSave(ctx context.Context) (_node *ContestDraftPick, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   contestdraftpick.Table,
+			Columns: contestdraftpick.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: contestdraftpick.FieldID,
+			},
+		},
+	}
+	id, ok := cdpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing ContestDraftPick.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := cdpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if cdpuo.mutation.DraftCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestdraftpick.DraftTable,
+			Columns: []string{contestdraftpick.DraftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraft.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cdpuo.mutation.DraftIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestdraftpick.DraftTable,
+			Columns: []string{contestdraftpick.DraftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraft.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cdpuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestdraftpick.UserTable,
+			Columns: []string{contestdraftpick.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cdpuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestdraftpick.UserTable,
+			Columns: []string{contestdraftpick.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cdpuo.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestdraftpick.PlayerTable,
+			Columns: []string{contestdraftpick.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cdpuo.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestdraftpick.PlayerTable,
+			Columns: []string{contestdraftpick.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ContestDraftPick{config: cdpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cdpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contestdraftpick.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
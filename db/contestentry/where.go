@@ -0,0 +1,291 @@
+// Code generated by entc, DO NOT EDIT.
+
+package contestentry
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TotalPoints applies equality check predicate on the "totalPoints" field. It's identical to TotalPointsEQ.
+func TotalPoints(v int) predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTotalPoints), v))
+	})
+}
+
+// TotalPointsEQ applies the EQ predicate on the "totalPoints" field.
+func TotalPointsEQ(v int) predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTotalPoints), v))
+	})
+}
+
+// TotalPointsNEQ applies the NEQ predicate on the "totalPoints" field.
+func TotalPointsNEQ(v int) predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTotalPoints), v))
+	})
+}
+
+// TotalPointsIn applies the In predicate on the "totalPoints" field.
+func TotalPointsIn(vs ...int) predicate.ContestEntry {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTotalPoints), v...))
+	})
+}
+
+// TotalPointsNotIn applies the NotIn predicate on the "totalPoints" field.
+func TotalPointsNotIn(vs ...int) predicate.ContestEntry {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTotalPoints), v...))
+	})
+}
+
+// TotalPointsGT applies the GT predicate on the "totalPoints" field.
+func TotalPointsGT(v int) predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTotalPoints), v))
+	})
+}
+
+// TotalPointsGTE applies the GTE predicate on the "totalPoints" field.
+func TotalPointsGTE(v int) predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTotalPoints), v))
+	})
+}
+
+// TotalPointsLT applies the LT predicate on the "totalPoints" field.
+func TotalPointsLT(v int) predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTotalPoints), v))
+	})
+}
+
+// TotalPointsLTE applies the LTE predicate on the "totalPoints" field.
+func TotalPointsLTE(v int) predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTotalPoints), v))
+	})
+}
+
+// HasContest applies the HasEdge predicate on the "contest" edge.
+func HasContest() predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ContestTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ContestTable, ContestColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasContestWith applies the HasEdge predicate on the "contest" edge with a given conditions (other predicates).
+func HasContestWith(preds ...predicate.Contest) predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ContestInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ContestTable, ContestColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCorrections applies the HasEdge predicate on the "corrections" edge.
+func HasCorrections() predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CorrectionsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CorrectionsTable, CorrectionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCorrectionsWith applies the HasEdge predicate on the "corrections" edge with a given conditions (other predicates).
+func HasCorrectionsWith(preds ...predicate.ContestEntryCorrection) predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CorrectionsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CorrectionsTable, CorrectionsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ContestEntry) predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ContestEntry) predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ContestEntry) predicate.ContestEntry {
+	return predicate.ContestEntry(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,307 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/contest"
+	"github.com/NickDubelman/fantasy-bball/db/contestentry"
+	"github.com/NickDubelman/fantasy-bball/db/contestentrycorrection"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+)
+
+// ContestEntryCreate is the builder for creating a ContestEntry entity.
+type ContestEntryCreate struct {
+	config
+	mutation *ContestEntryMutation
+	hooks    []Hook
+}
+
+// SetTotalPoints sets the "totalPoints" field.
+func (cec *ContestEntryCreate) SetTotalPoints(i int) *ContestEntryCreate {
+	cec.mutation.SetTotalPoints(i)
+	return cec
+}
+
+// SetNillableTotalPoints sets the "totalPoints" field if the given value is not nil.
+func (cec *ContestEntryCreate) SetNillableTotalPoints(i *int) *ContestEntryCreate {
+	if i != nil {
+		cec.SetTotalPoints(*i)
+	}
+	return cec
+}
+
+// SetContestID sets the "contest" edge to the Contest entity by ID.
+func (cec *ContestEntryCreate) SetContestID(id int) *ContestEntryCreate {
+	cec.mutation.SetContestID(id)
+	return cec
+}
+
+// SetContest sets the "contest" edge to the Contest entity.
+func (cec *ContestEntryCreate) SetContest(c *Contest) *ContestEntryCreate {
+	return cec.SetContestID(c.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (cec *ContestEntryCreate) SetUserID(id int) *ContestEntryCreate {
+	cec.mutation.SetUserID(id)
+	return cec
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (cec *ContestEntryCreate) SetUser(u *User) *ContestEntryCreate {
+	return cec.SetUserID(u.ID)
+}
+
+// AddCorrectionIDs adds the "corrections" edge to the ContestEntryCorrection entity by IDs.
+func (cec *ContestEntryCreate) AddCorrectionIDs(ids ...int) *ContestEntryCreate {
+	cec.mutation.AddCorrectionIDs(ids...)
+	return cec
+}
+
+// AddCorrections adds the "corrections" edges to the ContestEntryCorrection entity.
+func (cec *ContestEntryCreate) AddCorrections(c ...*ContestEntryCorrection) *ContestEntryCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cec.AddCorrectionIDs(ids...)
+}
+
+// Mutation returns the ContestEntryMutation object of the builder.
+func (cec *ContestEntryCreate) Mutation() *ContestEntryMutation {
+	return cec.mutation
+}
+
+// Save creates the ContestEntry in the database.
+func (cec *ContestEntryCreate) Save(ctx context.Context) (*ContestEntry, error) {
+	var (
+		err  error
+		node *ContestEntry
+	)
+	cec.defaults()
+	if len(cec.hooks) == 0 {
+		if err = cec.check(); err != nil {
+			return nil, err
+		}
+		node, err = cec.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ContestEntryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cec.check(); err != nil {
+				return nil, err
+			}
+			cec.mutation = mutation
+			node, err = cec.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cec.hooks) - 1; i >= 0; i-- {
+			mut = cec.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cec.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cec *ContestEntryCreate) SaveX(ctx context.Context) *ContestEntry {
+	v, err := cec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (cec *ContestEntryCreate) defaults() {
+	if _, ok := cec.mutation.TotalPoints(); !ok {
+		v := contestentry.DefaultTotalPoints
+		cec.mutation.SetTotalPoints(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cec *ContestEntryCreate) check() error {
+	if _, ok := cec.mutation.TotalPoints(); !ok {
+		return &ValidationError{Name: "totalPoints", err: errors.New("db: missing required field \"totalPoints\"")}
+	}
+	if _, ok := cec.mutation.ContestID(); !ok {
+		return &ValidationError{Name: "contest", err: errors.New("db: missing required edge \"contest\"")}
+	}
+	if _, ok := cec.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New("db: missing required edge \"user\"")}
+	}
+	return nil
+}
+
+func (cec *ContestEntryCreate) sqlSave(ctx context.Context) (*ContestEntry, error) {
+	_node, _spec := cec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cec.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (cec *ContestEntryCreate) createSpec() (*ContestEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ContestEntry{config: cec.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: contestentry.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: contestentry.FieldID,
+			},
+		}
+	)
+	if value, ok := cec.mutation.TotalPoints(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: contestentry.FieldTotalPoints,
+		})
+		_node.TotalPoints = value
+	}
+	if nodes := cec.mutation.ContestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestentry.ContestTable,
+			Columns: []string{contestentry.ContestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contest.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.contest_entries = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cec.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestentry.UserTable,
+			Columns: []string{contestentry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_contest_entries = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cec.mutation.CorrectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contestentry.CorrectionsTable,
+			Columns: []string{contestentry.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentrycorrection.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ContestEntryCreateBulk is the builder for creating many ContestEntry entities in bulk.
+type ContestEntryCreateBulk struct {
+	config
+	builders []*ContestEntryCreate
+}
+
+// Save creates the ContestEntry entities in the database.
+func (cecb *ContestEntryCreateBulk) Save(ctx context.Context) ([]*ContestEntry, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(cecb.builders))
+	nodes := make([]*ContestEntry, len(cecb.builders))
+	mutators := make([]Mutator, len(cecb.builders))
+	for i := range cecb.builders {
+		func(i int, root context.Context) {
+			builder := cecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ContestEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cecb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cecb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cecb *ContestEntryCreateBulk) SaveX(ctx context.Context) []*ContestEntry {
+	v, err := cecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,1099 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/contest"
+	"github.com/NickDubelman/fantasy-bball/db/contestentry"
+	"github.com/NickDubelman/fantasy-bball/db/contestentrycorrection"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+)
+
+// ContestEntryQuery is the builder for querying ContestEntry entities.
+type ContestEntryQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ContestEntry
+	// eager-loading edges.
+	withContest     *ContestQuery
+	withUser        *UserQuery
+	withCorrections *ContestEntryCorrectionQuery
+	withFKs         bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ContestEntryQuery builder.
+func (ceq *ContestEntryQuery) Where(ps ...predicate.ContestEntry) *ContestEntryQuery {
+	ceq.predicates = append(ceq.predicates, ps...)
+	return ceq
+}
+
+// Limit adds a limit step to the query.
+func (ceq *ContestEntryQuery) Limit(limit int) *ContestEntryQuery {
+	ceq.limit = &limit
+	return ceq
+}
+
+// Offset adds an offset step to the query.
+func (ceq *ContestEntryQuery) Offset(offset int) *ContestEntryQuery {
+	ceq.offset = &offset
+	return ceq
+}
+
+// Order adds an order step to the query.
+func (ceq *ContestEntryQuery) Order(o ...OrderFunc) *ContestEntryQuery {
+	ceq.order = append(ceq.order, o...)
+	return ceq
+}
+
+// QueryContest chains the current query on the "contest" edge.
+func (ceq *ContestEntryQuery) QueryContest() *ContestQuery {
+	query := &ContestQuery{config: ceq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ceq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ceq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contestentry.Table, contestentry.FieldID, selector),
+			sqlgraph.To(contest.Table, contest.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contestentry.ContestTable, contestentry.ContestColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ceq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (ceq *ContestEntryQuery) QueryUser() *UserQuery {
+	query := &UserQuery{config: ceq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ceq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ceq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contestentry.Table, contestentry.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contestentry.UserTable, contestentry.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ceq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCorrections chains the current query on the "corrections" edge.
+func (ceq *ContestEntryQuery) QueryCorrections() *ContestEntryCorrectionQuery {
+	query := &ContestEntryCorrectionQuery{config: ceq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ceq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ceq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contestentry.Table, contestentry.FieldID, selector),
+			sqlgraph.To(contestentrycorrection.Table, contestentrycorrection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, contestentry.CorrectionsTable, contestentry.CorrectionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ceq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ContestEntry entity from the query.
+// Returns a *NotFoundError when no ContestEntry was found.
+func (ceq *ContestEntryQuery) First(ctx context.Context) (*ContestEntry, error) {
+	nodes, err := ceq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{contestentry.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ceq *ContestEntryQuery) FirstX(ctx context.Context) *ContestEntry {
+	node, err := ceq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ContestEntry ID from the query.
+// Returns a *NotFoundError when no ContestEntry ID was found.
+func (ceq *ContestEntryQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ceq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{contestentry.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ceq *ContestEntryQuery) FirstIDX(ctx context.Context) int {
+	id, err := ceq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ContestEntry entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one ContestEntry entity is not found.
+// Returns a *NotFoundError when no ContestEntry entities are found.
+func (ceq *ContestEntryQuery) Only(ctx context.Context) (*ContestEntry, error) {
+	nodes, err := ceq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{contestentry.Label}
+	default:
+		return nil, &NotSingularError{contestentry.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ceq *ContestEntryQuery) OnlyX(ctx context.Context) *ContestEntry {
+	node, err := ceq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ContestEntry ID in the query.
+// Returns a *NotSingularError when exactly one ContestEntry ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (ceq *ContestEntryQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ceq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{contestentry.Label}
+	default:
+		err = &NotSingularError{contestentry.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ceq *ContestEntryQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ceq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ContestEntries.
+func (ceq *ContestEntryQuery) All(ctx context.Context) ([]*ContestEntry, error) {
+	if err := ceq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return ceq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ceq *ContestEntryQuery) AllX(ctx context.Context) []*ContestEntry {
+	nodes, err := ceq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ContestEntry IDs.
+func (ceq *ContestEntryQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := ceq.Select(contestentry.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ceq *ContestEntryQuery) IDsX(ctx context.Context) []int {
+	ids, err := ceq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ceq *ContestEntryQuery) Count(ctx context.Context) (int, error) {
+	if err := ceq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return ceq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ceq *ContestEntryQuery) CountX(ctx context.Context) int {
+	count, err := ceq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ceq *ContestEntryQuery) Exist(ctx context.Context) (bool, error) {
+	if err := ceq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return ceq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ceq *ContestEntryQuery) ExistX(ctx context.Context) bool {
+	exist, err := ceq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ContestEntryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ceq *ContestEntryQuery) Clone() *ContestEntryQuery {
+	if ceq == nil {
+		return nil
+	}
+	return &ContestEntryQuery{
+		config:          ceq.config,
+		limit:           ceq.limit,
+		offset:          ceq.offset,
+		order:           append([]OrderFunc{}, ceq.order...),
+		predicates:      append([]predicate.ContestEntry{}, ceq.predicates...),
+		withContest:     ceq.withContest.Clone(),
+		withUser:        ceq.withUser.Clone(),
+		withCorrections: ceq.withCorrections.Clone(),
+		// clone intermediate query.
+		sql:  ceq.sql.Clone(),
+		path: ceq.path,
+	}
+}
+
+// WithContest tells the query-builder to eager-load the nodes that are connected to
+// the "contest" edge. The optional arguments are used to configure the query builder of the edge.
+func (ceq *ContestEntryQuery) WithContest(opts ...func(*ContestQuery)) *ContestEntryQuery {
+	query := &ContestQuery{config: ceq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	ceq.withContest = query
+	return ceq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (ceq *ContestEntryQuery) WithUser(opts ...func(*UserQuery)) *ContestEntryQuery {
+	query := &UserQuery{config: ceq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	ceq.withUser = query
+	return ceq
+}
+
+// WithCorrections tells the query-builder to eager-load the nodes that are connected to
+// the "corrections" edge. The optional arguments are used to configure the query builder of the edge.
+func (ceq *ContestEntryQuery) WithCorrections(opts ...func(*ContestEntryCorrectionQuery)) *ContestEntryQuery {
+	query := &ContestEntryCorrectionQuery{config: ceq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	ceq.withCorrections = query
+	return ceq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TotalPoints int `json:"totalPoints,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ContestEntry.Query().
+//		GroupBy(contestentry.FieldTotalPoints).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (ceq *ContestEntryQuery) GroupBy(field string, fields ...string) *ContestEntryGroupBy {
+	group := &ContestEntryGroupBy{config: ceq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ceq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ceq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TotalPoints int `json:"totalPoints,omitempty"`
+//	}
+//
+//	client.ContestEntry.Query().
+//		Select(contestentry.FieldTotalPoints).
+//		Scan(ctx, &v)
+func (ceq *ContestEntryQuery) Select(field string, fields ...string) *ContestEntrySelect {
+	ceq.fields = append([]string{field}, fields...)
+	return &ContestEntrySelect{ContestEntryQuery: ceq}
+}
+
+func (ceq *ContestEntryQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range ceq.fields {
+		if !contestentry.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if ceq.path != nil {
+		prev, err := ceq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ceq.sql = prev
+	}
+	return nil
+}
+
+func (ceq *ContestEntryQuery) sqlAll(ctx context.Context) ([]*ContestEntry, error) {
+	var (
+		nodes       = []*ContestEntry{}
+		withFKs     = ceq.withFKs
+		_spec       = ceq.querySpec()
+		loadedTypes = [3]bool{
+			ceq.withContest != nil,
+			ceq.withUser != nil,
+			ceq.withCorrections != nil,
+		}
+	)
+	if ceq.withContest != nil || ceq.withUser != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, contestentry.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &ContestEntry{config: ceq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("db: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ceq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := ceq.withContest; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*ContestEntry)
+		for i := range nodes {
+			fk := nodes[i].contest_entries
+			if fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(contest.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "contest_entries" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Contest = n
+			}
+		}
+	}
+
+	if query := ceq.withUser; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*ContestEntry)
+		for i := range nodes {
+			fk := nodes[i].user_contest_entries
+			if fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(user.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_contest_entries" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.User = n
+			}
+		}
+	}
+
+	if query := ceq.withCorrections; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*ContestEntry)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Corrections = []*ContestEntryCorrection{}
+		}
+		query.withFKs = true
+		query.Where(predicate.ContestEntryCorrection(func(s *sql.Selector) {
+			s.Where(sql.InValues(contestentry.CorrectionsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.contest_entry_corrections
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "contest_entry_corrections" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "contest_entry_corrections" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Corrections = append(node.Edges.Corrections, n)
+		}
+	}
+
+	return nodes, nil
+}
+
+func (ceq *ContestEntryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ceq.querySpec()
+	return sqlgraph.CountNodes(ctx, ceq.driver, _spec)
+}
+
+func (ceq *ContestEntryQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := ceq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("db: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (ceq *ContestEntryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   contestentry.Table,
+			Columns: contestentry.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: contestentry.FieldID,
+			},
+		},
+		From:   ceq.sql,
+		Unique: true,
+	}
+	if fields := ceq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contestentry.FieldID)
+		for i := range fields {
+			if fields[i] != contestentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ceq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ceq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ceq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ceq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, contestentry.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ceq *ContestEntryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ceq.driver.Dialect())
+	t1 := builder.Table(contestentry.Table)
+	selector := builder.Select(t1.Columns(contestentry.Columns...)...).From(t1)
+	if ceq.sql != nil {
+		selector = ceq.sql
+		selector.Select(selector.Columns(contestentry.Columns...)...)
+	}
+	for _, p := range ceq.predicates {
+		p(selector)
+	}
+	for _, p := range ceq.order {
+		p(selector, contestentry.ValidColumn)
+	}
+	if offset := ceq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ceq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ContestEntryGroupBy is the group-by builder for ContestEntry entities.
+type ContestEntryGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cegb *ContestEntryGroupBy) Aggregate(fns ...AggregateFunc) *ContestEntryGroupBy {
+	cegb.fns = append(cegb.fns, fns...)
+	return cegb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (cegb *ContestEntryGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := cegb.path(ctx)
+	if err != nil {
+		return err
+	}
+	cegb.sql = query
+	return cegb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (cegb *ContestEntryGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := cegb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cegb *ContestEntryGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(cegb.fields) > 1 {
+		return nil, errors.New("db: ContestEntryGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := cegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (cegb *ContestEntryGroupBy) StringsX(ctx context.Context) []string {
+	v, err := cegb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cegb *ContestEntryGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = cegb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestentry.Label}
+	default:
+		err = fmt.Errorf("db: ContestEntryGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (cegb *ContestEntryGroupBy) StringX(ctx context.Context) string {
+	v, err := cegb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cegb *ContestEntryGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(cegb.fields) > 1 {
+		return nil, errors.New("db: ContestEntryGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := cegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (cegb *ContestEntryGroupBy) IntsX(ctx context.Context) []int {
+	v, err := cegb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cegb *ContestEntryGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = cegb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestentry.Label}
+	default:
+		err = fmt.Errorf("db: ContestEntryGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (cegb *ContestEntryGroupBy) IntX(ctx context.Context) int {
+	v, err := cegb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cegb *ContestEntryGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(cegb.fields) > 1 {
+		return nil, errors.New("db: ContestEntryGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := cegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (cegb *ContestEntryGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := cegb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cegb *ContestEntryGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = cegb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestentry.Label}
+	default:
+		err = fmt.Errorf("db: ContestEntryGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (cegb *ContestEntryGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := cegb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cegb *ContestEntryGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(cegb.fields) > 1 {
+		return nil, errors.New("db: ContestEntryGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := cegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (cegb *ContestEntryGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := cegb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cegb *ContestEntryGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = cegb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestentry.Label}
+	default:
+		err = fmt.Errorf("db: ContestEntryGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (cegb *ContestEntryGroupBy) BoolX(ctx context.Context) bool {
+	v, err := cegb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (cegb *ContestEntryGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range cegb.fields {
+		if !contestentry.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := cegb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cegb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (cegb *ContestEntryGroupBy) sqlQuery() *sql.Selector {
+	selector := cegb.sql
+	columns := make([]string, 0, len(cegb.fields)+len(cegb.fns))
+	columns = append(columns, cegb.fields...)
+	for _, fn := range cegb.fns {
+		columns = append(columns, fn(selector, contestentry.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(cegb.fields...)
+}
+
+// ContestEntrySelect is the builder for selecting fields of ContestEntry entities.
+type ContestEntrySelect struct {
+	*ContestEntryQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ces *ContestEntrySelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ces.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ces.sql = ces.ContestEntryQuery.sqlQuery(ctx)
+	return ces.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ces *ContestEntrySelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ces.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (ces *ContestEntrySelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ces.fields) > 1 {
+		return nil, errors.New("db: ContestEntrySelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ces.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ces *ContestEntrySelect) StringsX(ctx context.Context) []string {
+	v, err := ces.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (ces *ContestEntrySelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ces.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestentry.Label}
+	default:
+		err = fmt.Errorf("db: ContestEntrySelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ces *ContestEntrySelect) StringX(ctx context.Context) string {
+	v, err := ces.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (ces *ContestEntrySelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ces.fields) > 1 {
+		return nil, errors.New("db: ContestEntrySelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ces.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ces *ContestEntrySelect) IntsX(ctx context.Context) []int {
+	v, err := ces.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (ces *ContestEntrySelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ces.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestentry.Label}
+	default:
+		err = fmt.Errorf("db: ContestEntrySelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ces *ContestEntrySelect) IntX(ctx context.Context) int {
+	v, err := ces.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (ces *ContestEntrySelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ces.fields) > 1 {
+		return nil, errors.New("db: ContestEntrySelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ces.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ces *ContestEntrySelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ces.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (ces *ContestEntrySelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ces.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestentry.Label}
+	default:
+		err = fmt.Errorf("db: ContestEntrySelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ces *ContestEntrySelect) Float64X(ctx context.Context) float64 {
+	v, err := ces.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (ces *ContestEntrySelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ces.fields) > 1 {
+		return nil, errors.New("db: ContestEntrySelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ces.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ces *ContestEntrySelect) BoolsX(ctx context.Context) []bool {
+	v, err := ces.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (ces *ContestEntrySelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ces.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestentry.Label}
+	default:
+		err = fmt.Errorf("db: ContestEntrySelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ces *ContestEntrySelect) BoolX(ctx context.Context) bool {
+	v, err := ces.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ces *ContestEntrySelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ces.sqlQuery().Query()
+	if err := ces.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ces *ContestEntrySelect) sqlQuery() sql.Querier {
+	selector := ces.sql
+	selector.Select(selector.Columns(ces.fields...)...)
+	return selector
+}
@@ -0,0 +1,708 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/contest"
+	"github.com/NickDubelman/fantasy-bball/db/contestentry"
+	"github.com/NickDubelman/fantasy-bball/db/contestentrycorrection"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+)
+
+// ContestEntryUpdate is the builder for updating ContestEntry entities.
+type ContestEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ContestEntryMutation
+}
+
+// Where adds a new predicate for the ContestEntryUpdate builder.
+func (ceu *ContestEntryUpdate) Where(ps ...predicate.ContestEntry) *ContestEntryUpdate {
+	ceu.mutation.predicates = append(ceu.mutation.predicates, ps...)
+	return ceu
+}
+
+// SetTotalPoints sets the "totalPoints" field.
+func (ceu *ContestEntryUpdate) SetTotalPoints(i int) *ContestEntryUpdate {
+	ceu.mutation.ResetTotalPoints()
+	ceu.mutation.SetTotalPoints(i)
+	return ceu
+}
+
+// SetNillableTotalPoints sets the "totalPoints" field if the given value is not nil.
+func (ceu *ContestEntryUpdate) SetNillableTotalPoints(i *int) *ContestEntryUpdate {
+	if i != nil {
+		ceu.SetTotalPoints(*i)
+	}
+	return ceu
+}
+
+// AddTotalPoints adds i to the "totalPoints" field.
+func (ceu *ContestEntryUpdate) AddTotalPoints(i int) *ContestEntryUpdate {
+	ceu.mutation.AddTotalPoints(i)
+	return ceu
+}
+
+// SetContestID sets the "contest" edge to the Contest entity by ID.
+func (ceu *ContestEntryUpdate) SetContestID(id int) *ContestEntryUpdate {
+	ceu.mutation.SetContestID(id)
+	return ceu
+}
+
+// SetContest sets the "contest" edge to the Contest entity.
+func (ceu *ContestEntryUpdate) SetContest(c *Contest) *ContestEntryUpdate {
+	return ceu.SetContestID(c.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ceu *ContestEntryUpdate) SetUserID(id int) *ContestEntryUpdate {
+	ceu.mutation.SetUserID(id)
+	return ceu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ceu *ContestEntryUpdate) SetUser(u *User) *ContestEntryUpdate {
+	return ceu.SetUserID(u.ID)
+}
+
+// AddCorrectionIDs adds the "corrections" edge to the ContestEntryCorrection entity by IDs.
+func (ceu *ContestEntryUpdate) AddCorrectionIDs(ids ...int) *ContestEntryUpdate {
+	ceu.mutation.AddCorrectionIDs(ids...)
+	return ceu
+}
+
+// AddCorrections adds the "corrections" edges to the ContestEntryCorrection entity.
+func (ceu *ContestEntryUpdate) AddCorrections(c ...*ContestEntryCorrection) *ContestEntryUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ceu.AddCorrectionIDs(ids...)
+}
+
+// Mutation returns the ContestEntryMutation object of the builder.
+func (ceu *ContestEntryUpdate) Mutation() *ContestEntryMutation {
+	return ceu.mutation
+}
+
+// ClearContest clears the "contest" edge to the Contest entity.
+func (ceu *ContestEntryUpdate) ClearContest() *ContestEntryUpdate {
+	ceu.mutation.ClearContest()
+	return ceu
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ceu *ContestEntryUpdate) ClearUser() *ContestEntryUpdate {
+	ceu.mutation.ClearUser()
+	return ceu
+}
+
+// ClearCorrections clears all "corrections" edges to the ContestEntryCorrection entity.
+func (ceu *ContestEntryUpdate) ClearCorrections() *ContestEntryUpdate {
+	ceu.mutation.ClearCorrections()
+	return ceu
+}
+
+// RemoveCorrectionIDs removes the "corrections" edge to ContestEntryCorrection entities by IDs.
+func (ceu *ContestEntryUpdate) RemoveCorrectionIDs(ids ...int) *ContestEntryUpdate {
+	ceu.mutation.RemoveCorrectionIDs(ids...)
+	return ceu
+}
+
+// RemoveCorrections removes "corrections" edges to ContestEntryCorrection entities.
+func (ceu *ContestEntryUpdate) RemoveCorrections(c ...*ContestEntryCorrection) *ContestEntryUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ceu.RemoveCorrectionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ceu *ContestEntryUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ceu.hooks) == 0 {
+		if err = ceu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = ceu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ContestEntryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ceu.check(); err != nil {
+				return 0, err
+			}
+			ceu.mutation = mutation
+			affected, err = ceu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ceu.hooks) - 1; i >= 0; i-- {
+			mut = ceu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ceu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ceu *ContestEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := ceu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ceu *ContestEntryUpdate) Exec(ctx context.Context) error {
+	_, err := ceu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ceu *ContestEntryUpdate) ExecX(ctx context.Context) {
+	if err := ceu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ceu *ContestEntryUpdate) check() error {
+	if _, ok := ceu.mutation.ContestID(); ceu.mutation.ContestCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"contest\"")
+	}
+	if _, ok := ceu.mutation.UserID(); ceu.mutation.UserCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"user\"")
+	}
+	return nil
+}
+
+func (ceu *ContestEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   contestentry.Table,
+			Columns: contestentry.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: contestentry.FieldID,
+			},
+		},
+	}
+	if ps := ceu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ceu.mutation.TotalPoints(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: contestentry.FieldTotalPoints,
+		})
+	}
+	if value, ok := ceu.mutation.AddedTotalPoints(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: contestentry.FieldTotalPoints,
+		})
+	}
+	if ceu.mutation.ContestCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestentry.ContestTable,
+			Columns: []string{contestentry.ContestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contest.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ceu.mutation.ContestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestentry.ContestTable,
+			Columns: []string{contestentry.ContestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contest.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ceu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestentry.UserTable,
+			Columns: []string{contestentry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ceu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestentry.UserTable,
+			Columns: []string{contestentry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ceu.mutation.CorrectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contestentry.CorrectionsTable,
+			Columns: []string{contestentry.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentrycorrection.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ceu.mutation.RemovedCorrectionsIDs(); len(nodes) > 0 && !ceu.mutation.CorrectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contestentry.CorrectionsTable,
+			Columns: []string{contestentry.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentrycorrection.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ceu.mutation.CorrectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contestentry.CorrectionsTable,
+			Columns: []string{contestentry.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentrycorrection.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ceu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contestentry.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ContestEntryUpdateOne is the builder for updating a single ContestEntry entity.
+type ContestEntryUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *ContestEntryMutation
+}
+
+// SetTotalPoints sets the "totalPoints" field.
+func (ceuo *ContestEntryUpdateOne) SetTotalPoints(i int) *ContestEntryUpdateOne {
+	ceuo.mutation.ResetTotalPoints()
+	ceuo.mutation.SetTotalPoints(i)
+	return ceuo
+}
+
+// SetNillableTotalPoints sets the "totalPoints" field if the given value is not nil.
+func (ceuo *ContestEntryUpdateOne) SetNillableTotalPoints(i *int) *ContestEntryUpdateOne {
+	if i != nil {
+		ceuo.SetTotalPoints(*i)
+	}
+	return ceuo
+}
+
+// AddTotalPoints adds i to the "totalPoints" field.
+func (ceuo *ContestEntryUpdateOne) AddTotalPoints(i int) *ContestEntryUpdateOne {
+	ceuo.mutation.AddTotalPoints(i)
+	return ceuo
+}
+
+// SetContestID sets the "contest" edge to the Contest entity by ID.
+func (ceuo *ContestEntryUpdateOne) SetContestID(id int) *ContestEntryUpdateOne {
+	ceuo.mutation.SetContestID(id)
+	return ceuo
+}
+
+// SetContest sets the "contest" edge to the Contest entity.
+func (ceuo *ContestEntryUpdateOne) SetContest(c *Contest) *ContestEntryUpdateOne {
+	return ceuo.SetContestID(c.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ceuo *ContestEntryUpdateOne) SetUserID(id int) *ContestEntryUpdateOne {
+	ceuo.mutation.SetUserID(id)
+	return ceuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ceuo *ContestEntryUpdateOne) SetUser(u *User) *ContestEntryUpdateOne {
+	return ceuo.SetUserID(u.ID)
+}
+
+// AddCorrectionIDs adds the "corrections" edge to the ContestEntryCorrection entity by IDs.
+func (ceuo *ContestEntryUpdateOne) AddCorrectionIDs(ids ...int) *ContestEntryUpdateOne {
+	ceuo.mutation.AddCorrectionIDs(ids...)
+	return ceuo
+}
+
+// AddCorrections adds the "corrections" edges to the ContestEntryCorrection entity.
+func (ceuo *ContestEntryUpdateOne) AddCorrections(c ...*ContestEntryCorrection) *ContestEntryUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ceuo.AddCorrectionIDs(ids...)
+}
+
+// Mutation returns the ContestEntryMutation object of the builder.
+func (ceuo *ContestEntryUpdateOne) Mutation() *ContestEntryMutation {
+	return ceuo.mutation
+}
+
+// ClearContest clears the "contest" edge to the Contest entity.
+func (ceuo *ContestEntryUpdateOne) ClearContest() *ContestEntryUpdateOne {
+	ceuo.mutation.ClearContest()
+	return ceuo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ceuo *ContestEntryUpdateOne) ClearUser() *ContestEntryUpdateOne {
+	ceuo.mutation.ClearUser()
+	return ceuo
+}
+
+// ClearCorrections clears all "corrections" edges to the ContestEntryCorrection entity.
+func (ceuo *ContestEntryUpdateOne) ClearCorrections() *ContestEntryUpdateOne {
+	ceuo.mutation.ClearCorrections()
+	return ceuo
+}
+
+// RemoveCorrectionIDs removes the "corrections" edge to ContestEntryCorrection entities by IDs.
+func (ceuo *ContestEntryUpdateOne) RemoveCorrectionIDs(ids ...int) *ContestEntryUpdateOne {
+	ceuo.mutation.RemoveCorrectionIDs(ids...)
+	return ceuo
+}
+
+// RemoveCorrections removes "corrections" edges to ContestEntryCorrection entities.
+func (ceuo *ContestEntryUpdateOne) RemoveCorrections(c ...*ContestEntryCorrection) *ContestEntryUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ceuo.RemoveCorrectionIDs(ids...)
+}
+
+// Save executes the query and returns the updated ContestEntry entity.
+func (ceuo *ContestEntryUpdateOne) Save(ctx context.Context) (*ContestEntry, error) {
+	var (
+		err  error
+		node *ContestEntry
+	)
+	if len(ceuo.hooks) == 0 {
+		if err = ceuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = ceuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ContestEntryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ceuo.check(); err != nil {
+				return nil, err
+			}
+			ceuo.mutation = mutation
+			node, err = ceuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ceuo.hooks) - 1; i >= 0; i-- {
+			mut = ceuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ceuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ceuo *ContestEntryUpdateOne) SaveX(ctx context.Context) *ContestEntry {
+	node, err := ceuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ceuo *ContestEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := ceuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ceuo *ContestEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := ceuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ceuo *ContestEntryUpdateOne) check() error {
+	if _, ok := ceuo.mutation.ContestID(); ceuo.mutation.ContestCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"contest\"")
+	}
+	if _, ok := ceuo.mutation.UserID(); ceuo.mutation.UserCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"user\"")
+	}
+	return nil
+}
+
+func (ceuo *ContestEntryUpdateOne) sqlSave(ctx context.Context) (_node *ContestEntry, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   contestentry.Table,
+			Columns: contestentry.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: contestentry.FieldID,
+			},
+		},
+	}
+	id, ok := ceuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing ContestEntry.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := ceuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ceuo.mutation.TotalPoints(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: contestentry.FieldTotalPoints,
+		})
+	}
+	if value, ok := ceuo.mutation.AddedTotalPoints(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: contestentry.FieldTotalPoints,
+		})
+	}
+	if ceuo.mutation.ContestCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestentry.ContestTable,
+			Columns: []string{contestentry.ContestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contest.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ceuo.mutation.ContestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestentry.ContestTable,
+			Columns: []string{contestentry.ContestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contest.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ceuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestentry.UserTable,
+			Columns: []string{contestentry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ceuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestentry.UserTable,
+			Columns: []string{contestentry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ceuo.mutation.CorrectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contestentry.CorrectionsTable,
+			Columns: []string{contestentry.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentrycorrection.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ceuo.mutation.RemovedCorrectionsIDs(); len(nodes) > 0 && !ceuo.mutation.CorrectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contestentry.CorrectionsTable,
+			Columns: []string{contestentry.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentrycorrection.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ceuo.mutation.CorrectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contestentry.CorrectionsTable,
+			Columns: []string{contestentry.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentrycorrection.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ContestEntry{config: ceuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ceuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contestentry.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,162 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/NickDubelman/fantasy-bball/db/contestentry"
+	"github.com/NickDubelman/fantasy-bball/db/contestentrycorrection"
+)
+
+// ContestEntryCorrection is the model entity for the ContestEntryCorrection schema.
+type ContestEntryCorrection struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// OldTotalPoints holds the value of the "oldTotalPoints" field.
+	OldTotalPoints int `json:"oldTotalPoints,omitempty"`
+	// NewTotalPoints holds the value of the "newTotalPoints" field.
+	NewTotalPoints int `json:"newTotalPoints,omitempty"`
+	// Created holds the value of the "created" field.
+	Created time.Time `json:"created,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ContestEntryCorrectionQuery when eager-loading is set.
+	Edges                     ContestEntryCorrectionEdges `json:"edges"`
+	contest_entry_corrections *int
+}
+
+// ContestEntryCorrectionEdges holds the relations/edges for other nodes in the graph.
+type ContestEntryCorrectionEdges struct {
+	// Entry holds the value of the entry edge.
+	Entry *ContestEntry `json:"entry,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// EntryOrErr returns the Entry value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ContestEntryCorrectionEdges) EntryOrErr() (*ContestEntry, error) {
+	if e.loadedTypes[0] {
+		if e.Entry == nil {
+			// The edge entry was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: contestentry.Label}
+		}
+		return e.Entry, nil
+	}
+	return nil, &NotLoadedError{edge: "entry"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ContestEntryCorrection) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case contestentrycorrection.FieldID, contestentrycorrection.FieldOldTotalPoints, contestentrycorrection.FieldNewTotalPoints:
+			values[i] = &sql.NullInt64{}
+		case contestentrycorrection.FieldCreated:
+			values[i] = &sql.NullTime{}
+		case contestentrycorrection.ForeignKeys[0]: // contest_entry_corrections
+			values[i] = &sql.NullInt64{}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ContestEntryCorrection", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ContestEntryCorrection fields.
+func (cec *ContestEntryCorrection) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case contestentrycorrection.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cec.ID = int(value.Int64)
+		case contestentrycorrection.FieldOldTotalPoints:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field oldTotalPoints", values[i])
+			} else if value.Valid {
+				cec.OldTotalPoints = int(value.Int64)
+			}
+		case contestentrycorrection.FieldNewTotalPoints:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field newTotalPoints", values[i])
+			} else if value.Valid {
+				cec.NewTotalPoints = int(value.Int64)
+			}
+		case contestentrycorrection.FieldCreated:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created", values[i])
+			} else if value.Valid {
+				cec.Created = value.Time
+			}
+		case contestentrycorrection.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field contest_entry_corrections", value)
+			} else if value.Valid {
+				cec.contest_entry_corrections = new(int)
+				*cec.contest_entry_corrections = int(value.Int64)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryEntry queries the "entry" edge of the ContestEntryCorrection entity.
+func (cec *ContestEntryCorrection) QueryEntry() *ContestEntryQuery {
+	return (&ContestEntryCorrectionClient{config: cec.config}).QueryEntry(cec)
+}
+
+// Update returns a builder for updating this ContestEntryCorrection.
+// Note that you need to call ContestEntryCorrection.Unwrap() before calling this method if this ContestEntryCorrection
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cec *ContestEntryCorrection) Update() *ContestEntryCorrectionUpdateOne {
+	return (&ContestEntryCorrectionClient{config: cec.config}).UpdateOne(cec)
+}
+
+// Unwrap unwraps the ContestEntryCorrection entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cec *ContestEntryCorrection) Unwrap() *ContestEntryCorrection {
+	tx, ok := cec.config.driver.(*txDriver)
+	if !ok {
+		panic("db: ContestEntryCorrection is not a transactional entity")
+	}
+	cec.config.driver = tx.drv
+	return cec
+}
+
+// String implements the fmt.Stringer.
+func (cec *ContestEntryCorrection) String() string {
+	var builder strings.Builder
+	builder.WriteString("ContestEntryCorrection(")
+	builder.WriteString(fmt.Sprintf("id=%v", cec.ID))
+	builder.WriteString(", oldTotalPoints=")
+	builder.WriteString(fmt.Sprintf("%v", cec.OldTotalPoints))
+	builder.WriteString(", newTotalPoints=")
+	builder.WriteString(fmt.Sprintf("%v", cec.NewTotalPoints))
+	builder.WriteString(", created=")
+	builder.WriteString(cec.Created.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ContestEntryCorrections is a parsable slice of ContestEntryCorrection.
+type ContestEntryCorrections []*ContestEntryCorrection
+
+func (cec ContestEntryCorrections) config(cfg config) {
+	for _i := range cec {
+		cec[_i].config = cfg
+	}
+}
@@ -0,0 +1,65 @@
+// Code generated by entc, DO NOT EDIT.
+
+package contestentrycorrection
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the contestentrycorrection type in the database.
+	Label = "contest_entry_correction"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOldTotalPoints holds the string denoting the oldtotalpoints field in the database.
+	FieldOldTotalPoints = "old_total_points"
+	// FieldNewTotalPoints holds the string denoting the newtotalpoints field in the database.
+	FieldNewTotalPoints = "new_total_points"
+	// FieldCreated holds the string denoting the created field in the database.
+	FieldCreated = "created"
+	// EdgeEntry holds the string denoting the entry edge name in mutations.
+	EdgeEntry = "entry"
+	// Table holds the table name of the contestentrycorrection in the database.
+	Table = "contest_entry_corrections"
+	// EntryTable is the table the holds the entry relation/edge.
+	EntryTable = "contest_entry_corrections"
+	// EntryInverseTable is the table name for the ContestEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "contestentry" package.
+	EntryInverseTable = "contest_entries"
+	// EntryColumn is the table column denoting the entry relation/edge.
+	EntryColumn = "contest_entry_corrections"
+)
+
+// Columns holds all SQL columns for contestentrycorrection fields.
+var Columns = []string{
+	FieldID,
+	FieldOldTotalPoints,
+	FieldNewTotalPoints,
+	FieldCreated,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "contest_entry_corrections"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"contest_entry_corrections",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreated holds the default value on creation for the "created" field.
+	DefaultCreated func() time.Time
+)
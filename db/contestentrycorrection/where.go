@@ -0,0 +1,403 @@
+// Code generated by entc, DO NOT EDIT.
+
+package contestentrycorrection
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// OldTotalPoints applies equality check predicate on the "oldTotalPoints" field. It's identical to OldTotalPointsEQ.
+func OldTotalPoints(v int) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOldTotalPoints), v))
+	})
+}
+
+// NewTotalPoints applies equality check predicate on the "newTotalPoints" field. It's identical to NewTotalPointsEQ.
+func NewTotalPoints(v int) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNewTotalPoints), v))
+	})
+}
+
+// Created applies equality check predicate on the "created" field. It's identical to CreatedEQ.
+func Created(v time.Time) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreated), v))
+	})
+}
+
+// OldTotalPointsEQ applies the EQ predicate on the "oldTotalPoints" field.
+func OldTotalPointsEQ(v int) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOldTotalPoints), v))
+	})
+}
+
+// OldTotalPointsNEQ applies the NEQ predicate on the "oldTotalPoints" field.
+func OldTotalPointsNEQ(v int) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOldTotalPoints), v))
+	})
+}
+
+// OldTotalPointsIn applies the In predicate on the "oldTotalPoints" field.
+func OldTotalPointsIn(vs ...int) predicate.ContestEntryCorrection {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldOldTotalPoints), v...))
+	})
+}
+
+// OldTotalPointsNotIn applies the NotIn predicate on the "oldTotalPoints" field.
+func OldTotalPointsNotIn(vs ...int) predicate.ContestEntryCorrection {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldOldTotalPoints), v...))
+	})
+}
+
+// OldTotalPointsGT applies the GT predicate on the "oldTotalPoints" field.
+func OldTotalPointsGT(v int) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldOldTotalPoints), v))
+	})
+}
+
+// OldTotalPointsGTE applies the GTE predicate on the "oldTotalPoints" field.
+func OldTotalPointsGTE(v int) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldOldTotalPoints), v))
+	})
+}
+
+// OldTotalPointsLT applies the LT predicate on the "oldTotalPoints" field.
+func OldTotalPointsLT(v int) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldOldTotalPoints), v))
+	})
+}
+
+// OldTotalPointsLTE applies the LTE predicate on the "oldTotalPoints" field.
+func OldTotalPointsLTE(v int) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldOldTotalPoints), v))
+	})
+}
+
+// NewTotalPointsEQ applies the EQ predicate on the "newTotalPoints" field.
+func NewTotalPointsEQ(v int) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNewTotalPoints), v))
+	})
+}
+
+// NewTotalPointsNEQ applies the NEQ predicate on the "newTotalPoints" field.
+func NewTotalPointsNEQ(v int) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNewTotalPoints), v))
+	})
+}
+
+// NewTotalPointsIn applies the In predicate on the "newTotalPoints" field.
+func NewTotalPointsIn(vs ...int) predicate.ContestEntryCorrection {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldNewTotalPoints), v...))
+	})
+}
+
+// NewTotalPointsNotIn applies the NotIn predicate on the "newTotalPoints" field.
+func NewTotalPointsNotIn(vs ...int) predicate.ContestEntryCorrection {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldNewTotalPoints), v...))
+	})
+}
+
+// NewTotalPointsGT applies the GT predicate on the "newTotalPoints" field.
+func NewTotalPointsGT(v int) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldNewTotalPoints), v))
+	})
+}
+
+// NewTotalPointsGTE applies the GTE predicate on the "newTotalPoints" field.
+func NewTotalPointsGTE(v int) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldNewTotalPoints), v))
+	})
+}
+
+// NewTotalPointsLT applies the LT predicate on the "newTotalPoints" field.
+func NewTotalPointsLT(v int) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldNewTotalPoints), v))
+	})
+}
+
+// NewTotalPointsLTE applies the LTE predicate on the "newTotalPoints" field.
+func NewTotalPointsLTE(v int) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldNewTotalPoints), v))
+	})
+}
+
+// CreatedEQ applies the EQ predicate on the "created" field.
+func CreatedEQ(v time.Time) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedNEQ applies the NEQ predicate on the "created" field.
+func CreatedNEQ(v time.Time) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedIn applies the In predicate on the "created" field.
+func CreatedIn(vs ...time.Time) predicate.ContestEntryCorrection {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreated), v...))
+	})
+}
+
+// CreatedNotIn applies the NotIn predicate on the "created" field.
+func CreatedNotIn(vs ...time.Time) predicate.ContestEntryCorrection {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreated), v...))
+	})
+}
+
+// CreatedGT applies the GT predicate on the "created" field.
+func CreatedGT(v time.Time) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedGTE applies the GTE predicate on the "created" field.
+func CreatedGTE(v time.Time) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedLT applies the LT predicate on the "created" field.
+func CreatedLT(v time.Time) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedLTE applies the LTE predicate on the "created" field.
+func CreatedLTE(v time.Time) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreated), v))
+	})
+}
+
+// HasEntry applies the HasEdge predicate on the "entry" edge.
+func HasEntry() predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(EntryTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EntryTable, EntryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEntryWith applies the HasEdge predicate on the "entry" edge with a given conditions (other predicates).
+func HasEntryWith(preds ...predicate.ContestEntry) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(EntryInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EntryTable, EntryColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ContestEntryCorrection) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ContestEntryCorrection) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ContestEntryCorrection) predicate.ContestEntryCorrection {
+	return predicate.ContestEntryCorrection(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,272 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/contestentry"
+	"github.com/NickDubelman/fantasy-bball/db/contestentrycorrection"
+)
+
+// ContestEntryCorrectionCreate is the builder for creating a ContestEntryCorrection entity.
+type ContestEntryCorrectionCreate struct {
+	config
+	mutation *ContestEntryCorrectionMutation
+	hooks    []Hook
+}
+
+// SetOldTotalPoints sets the "oldTotalPoints" field.
+func (cecc *ContestEntryCorrectionCreate) SetOldTotalPoints(i int) *ContestEntryCorrectionCreate {
+	cecc.mutation.SetOldTotalPoints(i)
+	return cecc
+}
+
+// SetNewTotalPoints sets the "newTotalPoints" field.
+func (cecc *ContestEntryCorrectionCreate) SetNewTotalPoints(i int) *ContestEntryCorrectionCreate {
+	cecc.mutation.SetNewTotalPoints(i)
+	return cecc
+}
+
+// SetCreated sets the "created" field.
+func (cecc *ContestEntryCorrectionCreate) SetCreated(t time.Time) *ContestEntryCorrectionCreate {
+	cecc.mutation.SetCreated(t)
+	return cecc
+}
+
+// SetNillableCreated sets the "created" field if the given value is not nil.
+func (cecc *ContestEntryCorrectionCreate) SetNillableCreated(t *time.Time) *ContestEntryCorrectionCreate {
+	if t != nil {
+		cecc.SetCreated(*t)
+	}
+	return cecc
+}
+
+// SetEntryID sets the "entry" edge to the ContestEntry entity by ID.
+func (cecc *ContestEntryCorrectionCreate) SetEntryID(id int) *ContestEntryCorrectionCreate {
+	cecc.mutation.SetEntryID(id)
+	return cecc
+}
+
+// SetEntry sets the "entry" edge to the ContestEntry entity.
+func (cecc *ContestEntryCorrectionCreate) SetEntry(c *ContestEntry) *ContestEntryCorrectionCreate {
+	return cecc.SetEntryID(c.ID)
+}
+
+// Mutation returns the ContestEntryCorrectionMutation object of the builder.
+func (cecc *ContestEntryCorrectionCreate) Mutation() *ContestEntryCorrectionMutation {
+	return cecc.mutation
+}
+
+// Save creates the ContestEntryCorrection in the database.
+func (cecc *ContestEntryCorrectionCreate) Save(ctx context.Context) (*ContestEntryCorrection, error) {
+	var (
+		err  error
+		node *ContestEntryCorrection
+	)
+	cecc.defaults()
+	if len(cecc.hooks) == 0 {
+		if err = cecc.check(); err != nil {
+			return nil, err
+		}
+		node, err = cecc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ContestEntryCorrectionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cecc.check(); err != nil {
+				return nil, err
+			}
+			cecc.mutation = mutation
+			node, err = cecc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cecc.hooks) - 1; i >= 0; i-- {
+			mut = cecc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cecc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cecc *ContestEntryCorrectionCreate) SaveX(ctx context.Context) *ContestEntryCorrection {
+	v, err := cecc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (cecc *ContestEntryCorrectionCreate) defaults() {
+	if _, ok := cecc.mutation.Created(); !ok {
+		v := contestentrycorrection.DefaultCreated()
+		cecc.mutation.SetCreated(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cecc *ContestEntryCorrectionCreate) check() error {
+	if _, ok := cecc.mutation.OldTotalPoints(); !ok {
+		return &ValidationError{Name: "oldTotalPoints", err: errors.New("db: missing required field \"oldTotalPoints\"")}
+	}
+	if _, ok := cecc.mutation.NewTotalPoints(); !ok {
+		return &ValidationError{Name: "newTotalPoints", err: errors.New("db: missing required field \"newTotalPoints\"")}
+	}
+	if _, ok := cecc.mutation.Created(); !ok {
+		return &ValidationError{Name: "created", err: errors.New("db: missing required field \"created\"")}
+	}
+	if _, ok := cecc.mutation.EntryID(); !ok {
+		return &ValidationError{Name: "entry", err: errors.New("db: missing required edge \"entry\"")}
+	}
+	return nil
+}
+
+func (cecc *ContestEntryCorrectionCreate) sqlSave(ctx context.Context) (*ContestEntryCorrection, error) {
+	_node, _spec := cecc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cecc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (cecc *ContestEntryCorrectionCreate) createSpec() (*ContestEntryCorrection, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ContestEntryCorrection{config: cecc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: contestentrycorrection.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: contestentrycorrection.FieldID,
+			},
+		}
+	)
+	if value, ok := cecc.mutation.OldTotalPoints(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: contestentrycorrection.FieldOldTotalPoints,
+		})
+		_node.OldTotalPoints = value
+	}
+	if value, ok := cecc.mutation.NewTotalPoints(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: contestentrycorrection.FieldNewTotalPoints,
+		})
+		_node.NewTotalPoints = value
+	}
+	if value, ok := cecc.mutation.Created(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: contestentrycorrection.FieldCreated,
+		})
+		_node.Created = value
+	}
+	if nodes := cecc.mutation.EntryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestentrycorrection.EntryTable,
+			Columns: []string{contestentrycorrection.EntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.contest_entry_corrections = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ContestEntryCorrectionCreateBulk is the builder for creating many ContestEntryCorrection entities in bulk.
+type ContestEntryCorrectionCreateBulk struct {
+	config
+	builders []*ContestEntryCorrectionCreate
+}
+
+// Save creates the ContestEntryCorrection entities in the database.
+func (ceccb *ContestEntryCorrectionCreateBulk) Save(ctx context.Context) ([]*ContestEntryCorrection, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ceccb.builders))
+	nodes := make([]*ContestEntryCorrection, len(ceccb.builders))
+	mutators := make([]Mutator, len(ceccb.builders))
+	for i := range ceccb.builders {
+		func(i int, root context.Context) {
+			builder := ceccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ContestEntryCorrectionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ceccb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ceccb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ceccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ceccb *ContestEntryCorrectionCreateBulk) SaveX(ctx context.Context) []*ContestEntryCorrection {
+	v, err := ceccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
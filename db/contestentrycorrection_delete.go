@@ -0,0 +1,108 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/contestentrycorrection"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+)
+
+// ContestEntryCorrectionDelete is the builder for deleting a ContestEntryCorrection entity.
+type ContestEntryCorrectionDelete struct {
+	config
+	hooks    []Hook
+	mutation *ContestEntryCorrectionMutation
+}
+
+// Where adds a new predicate to the ContestEntryCorrectionDelete builder.
+func (cecd *ContestEntryCorrectionDelete) Where(ps ...predicate.ContestEntryCorrection) *ContestEntryCorrectionDelete {
+	cecd.mutation.predicates = append(cecd.mutation.predicates, ps...)
+	return cecd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cecd *ContestEntryCorrectionDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(cecd.hooks) == 0 {
+		affected, err = cecd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ContestEntryCorrectionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			cecd.mutation = mutation
+			affected, err = cecd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cecd.hooks) - 1; i >= 0; i-- {
+			mut = cecd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cecd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cecd *ContestEntryCorrectionDelete) ExecX(ctx context.Context) int {
+	n, err := cecd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cecd *ContestEntryCorrectionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: contestentrycorrection.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: contestentrycorrection.FieldID,
+			},
+		},
+	}
+	if ps := cecd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, cecd.driver, _spec)
+}
+
+// ContestEntryCorrectionDeleteOne is the builder for deleting a single ContestEntryCorrection entity.
+type ContestEntryCorrectionDeleteOne struct {
+	cecd *ContestEntryCorrectionDelete
+}
+
+// Exec executes the deletion query.
+func (cecdo *ContestEntryCorrectionDeleteOne) Exec(ctx context.Context) error {
+	n, err := cecdo.cecd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{contestentrycorrection.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cecdo *ContestEntryCorrectionDeleteOne) ExecX(ctx context.Context) {
+	cecdo.cecd.ExecX(ctx)
+}
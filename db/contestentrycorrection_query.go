@@ -0,0 +1,969 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/contestentry"
+	"github.com/NickDubelman/fantasy-bball/db/contestentrycorrection"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+)
+
+// ContestEntryCorrectionQuery is the builder for querying ContestEntryCorrection entities.
+type ContestEntryCorrectionQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ContestEntryCorrection
+	// eager-loading edges.
+	withEntry *ContestEntryQuery
+	withFKs   bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ContestEntryCorrectionQuery builder.
+func (cecq *ContestEntryCorrectionQuery) Where(ps ...predicate.ContestEntryCorrection) *ContestEntryCorrectionQuery {
+	cecq.predicates = append(cecq.predicates, ps...)
+	return cecq
+}
+
+// Limit adds a limit step to the query.
+func (cecq *ContestEntryCorrectionQuery) Limit(limit int) *ContestEntryCorrectionQuery {
+	cecq.limit = &limit
+	return cecq
+}
+
+// Offset adds an offset step to the query.
+func (cecq *ContestEntryCorrectionQuery) Offset(offset int) *ContestEntryCorrectionQuery {
+	cecq.offset = &offset
+	return cecq
+}
+
+// Order adds an order step to the query.
+func (cecq *ContestEntryCorrectionQuery) Order(o ...OrderFunc) *ContestEntryCorrectionQuery {
+	cecq.order = append(cecq.order, o...)
+	return cecq
+}
+
+// QueryEntry chains the current query on the "entry" edge.
+func (cecq *ContestEntryCorrectionQuery) QueryEntry() *ContestEntryQuery {
+	query := &ContestEntryQuery{config: cecq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cecq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cecq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contestentrycorrection.Table, contestentrycorrection.FieldID, selector),
+			sqlgraph.To(contestentry.Table, contestentry.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contestentrycorrection.EntryTable, contestentrycorrection.EntryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cecq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ContestEntryCorrection entity from the query.
+// Returns a *NotFoundError when no ContestEntryCorrection was found.
+func (cecq *ContestEntryCorrectionQuery) First(ctx context.Context) (*ContestEntryCorrection, error) {
+	nodes, err := cecq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{contestentrycorrection.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cecq *ContestEntryCorrectionQuery) FirstX(ctx context.Context) *ContestEntryCorrection {
+	node, err := cecq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ContestEntryCorrection ID from the query.
+// Returns a *NotFoundError when no ContestEntryCorrection ID was found.
+func (cecq *ContestEntryCorrectionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cecq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{contestentrycorrection.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cecq *ContestEntryCorrectionQuery) FirstIDX(ctx context.Context) int {
+	id, err := cecq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ContestEntryCorrection entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one ContestEntryCorrection entity is not found.
+// Returns a *NotFoundError when no ContestEntryCorrection entities are found.
+func (cecq *ContestEntryCorrectionQuery) Only(ctx context.Context) (*ContestEntryCorrection, error) {
+	nodes, err := cecq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{contestentrycorrection.Label}
+	default:
+		return nil, &NotSingularError{contestentrycorrection.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cecq *ContestEntryCorrectionQuery) OnlyX(ctx context.Context) *ContestEntryCorrection {
+	node, err := cecq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ContestEntryCorrection ID in the query.
+// Returns a *NotSingularError when exactly one ContestEntryCorrection ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (cecq *ContestEntryCorrectionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cecq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{contestentrycorrection.Label}
+	default:
+		err = &NotSingularError{contestentrycorrection.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cecq *ContestEntryCorrectionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := cecq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ContestEntryCorrections.
+func (cecq *ContestEntryCorrectionQuery) All(ctx context.Context) ([]*ContestEntryCorrection, error) {
+	if err := cecq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return cecq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cecq *ContestEntryCorrectionQuery) AllX(ctx context.Context) []*ContestEntryCorrection {
+	nodes, err := cecq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ContestEntryCorrection IDs.
+func (cecq *ContestEntryCorrectionQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := cecq.Select(contestentrycorrection.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cecq *ContestEntryCorrectionQuery) IDsX(ctx context.Context) []int {
+	ids, err := cecq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cecq *ContestEntryCorrectionQuery) Count(ctx context.Context) (int, error) {
+	if err := cecq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return cecq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cecq *ContestEntryCorrectionQuery) CountX(ctx context.Context) int {
+	count, err := cecq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cecq *ContestEntryCorrectionQuery) Exist(ctx context.Context) (bool, error) {
+	if err := cecq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return cecq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cecq *ContestEntryCorrectionQuery) ExistX(ctx context.Context) bool {
+	exist, err := cecq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ContestEntryCorrectionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cecq *ContestEntryCorrectionQuery) Clone() *ContestEntryCorrectionQuery {
+	if cecq == nil {
+		return nil
+	}
+	return &ContestEntryCorrectionQuery{
+		config:     cecq.config,
+		limit:      cecq.limit,
+		offset:     cecq.offset,
+		order:      append([]OrderFunc{}, cecq.order...),
+		predicates: append([]predicate.ContestEntryCorrection{}, cecq.predicates...),
+		withEntry:  cecq.withEntry.Clone(),
+		// clone intermediate query.
+		sql:  cecq.sql.Clone(),
+		path: cecq.path,
+	}
+}
+
+// WithEntry tells the query-builder to eager-load the nodes that are connected to
+// the "entry" edge. The optional arguments are used to configure the query builder of the edge.
+func (cecq *ContestEntryCorrectionQuery) WithEntry(opts ...func(*ContestEntryQuery)) *ContestEntryCorrectionQuery {
+	query := &ContestEntryQuery{config: cecq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cecq.withEntry = query
+	return cecq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		OldTotalPoints int `json:"oldTotalPoints,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ContestEntryCorrection.Query().
+//		GroupBy(contestentrycorrection.FieldOldTotalPoints).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (cecq *ContestEntryCorrectionQuery) GroupBy(field string, fields ...string) *ContestEntryCorrectionGroupBy {
+	group := &ContestEntryCorrectionGroupBy{config: cecq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := cecq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return cecq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		OldTotalPoints int `json:"oldTotalPoints,omitempty"`
+//	}
+//
+//	client.ContestEntryCorrection.Query().
+//		Select(contestentrycorrection.FieldOldTotalPoints).
+//		Scan(ctx, &v)
+func (cecq *ContestEntryCorrectionQuery) Select(field string, fields ...string) *ContestEntryCorrectionSelect {
+	cecq.fields = append([]string{field}, fields...)
+	return &ContestEntryCorrectionSelect{ContestEntryCorrectionQuery: cecq}
+}
+
+func (cecq *ContestEntryCorrectionQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range cecq.fields {
+		if !contestentrycorrection.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if cecq.path != nil {
+		prev, err := cecq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cecq.sql = prev
+	}
+	return nil
+}
+
+func (cecq *ContestEntryCorrectionQuery) sqlAll(ctx context.Context) ([]*ContestEntryCorrection, error) {
+	var (
+		nodes       = []*ContestEntryCorrection{}
+		withFKs     = cecq.withFKs
+		_spec       = cecq.querySpec()
+		loadedTypes = [1]bool{
+			cecq.withEntry != nil,
+		}
+	)
+	if cecq.withEntry != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, contestentrycorrection.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &ContestEntryCorrection{config: cecq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("db: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cecq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := cecq.withEntry; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*ContestEntryCorrection)
+		for i := range nodes {
+			fk := nodes[i].contest_entry_corrections
+			if fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(contestentry.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "contest_entry_corrections" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Entry = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (cecq *ContestEntryCorrectionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cecq.querySpec()
+	return sqlgraph.CountNodes(ctx, cecq.driver, _spec)
+}
+
+func (cecq *ContestEntryCorrectionQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := cecq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("db: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (cecq *ContestEntryCorrectionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   contestentrycorrection.Table,
+			Columns: contestentrycorrection.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: contestentrycorrection.FieldID,
+			},
+		},
+		From:   cecq.sql,
+		Unique: true,
+	}
+	if fields := cecq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contestentrycorrection.FieldID)
+		for i := range fields {
+			if fields[i] != contestentrycorrection.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := cecq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cecq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cecq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cecq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, contestentrycorrection.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cecq *ContestEntryCorrectionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cecq.driver.Dialect())
+	t1 := builder.Table(contestentrycorrection.Table)
+	selector := builder.Select(t1.Columns(contestentrycorrection.Columns...)...).From(t1)
+	if cecq.sql != nil {
+		selector = cecq.sql
+		selector.Select(selector.Columns(contestentrycorrection.Columns...)...)
+	}
+	for _, p := range cecq.predicates {
+		p(selector)
+	}
+	for _, p := range cecq.order {
+		p(selector, contestentrycorrection.ValidColumn)
+	}
+	if offset := cecq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cecq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ContestEntryCorrectionGroupBy is the group-by builder for ContestEntryCorrection entities.
+type ContestEntryCorrectionGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cecgb *ContestEntryCorrectionGroupBy) Aggregate(fns ...AggregateFunc) *ContestEntryCorrectionGroupBy {
+	cecgb.fns = append(cecgb.fns, fns...)
+	return cecgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (cecgb *ContestEntryCorrectionGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := cecgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	cecgb.sql = query
+	return cecgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (cecgb *ContestEntryCorrectionGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := cecgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cecgb *ContestEntryCorrectionGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(cecgb.fields) > 1 {
+		return nil, errors.New("db: ContestEntryCorrectionGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := cecgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (cecgb *ContestEntryCorrectionGroupBy) StringsX(ctx context.Context) []string {
+	v, err := cecgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cecgb *ContestEntryCorrectionGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = cecgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestentrycorrection.Label}
+	default:
+		err = fmt.Errorf("db: ContestEntryCorrectionGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (cecgb *ContestEntryCorrectionGroupBy) StringX(ctx context.Context) string {
+	v, err := cecgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cecgb *ContestEntryCorrectionGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(cecgb.fields) > 1 {
+		return nil, errors.New("db: ContestEntryCorrectionGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := cecgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (cecgb *ContestEntryCorrectionGroupBy) IntsX(ctx context.Context) []int {
+	v, err := cecgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cecgb *ContestEntryCorrectionGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = cecgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestentrycorrection.Label}
+	default:
+		err = fmt.Errorf("db: ContestEntryCorrectionGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (cecgb *ContestEntryCorrectionGroupBy) IntX(ctx context.Context) int {
+	v, err := cecgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cecgb *ContestEntryCorrectionGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(cecgb.fields) > 1 {
+		return nil, errors.New("db: ContestEntryCorrectionGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := cecgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (cecgb *ContestEntryCorrectionGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := cecgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cecgb *ContestEntryCorrectionGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = cecgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestentrycorrection.Label}
+	default:
+		err = fmt.Errorf("db: ContestEntryCorrectionGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (cecgb *ContestEntryCorrectionGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := cecgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cecgb *ContestEntryCorrectionGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(cecgb.fields) > 1 {
+		return nil, errors.New("db: ContestEntryCorrectionGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := cecgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (cecgb *ContestEntryCorrectionGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := cecgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cecgb *ContestEntryCorrectionGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = cecgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestentrycorrection.Label}
+	default:
+		err = fmt.Errorf("db: ContestEntryCorrectionGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (cecgb *ContestEntryCorrectionGroupBy) BoolX(ctx context.Context) bool {
+	v, err := cecgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (cecgb *ContestEntryCorrectionGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range cecgb.fields {
+		if !contestentrycorrection.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := cecgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cecgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (cecgb *ContestEntryCorrectionGroupBy) sqlQuery() *sql.Selector {
+	selector := cecgb.sql
+	columns := make([]string, 0, len(cecgb.fields)+len(cecgb.fns))
+	columns = append(columns, cecgb.fields...)
+	for _, fn := range cecgb.fns {
+		columns = append(columns, fn(selector, contestentrycorrection.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(cecgb.fields...)
+}
+
+// ContestEntryCorrectionSelect is the builder for selecting fields of ContestEntryCorrection entities.
+type ContestEntryCorrectionSelect struct {
+	*ContestEntryCorrectionQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cecs *ContestEntryCorrectionSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := cecs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	cecs.sql = cecs.ContestEntryCorrectionQuery.sqlQuery(ctx)
+	return cecs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (cecs *ContestEntryCorrectionSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := cecs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (cecs *ContestEntryCorrectionSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(cecs.fields) > 1 {
+		return nil, errors.New("db: ContestEntryCorrectionSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := cecs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (cecs *ContestEntryCorrectionSelect) StringsX(ctx context.Context) []string {
+	v, err := cecs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (cecs *ContestEntryCorrectionSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = cecs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestentrycorrection.Label}
+	default:
+		err = fmt.Errorf("db: ContestEntryCorrectionSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (cecs *ContestEntryCorrectionSelect) StringX(ctx context.Context) string {
+	v, err := cecs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (cecs *ContestEntryCorrectionSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(cecs.fields) > 1 {
+		return nil, errors.New("db: ContestEntryCorrectionSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := cecs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (cecs *ContestEntryCorrectionSelect) IntsX(ctx context.Context) []int {
+	v, err := cecs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (cecs *ContestEntryCorrectionSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = cecs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestentrycorrection.Label}
+	default:
+		err = fmt.Errorf("db: ContestEntryCorrectionSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (cecs *ContestEntryCorrectionSelect) IntX(ctx context.Context) int {
+	v, err := cecs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (cecs *ContestEntryCorrectionSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(cecs.fields) > 1 {
+		return nil, errors.New("db: ContestEntryCorrectionSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := cecs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (cecs *ContestEntryCorrectionSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := cecs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (cecs *ContestEntryCorrectionSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = cecs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestentrycorrection.Label}
+	default:
+		err = fmt.Errorf("db: ContestEntryCorrectionSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (cecs *ContestEntryCorrectionSelect) Float64X(ctx context.Context) float64 {
+	v, err := cecs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (cecs *ContestEntryCorrectionSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(cecs.fields) > 1 {
+		return nil, errors.New("db: ContestEntryCorrectionSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := cecs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (cecs *ContestEntryCorrectionSelect) BoolsX(ctx context.Context) []bool {
+	v, err := cecs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (cecs *ContestEntryCorrectionSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = cecs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{contestentrycorrection.Label}
+	default:
+		err = fmt.Errorf("db: ContestEntryCorrectionSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (cecs *ContestEntryCorrectionSelect) BoolX(ctx context.Context) bool {
+	v, err := cecs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (cecs *ContestEntryCorrectionSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := cecs.sqlQuery().Query()
+	if err := cecs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (cecs *ContestEntryCorrectionSelect) sqlQuery() sql.Querier {
+	selector := cecs.sql
+	selector.Select(selector.Columns(cecs.fields...)...)
+	return selector
+}
@@ -0,0 +1,346 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/contestentry"
+	"github.com/NickDubelman/fantasy-bball/db/contestentrycorrection"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+)
+
+// ContestEntryCorrectionUpdate is the builder for updating ContestEntryCorrection entities.
+type ContestEntryCorrectionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ContestEntryCorrectionMutation
+}
+
+// Where adds a new predicate for the ContestEntryCorrectionUpdate builder.
+func (cecu *ContestEntryCorrectionUpdate) Where(ps ...predicate.ContestEntryCorrection) *ContestEntryCorrectionUpdate {
+	cecu.mutation.predicates = append(cecu.mutation.predicates, ps...)
+	return cecu
+}
+
+// SetEntryID sets the "entry" edge to the ContestEntry entity by ID.
+func (cecu *ContestEntryCorrectionUpdate) SetEntryID(id int) *ContestEntryCorrectionUpdate {
+	cecu.mutation.SetEntryID(id)
+	return cecu
+}
+
+// SetEntry sets the "entry" edge to the ContestEntry entity.
+func (cecu *ContestEntryCorrectionUpdate) SetEntry(c *ContestEntry) *ContestEntryCorrectionUpdate {
+	return cecu.SetEntryID(c.ID)
+}
+
+// Mutation returns the ContestEntryCorrectionMutation object of the builder.
+func (cecu *ContestEntryCorrectionUpdate) Mutation() *ContestEntryCorrectionMutation {
+	return cecu.mutation
+}
+
+// ClearEntry clears the "entry" edge to the ContestEntry entity.
+func (cecu *ContestEntryCorrectionUpdate) ClearEntry() *ContestEntryCorrectionUpdate {
+	cecu.mutation.ClearEntry()
+	return cecu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cecu *ContestEntryCorrectionUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(cecu.hooks) == 0 {
+		if err = cecu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = cecu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ContestEntryCorrectionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cecu.check(); err != nil {
+				return 0, err
+			}
+			cecu.mutation = mutation
+			affected, err = cecu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cecu.hooks) - 1; i >= 0; i-- {
+			mut = cecu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cecu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cecu *ContestEntryCorrectionUpdate) SaveX(ctx context.Context) int {
+	affected, err := cecu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cecu *ContestEntryCorrectionUpdate) Exec(ctx context.Context) error {
+	_, err := cecu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cecu *ContestEntryCorrectionUpdate) ExecX(ctx context.Context) {
+	if err := cecu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cecu *ContestEntryCorrectionUpdate) check() error {
+	if _, ok := cecu.mutation.EntryID(); cecu.mutation.EntryCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"entry\"")
+	}
+	return nil
+}
+
+func (cecu *ContestEntryCorrectionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   contestentrycorrection.Table,
+			Columns: contestentrycorrection.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: contestentrycorrection.FieldID,
+			},
+		},
+	}
+	if ps := cecu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if cecu.mutation.EntryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestentrycorrection.EntryTable,
+			Columns: []string{contestentrycorrection.EntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentry.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cecu.mutation.EntryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestentrycorrection.EntryTable,
+			Columns: []string{contestentrycorrection.EntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cecu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contestentrycorrection.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ContestEntryCorrectionUpdateOne is the builder for updating a single ContestEntryCorrection entity.
+type ContestEntryCorrectionUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *ContestEntryCorrectionMutation
+}
+
+// SetEntryID sets the "entry" edge to the ContestEntry entity by ID.
+func (cecuo *ContestEntryCorrectionUpdateOne) SetEntryID(id int) *ContestEntryCorrectionUpdateOne {
+	cecuo.mutation.SetEntryID(id)
+	return cecuo
+}
+
+// SetEntry sets the "entry" edge to the ContestEntry entity.
+func (cecuo *ContestEntryCorrectionUpdateOne) SetEntry(c *ContestEntry) *ContestEntryCorrectionUpdateOne {
+	return cecuo.SetEntryID(c.ID)
+}
+
+// Mutation returns the ContestEntryCorrectionMutation object of the builder.
+func (cecuo *ContestEntryCorrectionUpdateOne) Mutation() *ContestEntryCorrectionMutation {
+	return cecuo.mutation
+}
+
+// ClearEntry clears the "entry" edge to the ContestEntry entity.
+func (cecuo *ContestEntryCorrectionUpdateOne) ClearEntry() *ContestEntryCorrectionUpdateOne {
+	cecuo.mutation.ClearEntry()
+	return cecuo
+}
+
+// Save executes the query and returns the updated ContestEntryCorrection entity.
+func (cecuo *ContestEntryCorrectionUpdateOne) Save(ctx context.Context) (*ContestEntryCorrection, error) {
+	var (
+		err  error
+		node *ContestEntryCorrection
+	)
+	if len(cecuo.hooks) == 0 {
+		if err = cecuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = cecuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ContestEntryCorrectionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cecuo.check(); err != nil {
+				return nil, err
+			}
+			cecuo.mutation = mutation
+			node, err = cecuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cecuo.hooks) - 1; i >= 0; i-- {
+			mut = cecuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cecuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cecuo *ContestEntryCorrectionUpdateOne) SaveX(ctx context.Context) *ContestEntryCorrection {
+	node, err := cecuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cecuo *ContestEntryCorrectionUpdateOne) Exec(ctx context.Context) error {
+	_, err := cecuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cecuo *ContestEntryCorrectionUpdateOne) ExecX(ctx context.Context) {
+	if err := cecuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cecuo *ContestEntryCorrectionUpdateOne) check() error {
+	if _, ok := cecuo.mutation.EntryID(); cecuo.mutation.EntryCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"entry\"")
+	}
+	return nil
+}
+
+func (cecuo *ContestEntryCorrectionUpdateOne) sqlSave(ctx context.Context) (_node *ContestEntryCorrection, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   contestentrycorrection.Table,
+			Columns: contestentrycorrection.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: contestentrycorrection.FieldID,
+			},
+		},
+	}
+	id, ok := cecuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing ContestEntryCorrection.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := cecuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if cecuo.mutation.EntryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestentrycorrection.EntryTable,
+			Columns: []string{contestentrycorrection.EntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentry.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cecuo.mutation.EntryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contestentrycorrection.EntryTable,
+			Columns: []string{contestentrycorrection.EntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ContestEntryCorrection{config: cecuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cecuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contestentrycorrection.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -61,7 +61,6 @@ type AggregateFunc func(*sql.Selector, func(string) bool) string
 //	GroupBy(field1, field2).
 //	Aggregate(db.As(db.Sum(field1), "sum_field1"), (db.As(db.Sum(field2), "sum_field2")).
 //	Scan(ctx, &v)
-//
 func As(fn AggregateFunc, end string) AggregateFunc {
 	return func(s *sql.Selector, check func(string) bool) string {
 		return sql.As(fn(s, check), end)
@@ -0,0 +1,481 @@
+// Code generated by entc, DO NOT EDIT.
+
+package game
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// ExternalID applies equality check predicate on the "externalID" field. It's identical to ExternalIDEQ.
+func ExternalID(v string) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExternalID), v))
+	})
+}
+
+// Time applies equality check predicate on the "time" field. It's identical to TimeEQ.
+func Time(v time.Time) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTime), v))
+	})
+}
+
+// Postponed applies equality check predicate on the "postponed" field. It's identical to PostponedEQ.
+func Postponed(v bool) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPostponed), v))
+	})
+}
+
+// Final applies equality check predicate on the "final" field. It's identical to FinalEQ.
+func Final(v bool) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFinal), v))
+	})
+}
+
+// ExternalIDEQ applies the EQ predicate on the "externalID" field.
+func ExternalIDEQ(v string) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDNEQ applies the NEQ predicate on the "externalID" field.
+func ExternalIDNEQ(v string) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDIn applies the In predicate on the "externalID" field.
+func ExternalIDIn(vs ...string) predicate.Game {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Game(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExternalID), v...))
+	})
+}
+
+// ExternalIDNotIn applies the NotIn predicate on the "externalID" field.
+func ExternalIDNotIn(vs ...string) predicate.Game {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Game(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExternalID), v...))
+	})
+}
+
+// ExternalIDGT applies the GT predicate on the "externalID" field.
+func ExternalIDGT(v string) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDGTE applies the GTE predicate on the "externalID" field.
+func ExternalIDGTE(v string) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDLT applies the LT predicate on the "externalID" field.
+func ExternalIDLT(v string) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDLTE applies the LTE predicate on the "externalID" field.
+func ExternalIDLTE(v string) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDContains applies the Contains predicate on the "externalID" field.
+func ExternalIDContains(v string) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDHasPrefix applies the HasPrefix predicate on the "externalID" field.
+func ExternalIDHasPrefix(v string) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDHasSuffix applies the HasSuffix predicate on the "externalID" field.
+func ExternalIDHasSuffix(v string) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDEqualFold applies the EqualFold predicate on the "externalID" field.
+func ExternalIDEqualFold(v string) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDContainsFold applies the ContainsFold predicate on the "externalID" field.
+func ExternalIDContainsFold(v string) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldExternalID), v))
+	})
+}
+
+// TimeEQ applies the EQ predicate on the "time" field.
+func TimeEQ(v time.Time) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTime), v))
+	})
+}
+
+// TimeNEQ applies the NEQ predicate on the "time" field.
+func TimeNEQ(v time.Time) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTime), v))
+	})
+}
+
+// TimeIn applies the In predicate on the "time" field.
+func TimeIn(vs ...time.Time) predicate.Game {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Game(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTime), v...))
+	})
+}
+
+// TimeNotIn applies the NotIn predicate on the "time" field.
+func TimeNotIn(vs ...time.Time) predicate.Game {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Game(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTime), v...))
+	})
+}
+
+// TimeGT applies the GT predicate on the "time" field.
+func TimeGT(v time.Time) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTime), v))
+	})
+}
+
+// TimeGTE applies the GTE predicate on the "time" field.
+func TimeGTE(v time.Time) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTime), v))
+	})
+}
+
+// TimeLT applies the LT predicate on the "time" field.
+func TimeLT(v time.Time) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTime), v))
+	})
+}
+
+// TimeLTE applies the LTE predicate on the "time" field.
+func TimeLTE(v time.Time) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTime), v))
+	})
+}
+
+// PostponedEQ applies the EQ predicate on the "postponed" field.
+func PostponedEQ(v bool) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPostponed), v))
+	})
+}
+
+// PostponedNEQ applies the NEQ predicate on the "postponed" field.
+func PostponedNEQ(v bool) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPostponed), v))
+	})
+}
+
+// FinalEQ applies the EQ predicate on the "final" field.
+func FinalEQ(v bool) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFinal), v))
+	})
+}
+
+// FinalNEQ applies the NEQ predicate on the "final" field.
+func FinalNEQ(v bool) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldFinal), v))
+	})
+}
+
+// HasHomeTeam applies the HasEdge predicate on the "homeTeam" edge.
+func HasHomeTeam() predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(HomeTeamTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, HomeTeamTable, HomeTeamColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHomeTeamWith applies the HasEdge predicate on the "homeTeam" edge with a given conditions (other predicates).
+func HasHomeTeamWith(preds ...predicate.Team) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(HomeTeamInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, HomeTeamTable, HomeTeamColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAwayTeam applies the HasEdge predicate on the "awayTeam" edge.
+func HasAwayTeam() predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(AwayTeamTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AwayTeamTable, AwayTeamColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAwayTeamWith applies the HasEdge predicate on the "awayTeam" edge with a given conditions (other predicates).
+func HasAwayTeamWith(preds ...predicate.Team) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(AwayTeamInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AwayTeamTable, AwayTeamColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasResult applies the HasEdge predicate on the "result" edge.
+func HasResult() predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ResultTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, ResultTable, ResultColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasResultWith applies the HasEdge predicate on the "result" edge with a given conditions (other predicates).
+func HasResultWith(preds ...predicate.GameResult) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ResultInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, ResultTable, ResultColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPerformances applies the HasEdge predicate on the "performances" edge.
+func HasPerformances() predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PerformancesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PerformancesTable, PerformancesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPerformancesWith applies the HasEdge predicate on the "performances" edge with a given conditions (other predicates).
+func HasPerformancesWith(preds ...predicate.PlayerPerformance) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PerformancesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PerformancesTable, PerformancesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Game) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Game) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Game) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
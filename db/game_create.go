@@ -0,0 +1,409 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/game"
+	"github.com/NickDubelman/fantasy-bball/db/gameresult"
+	"github.com/NickDubelman/fantasy-bball/db/playerperformance"
+	"github.com/NickDubelman/fantasy-bball/db/team"
+)
+
+// GameCreate is the builder for creating a Game entity.
+type GameCreate struct {
+	config
+	mutation *GameMutation
+	hooks    []Hook
+}
+
+// SetExternalID sets the "externalID" field.
+func (gc *GameCreate) SetExternalID(s string) *GameCreate {
+	gc.mutation.SetExternalID(s)
+	return gc
+}
+
+// SetTime sets the "time" field.
+func (gc *GameCreate) SetTime(t time.Time) *GameCreate {
+	gc.mutation.SetTime(t)
+	return gc
+}
+
+// SetPostponed sets the "postponed" field.
+func (gc *GameCreate) SetPostponed(b bool) *GameCreate {
+	gc.mutation.SetPostponed(b)
+	return gc
+}
+
+// SetNillablePostponed sets the "postponed" field if the given value is not nil.
+func (gc *GameCreate) SetNillablePostponed(b *bool) *GameCreate {
+	if b != nil {
+		gc.SetPostponed(*b)
+	}
+	return gc
+}
+
+// SetFinal sets the "final" field.
+func (gc *GameCreate) SetFinal(b bool) *GameCreate {
+	gc.mutation.SetFinal(b)
+	return gc
+}
+
+// SetNillableFinal sets the "final" field if the given value is not nil.
+func (gc *GameCreate) SetNillableFinal(b *bool) *GameCreate {
+	if b != nil {
+		gc.SetFinal(*b)
+	}
+	return gc
+}
+
+// SetHomeTeamID sets the "homeTeam" edge to the Team entity by ID.
+func (gc *GameCreate) SetHomeTeamID(id int) *GameCreate {
+	gc.mutation.SetHomeTeamID(id)
+	return gc
+}
+
+// SetHomeTeam sets the "homeTeam" edge to the Team entity.
+func (gc *GameCreate) SetHomeTeam(t *Team) *GameCreate {
+	return gc.SetHomeTeamID(t.ID)
+}
+
+// SetAwayTeamID sets the "awayTeam" edge to the Team entity by ID.
+func (gc *GameCreate) SetAwayTeamID(id int) *GameCreate {
+	gc.mutation.SetAwayTeamID(id)
+	return gc
+}
+
+// SetAwayTeam sets the "awayTeam" edge to the Team entity.
+func (gc *GameCreate) SetAwayTeam(t *Team) *GameCreate {
+	return gc.SetAwayTeamID(t.ID)
+}
+
+// SetResultID sets the "result" edge to the GameResult entity by ID.
+func (gc *GameCreate) SetResultID(id int) *GameCreate {
+	gc.mutation.SetResultID(id)
+	return gc
+}
+
+// SetNillableResultID sets the "result" edge to the GameResult entity by ID if the given value is not nil.
+func (gc *GameCreate) SetNillableResultID(id *int) *GameCreate {
+	if id != nil {
+		gc = gc.SetResultID(*id)
+	}
+	return gc
+}
+
+// SetResult sets the "result" edge to the GameResult entity.
+func (gc *GameCreate) SetResult(g *GameResult) *GameCreate {
+	return gc.SetResultID(g.ID)
+}
+
+// AddPerformanceIDs adds the "performances" edge to the PlayerPerformance entity by IDs.
+func (gc *GameCreate) AddPerformanceIDs(ids ...int) *GameCreate {
+	gc.mutation.AddPerformanceIDs(ids...)
+	return gc
+}
+
+// AddPerformances adds the "performances" edges to the PlayerPerformance entity.
+func (gc *GameCreate) AddPerformances(p ...*PlayerPerformance) *GameCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return gc.AddPerformanceIDs(ids...)
+}
+
+// Mutation returns the GameMutation object of the builder.
+func (gc *GameCreate) Mutation() *GameMutation {
+	return gc.mutation
+}
+
+// Save creates the Game in the database.
+func (gc *GameCreate) Save(ctx context.Context) (*Game, error) {
+	var (
+		err  error
+		node *Game
+	)
+	gc.defaults()
+	if len(gc.hooks) == 0 {
+		if err = gc.check(); err != nil {
+			return nil, err
+		}
+		node, err = gc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*GameMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = gc.check(); err != nil {
+				return nil, err
+			}
+			gc.mutation = mutation
+			node, err = gc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(gc.hooks) - 1; i >= 0; i-- {
+			mut = gc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, gc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (gc *GameCreate) SaveX(ctx context.Context) *Game {
+	v, err := gc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (gc *GameCreate) defaults() {
+	if _, ok := gc.mutation.Postponed(); !ok {
+		v := game.DefaultPostponed
+		gc.mutation.SetPostponed(v)
+	}
+	if _, ok := gc.mutation.Final(); !ok {
+		v := game.DefaultFinal
+		gc.mutation.SetFinal(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gc *GameCreate) check() error {
+	if _, ok := gc.mutation.ExternalID(); !ok {
+		return &ValidationError{Name: "externalID", err: errors.New("db: missing required field \"externalID\"")}
+	}
+	if _, ok := gc.mutation.Time(); !ok {
+		return &ValidationError{Name: "time", err: errors.New("db: missing required field \"time\"")}
+	}
+	if _, ok := gc.mutation.Postponed(); !ok {
+		return &ValidationError{Name: "postponed", err: errors.New("db: missing required field \"postponed\"")}
+	}
+	if _, ok := gc.mutation.Final(); !ok {
+		return &ValidationError{Name: "final", err: errors.New("db: missing required field \"final\"")}
+	}
+	if _, ok := gc.mutation.HomeTeamID(); !ok {
+		return &ValidationError{Name: "homeTeam", err: errors.New("db: missing required edge \"homeTeam\"")}
+	}
+	if _, ok := gc.mutation.AwayTeamID(); !ok {
+		return &ValidationError{Name: "awayTeam", err: errors.New("db: missing required edge \"awayTeam\"")}
+	}
+	return nil
+}
+
+func (gc *GameCreate) sqlSave(ctx context.Context) (*Game, error) {
+	_node, _spec := gc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (gc *GameCreate) createSpec() (*Game, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Game{config: gc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: game.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: game.FieldID,
+			},
+		}
+	)
+	if value, ok := gc.mutation.ExternalID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: game.FieldExternalID,
+		})
+		_node.ExternalID = value
+	}
+	if value, ok := gc.mutation.Time(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: game.FieldTime,
+		})
+		_node.Time = value
+	}
+	if value, ok := gc.mutation.Postponed(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: game.FieldPostponed,
+		})
+		_node.Postponed = value
+	}
+	if value, ok := gc.mutation.Final(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: game.FieldFinal,
+		})
+		_node.Final = value
+	}
+	if nodes := gc.mutation.HomeTeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   game.HomeTeamTable,
+			Columns: []string{game.HomeTeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.team_home_games = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.AwayTeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   game.AwayTeamTable,
+			Columns: []string{game.AwayTeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.team_away_games = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.ResultIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   game.ResultTable,
+			Columns: []string{game.ResultColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: gameresult.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.PerformancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.PerformancesTable,
+			Columns: []string{game.PerformancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: playerperformance.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// GameCreateBulk is the builder for creating many Game entities in bulk.
+type GameCreateBulk struct {
+	config
+	builders []*GameCreate
+}
+
+// Save creates the Game entities in the database.
+func (gcb *GameCreateBulk) Save(ctx context.Context) ([]*Game, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(gcb.builders))
+	nodes := make([]*Game, len(gcb.builders))
+	mutators := make([]Mutator, len(gcb.builders))
+	for i := range gcb.builders {
+		func(i int, root context.Context) {
+			builder := gcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GameMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, gcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gcb *GameCreateBulk) SaveX(ctx context.Context) []*Game {
+	v, err := gcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,895 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/game"
+	"github.com/NickDubelman/fantasy-bball/db/gameresult"
+	"github.com/NickDubelman/fantasy-bball/db/playerperformance"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+	"github.com/NickDubelman/fantasy-bball/db/team"
+)
+
+// GameUpdate is the builder for updating Game entities.
+type GameUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GameMutation
+}
+
+// Where adds a new predicate for the GameUpdate builder.
+func (gu *GameUpdate) Where(ps ...predicate.Game) *GameUpdate {
+	gu.mutation.predicates = append(gu.mutation.predicates, ps...)
+	return gu
+}
+
+// SetExternalID sets the "externalID" field.
+func (gu *GameUpdate) SetExternalID(s string) *GameUpdate {
+	gu.mutation.SetExternalID(s)
+	return gu
+}
+
+// SetTime sets the "time" field.
+func (gu *GameUpdate) SetTime(t time.Time) *GameUpdate {
+	gu.mutation.SetTime(t)
+	return gu
+}
+
+// SetPostponed sets the "postponed" field.
+func (gu *GameUpdate) SetPostponed(b bool) *GameUpdate {
+	gu.mutation.SetPostponed(b)
+	return gu
+}
+
+// SetNillablePostponed sets the "postponed" field if the given value is not nil.
+func (gu *GameUpdate) SetNillablePostponed(b *bool) *GameUpdate {
+	if b != nil {
+		gu.SetPostponed(*b)
+	}
+	return gu
+}
+
+// SetFinal sets the "final" field.
+func (gu *GameUpdate) SetFinal(b bool) *GameUpdate {
+	gu.mutation.SetFinal(b)
+	return gu
+}
+
+// SetNillableFinal sets the "final" field if the given value is not nil.
+func (gu *GameUpdate) SetNillableFinal(b *bool) *GameUpdate {
+	if b != nil {
+		gu.SetFinal(*b)
+	}
+	return gu
+}
+
+// SetHomeTeamID sets the "homeTeam" edge to the Team entity by ID.
+func (gu *GameUpdate) SetHomeTeamID(id int) *GameUpdate {
+	gu.mutation.SetHomeTeamID(id)
+	return gu
+}
+
+// SetHomeTeam sets the "homeTeam" edge to the Team entity.
+func (gu *GameUpdate) SetHomeTeam(t *Team) *GameUpdate {
+	return gu.SetHomeTeamID(t.ID)
+}
+
+// SetAwayTeamID sets the "awayTeam" edge to the Team entity by ID.
+func (gu *GameUpdate) SetAwayTeamID(id int) *GameUpdate {
+	gu.mutation.SetAwayTeamID(id)
+	return gu
+}
+
+// SetAwayTeam sets the "awayTeam" edge to the Team entity.
+func (gu *GameUpdate) SetAwayTeam(t *Team) *GameUpdate {
+	return gu.SetAwayTeamID(t.ID)
+}
+
+// SetResultID sets the "result" edge to the GameResult entity by ID.
+func (gu *GameUpdate) SetResultID(id int) *GameUpdate {
+	gu.mutation.SetResultID(id)
+	return gu
+}
+
+// SetNillableResultID sets the "result" edge to the GameResult entity by ID if the given value is not nil.
+func (gu *GameUpdate) SetNillableResultID(id *int) *GameUpdate {
+	if id != nil {
+		gu = gu.SetResultID(*id)
+	}
+	return gu
+}
+
+// SetResult sets the "result" edge to the GameResult entity.
+func (gu *GameUpdate) SetResult(g *GameResult) *GameUpdate {
+	return gu.SetResultID(g.ID)
+}
+
+// AddPerformanceIDs adds the "performances" edge to the PlayerPerformance entity by IDs.
+func (gu *GameUpdate) AddPerformanceIDs(ids ...int) *GameUpdate {
+	gu.mutation.AddPerformanceIDs(ids...)
+	return gu
+}
+
+// AddPerformances adds the "performances" edges to the PlayerPerformance entity.
+func (gu *GameUpdate) AddPerformances(p ...*PlayerPerformance) *GameUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return gu.AddPerformanceIDs(ids...)
+}
+
+// Mutation returns the GameMutation object of the builder.
+func (gu *GameUpdate) Mutation() *GameMutation {
+	return gu.mutation
+}
+
+// ClearHomeTeam clears the "homeTeam" edge to the Team entity.
+func (gu *GameUpdate) ClearHomeTeam() *GameUpdate {
+	gu.mutation.ClearHomeTeam()
+	return gu
+}
+
+// ClearAwayTeam clears the "awayTeam" edge to the Team entity.
+func (gu *GameUpdate) ClearAwayTeam() *GameUpdate {
+	gu.mutation.ClearAwayTeam()
+	return gu
+}
+
+// ClearResult clears the "result" edge to the GameResult entity.
+func (gu *GameUpdate) ClearResult() *GameUpdate {
+	gu.mutation.ClearResult()
+	return gu
+}
+
+// ClearPerformances clears all "performances" edges to the PlayerPerformance entity.
+func (gu *GameUpdate) ClearPerformances() *GameUpdate {
+	gu.mutation.ClearPerformances()
+	return gu
+}
+
+// RemovePerformanceIDs removes the "performances" edge to PlayerPerformance entities by IDs.
+func (gu *GameUpdate) RemovePerformanceIDs(ids ...int) *GameUpdate {
+	gu.mutation.RemovePerformanceIDs(ids...)
+	return gu
+}
+
+// RemovePerformances removes "performances" edges to PlayerPerformance entities.
+func (gu *GameUpdate) RemovePerformances(p ...*PlayerPerformance) *GameUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return gu.RemovePerformanceIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gu *GameUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(gu.hooks) == 0 {
+		if err = gu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = gu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*GameMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = gu.check(); err != nil {
+				return 0, err
+			}
+			gu.mutation = mutation
+			affected, err = gu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(gu.hooks) - 1; i >= 0; i-- {
+			mut = gu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, gu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gu *GameUpdate) SaveX(ctx context.Context) int {
+	affected, err := gu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gu *GameUpdate) Exec(ctx context.Context) error {
+	_, err := gu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gu *GameUpdate) ExecX(ctx context.Context) {
+	if err := gu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gu *GameUpdate) check() error {
+	if _, ok := gu.mutation.HomeTeamID(); gu.mutation.HomeTeamCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"homeTeam\"")
+	}
+	if _, ok := gu.mutation.AwayTeamID(); gu.mutation.AwayTeamCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"awayTeam\"")
+	}
+	return nil
+}
+
+func (gu *GameUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   game.Table,
+			Columns: game.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: game.FieldID,
+			},
+		},
+	}
+	if ps := gu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gu.mutation.ExternalID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: game.FieldExternalID,
+		})
+	}
+	if value, ok := gu.mutation.Time(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: game.FieldTime,
+		})
+	}
+	if value, ok := gu.mutation.Postponed(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: game.FieldPostponed,
+		})
+	}
+	if value, ok := gu.mutation.Final(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: game.FieldFinal,
+		})
+	}
+	if gu.mutation.HomeTeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   game.HomeTeamTable,
+			Columns: []string{game.HomeTeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.HomeTeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   game.HomeTeamTable,
+			Columns: []string{game.HomeTeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.AwayTeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   game.AwayTeamTable,
+			Columns: []string{game.AwayTeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.AwayTeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   game.AwayTeamTable,
+			Columns: []string{game.AwayTeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.ResultCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   game.ResultTable,
+			Columns: []string{game.ResultColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: gameresult.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.ResultIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   game.ResultTable,
+			Columns: []string{game.ResultColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: gameresult.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.PerformancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.PerformancesTable,
+			Columns: []string{game.PerformancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: playerperformance.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedPerformancesIDs(); len(nodes) > 0 && !gu.mutation.PerformancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.PerformancesTable,
+			Columns: []string{game.PerformancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: playerperformance.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.PerformancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.PerformancesTable,
+			Columns: []string{game.PerformancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: playerperformance.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{game.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// GameUpdateOne is the builder for updating a single Game entity.
+type GameUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *GameMutation
+}
+
+// SetExternalID sets the "externalID" field.
+func (guo *GameUpdateOne) SetExternalID(s string) *GameUpdateOne {
+	guo.mutation.SetExternalID(s)
+	return guo
+}
+
+// SetTime sets the "time" field.
+func (guo *GameUpdateOne) SetTime(t time.Time) *GameUpdateOne {
+	guo.mutation.SetTime(t)
+	return guo
+}
+
+// SetPostponed sets the "postponed" field.
+func (guo *GameUpdateOne) SetPostponed(b bool) *GameUpdateOne {
+	guo.mutation.SetPostponed(b)
+	return guo
+}
+
+// SetNillablePostponed sets the "postponed" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillablePostponed(b *bool) *GameUpdateOne {
+	if b != nil {
+		guo.SetPostponed(*b)
+	}
+	return guo
+}
+
+// SetFinal sets the "final" field.
+func (guo *GameUpdateOne) SetFinal(b bool) *GameUpdateOne {
+	guo.mutation.SetFinal(b)
+	return guo
+}
+
+// SetNillableFinal sets the "final" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableFinal(b *bool) *GameUpdateOne {
+	if b != nil {
+		guo.SetFinal(*b)
+	}
+	return guo
+}
+
+// SetHomeTeamID sets the "homeTeam" edge to the Team entity by ID.
+func (guo *GameUpdateOne) SetHomeTeamID(id int) *GameUpdateOne {
+	guo.mutation.SetHomeTeamID(id)
+	return guo
+}
+
+// SetHomeTeam sets the "homeTeam" edge to the Team entity.
+func (guo *GameUpdateOne) SetHomeTeam(t *Team) *GameUpdateOne {
+	return guo.SetHomeTeamID(t.ID)
+}
+
+// SetAwayTeamID sets the "awayTeam" edge to the Team entity by ID.
+func (guo *GameUpdateOne) SetAwayTeamID(id int) *GameUpdateOne {
+	guo.mutation.SetAwayTeamID(id)
+	return guo
+}
+
+// SetAwayTeam sets the "awayTeam" edge to the Team entity.
+func (guo *GameUpdateOne) SetAwayTeam(t *Team) *GameUpdateOne {
+	return guo.SetAwayTeamID(t.ID)
+}
+
+// SetResultID sets the "result" edge to the GameResult entity by ID.
+func (guo *GameUpdateOne) SetResultID(id int) *GameUpdateOne {
+	guo.mutation.SetResultID(id)
+	return guo
+}
+
+// SetNillableResultID sets the "result" edge to the GameResult entity by ID if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableResultID(id *int) *GameUpdateOne {
+	if id != nil {
+		guo = guo.SetResultID(*id)
+	}
+	return guo
+}
+
+// SetResult sets the "result" edge to the GameResult entity.
+func (guo *GameUpdateOne) SetResult(g *GameResult) *GameUpdateOne {
+	return guo.SetResultID(g.ID)
+}
+
+// AddPerformanceIDs adds the "performances" edge to the PlayerPerformance entity by IDs.
+func (guo *GameUpdateOne) AddPerformanceIDs(ids ...int) *GameUpdateOne {
+	guo.mutation.AddPerformanceIDs(ids...)
+	return guo
+}
+
+// AddPerformances adds the "performances" edges to the PlayerPerformance entity.
+func (guo *GameUpdateOne) AddPerformances(p ...*PlayerPerformance) *GameUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return guo.AddPerformanceIDs(ids...)
+}
+
+// Mutation returns the GameMutation object of the builder.
+func (guo *GameUpdateOne) Mutation() *GameMutation {
+	return guo.mutation
+}
+
+// ClearHomeTeam clears the "homeTeam" edge to the Team entity.
+func (guo *GameUpdateOne) ClearHomeTeam() *GameUpdateOne {
+	guo.mutation.ClearHomeTeam()
+	return guo
+}
+
+// ClearAwayTeam clears the "awayTeam" edge to the Team entity.
+func (guo *GameUpdateOne) ClearAwayTeam() *GameUpdateOne {
+	guo.mutation.ClearAwayTeam()
+	return guo
+}
+
+// ClearResult clears the "result" edge to the GameResult entity.
+func (guo *GameUpdateOne) ClearResult() *GameUpdateOne {
+	guo.mutation.ClearResult()
+	return guo
+}
+
+// ClearPerformances clears all "performances" edges to the PlayerPerformance entity.
+func (guo *GameUpdateOne) ClearPerformances() *GameUpdateOne {
+	guo.mutation.ClearPerformances()
+	return guo
+}
+
+// RemovePerformanceIDs removes the "performances" edge to PlayerPerformance entities by IDs.
+func (guo *GameUpdateOne) RemovePerformanceIDs(ids ...int) *GameUpdateOne {
+	guo.mutation.RemovePerformanceIDs(ids...)
+	return guo
+}
+
+// RemovePerformances removes "performances" edges to PlayerPerformance entities.
+func (guo *GameUpdateOne) RemovePerformances(p ...*PlayerPerformance) *GameUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return guo.RemovePerformanceIDs(ids...)
+}
+
+// Save executes the query and returns the updated Game entity.
+func (guo *GameUpdateOne) Save(ctx context.Context) (*Game, error) {
+	var (
+		err  error
+		node *Game
+	)
+	if len(guo.hooks) == 0 {
+		if err = guo.check(); err != nil {
+			return nil, err
+		}
+		node, err = guo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*GameMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = guo.check(); err != nil {
+				return nil, err
+			}
+			guo.mutation = mutation
+			node, err = guo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(guo.hooks) - 1; i >= 0; i-- {
+			mut = guo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, guo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (guo *GameUpdateOne) SaveX(ctx context.Context) *Game {
+	node, err := guo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (guo *GameUpdateOne) Exec(ctx context.Context) error {
+	_, err := guo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (guo *GameUpdateOne) ExecX(ctx context.Context) {
+	if err := guo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (guo *GameUpdateOne) check() error {
+	if _, ok := guo.mutation.HomeTeamID(); guo.mutation.HomeTeamCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"homeTeam\"")
+	}
+	if _, ok := guo.mutation.AwayTeamID(); guo.mutation.AwayTeamCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"awayTeam\"")
+	}
+	return nil
+}
+
+func (guo *GameUpdateOne) sqlSave(ctx context.Context) (_node *Game, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   game.Table,
+			Columns: game.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: game.FieldID,
+			},
+		},
+	}
+	id, ok := guo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Game.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := guo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := guo.mutation.ExternalID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: game.FieldExternalID,
+		})
+	}
+	if value, ok := guo.mutation.Time(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: game.FieldTime,
+		})
+	}
+	if value, ok := guo.mutation.Postponed(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: game.FieldPostponed,
+		})
+	}
+	if value, ok := guo.mutation.Final(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: game.FieldFinal,
+		})
+	}
+	if guo.mutation.HomeTeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   game.HomeTeamTable,
+			Columns: []string{game.HomeTeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.HomeTeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   game.HomeTeamTable,
+			Columns: []string{game.HomeTeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.AwayTeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   game.AwayTeamTable,
+			Columns: []string{game.AwayTeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.AwayTeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   game.AwayTeamTable,
+			Columns: []string{game.AwayTeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.ResultCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   game.ResultTable,
+			Columns: []string{game.ResultColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: gameresult.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.ResultIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   game.ResultTable,
+			Columns: []string{game.ResultColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: gameresult.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.PerformancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.PerformancesTable,
+			Columns: []string{game.PerformancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: playerperformance.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedPerformancesIDs(); len(nodes) > 0 && !guo.mutation.PerformancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.PerformancesTable,
+			Columns: []string{game.PerformancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: playerperformance.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.PerformancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.PerformancesTable,
+			Columns: []string{game.PerformancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: playerperformance.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Game{config: guo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, guo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{game.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
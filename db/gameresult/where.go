@@ -0,0 +1,346 @@
+// Code generated by entc, DO NOT EDIT.
+
+package gameresult
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// HomeScore applies equality check predicate on the "homeScore" field. It's identical to HomeScoreEQ.
+func HomeScore(v int) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHomeScore), v))
+	})
+}
+
+// AwayScore applies equality check predicate on the "awayScore" field. It's identical to AwayScoreEQ.
+func AwayScore(v int) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAwayScore), v))
+	})
+}
+
+// HomeScoreEQ applies the EQ predicate on the "homeScore" field.
+func HomeScoreEQ(v int) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHomeScore), v))
+	})
+}
+
+// HomeScoreNEQ applies the NEQ predicate on the "homeScore" field.
+func HomeScoreNEQ(v int) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldHomeScore), v))
+	})
+}
+
+// HomeScoreIn applies the In predicate on the "homeScore" field.
+func HomeScoreIn(vs ...int) predicate.GameResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.GameResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldHomeScore), v...))
+	})
+}
+
+// HomeScoreNotIn applies the NotIn predicate on the "homeScore" field.
+func HomeScoreNotIn(vs ...int) predicate.GameResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.GameResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldHomeScore), v...))
+	})
+}
+
+// HomeScoreGT applies the GT predicate on the "homeScore" field.
+func HomeScoreGT(v int) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldHomeScore), v))
+	})
+}
+
+// HomeScoreGTE applies the GTE predicate on the "homeScore" field.
+func HomeScoreGTE(v int) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldHomeScore), v))
+	})
+}
+
+// HomeScoreLT applies the LT predicate on the "homeScore" field.
+func HomeScoreLT(v int) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldHomeScore), v))
+	})
+}
+
+// HomeScoreLTE applies the LTE predicate on the "homeScore" field.
+func HomeScoreLTE(v int) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldHomeScore), v))
+	})
+}
+
+// AwayScoreEQ applies the EQ predicate on the "awayScore" field.
+func AwayScoreEQ(v int) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAwayScore), v))
+	})
+}
+
+// AwayScoreNEQ applies the NEQ predicate on the "awayScore" field.
+func AwayScoreNEQ(v int) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAwayScore), v))
+	})
+}
+
+// AwayScoreIn applies the In predicate on the "awayScore" field.
+func AwayScoreIn(vs ...int) predicate.GameResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.GameResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAwayScore), v...))
+	})
+}
+
+// AwayScoreNotIn applies the NotIn predicate on the "awayScore" field.
+func AwayScoreNotIn(vs ...int) predicate.GameResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.GameResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAwayScore), v...))
+	})
+}
+
+// AwayScoreGT applies the GT predicate on the "awayScore" field.
+func AwayScoreGT(v int) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAwayScore), v))
+	})
+}
+
+// AwayScoreGTE applies the GTE predicate on the "awayScore" field.
+func AwayScoreGTE(v int) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAwayScore), v))
+	})
+}
+
+// AwayScoreLT applies the LT predicate on the "awayScore" field.
+func AwayScoreLT(v int) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAwayScore), v))
+	})
+}
+
+// AwayScoreLTE applies the LTE predicate on the "awayScore" field.
+func AwayScoreLTE(v int) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAwayScore), v))
+	})
+}
+
+// HasGame applies the HasEdge predicate on the "game" edge.
+func HasGame() predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(GameTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, GameTable, GameColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGameWith applies the HasEdge predicate on the "game" edge with a given conditions (other predicates).
+func HasGameWith(preds ...predicate.Game) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(GameInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, GameTable, GameColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWinner applies the HasEdge predicate on the "winner" edge.
+func HasWinner() predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WinnerTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WinnerTable, WinnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWinnerWith applies the HasEdge predicate on the "winner" edge with a given conditions (other predicates).
+func HasWinnerWith(preds ...predicate.Team) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WinnerInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WinnerTable, WinnerColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GameResult) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GameResult) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GameResult) predicate.GameResult {
+	return predicate.GameResult(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
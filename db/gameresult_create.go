@@ -0,0 +1,316 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/game"
+	"github.com/NickDubelman/fantasy-bball/db/gameresult"
+	"github.com/NickDubelman/fantasy-bball/db/team"
+)
+
+// GameResultCreate is the builder for creating a GameResult entity.
+type GameResultCreate struct {
+	config
+	mutation *GameResultMutation
+	hooks    []Hook
+}
+
+// SetHomeScore sets the "homeScore" field.
+func (grc *GameResultCreate) SetHomeScore(i int) *GameResultCreate {
+	grc.mutation.SetHomeScore(i)
+	return grc
+}
+
+// SetNillableHomeScore sets the "homeScore" field if the given value is not nil.
+func (grc *GameResultCreate) SetNillableHomeScore(i *int) *GameResultCreate {
+	if i != nil {
+		grc.SetHomeScore(*i)
+	}
+	return grc
+}
+
+// SetAwayScore sets the "awayScore" field.
+func (grc *GameResultCreate) SetAwayScore(i int) *GameResultCreate {
+	grc.mutation.SetAwayScore(i)
+	return grc
+}
+
+// SetNillableAwayScore sets the "awayScore" field if the given value is not nil.
+func (grc *GameResultCreate) SetNillableAwayScore(i *int) *GameResultCreate {
+	if i != nil {
+		grc.SetAwayScore(*i)
+	}
+	return grc
+}
+
+// SetGameID sets the "game" edge to the Game entity by ID.
+func (grc *GameResultCreate) SetGameID(id int) *GameResultCreate {
+	grc.mutation.SetGameID(id)
+	return grc
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (grc *GameResultCreate) SetGame(g *Game) *GameResultCreate {
+	return grc.SetGameID(g.ID)
+}
+
+// SetWinnerID sets the "winner" edge to the Team entity by ID.
+func (grc *GameResultCreate) SetWinnerID(id int) *GameResultCreate {
+	grc.mutation.SetWinnerID(id)
+	return grc
+}
+
+// SetNillableWinnerID sets the "winner" edge to the Team entity by ID if the given value is not nil.
+func (grc *GameResultCreate) SetNillableWinnerID(id *int) *GameResultCreate {
+	if id != nil {
+		grc = grc.SetWinnerID(*id)
+	}
+	return grc
+}
+
+// SetWinner sets the "winner" edge to the Team entity.
+func (grc *GameResultCreate) SetWinner(t *Team) *GameResultCreate {
+	return grc.SetWinnerID(t.ID)
+}
+
+// Mutation returns the GameResultMutation object of the builder.
+func (grc *GameResultCreate) Mutation() *GameResultMutation {
+	return grc.mutation
+}
+
+// Save creates the GameResult in the database.
+func (grc *GameResultCreate) Save(ctx context.Context) (*GameResult, error) {
+	var (
+		err  error
+		node *GameResult
+	)
+	grc.defaults()
+	if len(grc.hooks) == 0 {
+		if err = grc.check(); err != nil {
+			return nil, err
+		}
+		node, err = grc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*GameResultMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = grc.check(); err != nil {
+				return nil, err
+			}
+			grc.mutation = mutation
+			node, err = grc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(grc.hooks) - 1; i >= 0; i-- {
+			mut = grc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, grc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (grc *GameResultCreate) SaveX(ctx context.Context) *GameResult {
+	v, err := grc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (grc *GameResultCreate) defaults() {
+	if _, ok := grc.mutation.HomeScore(); !ok {
+		v := gameresult.DefaultHomeScore
+		grc.mutation.SetHomeScore(v)
+	}
+	if _, ok := grc.mutation.AwayScore(); !ok {
+		v := gameresult.DefaultAwayScore
+		grc.mutation.SetAwayScore(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (grc *GameResultCreate) check() error {
+	if _, ok := grc.mutation.HomeScore(); !ok {
+		return &ValidationError{Name: "homeScore", err: errors.New("db: missing required field \"homeScore\"")}
+	}
+	if v, ok := grc.mutation.HomeScore(); ok {
+		if err := gameresult.HomeScoreValidator(v); err != nil {
+			return &ValidationError{Name: "homeScore", err: fmt.Errorf("db: validator failed for field \"homeScore\": %w", err)}
+		}
+	}
+	if _, ok := grc.mutation.AwayScore(); !ok {
+		return &ValidationError{Name: "awayScore", err: errors.New("db: missing required field \"awayScore\"")}
+	}
+	if v, ok := grc.mutation.AwayScore(); ok {
+		if err := gameresult.AwayScoreValidator(v); err != nil {
+			return &ValidationError{Name: "awayScore", err: fmt.Errorf("db: validator failed for field \"awayScore\": %w", err)}
+		}
+	}
+	if _, ok := grc.mutation.GameID(); !ok {
+		return &ValidationError{Name: "game", err: errors.New("db: missing required edge \"game\"")}
+	}
+	return nil
+}
+
+func (grc *GameResultCreate) sqlSave(ctx context.Context) (*GameResult, error) {
+	_node, _spec := grc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, grc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (grc *GameResultCreate) createSpec() (*GameResult, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GameResult{config: grc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: gameresult.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: gameresult.FieldID,
+			},
+		}
+	)
+	if value, ok := grc.mutation.HomeScore(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: gameresult.FieldHomeScore,
+		})
+		_node.HomeScore = value
+	}
+	if value, ok := grc.mutation.AwayScore(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: gameresult.FieldAwayScore,
+		})
+		_node.AwayScore = value
+	}
+	if nodes := grc.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gameresult.GameTable,
+			Columns: []string{gameresult.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.game_result = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := grc.mutation.WinnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gameresult.WinnerTable,
+			Columns: []string{gameresult.WinnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.team_won_games = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// GameResultCreateBulk is the builder for creating many GameResult entities in bulk.
+type GameResultCreateBulk struct {
+	config
+	builders []*GameResultCreate
+}
+
+// Save creates the GameResult entities in the database.
+func (grcb *GameResultCreateBulk) Save(ctx context.Context) ([]*GameResult, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(grcb.builders))
+	nodes := make([]*GameResult, len(grcb.builders))
+	mutators := make([]Mutator, len(grcb.builders))
+	for i := range grcb.builders {
+		func(i int, root context.Context) {
+			builder := grcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GameResultMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, grcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, grcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, grcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (grcb *GameResultCreateBulk) SaveX(ctx context.Context) []*GameResult {
+	v, err := grcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,1032 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/game"
+	"github.com/NickDubelman/fantasy-bball/db/gameresult"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+	"github.com/NickDubelman/fantasy-bball/db/team"
+)
+
+// GameResultQuery is the builder for querying GameResult entities.
+type GameResultQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.GameResult
+	// eager-loading edges.
+	withGame   *GameQuery
+	withWinner *TeamQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the GameResultQuery builder.
+func (grq *GameResultQuery) Where(ps ...predicate.GameResult) *GameResultQuery {
+	grq.predicates = append(grq.predicates, ps...)
+	return grq
+}
+
+// Limit adds a limit step to the query.
+func (grq *GameResultQuery) Limit(limit int) *GameResultQuery {
+	grq.limit = &limit
+	return grq
+}
+
+// Offset adds an offset step to the query.
+func (grq *GameResultQuery) Offset(offset int) *GameResultQuery {
+	grq.offset = &offset
+	return grq
+}
+
+// Order adds an order step to the query.
+func (grq *GameResultQuery) Order(o ...OrderFunc) *GameResultQuery {
+	grq.order = append(grq.order, o...)
+	return grq
+}
+
+// QueryGame chains the current query on the "game" edge.
+func (grq *GameResultQuery) QueryGame() *GameQuery {
+	query := &GameQuery{config: grq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := grq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := grq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gameresult.Table, gameresult.FieldID, selector),
+			sqlgraph.To(game.Table, game.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, gameresult.GameTable, gameresult.GameColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(grq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWinner chains the current query on the "winner" edge.
+func (grq *GameResultQuery) QueryWinner() *TeamQuery {
+	query := &TeamQuery{config: grq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := grq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := grq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gameresult.Table, gameresult.FieldID, selector),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, gameresult.WinnerTable, gameresult.WinnerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(grq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first GameResult entity from the query.
+// Returns a *NotFoundError when no GameResult was found.
+func (grq *GameResultQuery) First(ctx context.Context) (*GameResult, error) {
+	nodes, err := grq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{gameresult.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (grq *GameResultQuery) FirstX(ctx context.Context) *GameResult {
+	node, err := grq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first GameResult ID from the query.
+// Returns a *NotFoundError when no GameResult ID was found.
+func (grq *GameResultQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = grq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{gameresult.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (grq *GameResultQuery) FirstIDX(ctx context.Context) int {
+	id, err := grq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single GameResult entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one GameResult entity is not found.
+// Returns a *NotFoundError when no GameResult entities are found.
+func (grq *GameResultQuery) Only(ctx context.Context) (*GameResult, error) {
+	nodes, err := grq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{gameresult.Label}
+	default:
+		return nil, &NotSingularError{gameresult.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (grq *GameResultQuery) OnlyX(ctx context.Context) *GameResult {
+	node, err := grq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only GameResult ID in the query.
+// Returns a *NotSingularError when exactly one GameResult ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (grq *GameResultQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = grq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{gameresult.Label}
+	default:
+		err = &NotSingularError{gameresult.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (grq *GameResultQuery) OnlyIDX(ctx context.Context) int {
+	id, err := grq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of GameResults.
+func (grq *GameResultQuery) All(ctx context.Context) ([]*GameResult, error) {
+	if err := grq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return grq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (grq *GameResultQuery) AllX(ctx context.Context) []*GameResult {
+	nodes, err := grq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of GameResult IDs.
+func (grq *GameResultQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := grq.Select(gameresult.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (grq *GameResultQuery) IDsX(ctx context.Context) []int {
+	ids, err := grq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (grq *GameResultQuery) Count(ctx context.Context) (int, error) {
+	if err := grq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return grq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (grq *GameResultQuery) CountX(ctx context.Context) int {
+	count, err := grq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (grq *GameResultQuery) Exist(ctx context.Context) (bool, error) {
+	if err := grq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return grq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (grq *GameResultQuery) ExistX(ctx context.Context) bool {
+	exist, err := grq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the GameResultQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (grq *GameResultQuery) Clone() *GameResultQuery {
+	if grq == nil {
+		return nil
+	}
+	return &GameResultQuery{
+		config:     grq.config,
+		limit:      grq.limit,
+		offset:     grq.offset,
+		order:      append([]OrderFunc{}, grq.order...),
+		predicates: append([]predicate.GameResult{}, grq.predicates...),
+		withGame:   grq.withGame.Clone(),
+		withWinner: grq.withWinner.Clone(),
+		// clone intermediate query.
+		sql:  grq.sql.Clone(),
+		path: grq.path,
+	}
+}
+
+// WithGame tells the query-builder to eager-load the nodes that are connected to
+// the "game" edge. The optional arguments are used to configure the query builder of the edge.
+func (grq *GameResultQuery) WithGame(opts ...func(*GameQuery)) *GameResultQuery {
+	query := &GameQuery{config: grq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	grq.withGame = query
+	return grq
+}
+
+// WithWinner tells the query-builder to eager-load the nodes that are connected to
+// the "winner" edge. The optional arguments are used to configure the query builder of the edge.
+func (grq *GameResultQuery) WithWinner(opts ...func(*TeamQuery)) *GameResultQuery {
+	query := &TeamQuery{config: grq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	grq.withWinner = query
+	return grq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		HomeScore int `json:"homeScore,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.GameResult.Query().
+//		GroupBy(gameresult.FieldHomeScore).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (grq *GameResultQuery) GroupBy(field string, fields ...string) *GameResultGroupBy {
+	group := &GameResultGroupBy{config: grq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := grq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return grq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		HomeScore int `json:"homeScore,omitempty"`
+//	}
+//
+//	client.GameResult.Query().
+//		Select(gameresult.FieldHomeScore).
+//		Scan(ctx, &v)
+func (grq *GameResultQuery) Select(field string, fields ...string) *GameResultSelect {
+	grq.fields = append([]string{field}, fields...)
+	return &GameResultSelect{GameResultQuery: grq}
+}
+
+func (grq *GameResultQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range grq.fields {
+		if !gameresult.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if grq.path != nil {
+		prev, err := grq.path(ctx)
+		if err != nil {
+			return err
+		}
+		grq.sql = prev
+	}
+	return nil
+}
+
+func (grq *GameResultQuery) sqlAll(ctx context.Context) ([]*GameResult, error) {
+	var (
+		nodes       = []*GameResult{}
+		withFKs     = grq.withFKs
+		_spec       = grq.querySpec()
+		loadedTypes = [2]bool{
+			grq.withGame != nil,
+			grq.withWinner != nil,
+		}
+	)
+	if grq.withGame != nil || grq.withWinner != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, gameresult.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &GameResult{config: grq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("db: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, grq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := grq.withGame; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*GameResult)
+		for i := range nodes {
+			fk := nodes[i].game_result
+			if fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(game.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "game_result" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Game = n
+			}
+		}
+	}
+
+	if query := grq.withWinner; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*GameResult)
+		for i := range nodes {
+			fk := nodes[i].team_won_games
+			if fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(team.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "team_won_games" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Winner = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (grq *GameResultQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := grq.querySpec()
+	return sqlgraph.CountNodes(ctx, grq.driver, _spec)
+}
+
+func (grq *GameResultQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := grq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("db: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (grq *GameResultQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   gameresult.Table,
+			Columns: gameresult.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: gameresult.FieldID,
+			},
+		},
+		From:   grq.sql,
+		Unique: true,
+	}
+	if fields := grq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, gameresult.FieldID)
+		for i := range fields {
+			if fields[i] != gameresult.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := grq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := grq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := grq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := grq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, gameresult.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (grq *GameResultQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(grq.driver.Dialect())
+	t1 := builder.Table(gameresult.Table)
+	selector := builder.Select(t1.Columns(gameresult.Columns...)...).From(t1)
+	if grq.sql != nil {
+		selector = grq.sql
+		selector.Select(selector.Columns(gameresult.Columns...)...)
+	}
+	for _, p := range grq.predicates {
+		p(selector)
+	}
+	for _, p := range grq.order {
+		p(selector, gameresult.ValidColumn)
+	}
+	if offset := grq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := grq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// GameResultGroupBy is the group-by builder for GameResult entities.
+type GameResultGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (grgb *GameResultGroupBy) Aggregate(fns ...AggregateFunc) *GameResultGroupBy {
+	grgb.fns = append(grgb.fns, fns...)
+	return grgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (grgb *GameResultGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := grgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	grgb.sql = query
+	return grgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (grgb *GameResultGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := grgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (grgb *GameResultGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(grgb.fields) > 1 {
+		return nil, errors.New("db: GameResultGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := grgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (grgb *GameResultGroupBy) StringsX(ctx context.Context) []string {
+	v, err := grgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (grgb *GameResultGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = grgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{gameresult.Label}
+	default:
+		err = fmt.Errorf("db: GameResultGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (grgb *GameResultGroupBy) StringX(ctx context.Context) string {
+	v, err := grgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (grgb *GameResultGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(grgb.fields) > 1 {
+		return nil, errors.New("db: GameResultGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := grgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (grgb *GameResultGroupBy) IntsX(ctx context.Context) []int {
+	v, err := grgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (grgb *GameResultGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = grgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{gameresult.Label}
+	default:
+		err = fmt.Errorf("db: GameResultGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (grgb *GameResultGroupBy) IntX(ctx context.Context) int {
+	v, err := grgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (grgb *GameResultGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(grgb.fields) > 1 {
+		return nil, errors.New("db: GameResultGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := grgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (grgb *GameResultGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := grgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (grgb *GameResultGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = grgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{gameresult.Label}
+	default:
+		err = fmt.Errorf("db: GameResultGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (grgb *GameResultGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := grgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (grgb *GameResultGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(grgb.fields) > 1 {
+		return nil, errors.New("db: GameResultGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := grgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (grgb *GameResultGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := grgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (grgb *GameResultGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = grgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{gameresult.Label}
+	default:
+		err = fmt.Errorf("db: GameResultGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (grgb *GameResultGroupBy) BoolX(ctx context.Context) bool {
+	v, err := grgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (grgb *GameResultGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range grgb.fields {
+		if !gameresult.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := grgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := grgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (grgb *GameResultGroupBy) sqlQuery() *sql.Selector {
+	selector := grgb.sql
+	columns := make([]string, 0, len(grgb.fields)+len(grgb.fns))
+	columns = append(columns, grgb.fields...)
+	for _, fn := range grgb.fns {
+		columns = append(columns, fn(selector, gameresult.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(grgb.fields...)
+}
+
+// GameResultSelect is the builder for selecting fields of GameResult entities.
+type GameResultSelect struct {
+	*GameResultQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (grs *GameResultSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := grs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	grs.sql = grs.GameResultQuery.sqlQuery(ctx)
+	return grs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (grs *GameResultSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := grs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (grs *GameResultSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(grs.fields) > 1 {
+		return nil, errors.New("db: GameResultSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := grs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (grs *GameResultSelect) StringsX(ctx context.Context) []string {
+	v, err := grs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (grs *GameResultSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = grs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{gameresult.Label}
+	default:
+		err = fmt.Errorf("db: GameResultSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (grs *GameResultSelect) StringX(ctx context.Context) string {
+	v, err := grs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (grs *GameResultSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(grs.fields) > 1 {
+		return nil, errors.New("db: GameResultSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := grs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (grs *GameResultSelect) IntsX(ctx context.Context) []int {
+	v, err := grs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (grs *GameResultSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = grs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{gameresult.Label}
+	default:
+		err = fmt.Errorf("db: GameResultSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (grs *GameResultSelect) IntX(ctx context.Context) int {
+	v, err := grs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (grs *GameResultSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(grs.fields) > 1 {
+		return nil, errors.New("db: GameResultSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := grs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (grs *GameResultSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := grs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (grs *GameResultSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = grs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{gameresult.Label}
+	default:
+		err = fmt.Errorf("db: GameResultSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (grs *GameResultSelect) Float64X(ctx context.Context) float64 {
+	v, err := grs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (grs *GameResultSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(grs.fields) > 1 {
+		return nil, errors.New("db: GameResultSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := grs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (grs *GameResultSelect) BoolsX(ctx context.Context) []bool {
+	v, err := grs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (grs *GameResultSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = grs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{gameresult.Label}
+	default:
+		err = fmt.Errorf("db: GameResultSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (grs *GameResultSelect) BoolX(ctx context.Context) bool {
+	v, err := grs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (grs *GameResultSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := grs.sqlQuery().Query()
+	if err := grs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (grs *GameResultSelect) sqlQuery() sql.Querier {
+	selector := grs.sql
+	selector.Select(selector.Columns(grs.fields...)...)
+	return selector
+}
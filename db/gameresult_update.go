@@ -0,0 +1,627 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/game"
+	"github.com/NickDubelman/fantasy-bball/db/gameresult"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+	"github.com/NickDubelman/fantasy-bball/db/team"
+)
+
+// GameResultUpdate is the builder for updating GameResult entities.
+type GameResultUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GameResultMutation
+}
+
+// Where adds a new predicate for the GameResultUpdate builder.
+func (gru *GameResultUpdate) Where(ps ...predicate.GameResult) *GameResultUpdate {
+	gru.mutation.predicates = append(gru.mutation.predicates, ps...)
+	return gru
+}
+
+// SetHomeScore sets the "homeScore" field.
+func (gru *GameResultUpdate) SetHomeScore(i int) *GameResultUpdate {
+	gru.mutation.ResetHomeScore()
+	gru.mutation.SetHomeScore(i)
+	return gru
+}
+
+// SetNillableHomeScore sets the "homeScore" field if the given value is not nil.
+func (gru *GameResultUpdate) SetNillableHomeScore(i *int) *GameResultUpdate {
+	if i != nil {
+		gru.SetHomeScore(*i)
+	}
+	return gru
+}
+
+// AddHomeScore adds i to the "homeScore" field.
+func (gru *GameResultUpdate) AddHomeScore(i int) *GameResultUpdate {
+	gru.mutation.AddHomeScore(i)
+	return gru
+}
+
+// SetAwayScore sets the "awayScore" field.
+func (gru *GameResultUpdate) SetAwayScore(i int) *GameResultUpdate {
+	gru.mutation.ResetAwayScore()
+	gru.mutation.SetAwayScore(i)
+	return gru
+}
+
+// SetNillableAwayScore sets the "awayScore" field if the given value is not nil.
+func (gru *GameResultUpdate) SetNillableAwayScore(i *int) *GameResultUpdate {
+	if i != nil {
+		gru.SetAwayScore(*i)
+	}
+	return gru
+}
+
+// AddAwayScore adds i to the "awayScore" field.
+func (gru *GameResultUpdate) AddAwayScore(i int) *GameResultUpdate {
+	gru.mutation.AddAwayScore(i)
+	return gru
+}
+
+// SetGameID sets the "game" edge to the Game entity by ID.
+func (gru *GameResultUpdate) SetGameID(id int) *GameResultUpdate {
+	gru.mutation.SetGameID(id)
+	return gru
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (gru *GameResultUpdate) SetGame(g *Game) *GameResultUpdate {
+	return gru.SetGameID(g.ID)
+}
+
+// SetWinnerID sets the "winner" edge to the Team entity by ID.
+func (gru *GameResultUpdate) SetWinnerID(id int) *GameResultUpdate {
+	gru.mutation.SetWinnerID(id)
+	return gru
+}
+
+// SetNillableWinnerID sets the "winner" edge to the Team entity by ID if the given value is not nil.
+func (gru *GameResultUpdate) SetNillableWinnerID(id *int) *GameResultUpdate {
+	if id != nil {
+		gru = gru.SetWinnerID(*id)
+	}
+	return gru
+}
+
+// SetWinner sets the "winner" edge to the Team entity.
+func (gru *GameResultUpdate) SetWinner(t *Team) *GameResultUpdate {
+	return gru.SetWinnerID(t.ID)
+}
+
+// Mutation returns the GameResultMutation object of the builder.
+func (gru *GameResultUpdate) Mutation() *GameResultMutation {
+	return gru.mutation
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (gru *GameResultUpdate) ClearGame() *GameResultUpdate {
+	gru.mutation.ClearGame()
+	return gru
+}
+
+// ClearWinner clears the "winner" edge to the Team entity.
+func (gru *GameResultUpdate) ClearWinner() *GameResultUpdate {
+	gru.mutation.ClearWinner()
+	return gru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gru *GameResultUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(gru.hooks) == 0 {
+		if err = gru.check(); err != nil {
+			return 0, err
+		}
+		affected, err = gru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*GameResultMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = gru.check(); err != nil {
+				return 0, err
+			}
+			gru.mutation = mutation
+			affected, err = gru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(gru.hooks) - 1; i >= 0; i-- {
+			mut = gru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, gru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gru *GameResultUpdate) SaveX(ctx context.Context) int {
+	affected, err := gru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gru *GameResultUpdate) Exec(ctx context.Context) error {
+	_, err := gru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gru *GameResultUpdate) ExecX(ctx context.Context) {
+	if err := gru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gru *GameResultUpdate) check() error {
+	if v, ok := gru.mutation.HomeScore(); ok {
+		if err := gameresult.HomeScoreValidator(v); err != nil {
+			return &ValidationError{Name: "homeScore", err: fmt.Errorf("db: validator failed for field \"homeScore\": %w", err)}
+		}
+	}
+	if v, ok := gru.mutation.AwayScore(); ok {
+		if err := gameresult.AwayScoreValidator(v); err != nil {
+			return &ValidationError{Name: "awayScore", err: fmt.Errorf("db: validator failed for field \"awayScore\": %w", err)}
+		}
+	}
+	if _, ok := gru.mutation.GameID(); gru.mutation.GameCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"game\"")
+	}
+	return nil
+}
+
+func (gru *GameResultUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   gameresult.Table,
+			Columns: gameresult.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: gameresult.FieldID,
+			},
+		},
+	}
+	if ps := gru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gru.mutation.HomeScore(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: gameresult.FieldHomeScore,
+		})
+	}
+	if value, ok := gru.mutation.AddedHomeScore(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: gameresult.FieldHomeScore,
+		})
+	}
+	if value, ok := gru.mutation.AwayScore(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: gameresult.FieldAwayScore,
+		})
+	}
+	if value, ok := gru.mutation.AddedAwayScore(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: gameresult.FieldAwayScore,
+		})
+	}
+	if gru.mutation.GameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gameresult.GameTable,
+			Columns: []string{gameresult.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gru.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gameresult.GameTable,
+			Columns: []string{gameresult.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gru.mutation.WinnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gameresult.WinnerTable,
+			Columns: []string{gameresult.WinnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gru.mutation.WinnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gameresult.WinnerTable,
+			Columns: []string{gameresult.WinnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gameresult.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// GameResultUpdateOne is the builder for updating a single GameResult entity.
+type GameResultUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *GameResultMutation
+}
+
+// SetHomeScore sets the "homeScore" field.
+func (gruo *GameResultUpdateOne) SetHomeScore(i int) *GameResultUpdateOne {
+	gruo.mutation.ResetHomeScore()
+	gruo.mutation.SetHomeScore(i)
+	return gruo
+}
+
+// SetNillableHomeScore sets the "homeScore" field if the given value is not nil.
+func (gruo *GameResultUpdateOne) SetNillableHomeScore(i *int) *GameResultUpdateOne {
+	if i != nil {
+		gruo.SetHomeScore(*i)
+	}
+	return gruo
+}
+
+// AddHomeScore adds i to the "homeScore" field.
+func (gruo *GameResultUpdateOne) AddHomeScore(i int) *GameResultUpdateOne {
+	gruo.mutation.AddHomeScore(i)
+	return gruo
+}
+
+// SetAwayScore sets the "awayScore" field.
+func (gruo *GameResultUpdateOne) SetAwayScore(i int) *GameResultUpdateOne {
+	gruo.mutation.ResetAwayScore()
+	gruo.mutation.SetAwayScore(i)
+	return gruo
+}
+
+// SetNillableAwayScore sets the "awayScore" field if the given value is not nil.
+func (gruo *GameResultUpdateOne) SetNillableAwayScore(i *int) *GameResultUpdateOne {
+	if i != nil {
+		gruo.SetAwayScore(*i)
+	}
+	return gruo
+}
+
+// AddAwayScore adds i to the "awayScore" field.
+func (gruo *GameResultUpdateOne) AddAwayScore(i int) *GameResultUpdateOne {
+	gruo.mutation.AddAwayScore(i)
+	return gruo
+}
+
+// SetGameID sets the "game" edge to the Game entity by ID.
+func (gruo *GameResultUpdateOne) SetGameID(id int) *GameResultUpdateOne {
+	gruo.mutation.SetGameID(id)
+	return gruo
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (gruo *GameResultUpdateOne) SetGame(g *Game) *GameResultUpdateOne {
+	return gruo.SetGameID(g.ID)
+}
+
+// SetWinnerID sets the "winner" edge to the Team entity by ID.
+func (gruo *GameResultUpdateOne) SetWinnerID(id int) *GameResultUpdateOne {
+	gruo.mutation.SetWinnerID(id)
+	return gruo
+}
+
+// SetNillableWinnerID sets the "winner" edge to the Team entity by ID if the given value is not nil.
+func (gruo *GameResultUpdateOne) SetNillableWinnerID(id *int) *GameResultUpdateOne {
+	if id != nil {
+		gruo = gruo.SetWinnerID(*id)
+	}
+	return gruo
+}
+
+// SetWinner sets the "winner" edge to the Team entity.
+func (gruo *GameResultUpdateOne) SetWinner(t *Team) *GameResultUpdateOne {
+	return gruo.SetWinnerID(t.ID)
+}
+
+// Mutation returns the GameResultMutation object of the builder.
+func (gruo *GameResultUpdateOne) Mutation() *GameResultMutation {
+	return gruo.mutation
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (gruo *GameResultUpdateOne) ClearGame() *GameResultUpdateOne {
+	gruo.mutation.ClearGame()
+	return gruo
+}
+
+// ClearWinner clears the "winner" edge to the Team entity.
+func (gruo *GameResultUpdateOne) ClearWinner() *GameResultUpdateOne {
+	gruo.mutation.ClearWinner()
+	return gruo
+}
+
+// Save executes the query and returns the updated GameResult entity.
+func (gruo *GameResultUpdateOne) Save(ctx context.Context) (*GameResult, error) {
+	var (
+		err  error
+		node *GameResult
+	)
+	if len(gruo.hooks) == 0 {
+		if err = gruo.check(); err != nil {
+			return nil, err
+		}
+		node, err = gruo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*GameResultMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = gruo.check(); err != nil {
+				return nil, err
+			}
+			gruo.mutation = mutation
+			node, err = gruo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(gruo.hooks) - 1; i >= 0; i-- {
+			mut = gruo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, gruo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gruo *GameResultUpdateOne) SaveX(ctx context.Context) *GameResult {
+	node, err := gruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (gruo *GameResultUpdateOne) Exec(ctx context.Context) error {
+	_, err := gruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gruo *GameResultUpdateOne) ExecX(ctx context.Context) {
+	if err := gruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gruo *GameResultUpdateOne) check() error {
+	if v, ok := gruo.mutation.HomeScore(); ok {
+		if err := gameresult.HomeScoreValidator(v); err != nil {
+			return &ValidationError{Name: "homeScore", err: fmt.Errorf("db: validator failed for field \"homeScore\": %w", err)}
+		}
+	}
+	if v, ok := gruo.mutation.AwayScore(); ok {
+		if err := gameresult.AwayScoreValidator(v); err != nil {
+			return &ValidationError{Name: "awayScore", err: fmt.Errorf("db: validator failed for field \"awayScore\": %w", err)}
+		}
+	}
+	if _, ok := gruo.mutation.GameID(); gruo.mutation.GameCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"game\"")
+	}
+	return nil
+}
+
+func (gruo *GameResultUpdateOne) sqlSave(ctx context.Context) (_node *GameResult, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   gameresult.Table,
+			Columns: gameresult.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: gameresult.FieldID,
+			},
+		},
+	}
+	id, ok := gruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing GameResult.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := gruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gruo.mutation.HomeScore(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: gameresult.FieldHomeScore,
+		})
+	}
+	if value, ok := gruo.mutation.AddedHomeScore(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: gameresult.FieldHomeScore,
+		})
+	}
+	if value, ok := gruo.mutation.AwayScore(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: gameresult.FieldAwayScore,
+		})
+	}
+	if value, ok := gruo.mutation.AddedAwayScore(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: gameresult.FieldAwayScore,
+		})
+	}
+	if gruo.mutation.GameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gameresult.GameTable,
+			Columns: []string{gameresult.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gruo.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gameresult.GameTable,
+			Columns: []string{gameresult.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gruo.mutation.WinnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gameresult.WinnerTable,
+			Columns: []string{gameresult.WinnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gruo.mutation.WinnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gameresult.WinnerTable,
+			Columns: []string{gameresult.WinnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &GameResult{config: gruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, gruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gameresult.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -9,6 +9,227 @@ import (
 	"github.com/NickDubelman/fantasy-bball/db"
 )
 
+// The AuthCodeFunc type is an adapter to allow the use of ordinary
+// function as AuthCode mutator.
+type AuthCodeFunc func(context.Context, *db.AuthCodeMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuthCodeFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.AuthCodeMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.AuthCodeMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ContestFunc type is an adapter to allow the use of ordinary
+// function as Contest mutator.
+type ContestFunc func(context.Context, *db.ContestMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ContestFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.ContestMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ContestMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ContestDraftFunc type is an adapter to allow the use of ordinary
+// function as ContestDraft mutator.
+type ContestDraftFunc func(context.Context, *db.ContestDraftMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ContestDraftFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.ContestDraftMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ContestDraftMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ContestDraftPickFunc type is an adapter to allow the use of ordinary
+// function as ContestDraftPick mutator.
+type ContestDraftPickFunc func(context.Context, *db.ContestDraftPickMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ContestDraftPickFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.ContestDraftPickMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ContestDraftPickMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ContestEntryFunc type is an adapter to allow the use of ordinary
+// function as ContestEntry mutator.
+type ContestEntryFunc func(context.Context, *db.ContestEntryMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ContestEntryFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.ContestEntryMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ContestEntryMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ContestEntryCorrectionFunc type is an adapter to allow the use of ordinary
+// function as ContestEntryCorrection mutator.
+type ContestEntryCorrectionFunc func(context.Context, *db.ContestEntryCorrectionMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ContestEntryCorrectionFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.ContestEntryCorrectionMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ContestEntryCorrectionMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The GameFunc type is an adapter to allow the use of ordinary
+// function as Game mutator.
+type GameFunc func(context.Context, *db.GameMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GameFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.GameMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.GameMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The GameResultFunc type is an adapter to allow the use of ordinary
+// function as GameResult mutator.
+type GameResultFunc func(context.Context, *db.GameResultMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GameResultFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.GameResultMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.GameResultMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The IdentityFunc type is an adapter to allow the use of ordinary
+// function as Identity mutator.
+type IdentityFunc func(context.Context, *db.IdentityMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IdentityFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.IdentityMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.IdentityMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The IngestionRunFunc type is an adapter to allow the use of ordinary
+// function as IngestionRun mutator.
+type IngestionRunFunc func(context.Context, *db.IngestionRunMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IngestionRunFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.IngestionRunMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.IngestionRunMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The InvitationFunc type is an adapter to allow the use of ordinary
+// function as Invitation mutator.
+type InvitationFunc func(context.Context, *db.InvitationMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InvitationFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.InvitationMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.InvitationMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The LeagueFunc type is an adapter to allow the use of ordinary
+// function as League mutator.
+type LeagueFunc func(context.Context, *db.LeagueMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LeagueFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.LeagueMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.LeagueMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The LeagueMembershipFunc type is an adapter to allow the use of ordinary
+// function as LeagueMembership mutator.
+type LeagueMembershipFunc func(context.Context, *db.LeagueMembershipMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LeagueMembershipFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.LeagueMembershipMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.LeagueMembershipMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The PlayerFunc type is an adapter to allow the use of ordinary
+// function as Player mutator.
+type PlayerFunc func(context.Context, *db.PlayerMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PlayerFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.PlayerMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.PlayerMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The PlayerPerformanceFunc type is an adapter to allow the use of ordinary
+// function as PlayerPerformance mutator.
+type PlayerPerformanceFunc func(context.Context, *db.PlayerPerformanceMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PlayerPerformanceFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.PlayerPerformanceMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.PlayerPerformanceMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The RefreshTokenFunc type is an adapter to allow the use of ordinary
+// function as RefreshToken mutator.
+type RefreshTokenFunc func(context.Context, *db.RefreshTokenMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RefreshTokenFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.RefreshTokenMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.RefreshTokenMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The TeamFunc type is an adapter to allow the use of ordinary
+// function as Team mutator.
+type TeamFunc func(context.Context, *db.TeamMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TeamFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.TeamMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.TeamMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *db.UserMutation) (db.Value, error)
@@ -117,7 +338,6 @@ func HasFields(field string, fields ...string) Condition {
 // If executes the given hook under condition.
 //
 //	hook.If(ComputeAverage, And(HasFields(...), HasAddedFields(...)))
-//
 func If(hk db.Hook, cond Condition) db.Hook {
 	return func(next db.Mutator) db.Mutator {
 		return db.MutateFunc(func(ctx context.Context, m db.Mutation) (db.Value, error) {
@@ -132,7 +352,6 @@ func If(hk db.Hook, cond Condition) db.Hook {
 // On executes the given hook only for the given operation.
 //
 //	hook.On(Log, db.Delete|db.Create)
-//
 func On(hk db.Hook, op db.Op) db.Hook {
 	return If(hk, HasOp(op))
 }
@@ -140,7 +359,6 @@ func On(hk db.Hook, op db.Op) db.Hook {
 // Unless skips the given hook only for the given operation.
 //
 //	hook.Unless(Log, db.Update|db.UpdateOne)
-//
 func Unless(hk db.Hook, op db.Op) db.Hook {
 	return If(hk, Not(HasOp(op)))
 }
@@ -161,7 +379,6 @@ func FixedError(err error) db.Hook {
 //			Reject(db.Delete|db.Update),
 //		}
 //	}
-//
 func Reject(op db.Op) db.Hook {
 	hk := FixedError(fmt.Errorf("%s operation is not allowed", op))
 	return On(hk, op)
@@ -0,0 +1,68 @@
+// Code generated by entc, DO NOT EDIT.
+
+package identity
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the identity type in the database.
+	Label = "identity"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldSubject holds the string denoting the subject field in the database.
+	FieldSubject = "subject"
+	// FieldEmail holds the string denoting the email field in the database.
+	FieldEmail = "email"
+	// FieldCreated holds the string denoting the created field in the database.
+	FieldCreated = "created"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the identity in the database.
+	Table = "identities"
+	// UserTable is the table the holds the user relation/edge.
+	UserTable = "identities"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_identities"
+)
+
+// Columns holds all SQL columns for identity fields.
+var Columns = []string{
+	FieldID,
+	FieldProvider,
+	FieldSubject,
+	FieldEmail,
+	FieldCreated,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "identities"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_identities",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreated holds the default value on creation for the "created" field.
+	DefaultCreated func() time.Time
+)
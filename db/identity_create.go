@@ -0,0 +1,289 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/identity"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+)
+
+// IdentityCreate is the builder for creating a Identity entity.
+type IdentityCreate struct {
+	config
+	mutation *IdentityMutation
+	hooks    []Hook
+}
+
+// SetProvider sets the "provider" field.
+func (ic *IdentityCreate) SetProvider(s string) *IdentityCreate {
+	ic.mutation.SetProvider(s)
+	return ic
+}
+
+// SetSubject sets the "subject" field.
+func (ic *IdentityCreate) SetSubject(s string) *IdentityCreate {
+	ic.mutation.SetSubject(s)
+	return ic
+}
+
+// SetEmail sets the "email" field.
+func (ic *IdentityCreate) SetEmail(s string) *IdentityCreate {
+	ic.mutation.SetEmail(s)
+	return ic
+}
+
+// SetCreated sets the "created" field.
+func (ic *IdentityCreate) SetCreated(t time.Time) *IdentityCreate {
+	ic.mutation.SetCreated(t)
+	return ic
+}
+
+// SetNillableCreated sets the "created" field if the given value is not nil.
+func (ic *IdentityCreate) SetNillableCreated(t *time.Time) *IdentityCreate {
+	if t != nil {
+		ic.SetCreated(*t)
+	}
+	return ic
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ic *IdentityCreate) SetUserID(id int) *IdentityCreate {
+	ic.mutation.SetUserID(id)
+	return ic
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ic *IdentityCreate) SetUser(u *User) *IdentityCreate {
+	return ic.SetUserID(u.ID)
+}
+
+// Mutation returns the IdentityMutation object of the builder.
+func (ic *IdentityCreate) Mutation() *IdentityMutation {
+	return ic.mutation
+}
+
+// Save creates the Identity in the database.
+func (ic *IdentityCreate) Save(ctx context.Context) (*Identity, error) {
+	var (
+		err  error
+		node *Identity
+	)
+	ic.defaults()
+	if len(ic.hooks) == 0 {
+		if err = ic.check(); err != nil {
+			return nil, err
+		}
+		node, err = ic.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IdentityMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ic.check(); err != nil {
+				return nil, err
+			}
+			ic.mutation = mutation
+			node, err = ic.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ic.hooks) - 1; i >= 0; i-- {
+			mut = ic.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ic.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *IdentityCreate) SaveX(ctx context.Context) *Identity {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *IdentityCreate) defaults() {
+	if _, ok := ic.mutation.Created(); !ok {
+		v := identity.DefaultCreated()
+		ic.mutation.SetCreated(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *IdentityCreate) check() error {
+	if _, ok := ic.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New("db: missing required field \"provider\"")}
+	}
+	if _, ok := ic.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New("db: missing required field \"subject\"")}
+	}
+	if _, ok := ic.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New("db: missing required field \"email\"")}
+	}
+	if _, ok := ic.mutation.Created(); !ok {
+		return &ValidationError{Name: "created", err: errors.New("db: missing required field \"created\"")}
+	}
+	if _, ok := ic.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New("db: missing required edge \"user\"")}
+	}
+	return nil
+}
+
+func (ic *IdentityCreate) sqlSave(ctx context.Context) (*Identity, error) {
+	_node, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (ic *IdentityCreate) createSpec() (*Identity, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Identity{config: ic.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: identity.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: identity.FieldID,
+			},
+		}
+	)
+	if value, ok := ic.mutation.Provider(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: identity.FieldProvider,
+		})
+		_node.Provider = value
+	}
+	if value, ok := ic.mutation.Subject(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: identity.FieldSubject,
+		})
+		_node.Subject = value
+	}
+	if value, ok := ic.mutation.Email(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: identity.FieldEmail,
+		})
+		_node.Email = value
+	}
+	if value, ok := ic.mutation.Created(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: identity.FieldCreated,
+		})
+		_node.Created = value
+	}
+	if nodes := ic.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   identity.UserTable,
+			Columns: []string{identity.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_identities = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// IdentityCreateBulk is the builder for creating many Identity entities in bulk.
+type IdentityCreateBulk struct {
+	config
+	builders []*IdentityCreate
+}
+
+// Save creates the Identity entities in the database.
+func (icb *IdentityCreateBulk) Save(ctx context.Context) ([]*Identity, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Identity, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IdentityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *IdentityCreateBulk) SaveX(ctx context.Context) []*Identity {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,52 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ingestionrun
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the ingestionrun type in the database.
+	Label = "ingestion_run"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStarted holds the string denoting the started field in the database.
+	FieldStarted = "started"
+	// FieldFinished holds the string denoting the finished field in the database.
+	FieldFinished = "finished"
+	// FieldRowsChanged holds the string denoting the rowschanged field in the database.
+	FieldRowsChanged = "rows_changed"
+	// FieldErrors holds the string denoting the errors field in the database.
+	FieldErrors = "errors"
+	// Table holds the table name of the ingestionrun in the database.
+	Table = "ingestion_runs"
+)
+
+// Columns holds all SQL columns for ingestionrun fields.
+var Columns = []string{
+	FieldID,
+	FieldStarted,
+	FieldFinished,
+	FieldRowsChanged,
+	FieldErrors,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStarted holds the default value on creation for the "started" field.
+	DefaultStarted func() time.Time
+	// DefaultRowsChanged holds the default value on creation for the "rowsChanged" field.
+	DefaultRowsChanged int
+	// RowsChangedValidator is a validator for the "rowsChanged" field. It is called by the builders before save.
+	RowsChangedValidator func(int) error
+)
@@ -0,0 +1,402 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ingestionrun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Started applies equality check predicate on the "started" field. It's identical to StartedEQ.
+func Started(v time.Time) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStarted), v))
+	})
+}
+
+// Finished applies equality check predicate on the "finished" field. It's identical to FinishedEQ.
+func Finished(v time.Time) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFinished), v))
+	})
+}
+
+// RowsChanged applies equality check predicate on the "rowsChanged" field. It's identical to RowsChangedEQ.
+func RowsChanged(v int) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRowsChanged), v))
+	})
+}
+
+// StartedEQ applies the EQ predicate on the "started" field.
+func StartedEQ(v time.Time) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStarted), v))
+	})
+}
+
+// StartedNEQ applies the NEQ predicate on the "started" field.
+func StartedNEQ(v time.Time) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStarted), v))
+	})
+}
+
+// StartedIn applies the In predicate on the "started" field.
+func StartedIn(vs ...time.Time) predicate.IngestionRun {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStarted), v...))
+	})
+}
+
+// StartedNotIn applies the NotIn predicate on the "started" field.
+func StartedNotIn(vs ...time.Time) predicate.IngestionRun {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStarted), v...))
+	})
+}
+
+// StartedGT applies the GT predicate on the "started" field.
+func StartedGT(v time.Time) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStarted), v))
+	})
+}
+
+// StartedGTE applies the GTE predicate on the "started" field.
+func StartedGTE(v time.Time) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStarted), v))
+	})
+}
+
+// StartedLT applies the LT predicate on the "started" field.
+func StartedLT(v time.Time) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStarted), v))
+	})
+}
+
+// StartedLTE applies the LTE predicate on the "started" field.
+func StartedLTE(v time.Time) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStarted), v))
+	})
+}
+
+// FinishedEQ applies the EQ predicate on the "finished" field.
+func FinishedEQ(v time.Time) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFinished), v))
+	})
+}
+
+// FinishedNEQ applies the NEQ predicate on the "finished" field.
+func FinishedNEQ(v time.Time) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldFinished), v))
+	})
+}
+
+// FinishedIn applies the In predicate on the "finished" field.
+func FinishedIn(vs ...time.Time) predicate.IngestionRun {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldFinished), v...))
+	})
+}
+
+// FinishedNotIn applies the NotIn predicate on the "finished" field.
+func FinishedNotIn(vs ...time.Time) predicate.IngestionRun {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldFinished), v...))
+	})
+}
+
+// FinishedGT applies the GT predicate on the "finished" field.
+func FinishedGT(v time.Time) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldFinished), v))
+	})
+}
+
+// FinishedGTE applies the GTE predicate on the "finished" field.
+func FinishedGTE(v time.Time) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldFinished), v))
+	})
+}
+
+// FinishedLT applies the LT predicate on the "finished" field.
+func FinishedLT(v time.Time) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldFinished), v))
+	})
+}
+
+// FinishedLTE applies the LTE predicate on the "finished" field.
+func FinishedLTE(v time.Time) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldFinished), v))
+	})
+}
+
+// FinishedIsNil applies the IsNil predicate on the "finished" field.
+func FinishedIsNil() predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldFinished)))
+	})
+}
+
+// FinishedNotNil applies the NotNil predicate on the "finished" field.
+func FinishedNotNil() predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldFinished)))
+	})
+}
+
+// RowsChangedEQ applies the EQ predicate on the "rowsChanged" field.
+func RowsChangedEQ(v int) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRowsChanged), v))
+	})
+}
+
+// RowsChangedNEQ applies the NEQ predicate on the "rowsChanged" field.
+func RowsChangedNEQ(v int) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRowsChanged), v))
+	})
+}
+
+// RowsChangedIn applies the In predicate on the "rowsChanged" field.
+func RowsChangedIn(vs ...int) predicate.IngestionRun {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRowsChanged), v...))
+	})
+}
+
+// RowsChangedNotIn applies the NotIn predicate on the "rowsChanged" field.
+func RowsChangedNotIn(vs ...int) predicate.IngestionRun {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRowsChanged), v...))
+	})
+}
+
+// RowsChangedGT applies the GT predicate on the "rowsChanged" field.
+func RowsChangedGT(v int) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRowsChanged), v))
+	})
+}
+
+// RowsChangedGTE applies the GTE predicate on the "rowsChanged" field.
+func RowsChangedGTE(v int) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRowsChanged), v))
+	})
+}
+
+// RowsChangedLT applies the LT predicate on the "rowsChanged" field.
+func RowsChangedLT(v int) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRowsChanged), v))
+	})
+}
+
+// RowsChangedLTE applies the LTE predicate on the "rowsChanged" field.
+func RowsChangedLTE(v int) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRowsChanged), v))
+	})
+}
+
+// ErrorsIsNil applies the IsNil predicate on the "errors" field.
+func ErrorsIsNil() predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldErrors)))
+	})
+}
+
+// ErrorsNotNil applies the NotNil predicate on the "errors" field.
+func ErrorsNotNil() predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldErrors)))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.IngestionRun) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.IngestionRun) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.IngestionRun) predicate.IngestionRun {
+	return predicate.IngestionRun(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
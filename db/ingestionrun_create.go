@@ -0,0 +1,273 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/ingestionrun"
+)
+
+// IngestionRunCreate is the builder for creating a IngestionRun entity.
+type IngestionRunCreate struct {
+	config
+	mutation *IngestionRunMutation
+	hooks    []Hook
+}
+
+// SetStarted sets the "started" field.
+func (irc *IngestionRunCreate) SetStarted(t time.Time) *IngestionRunCreate {
+	irc.mutation.SetStarted(t)
+	return irc
+}
+
+// SetNillableStarted sets the "started" field if the given value is not nil.
+func (irc *IngestionRunCreate) SetNillableStarted(t *time.Time) *IngestionRunCreate {
+	if t != nil {
+		irc.SetStarted(*t)
+	}
+	return irc
+}
+
+// SetFinished sets the "finished" field.
+func (irc *IngestionRunCreate) SetFinished(t time.Time) *IngestionRunCreate {
+	irc.mutation.SetFinished(t)
+	return irc
+}
+
+// SetNillableFinished sets the "finished" field if the given value is not nil.
+func (irc *IngestionRunCreate) SetNillableFinished(t *time.Time) *IngestionRunCreate {
+	if t != nil {
+		irc.SetFinished(*t)
+	}
+	return irc
+}
+
+// SetRowsChanged sets the "rowsChanged" field.
+func (irc *IngestionRunCreate) SetRowsChanged(i int) *IngestionRunCreate {
+	irc.mutation.SetRowsChanged(i)
+	return irc
+}
+
+// SetNillableRowsChanged sets the "rowsChanged" field if the given value is not nil.
+func (irc *IngestionRunCreate) SetNillableRowsChanged(i *int) *IngestionRunCreate {
+	if i != nil {
+		irc.SetRowsChanged(*i)
+	}
+	return irc
+}
+
+// SetErrors sets the "errors" field.
+func (irc *IngestionRunCreate) SetErrors(s []string) *IngestionRunCreate {
+	irc.mutation.SetErrors(s)
+	return irc
+}
+
+// Mutation returns the IngestionRunMutation object of the builder.
+func (irc *IngestionRunCreate) Mutation() *IngestionRunMutation {
+	return irc.mutation
+}
+
+// Save creates the IngestionRun in the database.
+func (irc *IngestionRunCreate) Save(ctx context.Context) (*IngestionRun, error) {
+	var (
+		err  error
+		node *IngestionRun
+	)
+	irc.defaults()
+	if len(irc.hooks) == 0 {
+		if err = irc.check(); err != nil {
+			return nil, err
+		}
+		node, err = irc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IngestionRunMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = irc.check(); err != nil {
+				return nil, err
+			}
+			irc.mutation = mutation
+			node, err = irc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(irc.hooks) - 1; i >= 0; i-- {
+			mut = irc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, irc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (irc *IngestionRunCreate) SaveX(ctx context.Context) *IngestionRun {
+	v, err := irc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (irc *IngestionRunCreate) defaults() {
+	if _, ok := irc.mutation.Started(); !ok {
+		v := ingestionrun.DefaultStarted()
+		irc.mutation.SetStarted(v)
+	}
+	if _, ok := irc.mutation.RowsChanged(); !ok {
+		v := ingestionrun.DefaultRowsChanged
+		irc.mutation.SetRowsChanged(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (irc *IngestionRunCreate) check() error {
+	if _, ok := irc.mutation.Started(); !ok {
+		return &ValidationError{Name: "started", err: errors.New("db: missing required field \"started\"")}
+	}
+	if _, ok := irc.mutation.RowsChanged(); !ok {
+		return &ValidationError{Name: "rowsChanged", err: errors.New("db: missing required field \"rowsChanged\"")}
+	}
+	if v, ok := irc.mutation.RowsChanged(); ok {
+		if err := ingestionrun.RowsChangedValidator(v); err != nil {
+			return &ValidationError{Name: "rowsChanged", err: fmt.Errorf("db: validator failed for field \"rowsChanged\": %w", err)}
+		}
+	}
+	return nil
+}
+
+func (irc *IngestionRunCreate) sqlSave(ctx context.Context) (*IngestionRun, error) {
+	_node, _spec := irc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, irc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (irc *IngestionRunCreate) createSpec() (*IngestionRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &IngestionRun{config: irc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: ingestionrun.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: ingestionrun.FieldID,
+			},
+		}
+	)
+	if value, ok := irc.mutation.Started(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: ingestionrun.FieldStarted,
+		})
+		_node.Started = value
+	}
+	if value, ok := irc.mutation.Finished(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: ingestionrun.FieldFinished,
+		})
+		_node.Finished = &value
+	}
+	if value, ok := irc.mutation.RowsChanged(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ingestionrun.FieldRowsChanged,
+		})
+		_node.RowsChanged = value
+	}
+	if value, ok := irc.mutation.Errors(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: ingestionrun.FieldErrors,
+		})
+		_node.Errors = value
+	}
+	return _node, _spec
+}
+
+// IngestionRunCreateBulk is the builder for creating many IngestionRun entities in bulk.
+type IngestionRunCreateBulk struct {
+	config
+	builders []*IngestionRunCreate
+}
+
+// Save creates the IngestionRun entities in the database.
+func (ircb *IngestionRunCreateBulk) Save(ctx context.Context) ([]*IngestionRun, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ircb.builders))
+	nodes := make([]*IngestionRun, len(ircb.builders))
+	mutators := make([]Mutator, len(ircb.builders))
+	for i := range ircb.builders {
+		func(i int, root context.Context) {
+			builder := ircb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IngestionRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ircb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ircb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ircb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ircb *IngestionRunCreateBulk) SaveX(ctx context.Context) []*IngestionRun {
+	v, err := ircb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
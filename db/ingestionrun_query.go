@@ -0,0 +1,893 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/ingestionrun"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+)
+
+// IngestionRunQuery is the builder for querying IngestionRun entities.
+type IngestionRunQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.IngestionRun
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the IngestionRunQuery builder.
+func (irq *IngestionRunQuery) Where(ps ...predicate.IngestionRun) *IngestionRunQuery {
+	irq.predicates = append(irq.predicates, ps...)
+	return irq
+}
+
+// Limit adds a limit step to the query.
+func (irq *IngestionRunQuery) Limit(limit int) *IngestionRunQuery {
+	irq.limit = &limit
+	return irq
+}
+
+// Offset adds an offset step to the query.
+func (irq *IngestionRunQuery) Offset(offset int) *IngestionRunQuery {
+	irq.offset = &offset
+	return irq
+}
+
+// Order adds an order step to the query.
+func (irq *IngestionRunQuery) Order(o ...OrderFunc) *IngestionRunQuery {
+	irq.order = append(irq.order, o...)
+	return irq
+}
+
+// First returns the first IngestionRun entity from the query.
+// Returns a *NotFoundError when no IngestionRun was found.
+func (irq *IngestionRunQuery) First(ctx context.Context) (*IngestionRun, error) {
+	nodes, err := irq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{ingestionrun.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (irq *IngestionRunQuery) FirstX(ctx context.Context) *IngestionRun {
+	node, err := irq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first IngestionRun ID from the query.
+// Returns a *NotFoundError when no IngestionRun ID was found.
+func (irq *IngestionRunQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = irq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{ingestionrun.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (irq *IngestionRunQuery) FirstIDX(ctx context.Context) int {
+	id, err := irq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single IngestionRun entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one IngestionRun entity is not found.
+// Returns a *NotFoundError when no IngestionRun entities are found.
+func (irq *IngestionRunQuery) Only(ctx context.Context) (*IngestionRun, error) {
+	nodes, err := irq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{ingestionrun.Label}
+	default:
+		return nil, &NotSingularError{ingestionrun.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (irq *IngestionRunQuery) OnlyX(ctx context.Context) *IngestionRun {
+	node, err := irq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only IngestionRun ID in the query.
+// Returns a *NotSingularError when exactly one IngestionRun ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (irq *IngestionRunQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = irq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{ingestionrun.Label}
+	default:
+		err = &NotSingularError{ingestionrun.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (irq *IngestionRunQuery) OnlyIDX(ctx context.Context) int {
+	id, err := irq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of IngestionRuns.
+func (irq *IngestionRunQuery) All(ctx context.Context) ([]*IngestionRun, error) {
+	if err := irq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return irq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (irq *IngestionRunQuery) AllX(ctx context.Context) []*IngestionRun {
+	nodes, err := irq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of IngestionRun IDs.
+func (irq *IngestionRunQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := irq.Select(ingestionrun.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (irq *IngestionRunQuery) IDsX(ctx context.Context) []int {
+	ids, err := irq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (irq *IngestionRunQuery) Count(ctx context.Context) (int, error) {
+	if err := irq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return irq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (irq *IngestionRunQuery) CountX(ctx context.Context) int {
+	count, err := irq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (irq *IngestionRunQuery) Exist(ctx context.Context) (bool, error) {
+	if err := irq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return irq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (irq *IngestionRunQuery) ExistX(ctx context.Context) bool {
+	exist, err := irq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the IngestionRunQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (irq *IngestionRunQuery) Clone() *IngestionRunQuery {
+	if irq == nil {
+		return nil
+	}
+	return &IngestionRunQuery{
+		config:     irq.config,
+		limit:      irq.limit,
+		offset:     irq.offset,
+		order:      append([]OrderFunc{}, irq.order...),
+		predicates: append([]predicate.IngestionRun{}, irq.predicates...),
+		// clone intermediate query.
+		sql:  irq.sql.Clone(),
+		path: irq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Started time.Time `json:"started,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.IngestionRun.Query().
+//		GroupBy(ingestionrun.FieldStarted).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (irq *IngestionRunQuery) GroupBy(field string, fields ...string) *IngestionRunGroupBy {
+	group := &IngestionRunGroupBy{config: irq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := irq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return irq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Started time.Time `json:"started,omitempty"`
+//	}
+//
+//	client.IngestionRun.Query().
+//		Select(ingestionrun.FieldStarted).
+//		Scan(ctx, &v)
+func (irq *IngestionRunQuery) Select(field string, fields ...string) *IngestionRunSelect {
+	irq.fields = append([]string{field}, fields...)
+	return &IngestionRunSelect{IngestionRunQuery: irq}
+}
+
+func (irq *IngestionRunQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range irq.fields {
+		if !ingestionrun.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if irq.path != nil {
+		prev, err := irq.path(ctx)
+		if err != nil {
+			return err
+		}
+		irq.sql = prev
+	}
+	return nil
+}
+
+func (irq *IngestionRunQuery) sqlAll(ctx context.Context) ([]*IngestionRun, error) {
+	var (
+		nodes = []*IngestionRun{}
+		_spec = irq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &IngestionRun{config: irq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("db: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, irq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (irq *IngestionRunQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := irq.querySpec()
+	return sqlgraph.CountNodes(ctx, irq.driver, _spec)
+}
+
+func (irq *IngestionRunQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := irq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("db: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (irq *IngestionRunQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   ingestionrun.Table,
+			Columns: ingestionrun.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: ingestionrun.FieldID,
+			},
+		},
+		From:   irq.sql,
+		Unique: true,
+	}
+	if fields := irq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ingestionrun.FieldID)
+		for i := range fields {
+			if fields[i] != ingestionrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := irq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := irq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := irq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := irq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, ingestionrun.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (irq *IngestionRunQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(irq.driver.Dialect())
+	t1 := builder.Table(ingestionrun.Table)
+	selector := builder.Select(t1.Columns(ingestionrun.Columns...)...).From(t1)
+	if irq.sql != nil {
+		selector = irq.sql
+		selector.Select(selector.Columns(ingestionrun.Columns...)...)
+	}
+	for _, p := range irq.predicates {
+		p(selector)
+	}
+	for _, p := range irq.order {
+		p(selector, ingestionrun.ValidColumn)
+	}
+	if offset := irq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := irq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// IngestionRunGroupBy is the group-by builder for IngestionRun entities.
+type IngestionRunGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (irgb *IngestionRunGroupBy) Aggregate(fns ...AggregateFunc) *IngestionRunGroupBy {
+	irgb.fns = append(irgb.fns, fns...)
+	return irgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (irgb *IngestionRunGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := irgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	irgb.sql = query
+	return irgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (irgb *IngestionRunGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := irgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (irgb *IngestionRunGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(irgb.fields) > 1 {
+		return nil, errors.New("db: IngestionRunGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := irgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (irgb *IngestionRunGroupBy) StringsX(ctx context.Context) []string {
+	v, err := irgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (irgb *IngestionRunGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = irgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ingestionrun.Label}
+	default:
+		err = fmt.Errorf("db: IngestionRunGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (irgb *IngestionRunGroupBy) StringX(ctx context.Context) string {
+	v, err := irgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (irgb *IngestionRunGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(irgb.fields) > 1 {
+		return nil, errors.New("db: IngestionRunGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := irgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (irgb *IngestionRunGroupBy) IntsX(ctx context.Context) []int {
+	v, err := irgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (irgb *IngestionRunGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = irgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ingestionrun.Label}
+	default:
+		err = fmt.Errorf("db: IngestionRunGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (irgb *IngestionRunGroupBy) IntX(ctx context.Context) int {
+	v, err := irgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (irgb *IngestionRunGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(irgb.fields) > 1 {
+		return nil, errors.New("db: IngestionRunGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := irgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (irgb *IngestionRunGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := irgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (irgb *IngestionRunGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = irgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ingestionrun.Label}
+	default:
+		err = fmt.Errorf("db: IngestionRunGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (irgb *IngestionRunGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := irgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (irgb *IngestionRunGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(irgb.fields) > 1 {
+		return nil, errors.New("db: IngestionRunGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := irgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (irgb *IngestionRunGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := irgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (irgb *IngestionRunGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = irgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ingestionrun.Label}
+	default:
+		err = fmt.Errorf("db: IngestionRunGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (irgb *IngestionRunGroupBy) BoolX(ctx context.Context) bool {
+	v, err := irgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (irgb *IngestionRunGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range irgb.fields {
+		if !ingestionrun.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := irgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := irgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (irgb *IngestionRunGroupBy) sqlQuery() *sql.Selector {
+	selector := irgb.sql
+	columns := make([]string, 0, len(irgb.fields)+len(irgb.fns))
+	columns = append(columns, irgb.fields...)
+	for _, fn := range irgb.fns {
+		columns = append(columns, fn(selector, ingestionrun.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(irgb.fields...)
+}
+
+// IngestionRunSelect is the builder for selecting fields of IngestionRun entities.
+type IngestionRunSelect struct {
+	*IngestionRunQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (irs *IngestionRunSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := irs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	irs.sql = irs.IngestionRunQuery.sqlQuery(ctx)
+	return irs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (irs *IngestionRunSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := irs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (irs *IngestionRunSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(irs.fields) > 1 {
+		return nil, errors.New("db: IngestionRunSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := irs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (irs *IngestionRunSelect) StringsX(ctx context.Context) []string {
+	v, err := irs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (irs *IngestionRunSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = irs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ingestionrun.Label}
+	default:
+		err = fmt.Errorf("db: IngestionRunSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (irs *IngestionRunSelect) StringX(ctx context.Context) string {
+	v, err := irs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (irs *IngestionRunSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(irs.fields) > 1 {
+		return nil, errors.New("db: IngestionRunSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := irs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (irs *IngestionRunSelect) IntsX(ctx context.Context) []int {
+	v, err := irs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (irs *IngestionRunSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = irs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ingestionrun.Label}
+	default:
+		err = fmt.Errorf("db: IngestionRunSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (irs *IngestionRunSelect) IntX(ctx context.Context) int {
+	v, err := irs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (irs *IngestionRunSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(irs.fields) > 1 {
+		return nil, errors.New("db: IngestionRunSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := irs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (irs *IngestionRunSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := irs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (irs *IngestionRunSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = irs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ingestionrun.Label}
+	default:
+		err = fmt.Errorf("db: IngestionRunSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (irs *IngestionRunSelect) Float64X(ctx context.Context) float64 {
+	v, err := irs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (irs *IngestionRunSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(irs.fields) > 1 {
+		return nil, errors.New("db: IngestionRunSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := irs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (irs *IngestionRunSelect) BoolsX(ctx context.Context) []bool {
+	v, err := irs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (irs *IngestionRunSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = irs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ingestionrun.Label}
+	default:
+		err = fmt.Errorf("db: IngestionRunSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (irs *IngestionRunSelect) BoolX(ctx context.Context) bool {
+	v, err := irs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (irs *IngestionRunSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := irs.sqlQuery().Query()
+	if err := irs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (irs *IngestionRunSelect) sqlQuery() sql.Querier {
+	selector := irs.sql
+	selector.Select(selector.Columns(irs.fields...)...)
+	return selector
+}
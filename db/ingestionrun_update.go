@@ -0,0 +1,431 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/ingestionrun"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+)
+
+// IngestionRunUpdate is the builder for updating IngestionRun entities.
+type IngestionRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *IngestionRunMutation
+}
+
+// Where adds a new predicate for the IngestionRunUpdate builder.
+func (iru *IngestionRunUpdate) Where(ps ...predicate.IngestionRun) *IngestionRunUpdate {
+	iru.mutation.predicates = append(iru.mutation.predicates, ps...)
+	return iru
+}
+
+// SetFinished sets the "finished" field.
+func (iru *IngestionRunUpdate) SetFinished(t time.Time) *IngestionRunUpdate {
+	iru.mutation.SetFinished(t)
+	return iru
+}
+
+// SetNillableFinished sets the "finished" field if the given value is not nil.
+func (iru *IngestionRunUpdate) SetNillableFinished(t *time.Time) *IngestionRunUpdate {
+	if t != nil {
+		iru.SetFinished(*t)
+	}
+	return iru
+}
+
+// ClearFinished clears the value of the "finished" field.
+func (iru *IngestionRunUpdate) ClearFinished() *IngestionRunUpdate {
+	iru.mutation.ClearFinished()
+	return iru
+}
+
+// SetRowsChanged sets the "rowsChanged" field.
+func (iru *IngestionRunUpdate) SetRowsChanged(i int) *IngestionRunUpdate {
+	iru.mutation.ResetRowsChanged()
+	iru.mutation.SetRowsChanged(i)
+	return iru
+}
+
+// SetNillableRowsChanged sets the "rowsChanged" field if the given value is not nil.
+func (iru *IngestionRunUpdate) SetNillableRowsChanged(i *int) *IngestionRunUpdate {
+	if i != nil {
+		iru.SetRowsChanged(*i)
+	}
+	return iru
+}
+
+// AddRowsChanged adds i to the "rowsChanged" field.
+func (iru *IngestionRunUpdate) AddRowsChanged(i int) *IngestionRunUpdate {
+	iru.mutation.AddRowsChanged(i)
+	return iru
+}
+
+// SetErrors sets the "errors" field.
+func (iru *IngestionRunUpdate) SetErrors(s []string) *IngestionRunUpdate {
+	iru.mutation.SetErrors(s)
+	return iru
+}
+
+// ClearErrors clears the value of the "errors" field.
+func (iru *IngestionRunUpdate) ClearErrors() *IngestionRunUpdate {
+	iru.mutation.ClearErrors()
+	return iru
+}
+
+// Mutation returns the IngestionRunMutation object of the builder.
+func (iru *IngestionRunUpdate) Mutation() *IngestionRunMutation {
+	return iru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iru *IngestionRunUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(iru.hooks) == 0 {
+		if err = iru.check(); err != nil {
+			return 0, err
+		}
+		affected, err = iru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IngestionRunMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = iru.check(); err != nil {
+				return 0, err
+			}
+			iru.mutation = mutation
+			affected, err = iru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(iru.hooks) - 1; i >= 0; i-- {
+			mut = iru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iru *IngestionRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := iru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iru *IngestionRunUpdate) Exec(ctx context.Context) error {
+	_, err := iru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iru *IngestionRunUpdate) ExecX(ctx context.Context) {
+	if err := iru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iru *IngestionRunUpdate) check() error {
+	if v, ok := iru.mutation.RowsChanged(); ok {
+		if err := ingestionrun.RowsChangedValidator(v); err != nil {
+			return &ValidationError{Name: "rowsChanged", err: fmt.Errorf("db: validator failed for field \"rowsChanged\": %w", err)}
+		}
+	}
+	return nil
+}
+
+func (iru *IngestionRunUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   ingestionrun.Table,
+			Columns: ingestionrun.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: ingestionrun.FieldID,
+			},
+		},
+	}
+	if ps := iru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iru.mutation.Finished(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: ingestionrun.FieldFinished,
+		})
+	}
+	if iru.mutation.FinishedCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: ingestionrun.FieldFinished,
+		})
+	}
+	if value, ok := iru.mutation.RowsChanged(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ingestionrun.FieldRowsChanged,
+		})
+	}
+	if value, ok := iru.mutation.AddedRowsChanged(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ingestionrun.FieldRowsChanged,
+		})
+	}
+	if value, ok := iru.mutation.Errors(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: ingestionrun.FieldErrors,
+		})
+	}
+	if iru.mutation.ErrorsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: ingestionrun.FieldErrors,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ingestionrun.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// IngestionRunUpdateOne is the builder for updating a single IngestionRun entity.
+type IngestionRunUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *IngestionRunMutation
+}
+
+// SetFinished sets the "finished" field.
+func (iruo *IngestionRunUpdateOne) SetFinished(t time.Time) *IngestionRunUpdateOne {
+	iruo.mutation.SetFinished(t)
+	return iruo
+}
+
+// SetNillableFinished sets the "finished" field if the given value is not nil.
+func (iruo *IngestionRunUpdateOne) SetNillableFinished(t *time.Time) *IngestionRunUpdateOne {
+	if t != nil {
+		iruo.SetFinished(*t)
+	}
+	return iruo
+}
+
+// ClearFinished clears the value of the "finished" field.
+func (iruo *IngestionRunUpdateOne) ClearFinished() *IngestionRunUpdateOne {
+	iruo.mutation.ClearFinished()
+	return iruo
+}
+
+// SetRowsChanged sets the "rowsChanged" field.
+func (iruo *IngestionRunUpdateOne) SetRowsChanged(i int) *IngestionRunUpdateOne {
+	iruo.mutation.ResetRowsChanged()
+	iruo.mutation.SetRowsChanged(i)
+	return iruo
+}
+
+// SetNillableRowsChanged sets the "rowsChanged" field if the given value is not nil.
+func (iruo *IngestionRunUpdateOne) SetNillableRowsChanged(i *int) *IngestionRunUpdateOne {
+	if i != nil {
+		iruo.SetRowsChanged(*i)
+	}
+	return iruo
+}
+
+// AddRowsChanged adds i to the "rowsChanged" field.
+func (iruo *IngestionRunUpdateOne) AddRowsChanged(i int) *IngestionRunUpdateOne {
+	iruo.mutation.AddRowsChanged(i)
+	return iruo
+}
+
+// SetErrors sets the "errors" field.
+func (iruo *IngestionRunUpdateOne) SetErrors(s []string) *IngestionRunUpdateOne {
+	iruo.mutation.SetErrors(s)
+	return iruo
+}
+
+// ClearErrors clears the value of the "errors" field.
+func (iruo *IngestionRunUpdateOne) ClearErrors() *IngestionRunUpdateOne {
+	iruo.mutation.ClearErrors()
+	return iruo
+}
+
+// Mutation returns the IngestionRunMutation object of the builder.
+func (iruo *IngestionRunUpdateOne) Mutation() *IngestionRunMutation {
+	return iruo.mutation
+}
+
+// Save executes the query and returns the updated IngestionRun entity.
+func (iruo *IngestionRunUpdateOne) Save(ctx context.Context) (*IngestionRun, error) {
+	var (
+		err  error
+		node *IngestionRun
+	)
+	if len(iruo.hooks) == 0 {
+		if err = iruo.check(); err != nil {
+			return nil, err
+		}
+		node, err = iruo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IngestionRunMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = iruo.check(); err != nil {
+				return nil, err
+			}
+			iruo.mutation = mutation
+			node, err = iruo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(iruo.hooks) - 1; i >= 0; i-- {
+			mut = iruo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iruo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iruo *IngestionRunUpdateOne) SaveX(ctx context.Context) *IngestionRun {
+	node, err := iruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iruo *IngestionRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := iruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iruo *IngestionRunUpdateOne) ExecX(ctx context.Context) {
+	if err := iruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iruo *IngestionRunUpdateOne) check() error {
+	if v, ok := iruo.mutation.RowsChanged(); ok {
+		if err := ingestionrun.RowsChangedValidator(v); err != nil {
+			return &ValidationError{Name: "rowsChanged", err: fmt.Errorf("db: validator failed for field \"rowsChanged\": %w", err)}
+		}
+	}
+	return nil
+}
+
+func (iruo *IngestionRunUpdateOne) sqlSave(ctx context.Context) (_node *IngestionRun, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   ingestionrun.Table,
+			Columns: ingestionrun.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: ingestionrun.FieldID,
+			},
+		},
+	}
+	id, ok := iruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing IngestionRun.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := iruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iruo.mutation.Finished(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: ingestionrun.FieldFinished,
+		})
+	}
+	if iruo.mutation.FinishedCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: ingestionrun.FieldFinished,
+		})
+	}
+	if value, ok := iruo.mutation.RowsChanged(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ingestionrun.FieldRowsChanged,
+		})
+	}
+	if value, ok := iruo.mutation.AddedRowsChanged(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ingestionrun.FieldRowsChanged,
+		})
+	}
+	if value, ok := iruo.mutation.Errors(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: ingestionrun.FieldErrors,
+		})
+	}
+	if iruo.mutation.ErrorsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: ingestionrun.FieldErrors,
+		})
+	}
+	_node = &IngestionRun{config: iruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ingestionrun.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
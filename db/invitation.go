@@ -0,0 +1,219 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/NickDubelman/fantasy-bball/db/invitation"
+	"github.com/NickDubelman/fantasy-bball/db/league"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+)
+
+// Invitation is the model entity for the Invitation schema.
+type Invitation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email *string `json:"email,omitempty"`
+	// TokenHash holds the value of the "tokenHash" field.
+	TokenHash *string `json:"-"`
+	// Status holds the value of the "status" field.
+	Status invitation.Status `json:"status,omitempty"`
+	// Created holds the value of the "created" field.
+	Created time.Time `json:"created,omitempty"`
+	// Expires holds the value of the "expires" field.
+	Expires time.Time `json:"expires,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InvitationQuery when eager-loading is set.
+	Edges                 InvitationEdges `json:"edges"`
+	league_invitations    *int
+	user_sent_invitations *int
+}
+
+// InvitationEdges holds the relations/edges for other nodes in the graph.
+type InvitationEdges struct {
+	// League holds the value of the league edge.
+	League *League `json:"league,omitempty"`
+	// Inviter holds the value of the inviter edge.
+	Inviter *User `json:"inviter,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// LeagueOrErr returns the League value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvitationEdges) LeagueOrErr() (*League, error) {
+	if e.loadedTypes[0] {
+		if e.League == nil {
+			// The edge league was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: league.Label}
+		}
+		return e.League, nil
+	}
+	return nil, &NotLoadedError{edge: "league"}
+}
+
+// InviterOrErr returns the Inviter value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvitationEdges) InviterOrErr() (*User, error) {
+	if e.loadedTypes[1] {
+		if e.Inviter == nil {
+			// The edge inviter was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Inviter, nil
+	}
+	return nil, &NotLoadedError{edge: "inviter"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Invitation) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invitation.FieldID:
+			values[i] = &sql.NullInt64{}
+		case invitation.FieldEmail, invitation.FieldTokenHash, invitation.FieldStatus:
+			values[i] = &sql.NullString{}
+		case invitation.FieldCreated, invitation.FieldExpires:
+			values[i] = &sql.NullTime{}
+		case invitation.ForeignKeys[0]: // league_invitations
+			values[i] = &sql.NullInt64{}
+		case invitation.ForeignKeys[1]: // user_sent_invitations
+			values[i] = &sql.NullInt64{}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Invitation", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Invitation fields.
+func (i *Invitation) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for j := range columns {
+		switch columns[j] {
+		case invitation.FieldID:
+			value, ok := values[j].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			i.ID = int(value.Int64)
+		case invitation.FieldEmail:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[j])
+			} else if value.Valid {
+				i.Email = new(string)
+				*i.Email = value.String
+			}
+		case invitation.FieldTokenHash:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tokenHash", values[j])
+			} else if value.Valid {
+				i.TokenHash = new(string)
+				*i.TokenHash = value.String
+			}
+		case invitation.FieldStatus:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[j])
+			} else if value.Valid {
+				i.Status = invitation.Status(value.String)
+			}
+		case invitation.FieldCreated:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created", values[j])
+			} else if value.Valid {
+				i.Created = value.Time
+			}
+		case invitation.FieldExpires:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires", values[j])
+			} else if value.Valid {
+				i.Expires = value.Time
+			}
+		case invitation.ForeignKeys[0]:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field league_invitations", value)
+			} else if value.Valid {
+				i.league_invitations = new(int)
+				*i.league_invitations = int(value.Int64)
+			}
+		case invitation.ForeignKeys[1]:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_sent_invitations", value)
+			} else if value.Valid {
+				i.user_sent_invitations = new(int)
+				*i.user_sent_invitations = int(value.Int64)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryLeague queries the "league" edge of the Invitation entity.
+func (i *Invitation) QueryLeague() *LeagueQuery {
+	return (&InvitationClient{config: i.config}).QueryLeague(i)
+}
+
+// QueryInviter queries the "inviter" edge of the Invitation entity.
+func (i *Invitation) QueryInviter() *UserQuery {
+	return (&InvitationClient{config: i.config}).QueryInviter(i)
+}
+
+// Update returns a builder for updating this Invitation.
+// Note that you need to call Invitation.Unwrap() before calling this method if this Invitation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (i *Invitation) Update() *InvitationUpdateOne {
+	return (&InvitationClient{config: i.config}).UpdateOne(i)
+}
+
+// Unwrap unwraps the Invitation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (i *Invitation) Unwrap() *Invitation {
+	tx, ok := i.config.driver.(*txDriver)
+	if !ok {
+		panic("db: Invitation is not a transactional entity")
+	}
+	i.config.driver = tx.drv
+	return i
+}
+
+// String implements the fmt.Stringer.
+func (i *Invitation) String() string {
+	var builder strings.Builder
+	builder.WriteString("Invitation(")
+	builder.WriteString(fmt.Sprintf("id=%v", i.ID))
+	if v := i.Email; v != nil {
+		builder.WriteString(", email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", tokenHash=<sensitive>")
+	builder.WriteString(", status=")
+	builder.WriteString(fmt.Sprintf("%v", i.Status))
+	builder.WriteString(", created=")
+	builder.WriteString(i.Created.Format(time.ANSIC))
+	builder.WriteString(", expires=")
+	builder.WriteString(i.Expires.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Invitations is a parsable slice of Invitation.
+type Invitations []*Invitation
+
+func (i Invitations) config(cfg config) {
+	for _i := range i {
+		i[_i].config = cfg
+	}
+}
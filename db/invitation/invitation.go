@@ -0,0 +1,110 @@
+// Code generated by entc, DO NOT EDIT.
+
+package invitation
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the invitation type in the database.
+	Label = "invitation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEmail holds the string denoting the email field in the database.
+	FieldEmail = "email"
+	// FieldTokenHash holds the string denoting the tokenhash field in the database.
+	FieldTokenHash = "token_hash"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreated holds the string denoting the created field in the database.
+	FieldCreated = "created"
+	// FieldExpires holds the string denoting the expires field in the database.
+	FieldExpires = "expires"
+	// EdgeLeague holds the string denoting the league edge name in mutations.
+	EdgeLeague = "league"
+	// EdgeInviter holds the string denoting the inviter edge name in mutations.
+	EdgeInviter = "inviter"
+	// Table holds the table name of the invitation in the database.
+	Table = "invitations"
+	// LeagueTable is the table the holds the league relation/edge.
+	LeagueTable = "invitations"
+	// LeagueInverseTable is the table name for the League entity.
+	// It exists in this package in order to avoid circular dependency with the "league" package.
+	LeagueInverseTable = "leagues"
+	// LeagueColumn is the table column denoting the league relation/edge.
+	LeagueColumn = "league_invitations"
+	// InviterTable is the table the holds the inviter relation/edge.
+	InviterTable = "invitations"
+	// InviterInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	InviterInverseTable = "users"
+	// InviterColumn is the table column denoting the inviter relation/edge.
+	InviterColumn = "user_sent_invitations"
+)
+
+// Columns holds all SQL columns for invitation fields.
+var Columns = []string{
+	FieldID,
+	FieldEmail,
+	FieldTokenHash,
+	FieldStatus,
+	FieldCreated,
+	FieldExpires,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "invitations"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"league_invitations",
+	"user_sent_invitations",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreated holds the default value on creation for the "created" field.
+	DefaultCreated func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPENDING is the default value of the Status enum.
+const DefaultStatus = StatusPENDING
+
+// Status values.
+const (
+	StatusPENDING  Status = "PENDING"
+	StatusACCEPTED Status = "ACCEPTED"
+	StatusDECLINED Status = "DECLINED"
+	StatusREVOKED  Status = "REVOKED"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPENDING, StatusACCEPTED, StatusDECLINED, StatusREVOKED:
+		return nil
+	default:
+		return fmt.Errorf("invitation: invalid enum value for status field: %q", s)
+	}
+}
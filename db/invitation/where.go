@@ -0,0 +1,660 @@
+// Code generated by entc, DO NOT EDIT.
+
+package invitation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEmail), v))
+	})
+}
+
+// TokenHash applies equality check predicate on the "tokenHash" field. It's identical to TokenHashEQ.
+func TokenHash(v string) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTokenHash), v))
+	})
+}
+
+// Created applies equality check predicate on the "created" field. It's identical to CreatedEQ.
+func Created(v time.Time) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreated), v))
+	})
+}
+
+// Expires applies equality check predicate on the "expires" field. It's identical to ExpiresEQ.
+func Expires(v time.Time) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpires), v))
+	})
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEmail), v))
+	})
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEmail), v))
+	})
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.Invitation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invitation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEmail), v...))
+	})
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.Invitation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invitation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEmail), v...))
+	})
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEmail), v))
+	})
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEmail), v))
+	})
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEmail), v))
+	})
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEmail), v))
+	})
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldEmail), v))
+	})
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldEmail), v))
+	})
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldEmail), v))
+	})
+}
+
+// EmailIsNil applies the IsNil predicate on the "email" field.
+func EmailIsNil() predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEmail)))
+	})
+}
+
+// EmailNotNil applies the NotNil predicate on the "email" field.
+func EmailNotNil() predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEmail)))
+	})
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldEmail), v))
+	})
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldEmail), v))
+	})
+}
+
+// TokenHashEQ applies the EQ predicate on the "tokenHash" field.
+func TokenHashEQ(v string) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashNEQ applies the NEQ predicate on the "tokenHash" field.
+func TokenHashNEQ(v string) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashIn applies the In predicate on the "tokenHash" field.
+func TokenHashIn(vs ...string) predicate.Invitation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invitation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTokenHash), v...))
+	})
+}
+
+// TokenHashNotIn applies the NotIn predicate on the "tokenHash" field.
+func TokenHashNotIn(vs ...string) predicate.Invitation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invitation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTokenHash), v...))
+	})
+}
+
+// TokenHashGT applies the GT predicate on the "tokenHash" field.
+func TokenHashGT(v string) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashGTE applies the GTE predicate on the "tokenHash" field.
+func TokenHashGTE(v string) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashLT applies the LT predicate on the "tokenHash" field.
+func TokenHashLT(v string) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashLTE applies the LTE predicate on the "tokenHash" field.
+func TokenHashLTE(v string) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashContains applies the Contains predicate on the "tokenHash" field.
+func TokenHashContains(v string) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashHasPrefix applies the HasPrefix predicate on the "tokenHash" field.
+func TokenHashHasPrefix(v string) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashHasSuffix applies the HasSuffix predicate on the "tokenHash" field.
+func TokenHashHasSuffix(v string) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashIsNil applies the IsNil predicate on the "tokenHash" field.
+func TokenHashIsNil() predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTokenHash)))
+	})
+}
+
+// TokenHashNotNil applies the NotNil predicate on the "tokenHash" field.
+func TokenHashNotNil() predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTokenHash)))
+	})
+}
+
+// TokenHashEqualFold applies the EqualFold predicate on the "tokenHash" field.
+func TokenHashEqualFold(v string) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashContainsFold applies the ContainsFold predicate on the "tokenHash" field.
+func TokenHashContainsFold(v string) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTokenHash), v))
+	})
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Invitation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invitation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Invitation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invitation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStatus), v...))
+	})
+}
+
+// CreatedEQ applies the EQ predicate on the "created" field.
+func CreatedEQ(v time.Time) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedNEQ applies the NEQ predicate on the "created" field.
+func CreatedNEQ(v time.Time) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedIn applies the In predicate on the "created" field.
+func CreatedIn(vs ...time.Time) predicate.Invitation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invitation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreated), v...))
+	})
+}
+
+// CreatedNotIn applies the NotIn predicate on the "created" field.
+func CreatedNotIn(vs ...time.Time) predicate.Invitation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invitation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreated), v...))
+	})
+}
+
+// CreatedGT applies the GT predicate on the "created" field.
+func CreatedGT(v time.Time) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedGTE applies the GTE predicate on the "created" field.
+func CreatedGTE(v time.Time) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedLT applies the LT predicate on the "created" field.
+func CreatedLT(v time.Time) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedLTE applies the LTE predicate on the "created" field.
+func CreatedLTE(v time.Time) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreated), v))
+	})
+}
+
+// ExpiresEQ applies the EQ predicate on the "expires" field.
+func ExpiresEQ(v time.Time) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresNEQ applies the NEQ predicate on the "expires" field.
+func ExpiresNEQ(v time.Time) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresIn applies the In predicate on the "expires" field.
+func ExpiresIn(vs ...time.Time) predicate.Invitation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invitation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExpires), v...))
+	})
+}
+
+// ExpiresNotIn applies the NotIn predicate on the "expires" field.
+func ExpiresNotIn(vs ...time.Time) predicate.Invitation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invitation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExpires), v...))
+	})
+}
+
+// ExpiresGT applies the GT predicate on the "expires" field.
+func ExpiresGT(v time.Time) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresGTE applies the GTE predicate on the "expires" field.
+func ExpiresGTE(v time.Time) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresLT applies the LT predicate on the "expires" field.
+func ExpiresLT(v time.Time) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresLTE applies the LTE predicate on the "expires" field.
+func ExpiresLTE(v time.Time) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExpires), v))
+	})
+}
+
+// HasLeague applies the HasEdge predicate on the "league" edge.
+func HasLeague() predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(LeagueTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LeagueTable, LeagueColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLeagueWith applies the HasEdge predicate on the "league" edge with a given conditions (other predicates).
+func HasLeagueWith(preds ...predicate.League) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(LeagueInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LeagueTable, LeagueColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInviter applies the HasEdge predicate on the "inviter" edge.
+func HasInviter() predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(InviterTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, InviterTable, InviterColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInviterWith applies the HasEdge predicate on the "inviter" edge with a given conditions (other predicates).
+func HasInviterWith(preds ...predicate.User) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(InviterInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, InviterTable, InviterColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Invitation) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Invitation) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Invitation) predicate.Invitation {
+	return predicate.Invitation(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,368 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/invitation"
+	"github.com/NickDubelman/fantasy-bball/db/league"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+)
+
+// InvitationCreate is the builder for creating a Invitation entity.
+type InvitationCreate struct {
+	config
+	mutation *InvitationMutation
+	hooks    []Hook
+}
+
+// SetEmail sets the "email" field.
+func (ic *InvitationCreate) SetEmail(s string) *InvitationCreate {
+	ic.mutation.SetEmail(s)
+	return ic
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableEmail(s *string) *InvitationCreate {
+	if s != nil {
+		ic.SetEmail(*s)
+	}
+	return ic
+}
+
+// SetTokenHash sets the "tokenHash" field.
+func (ic *InvitationCreate) SetTokenHash(s string) *InvitationCreate {
+	ic.mutation.SetTokenHash(s)
+	return ic
+}
+
+// SetNillableTokenHash sets the "tokenHash" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableTokenHash(s *string) *InvitationCreate {
+	if s != nil {
+		ic.SetTokenHash(*s)
+	}
+	return ic
+}
+
+// SetStatus sets the "status" field.
+func (ic *InvitationCreate) SetStatus(i invitation.Status) *InvitationCreate {
+	ic.mutation.SetStatus(i)
+	return ic
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableStatus(i *invitation.Status) *InvitationCreate {
+	if i != nil {
+		ic.SetStatus(*i)
+	}
+	return ic
+}
+
+// SetCreated sets the "created" field.
+func (ic *InvitationCreate) SetCreated(t time.Time) *InvitationCreate {
+	ic.mutation.SetCreated(t)
+	return ic
+}
+
+// SetNillableCreated sets the "created" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableCreated(t *time.Time) *InvitationCreate {
+	if t != nil {
+		ic.SetCreated(*t)
+	}
+	return ic
+}
+
+// SetExpires sets the "expires" field.
+func (ic *InvitationCreate) SetExpires(t time.Time) *InvitationCreate {
+	ic.mutation.SetExpires(t)
+	return ic
+}
+
+// SetLeagueID sets the "league" edge to the League entity by ID.
+func (ic *InvitationCreate) SetLeagueID(id int) *InvitationCreate {
+	ic.mutation.SetLeagueID(id)
+	return ic
+}
+
+// SetLeague sets the "league" edge to the League entity.
+func (ic *InvitationCreate) SetLeague(l *League) *InvitationCreate {
+	return ic.SetLeagueID(l.ID)
+}
+
+// SetInviterID sets the "inviter" edge to the User entity by ID.
+func (ic *InvitationCreate) SetInviterID(id int) *InvitationCreate {
+	ic.mutation.SetInviterID(id)
+	return ic
+}
+
+// SetInviter sets the "inviter" edge to the User entity.
+func (ic *InvitationCreate) SetInviter(u *User) *InvitationCreate {
+	return ic.SetInviterID(u.ID)
+}
+
+// Mutation returns the InvitationMutation object of the builder.
+func (ic *InvitationCreate) Mutation() *InvitationMutation {
+	return ic.mutation
+}
+
+// Save creates the Invitation in the database.
+func (ic *InvitationCreate) Save(ctx context.Context) (*Invitation, error) {
+	var (
+		err  error
+		node *Invitation
+	)
+	ic.defaults()
+	if len(ic.hooks) == 0 {
+		if err = ic.check(); err != nil {
+			return nil, err
+		}
+		node, err = ic.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvitationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ic.check(); err != nil {
+				return nil, err
+			}
+			ic.mutation = mutation
+			node, err = ic.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ic.hooks) - 1; i >= 0; i-- {
+			mut = ic.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ic.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *InvitationCreate) SaveX(ctx context.Context) *Invitation {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *InvitationCreate) defaults() {
+	if _, ok := ic.mutation.Status(); !ok {
+		v := invitation.DefaultStatus
+		ic.mutation.SetStatus(v)
+	}
+	if _, ok := ic.mutation.Created(); !ok {
+		v := invitation.DefaultCreated()
+		ic.mutation.SetCreated(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *InvitationCreate) check() error {
+	if _, ok := ic.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New("db: missing required field \"status\"")}
+	}
+	if v, ok := ic.mutation.Status(); ok {
+		if err := invitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf("db: validator failed for field \"status\": %w", err)}
+		}
+	}
+	if _, ok := ic.mutation.Created(); !ok {
+		return &ValidationError{Name: "created", err: errors.New("db: missing required field \"created\"")}
+	}
+	if _, ok := ic.mutation.Expires(); !ok {
+		return &ValidationError{Name: "expires", err: errors.New("db: missing required field \"expires\"")}
+	}
+	if _, ok := ic.mutation.LeagueID(); !ok {
+		return &ValidationError{Name: "league", err: errors.New("db: missing required edge \"league\"")}
+	}
+	if _, ok := ic.mutation.InviterID(); !ok {
+		return &ValidationError{Name: "inviter", err: errors.New("db: missing required edge \"inviter\"")}
+	}
+	return nil
+}
+
+func (ic *InvitationCreate) sqlSave(ctx context.Context) (*Invitation, error) {
+	_node, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (ic *InvitationCreate) createSpec() (*Invitation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Invitation{config: ic.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: invitation.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invitation.FieldID,
+			},
+		}
+	)
+	if value, ok := ic.mutation.Email(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invitation.FieldEmail,
+		})
+		_node.Email = &value
+	}
+	if value, ok := ic.mutation.TokenHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invitation.FieldTokenHash,
+		})
+		_node.TokenHash = &value
+	}
+	if value, ok := ic.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: invitation.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := ic.mutation.Created(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invitation.FieldCreated,
+		})
+		_node.Created = value
+	}
+	if value, ok := ic.mutation.Expires(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invitation.FieldExpires,
+		})
+		_node.Expires = value
+	}
+	if nodes := ic.mutation.LeagueIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.LeagueTable,
+			Columns: []string{invitation.LeagueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: league.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.league_invitations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.InviterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.InviterTable,
+			Columns: []string{invitation.InviterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_sent_invitations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// InvitationCreateBulk is the builder for creating many Invitation entities in bulk.
+type InvitationCreateBulk struct {
+	config
+	builders []*InvitationCreate
+}
+
+// Save creates the Invitation entities in the database.
+func (icb *InvitationCreateBulk) Save(ctx context.Context) ([]*Invitation, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Invitation, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvitationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *InvitationCreateBulk) SaveX(ctx context.Context) []*Invitation {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
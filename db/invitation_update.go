@@ -0,0 +1,533 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/invitation"
+	"github.com/NickDubelman/fantasy-bball/db/league"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+)
+
+// InvitationUpdate is the builder for updating Invitation entities.
+type InvitationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvitationMutation
+}
+
+// Where adds a new predicate for the InvitationUpdate builder.
+func (iu *InvitationUpdate) Where(ps ...predicate.Invitation) *InvitationUpdate {
+	iu.mutation.predicates = append(iu.mutation.predicates, ps...)
+	return iu
+}
+
+// SetStatus sets the "status" field.
+func (iu *InvitationUpdate) SetStatus(i invitation.Status) *InvitationUpdate {
+	iu.mutation.SetStatus(i)
+	return iu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableStatus(i *invitation.Status) *InvitationUpdate {
+	if i != nil {
+		iu.SetStatus(*i)
+	}
+	return iu
+}
+
+// SetLeagueID sets the "league" edge to the League entity by ID.
+func (iu *InvitationUpdate) SetLeagueID(id int) *InvitationUpdate {
+	iu.mutation.SetLeagueID(id)
+	return iu
+}
+
+// SetLeague sets the "league" edge to the League entity.
+func (iu *InvitationUpdate) SetLeague(l *League) *InvitationUpdate {
+	return iu.SetLeagueID(l.ID)
+}
+
+// SetInviterID sets the "inviter" edge to the User entity by ID.
+func (iu *InvitationUpdate) SetInviterID(id int) *InvitationUpdate {
+	iu.mutation.SetInviterID(id)
+	return iu
+}
+
+// SetInviter sets the "inviter" edge to the User entity.
+func (iu *InvitationUpdate) SetInviter(u *User) *InvitationUpdate {
+	return iu.SetInviterID(u.ID)
+}
+
+// Mutation returns the InvitationMutation object of the builder.
+func (iu *InvitationUpdate) Mutation() *InvitationMutation {
+	return iu.mutation
+}
+
+// ClearLeague clears the "league" edge to the League entity.
+func (iu *InvitationUpdate) ClearLeague() *InvitationUpdate {
+	iu.mutation.ClearLeague()
+	return iu
+}
+
+// ClearInviter clears the "inviter" edge to the User entity.
+func (iu *InvitationUpdate) ClearInviter() *InvitationUpdate {
+	iu.mutation.ClearInviter()
+	return iu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iu *InvitationUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(iu.hooks) == 0 {
+		if err = iu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = iu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvitationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = iu.check(); err != nil {
+				return 0, err
+			}
+			iu.mutation = mutation
+			affected, err = iu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(iu.hooks) - 1; i >= 0; i-- {
+			mut = iu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *InvitationUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *InvitationUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *InvitationUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iu *InvitationUpdate) check() error {
+	if v, ok := iu.mutation.Status(); ok {
+		if err := invitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf("db: validator failed for field \"status\": %w", err)}
+		}
+	}
+	if _, ok := iu.mutation.LeagueID(); iu.mutation.LeagueCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"league\"")
+	}
+	if _, ok := iu.mutation.InviterID(); iu.mutation.InviterCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"inviter\"")
+	}
+	return nil
+}
+
+func (iu *InvitationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invitation.Table,
+			Columns: invitation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invitation.FieldID,
+			},
+		},
+	}
+	if ps := iu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if iu.mutation.EmailCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: invitation.FieldEmail,
+		})
+	}
+	if iu.mutation.TokenHashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: invitation.FieldTokenHash,
+		})
+	}
+	if value, ok := iu.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: invitation.FieldStatus,
+		})
+	}
+	if iu.mutation.LeagueCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.LeagueTable,
+			Columns: []string{invitation.LeagueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: league.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.LeagueIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.LeagueTable,
+			Columns: []string{invitation.LeagueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: league.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.InviterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.InviterTable,
+			Columns: []string{invitation.InviterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.InviterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.InviterTable,
+			Columns: []string{invitation.InviterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invitation.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// InvitationUpdateOne is the builder for updating a single Invitation entity.
+type InvitationUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *InvitationMutation
+}
+
+// SetStatus sets the "status" field.
+func (iuo *InvitationUpdateOne) SetStatus(i invitation.Status) *InvitationUpdateOne {
+	iuo.mutation.SetStatus(i)
+	return iuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableStatus(i *invitation.Status) *InvitationUpdateOne {
+	if i != nil {
+		iuo.SetStatus(*i)
+	}
+	return iuo
+}
+
+// SetLeagueID sets the "league" edge to the League entity by ID.
+func (iuo *InvitationUpdateOne) SetLeagueID(id int) *InvitationUpdateOne {
+	iuo.mutation.SetLeagueID(id)
+	return iuo
+}
+
+// SetLeague sets the "league" edge to the League entity.
+func (iuo *InvitationUpdateOne) SetLeague(l *League) *InvitationUpdateOne {
+	return iuo.SetLeagueID(l.ID)
+}
+
+// SetInviterID sets the "inviter" edge to the User entity by ID.
+func (iuo *InvitationUpdateOne) SetInviterID(id int) *InvitationUpdateOne {
+	iuo.mutation.SetInviterID(id)
+	return iuo
+}
+
+// SetInviter sets the "inviter" edge to the User entity.
+func (iuo *InvitationUpdateOne) SetInviter(u *User) *InvitationUpdateOne {
+	return iuo.SetInviterID(u.ID)
+}
+
+// Mutation returns the InvitationMutation object of the builder.
+func (iuo *InvitationUpdateOne) Mutation() *InvitationMutation {
+	return iuo.mutation
+}
+
+// ClearLeague clears the "league" edge to the League entity.
+func (iuo *InvitationUpdateOne) ClearLeague() *InvitationUpdateOne {
+	iuo.mutation.ClearLeague()
+	return iuo
+}
+
+// ClearInviter clears the "inviter" edge to the User entity.
+func (iuo *InvitationUpdateOne) ClearInviter() *InvitationUpdateOne {
+	iuo.mutation.ClearInviter()
+	return iuo
+}
+
+// Save executes the query and returns the updated Invitation entity.
+func (iuo *InvitationUpdateOne) Save(ctx context.Context) (*Invitation, error) {
+	var (
+		err  error
+		node *Invitation
+	)
+	if len(iuo.hooks) == 0 {
+		if err = iuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = iuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvitationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = iuo.check(); err != nil {
+				return nil, err
+			}
+			iuo.mutation = mutation
+			node, err = iuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(iuo.hooks) - 1; i >= 0; i-- {
+			mut = iuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *InvitationUpdateOne) SaveX(ctx context.Context) *Invitation {
+	node, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iuo *InvitationUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *InvitationUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iuo *InvitationUpdateOne) check() error {
+	if v, ok := iuo.mutation.Status(); ok {
+		if err := invitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf("db: validator failed for field \"status\": %w", err)}
+		}
+	}
+	if _, ok := iuo.mutation.LeagueID(); iuo.mutation.LeagueCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"league\"")
+	}
+	if _, ok := iuo.mutation.InviterID(); iuo.mutation.InviterCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"inviter\"")
+	}
+	return nil
+}
+
+func (iuo *InvitationUpdateOne) sqlSave(ctx context.Context) (_node *Invitation, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invitation.Table,
+			Columns: invitation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invitation.FieldID,
+			},
+		},
+	}
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Invitation.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := iuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if iuo.mutation.EmailCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: invitation.FieldEmail,
+		})
+	}
+	if iuo.mutation.TokenHashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: invitation.FieldTokenHash,
+		})
+	}
+	if value, ok := iuo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: invitation.FieldStatus,
+		})
+	}
+	if iuo.mutation.LeagueCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.LeagueTable,
+			Columns: []string{invitation.LeagueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: league.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.LeagueIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.LeagueTable,
+			Columns: []string{invitation.LeagueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: league.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.InviterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.InviterTable,
+			Columns: []string{invitation.InviterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.InviterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.InviterTable,
+			Columns: []string{invitation.InviterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Invitation{config: iuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invitation.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,227 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/NickDubelman/fantasy-bball/db/league"
+	"github.com/NickDubelman/fantasy-bball/db/schema/schematype"
+)
+
+// League is the model entity for the League schema.
+type League struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// MaxMembers holds the value of the "maxMembers" field.
+	MaxMembers int `json:"maxMembers,omitempty"`
+	// StatWeights holds the value of the "statWeights" field.
+	StatWeights schematype.StatWeights `json:"statWeights,omitempty"`
+	// DraftRounds holds the value of the "draftRounds" field.
+	DraftRounds int `json:"draftRounds,omitempty"`
+	// PickTimeLimit holds the value of the "pickTimeLimit" field.
+	PickTimeLimit int `json:"pickTimeLimit,omitempty"`
+	// Created holds the value of the "created" field.
+	Created time.Time `json:"created,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LeagueQuery when eager-loading is set.
+	Edges LeagueEdges `json:"edges"`
+}
+
+// LeagueEdges holds the relations/edges for other nodes in the graph.
+type LeagueEdges struct {
+	// Memberships holds the value of the memberships edge.
+	Memberships []*LeagueMembership `json:"memberships,omitempty"`
+	// Invitations holds the value of the invitations edge.
+	Invitations []*Invitation `json:"invitations,omitempty"`
+	// Contests holds the value of the contests edge.
+	Contests []*Contest `json:"contests,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// MembershipsOrErr returns the Memberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e LeagueEdges) MembershipsOrErr() ([]*LeagueMembership, error) {
+	if e.loadedTypes[0] {
+		return e.Memberships, nil
+	}
+	return nil, &NotLoadedError{edge: "memberships"}
+}
+
+// InvitationsOrErr returns the Invitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e LeagueEdges) InvitationsOrErr() ([]*Invitation, error) {
+	if e.loadedTypes[1] {
+		return e.Invitations, nil
+	}
+	return nil, &NotLoadedError{edge: "invitations"}
+}
+
+// ContestsOrErr returns the Contests value or an error if the edge
+// was not loaded in eager-loading.
+func (e LeagueEdges) ContestsOrErr() ([]*Contest, error) {
+	if e.loadedTypes[2] {
+		return e.Contests, nil
+	}
+	return nil, &NotLoadedError{edge: "contests"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*League) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case league.FieldStatWeights:
+			values[i] = &[]byte{}
+		case league.FieldID, league.FieldMaxMembers, league.FieldDraftRounds, league.FieldPickTimeLimit:
+			values[i] = &sql.NullInt64{}
+		case league.FieldName, league.FieldDescription:
+			values[i] = &sql.NullString{}
+		case league.FieldCreated:
+			values[i] = &sql.NullTime{}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type League", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the League fields.
+func (l *League) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case league.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			l.ID = int(value.Int64)
+		case league.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				l.Name = value.String
+			}
+		case league.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				l.Description = value.String
+			}
+		case league.FieldMaxMembers:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field maxMembers", values[i])
+			} else if value.Valid {
+				l.MaxMembers = int(value.Int64)
+			}
+		case league.FieldStatWeights:
+
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field statWeights", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &l.StatWeights); err != nil {
+					return fmt.Errorf("unmarshal field statWeights: %w", err)
+				}
+			}
+		case league.FieldDraftRounds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field draftRounds", values[i])
+			} else if value.Valid {
+				l.DraftRounds = int(value.Int64)
+			}
+		case league.FieldPickTimeLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pickTimeLimit", values[i])
+			} else if value.Valid {
+				l.PickTimeLimit = int(value.Int64)
+			}
+		case league.FieldCreated:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created", values[i])
+			} else if value.Valid {
+				l.Created = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryMemberships queries the "memberships" edge of the League entity.
+func (l *League) QueryMemberships() *LeagueMembershipQuery {
+	return (&LeagueClient{config: l.config}).QueryMemberships(l)
+}
+
+// QueryInvitations queries the "invitations" edge of the League entity.
+func (l *League) QueryInvitations() *InvitationQuery {
+	return (&LeagueClient{config: l.config}).QueryInvitations(l)
+}
+
+// QueryContests queries the "contests" edge of the League entity.
+func (l *League) QueryContests() *ContestQuery {
+	return (&LeagueClient{config: l.config}).QueryContests(l)
+}
+
+// Update returns a builder for updating this League.
+// Note that you need to call League.Unwrap() before calling this method if this League
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (l *League) Update() *LeagueUpdateOne {
+	return (&LeagueClient{config: l.config}).UpdateOne(l)
+}
+
+// Unwrap unwraps the League entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (l *League) Unwrap() *League {
+	tx, ok := l.config.driver.(*txDriver)
+	if !ok {
+		panic("db: League is not a transactional entity")
+	}
+	l.config.driver = tx.drv
+	return l
+}
+
+// String implements the fmt.Stringer.
+func (l *League) String() string {
+	var builder strings.Builder
+	builder.WriteString("League(")
+	builder.WriteString(fmt.Sprintf("id=%v", l.ID))
+	builder.WriteString(", name=")
+	builder.WriteString(l.Name)
+	builder.WriteString(", description=")
+	builder.WriteString(l.Description)
+	builder.WriteString(", maxMembers=")
+	builder.WriteString(fmt.Sprintf("%v", l.MaxMembers))
+	builder.WriteString(", statWeights=")
+	builder.WriteString(fmt.Sprintf("%v", l.StatWeights))
+	builder.WriteString(", draftRounds=")
+	builder.WriteString(fmt.Sprintf("%v", l.DraftRounds))
+	builder.WriteString(", pickTimeLimit=")
+	builder.WriteString(fmt.Sprintf("%v", l.PickTimeLimit))
+	builder.WriteString(", created=")
+	builder.WriteString(l.Created.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Leagues is a parsable slice of League.
+type Leagues []*League
+
+func (l Leagues) config(cfg config) {
+	for _i := range l {
+		l[_i].config = cfg
+	}
+}
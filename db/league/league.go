@@ -0,0 +1,100 @@
+// Code generated by entc, DO NOT EDIT.
+
+package league
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the league type in the database.
+	Label = "league"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldMaxMembers holds the string denoting the maxmembers field in the database.
+	FieldMaxMembers = "max_members"
+	// FieldStatWeights holds the string denoting the statweights field in the database.
+	FieldStatWeights = "stat_weights"
+	// FieldDraftRounds holds the string denoting the draftrounds field in the database.
+	FieldDraftRounds = "draft_rounds"
+	// FieldPickTimeLimit holds the string denoting the picktimelimit field in the database.
+	FieldPickTimeLimit = "pick_time_limit"
+	// FieldCreated holds the string denoting the created field in the database.
+	FieldCreated = "created"
+	// EdgeMemberships holds the string denoting the memberships edge name in mutations.
+	EdgeMemberships = "memberships"
+	// EdgeInvitations holds the string denoting the invitations edge name in mutations.
+	EdgeInvitations = "invitations"
+	// EdgeContests holds the string denoting the contests edge name in mutations.
+	EdgeContests = "contests"
+	// Table holds the table name of the league in the database.
+	Table = "leagues"
+	// MembershipsTable is the table the holds the memberships relation/edge.
+	MembershipsTable = "league_memberships"
+	// MembershipsInverseTable is the table name for the LeagueMembership entity.
+	// It exists in this package in order to avoid circular dependency with the "leaguemembership" package.
+	MembershipsInverseTable = "league_memberships"
+	// MembershipsColumn is the table column denoting the memberships relation/edge.
+	MembershipsColumn = "league_memberships"
+	// InvitationsTable is the table the holds the invitations relation/edge.
+	InvitationsTable = "invitations"
+	// InvitationsInverseTable is the table name for the Invitation entity.
+	// It exists in this package in order to avoid circular dependency with the "invitation" package.
+	InvitationsInverseTable = "invitations"
+	// InvitationsColumn is the table column denoting the invitations relation/edge.
+	InvitationsColumn = "league_invitations"
+	// ContestsTable is the table the holds the contests relation/edge.
+	ContestsTable = "contests"
+	// ContestsInverseTable is the table name for the Contest entity.
+	// It exists in this package in order to avoid circular dependency with the "contest" package.
+	ContestsInverseTable = "contests"
+	// ContestsColumn is the table column denoting the contests relation/edge.
+	ContestsColumn = "league_contests"
+)
+
+// Columns holds all SQL columns for league fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldDescription,
+	FieldMaxMembers,
+	FieldStatWeights,
+	FieldDraftRounds,
+	FieldPickTimeLimit,
+	FieldCreated,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultDescription holds the default value on creation for the "description" field.
+	DefaultDescription string
+	// DefaultMaxMembers holds the default value on creation for the "maxMembers" field.
+	DefaultMaxMembers int
+	// MaxMembersValidator is a validator for the "maxMembers" field. It is called by the builders before save.
+	MaxMembersValidator func(int) error
+	// DefaultDraftRounds holds the default value on creation for the "draftRounds" field.
+	DefaultDraftRounds int
+	// DraftRoundsValidator is a validator for the "draftRounds" field. It is called by the builders before save.
+	DraftRoundsValidator func(int) error
+	// DefaultPickTimeLimit holds the default value on creation for the "pickTimeLimit" field.
+	DefaultPickTimeLimit int
+	// PickTimeLimitValidator is a validator for the "pickTimeLimit" field. It is called by the builders before save.
+	PickTimeLimitValidator func(int) error
+	// DefaultCreated holds the default value on creation for the "created" field.
+	DefaultCreated func() time.Time
+)
@@ -0,0 +1,778 @@
+// Code generated by entc, DO NOT EDIT.
+
+package league
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDescription), v))
+	})
+}
+
+// MaxMembers applies equality check predicate on the "maxMembers" field. It's identical to MaxMembersEQ.
+func MaxMembers(v int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMaxMembers), v))
+	})
+}
+
+// DraftRounds applies equality check predicate on the "draftRounds" field. It's identical to DraftRoundsEQ.
+func DraftRounds(v int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDraftRounds), v))
+	})
+}
+
+// PickTimeLimit applies equality check predicate on the "pickTimeLimit" field. It's identical to PickTimeLimitEQ.
+func PickTimeLimit(v int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPickTimeLimit), v))
+	})
+}
+
+// Created applies equality check predicate on the "created" field. It's identical to CreatedEQ.
+func Created(v time.Time) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreated), v))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.League {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.League(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.League {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.League(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.League {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.League(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDescription), v...))
+	})
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.League {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.League(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDescription), v...))
+	})
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDescription), v))
+	})
+}
+
+// MaxMembersEQ applies the EQ predicate on the "maxMembers" field.
+func MaxMembersEQ(v int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMaxMembers), v))
+	})
+}
+
+// MaxMembersNEQ applies the NEQ predicate on the "maxMembers" field.
+func MaxMembersNEQ(v int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMaxMembers), v))
+	})
+}
+
+// MaxMembersIn applies the In predicate on the "maxMembers" field.
+func MaxMembersIn(vs ...int) predicate.League {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.League(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMaxMembers), v...))
+	})
+}
+
+// MaxMembersNotIn applies the NotIn predicate on the "maxMembers" field.
+func MaxMembersNotIn(vs ...int) predicate.League {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.League(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMaxMembers), v...))
+	})
+}
+
+// MaxMembersGT applies the GT predicate on the "maxMembers" field.
+func MaxMembersGT(v int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMaxMembers), v))
+	})
+}
+
+// MaxMembersGTE applies the GTE predicate on the "maxMembers" field.
+func MaxMembersGTE(v int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMaxMembers), v))
+	})
+}
+
+// MaxMembersLT applies the LT predicate on the "maxMembers" field.
+func MaxMembersLT(v int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMaxMembers), v))
+	})
+}
+
+// MaxMembersLTE applies the LTE predicate on the "maxMembers" field.
+func MaxMembersLTE(v int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMaxMembers), v))
+	})
+}
+
+// DraftRoundsEQ applies the EQ predicate on the "draftRounds" field.
+func DraftRoundsEQ(v int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDraftRounds), v))
+	})
+}
+
+// DraftRoundsNEQ applies the NEQ predicate on the "draftRounds" field.
+func DraftRoundsNEQ(v int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDraftRounds), v))
+	})
+}
+
+// DraftRoundsIn applies the In predicate on the "draftRounds" field.
+func DraftRoundsIn(vs ...int) predicate.League {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.League(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDraftRounds), v...))
+	})
+}
+
+// DraftRoundsNotIn applies the NotIn predicate on the "draftRounds" field.
+func DraftRoundsNotIn(vs ...int) predicate.League {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.League(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDraftRounds), v...))
+	})
+}
+
+// DraftRoundsGT applies the GT predicate on the "draftRounds" field.
+func DraftRoundsGT(v int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDraftRounds), v))
+	})
+}
+
+// DraftRoundsGTE applies the GTE predicate on the "draftRounds" field.
+func DraftRoundsGTE(v int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDraftRounds), v))
+	})
+}
+
+// DraftRoundsLT applies the LT predicate on the "draftRounds" field.
+func DraftRoundsLT(v int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDraftRounds), v))
+	})
+}
+
+// DraftRoundsLTE applies the LTE predicate on the "draftRounds" field.
+func DraftRoundsLTE(v int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDraftRounds), v))
+	})
+}
+
+// PickTimeLimitEQ applies the EQ predicate on the "pickTimeLimit" field.
+func PickTimeLimitEQ(v int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPickTimeLimit), v))
+	})
+}
+
+// PickTimeLimitNEQ applies the NEQ predicate on the "pickTimeLimit" field.
+func PickTimeLimitNEQ(v int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPickTimeLimit), v))
+	})
+}
+
+// PickTimeLimitIn applies the In predicate on the "pickTimeLimit" field.
+func PickTimeLimitIn(vs ...int) predicate.League {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.League(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPickTimeLimit), v...))
+	})
+}
+
+// PickTimeLimitNotIn applies the NotIn predicate on the "pickTimeLimit" field.
+func PickTimeLimitNotIn(vs ...int) predicate.League {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.League(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPickTimeLimit), v...))
+	})
+}
+
+// PickTimeLimitGT applies the GT predicate on the "pickTimeLimit" field.
+func PickTimeLimitGT(v int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPickTimeLimit), v))
+	})
+}
+
+// PickTimeLimitGTE applies the GTE predicate on the "pickTimeLimit" field.
+func PickTimeLimitGTE(v int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPickTimeLimit), v))
+	})
+}
+
+// PickTimeLimitLT applies the LT predicate on the "pickTimeLimit" field.
+func PickTimeLimitLT(v int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPickTimeLimit), v))
+	})
+}
+
+// PickTimeLimitLTE applies the LTE predicate on the "pickTimeLimit" field.
+func PickTimeLimitLTE(v int) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPickTimeLimit), v))
+	})
+}
+
+// CreatedEQ applies the EQ predicate on the "created" field.
+func CreatedEQ(v time.Time) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedNEQ applies the NEQ predicate on the "created" field.
+func CreatedNEQ(v time.Time) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedIn applies the In predicate on the "created" field.
+func CreatedIn(vs ...time.Time) predicate.League {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.League(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreated), v...))
+	})
+}
+
+// CreatedNotIn applies the NotIn predicate on the "created" field.
+func CreatedNotIn(vs ...time.Time) predicate.League {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.League(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreated), v...))
+	})
+}
+
+// CreatedGT applies the GT predicate on the "created" field.
+func CreatedGT(v time.Time) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedGTE applies the GTE predicate on the "created" field.
+func CreatedGTE(v time.Time) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedLT applies the LT predicate on the "created" field.
+func CreatedLT(v time.Time) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedLTE applies the LTE predicate on the "created" field.
+func CreatedLTE(v time.Time) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreated), v))
+	})
+}
+
+// HasMemberships applies the HasEdge predicate on the "memberships" edge.
+func HasMemberships() predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(MembershipsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MembershipsTable, MembershipsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMembershipsWith applies the HasEdge predicate on the "memberships" edge with a given conditions (other predicates).
+func HasMembershipsWith(preds ...predicate.LeagueMembership) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(MembershipsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MembershipsTable, MembershipsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvitations applies the HasEdge predicate on the "invitations" edge.
+func HasInvitations() predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(InvitationsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InvitationsTable, InvitationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvitationsWith applies the HasEdge predicate on the "invitations" edge with a given conditions (other predicates).
+func HasInvitationsWith(preds ...predicate.Invitation) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(InvitationsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InvitationsTable, InvitationsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasContests applies the HasEdge predicate on the "contests" edge.
+func HasContests() predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ContestsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ContestsTable, ContestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasContestsWith applies the HasEdge predicate on the "contests" edge with a given conditions (other predicates).
+func HasContestsWith(preds ...predicate.Contest) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ContestsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ContestsTable, ContestsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.League) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.League) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.League) predicate.League {
+	return predicate.League(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,479 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/contest"
+	"github.com/NickDubelman/fantasy-bball/db/invitation"
+	"github.com/NickDubelman/fantasy-bball/db/league"
+	"github.com/NickDubelman/fantasy-bball/db/leaguemembership"
+	"github.com/NickDubelman/fantasy-bball/db/schema/schematype"
+)
+
+// LeagueCreate is the builder for creating a League entity.
+type LeagueCreate struct {
+	config
+	mutation *LeagueMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (lc *LeagueCreate) SetName(s string) *LeagueCreate {
+	lc.mutation.SetName(s)
+	return lc
+}
+
+// SetDescription sets the "description" field.
+func (lc *LeagueCreate) SetDescription(s string) *LeagueCreate {
+	lc.mutation.SetDescription(s)
+	return lc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (lc *LeagueCreate) SetNillableDescription(s *string) *LeagueCreate {
+	if s != nil {
+		lc.SetDescription(*s)
+	}
+	return lc
+}
+
+// SetMaxMembers sets the "maxMembers" field.
+func (lc *LeagueCreate) SetMaxMembers(i int) *LeagueCreate {
+	lc.mutation.SetMaxMembers(i)
+	return lc
+}
+
+// SetNillableMaxMembers sets the "maxMembers" field if the given value is not nil.
+func (lc *LeagueCreate) SetNillableMaxMembers(i *int) *LeagueCreate {
+	if i != nil {
+		lc.SetMaxMembers(*i)
+	}
+	return lc
+}
+
+// SetStatWeights sets the "statWeights" field.
+func (lc *LeagueCreate) SetStatWeights(sw schematype.StatWeights) *LeagueCreate {
+	lc.mutation.SetStatWeights(sw)
+	return lc
+}
+
+// SetDraftRounds sets the "draftRounds" field.
+func (lc *LeagueCreate) SetDraftRounds(i int) *LeagueCreate {
+	lc.mutation.SetDraftRounds(i)
+	return lc
+}
+
+// SetNillableDraftRounds sets the "draftRounds" field if the given value is not nil.
+func (lc *LeagueCreate) SetNillableDraftRounds(i *int) *LeagueCreate {
+	if i != nil {
+		lc.SetDraftRounds(*i)
+	}
+	return lc
+}
+
+// SetPickTimeLimit sets the "pickTimeLimit" field.
+func (lc *LeagueCreate) SetPickTimeLimit(i int) *LeagueCreate {
+	lc.mutation.SetPickTimeLimit(i)
+	return lc
+}
+
+// SetNillablePickTimeLimit sets the "pickTimeLimit" field if the given value is not nil.
+func (lc *LeagueCreate) SetNillablePickTimeLimit(i *int) *LeagueCreate {
+	if i != nil {
+		lc.SetPickTimeLimit(*i)
+	}
+	return lc
+}
+
+// SetCreated sets the "created" field.
+func (lc *LeagueCreate) SetCreated(t time.Time) *LeagueCreate {
+	lc.mutation.SetCreated(t)
+	return lc
+}
+
+// SetNillableCreated sets the "created" field if the given value is not nil.
+func (lc *LeagueCreate) SetNillableCreated(t *time.Time) *LeagueCreate {
+	if t != nil {
+		lc.SetCreated(*t)
+	}
+	return lc
+}
+
+// AddMembershipIDs adds the "memberships" edge to the LeagueMembership entity by IDs.
+func (lc *LeagueCreate) AddMembershipIDs(ids ...int) *LeagueCreate {
+	lc.mutation.AddMembershipIDs(ids...)
+	return lc
+}
+
+// AddMemberships adds the "memberships" edges to the LeagueMembership entity.
+func (lc *LeagueCreate) AddMemberships(l ...*LeagueMembership) *LeagueCreate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lc.AddMembershipIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
+func (lc *LeagueCreate) AddInvitationIDs(ids ...int) *LeagueCreate {
+	lc.mutation.AddInvitationIDs(ids...)
+	return lc
+}
+
+// AddInvitations adds the "invitations" edges to the Invitation entity.
+func (lc *LeagueCreate) AddInvitations(i ...*Invitation) *LeagueCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return lc.AddInvitationIDs(ids...)
+}
+
+// AddContestIDs adds the "contests" edge to the Contest entity by IDs.
+func (lc *LeagueCreate) AddContestIDs(ids ...int) *LeagueCreate {
+	lc.mutation.AddContestIDs(ids...)
+	return lc
+}
+
+// AddContests adds the "contests" edges to the Contest entity.
+func (lc *LeagueCreate) AddContests(c ...*Contest) *LeagueCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return lc.AddContestIDs(ids...)
+}
+
+// Mutation returns the LeagueMutation object of the builder.
+func (lc *LeagueCreate) Mutation() *LeagueMutation {
+	return lc.mutation
+}
+
+// Save creates the League in the database.
+func (lc *LeagueCreate) Save(ctx context.Context) (*League, error) {
+	var (
+		err  error
+		node *League
+	)
+	lc.defaults()
+	if len(lc.hooks) == 0 {
+		if err = lc.check(); err != nil {
+			return nil, err
+		}
+		node, err = lc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LeagueMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = lc.check(); err != nil {
+				return nil, err
+			}
+			lc.mutation = mutation
+			node, err = lc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(lc.hooks) - 1; i >= 0; i-- {
+			mut = lc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, lc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lc *LeagueCreate) SaveX(ctx context.Context) *League {
+	v, err := lc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (lc *LeagueCreate) defaults() {
+	if _, ok := lc.mutation.Description(); !ok {
+		v := league.DefaultDescription
+		lc.mutation.SetDescription(v)
+	}
+	if _, ok := lc.mutation.MaxMembers(); !ok {
+		v := league.DefaultMaxMembers
+		lc.mutation.SetMaxMembers(v)
+	}
+	if _, ok := lc.mutation.DraftRounds(); !ok {
+		v := league.DefaultDraftRounds
+		lc.mutation.SetDraftRounds(v)
+	}
+	if _, ok := lc.mutation.PickTimeLimit(); !ok {
+		v := league.DefaultPickTimeLimit
+		lc.mutation.SetPickTimeLimit(v)
+	}
+	if _, ok := lc.mutation.Created(); !ok {
+		v := league.DefaultCreated()
+		lc.mutation.SetCreated(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lc *LeagueCreate) check() error {
+	if _, ok := lc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New("db: missing required field \"name\"")}
+	}
+	if v, ok := lc.mutation.Name(); ok {
+		if err := league.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("db: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if _, ok := lc.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New("db: missing required field \"description\"")}
+	}
+	if _, ok := lc.mutation.MaxMembers(); !ok {
+		return &ValidationError{Name: "maxMembers", err: errors.New("db: missing required field \"maxMembers\"")}
+	}
+	if v, ok := lc.mutation.MaxMembers(); ok {
+		if err := league.MaxMembersValidator(v); err != nil {
+			return &ValidationError{Name: "maxMembers", err: fmt.Errorf("db: validator failed for field \"maxMembers\": %w", err)}
+		}
+	}
+	if _, ok := lc.mutation.StatWeights(); !ok {
+		return &ValidationError{Name: "statWeights", err: errors.New("db: missing required field \"statWeights\"")}
+	}
+	if _, ok := lc.mutation.DraftRounds(); !ok {
+		return &ValidationError{Name: "draftRounds", err: errors.New("db: missing required field \"draftRounds\"")}
+	}
+	if v, ok := lc.mutation.DraftRounds(); ok {
+		if err := league.DraftRoundsValidator(v); err != nil {
+			return &ValidationError{Name: "draftRounds", err: fmt.Errorf("db: validator failed for field \"draftRounds\": %w", err)}
+		}
+	}
+	if _, ok := lc.mutation.PickTimeLimit(); !ok {
+		return &ValidationError{Name: "pickTimeLimit", err: errors.New("db: missing required field \"pickTimeLimit\"")}
+	}
+	if v, ok := lc.mutation.PickTimeLimit(); ok {
+		if err := league.PickTimeLimitValidator(v); err != nil {
+			return &ValidationError{Name: "pickTimeLimit", err: fmt.Errorf("db: validator failed for field \"pickTimeLimit\": %w", err)}
+		}
+	}
+	if _, ok := lc.mutation.Created(); !ok {
+		return &ValidationError{Name: "created", err: errors.New("db: missing required field \"created\"")}
+	}
+	return nil
+}
+
+func (lc *LeagueCreate) sqlSave(ctx context.Context) (*League, error) {
+	_node, _spec := lc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (lc *LeagueCreate) createSpec() (*League, *sqlgraph.CreateSpec) {
+	var (
+		_node = &League{config: lc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: league.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: league.FieldID,
+			},
+		}
+	)
+	if value, ok := lc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: league.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := lc.mutation.Description(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: league.FieldDescription,
+		})
+		_node.Description = value
+	}
+	if value, ok := lc.mutation.MaxMembers(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: league.FieldMaxMembers,
+		})
+		_node.MaxMembers = value
+	}
+	if value, ok := lc.mutation.StatWeights(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: league.FieldStatWeights,
+		})
+		_node.StatWeights = value
+	}
+	if value, ok := lc.mutation.DraftRounds(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: league.FieldDraftRounds,
+		})
+		_node.DraftRounds = value
+	}
+	if value, ok := lc.mutation.PickTimeLimit(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: league.FieldPickTimeLimit,
+		})
+		_node.PickTimeLimit = value
+	}
+	if value, ok := lc.mutation.Created(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: league.FieldCreated,
+		})
+		_node.Created = value
+	}
+	if nodes := lc.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   league.MembershipsTable,
+			Columns: []string{league.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: leaguemembership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lc.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   league.InvitationsTable,
+			Columns: []string{league.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invitation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lc.mutation.ContestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   league.ContestsTable,
+			Columns: []string{league.ContestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contest.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LeagueCreateBulk is the builder for creating many League entities in bulk.
+type LeagueCreateBulk struct {
+	config
+	builders []*LeagueCreate
+}
+
+// Save creates the League entities in the database.
+func (lcb *LeagueCreateBulk) Save(ctx context.Context) ([]*League, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(lcb.builders))
+	nodes := make([]*League, len(lcb.builders))
+	mutators := make([]Mutator, len(lcb.builders))
+	for i := range lcb.builders {
+		func(i int, root context.Context) {
+			builder := lcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LeagueMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lcb *LeagueCreateBulk) SaveX(ctx context.Context) []*League {
+	v, err := lcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
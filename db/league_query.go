@@ -0,0 +1,1097 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/contest"
+	"github.com/NickDubelman/fantasy-bball/db/invitation"
+	"github.com/NickDubelman/fantasy-bball/db/league"
+	"github.com/NickDubelman/fantasy-bball/db/leaguemembership"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+)
+
+// LeagueQuery is the builder for querying League entities.
+type LeagueQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.League
+	// eager-loading edges.
+	withMemberships *LeagueMembershipQuery
+	withInvitations *InvitationQuery
+	withContests    *ContestQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LeagueQuery builder.
+func (lq *LeagueQuery) Where(ps ...predicate.League) *LeagueQuery {
+	lq.predicates = append(lq.predicates, ps...)
+	return lq
+}
+
+// Limit adds a limit step to the query.
+func (lq *LeagueQuery) Limit(limit int) *LeagueQuery {
+	lq.limit = &limit
+	return lq
+}
+
+// Offset adds an offset step to the query.
+func (lq *LeagueQuery) Offset(offset int) *LeagueQuery {
+	lq.offset = &offset
+	return lq
+}
+
+// Order adds an order step to the query.
+func (lq *LeagueQuery) Order(o ...OrderFunc) *LeagueQuery {
+	lq.order = append(lq.order, o...)
+	return lq
+}
+
+// QueryMemberships chains the current query on the "memberships" edge.
+func (lq *LeagueQuery) QueryMemberships() *LeagueMembershipQuery {
+	query := &LeagueMembershipQuery{config: lq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(league.Table, league.FieldID, selector),
+			sqlgraph.To(leaguemembership.Table, leaguemembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, league.MembershipsTable, league.MembershipsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvitations chains the current query on the "invitations" edge.
+func (lq *LeagueQuery) QueryInvitations() *InvitationQuery {
+	query := &InvitationQuery{config: lq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(league.Table, league.FieldID, selector),
+			sqlgraph.To(invitation.Table, invitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, league.InvitationsTable, league.InvitationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryContests chains the current query on the "contests" edge.
+func (lq *LeagueQuery) QueryContests() *ContestQuery {
+	query := &ContestQuery{config: lq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(league.Table, league.FieldID, selector),
+			sqlgraph.To(contest.Table, contest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, league.ContestsTable, league.ContestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first League entity from the query.
+// Returns a *NotFoundError when no League was found.
+func (lq *LeagueQuery) First(ctx context.Context) (*League, error) {
+	nodes, err := lq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{league.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (lq *LeagueQuery) FirstX(ctx context.Context) *League {
+	node, err := lq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first League ID from the query.
+// Returns a *NotFoundError when no League ID was found.
+func (lq *LeagueQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = lq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{league.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (lq *LeagueQuery) FirstIDX(ctx context.Context) int {
+	id, err := lq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single League entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one League entity is not found.
+// Returns a *NotFoundError when no League entities are found.
+func (lq *LeagueQuery) Only(ctx context.Context) (*League, error) {
+	nodes, err := lq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{league.Label}
+	default:
+		return nil, &NotSingularError{league.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (lq *LeagueQuery) OnlyX(ctx context.Context) *League {
+	node, err := lq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only League ID in the query.
+// Returns a *NotSingularError when exactly one League ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (lq *LeagueQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = lq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{league.Label}
+	default:
+		err = &NotSingularError{league.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (lq *LeagueQuery) OnlyIDX(ctx context.Context) int {
+	id, err := lq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Leagues.
+func (lq *LeagueQuery) All(ctx context.Context) ([]*League, error) {
+	if err := lq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return lq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (lq *LeagueQuery) AllX(ctx context.Context) []*League {
+	nodes, err := lq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of League IDs.
+func (lq *LeagueQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := lq.Select(league.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (lq *LeagueQuery) IDsX(ctx context.Context) []int {
+	ids, err := lq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (lq *LeagueQuery) Count(ctx context.Context) (int, error) {
+	if err := lq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return lq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (lq *LeagueQuery) CountX(ctx context.Context) int {
+	count, err := lq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (lq *LeagueQuery) Exist(ctx context.Context) (bool, error) {
+	if err := lq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return lq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (lq *LeagueQuery) ExistX(ctx context.Context) bool {
+	exist, err := lq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LeagueQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (lq *LeagueQuery) Clone() *LeagueQuery {
+	if lq == nil {
+		return nil
+	}
+	return &LeagueQuery{
+		config:          lq.config,
+		limit:           lq.limit,
+		offset:          lq.offset,
+		order:           append([]OrderFunc{}, lq.order...),
+		predicates:      append([]predicate.League{}, lq.predicates...),
+		withMemberships: lq.withMemberships.Clone(),
+		withInvitations: lq.withInvitations.Clone(),
+		withContests:    lq.withContests.Clone(),
+		// clone intermediate query.
+		sql:  lq.sql.Clone(),
+		path: lq.path,
+	}
+}
+
+// WithMemberships tells the query-builder to eager-load the nodes that are connected to
+// the "memberships" edge. The optional arguments are used to configure the query builder of the edge.
+func (lq *LeagueQuery) WithMemberships(opts ...func(*LeagueMembershipQuery)) *LeagueQuery {
+	query := &LeagueMembershipQuery{config: lq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	lq.withMemberships = query
+	return lq
+}
+
+// WithInvitations tells the query-builder to eager-load the nodes that are connected to
+// the "invitations" edge. The optional arguments are used to configure the query builder of the edge.
+func (lq *LeagueQuery) WithInvitations(opts ...func(*InvitationQuery)) *LeagueQuery {
+	query := &InvitationQuery{config: lq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	lq.withInvitations = query
+	return lq
+}
+
+// WithContests tells the query-builder to eager-load the nodes that are connected to
+// the "contests" edge. The optional arguments are used to configure the query builder of the edge.
+func (lq *LeagueQuery) WithContests(opts ...func(*ContestQuery)) *LeagueQuery {
+	query := &ContestQuery{config: lq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	lq.withContests = query
+	return lq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.League.Query().
+//		GroupBy(league.FieldName).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (lq *LeagueQuery) GroupBy(field string, fields ...string) *LeagueGroupBy {
+	group := &LeagueGroupBy{config: lq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := lq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return lq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.League.Query().
+//		Select(league.FieldName).
+//		Scan(ctx, &v)
+func (lq *LeagueQuery) Select(field string, fields ...string) *LeagueSelect {
+	lq.fields = append([]string{field}, fields...)
+	return &LeagueSelect{LeagueQuery: lq}
+}
+
+func (lq *LeagueQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range lq.fields {
+		if !league.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if lq.path != nil {
+		prev, err := lq.path(ctx)
+		if err != nil {
+			return err
+		}
+		lq.sql = prev
+	}
+	return nil
+}
+
+func (lq *LeagueQuery) sqlAll(ctx context.Context) ([]*League, error) {
+	var (
+		nodes       = []*League{}
+		_spec       = lq.querySpec()
+		loadedTypes = [3]bool{
+			lq.withMemberships != nil,
+			lq.withInvitations != nil,
+			lq.withContests != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &League{config: lq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("db: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, lq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := lq.withMemberships; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*League)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Memberships = []*LeagueMembership{}
+		}
+		query.withFKs = true
+		query.Where(predicate.LeagueMembership(func(s *sql.Selector) {
+			s.Where(sql.InValues(league.MembershipsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.league_memberships
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "league_memberships" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "league_memberships" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Memberships = append(node.Edges.Memberships, n)
+		}
+	}
+
+	if query := lq.withInvitations; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*League)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Invitations = []*Invitation{}
+		}
+		query.withFKs = true
+		query.Where(predicate.Invitation(func(s *sql.Selector) {
+			s.Where(sql.InValues(league.InvitationsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.league_invitations
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "league_invitations" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "league_invitations" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Invitations = append(node.Edges.Invitations, n)
+		}
+	}
+
+	if query := lq.withContests; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*League)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Contests = []*Contest{}
+		}
+		query.withFKs = true
+		query.Where(predicate.Contest(func(s *sql.Selector) {
+			s.Where(sql.InValues(league.ContestsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.league_contests
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "league_contests" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "league_contests" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Contests = append(node.Edges.Contests, n)
+		}
+	}
+
+	return nodes, nil
+}
+
+func (lq *LeagueQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := lq.querySpec()
+	return sqlgraph.CountNodes(ctx, lq.driver, _spec)
+}
+
+func (lq *LeagueQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := lq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("db: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (lq *LeagueQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   league.Table,
+			Columns: league.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: league.FieldID,
+			},
+		},
+		From:   lq.sql,
+		Unique: true,
+	}
+	if fields := lq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, league.FieldID)
+		for i := range fields {
+			if fields[i] != league.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := lq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := lq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := lq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := lq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, league.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (lq *LeagueQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(lq.driver.Dialect())
+	t1 := builder.Table(league.Table)
+	selector := builder.Select(t1.Columns(league.Columns...)...).From(t1)
+	if lq.sql != nil {
+		selector = lq.sql
+		selector.Select(selector.Columns(league.Columns...)...)
+	}
+	for _, p := range lq.predicates {
+		p(selector)
+	}
+	for _, p := range lq.order {
+		p(selector, league.ValidColumn)
+	}
+	if offset := lq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := lq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// LeagueGroupBy is the group-by builder for League entities.
+type LeagueGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (lgb *LeagueGroupBy) Aggregate(fns ...AggregateFunc) *LeagueGroupBy {
+	lgb.fns = append(lgb.fns, fns...)
+	return lgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (lgb *LeagueGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := lgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	lgb.sql = query
+	return lgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (lgb *LeagueGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := lgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (lgb *LeagueGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(lgb.fields) > 1 {
+		return nil, errors.New("db: LeagueGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := lgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (lgb *LeagueGroupBy) StringsX(ctx context.Context) []string {
+	v, err := lgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (lgb *LeagueGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = lgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{league.Label}
+	default:
+		err = fmt.Errorf("db: LeagueGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (lgb *LeagueGroupBy) StringX(ctx context.Context) string {
+	v, err := lgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (lgb *LeagueGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(lgb.fields) > 1 {
+		return nil, errors.New("db: LeagueGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := lgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (lgb *LeagueGroupBy) IntsX(ctx context.Context) []int {
+	v, err := lgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (lgb *LeagueGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = lgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{league.Label}
+	default:
+		err = fmt.Errorf("db: LeagueGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (lgb *LeagueGroupBy) IntX(ctx context.Context) int {
+	v, err := lgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (lgb *LeagueGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(lgb.fields) > 1 {
+		return nil, errors.New("db: LeagueGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := lgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (lgb *LeagueGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := lgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (lgb *LeagueGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = lgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{league.Label}
+	default:
+		err = fmt.Errorf("db: LeagueGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (lgb *LeagueGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := lgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (lgb *LeagueGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(lgb.fields) > 1 {
+		return nil, errors.New("db: LeagueGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := lgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (lgb *LeagueGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := lgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (lgb *LeagueGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = lgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{league.Label}
+	default:
+		err = fmt.Errorf("db: LeagueGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (lgb *LeagueGroupBy) BoolX(ctx context.Context) bool {
+	v, err := lgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (lgb *LeagueGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range lgb.fields {
+		if !league.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := lgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (lgb *LeagueGroupBy) sqlQuery() *sql.Selector {
+	selector := lgb.sql
+	columns := make([]string, 0, len(lgb.fields)+len(lgb.fns))
+	columns = append(columns, lgb.fields...)
+	for _, fn := range lgb.fns {
+		columns = append(columns, fn(selector, league.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(lgb.fields...)
+}
+
+// LeagueSelect is the builder for selecting fields of League entities.
+type LeagueSelect struct {
+	*LeagueQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ls *LeagueSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ls.sql = ls.LeagueQuery.sqlQuery(ctx)
+	return ls.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ls *LeagueSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ls.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (ls *LeagueSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ls.fields) > 1 {
+		return nil, errors.New("db: LeagueSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ls.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ls *LeagueSelect) StringsX(ctx context.Context) []string {
+	v, err := ls.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (ls *LeagueSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ls.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{league.Label}
+	default:
+		err = fmt.Errorf("db: LeagueSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ls *LeagueSelect) StringX(ctx context.Context) string {
+	v, err := ls.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (ls *LeagueSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ls.fields) > 1 {
+		return nil, errors.New("db: LeagueSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ls.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ls *LeagueSelect) IntsX(ctx context.Context) []int {
+	v, err := ls.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (ls *LeagueSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ls.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{league.Label}
+	default:
+		err = fmt.Errorf("db: LeagueSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ls *LeagueSelect) IntX(ctx context.Context) int {
+	v, err := ls.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (ls *LeagueSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ls.fields) > 1 {
+		return nil, errors.New("db: LeagueSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ls.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ls *LeagueSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ls.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (ls *LeagueSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ls.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{league.Label}
+	default:
+		err = fmt.Errorf("db: LeagueSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ls *LeagueSelect) Float64X(ctx context.Context) float64 {
+	v, err := ls.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (ls *LeagueSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ls.fields) > 1 {
+		return nil, errors.New("db: LeagueSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ls.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ls *LeagueSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ls.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (ls *LeagueSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ls.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{league.Label}
+	default:
+		err = fmt.Errorf("db: LeagueSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ls *LeagueSelect) BoolX(ctx context.Context) bool {
+	v, err := ls.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ls *LeagueSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ls.sqlQuery().Query()
+	if err := ls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ls *LeagueSelect) sqlQuery() sql.Querier {
+	selector := ls.sql
+	selector.Select(selector.Columns(ls.fields...)...)
+	return selector
+}
@@ -0,0 +1,1122 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/contest"
+	"github.com/NickDubelman/fantasy-bball/db/invitation"
+	"github.com/NickDubelman/fantasy-bball/db/league"
+	"github.com/NickDubelman/fantasy-bball/db/leaguemembership"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+	"github.com/NickDubelman/fantasy-bball/db/schema/schematype"
+)
+
+// LeagueUpdate is the builder for updating League entities.
+type LeagueUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LeagueMutation
+}
+
+// Where adds a new predicate for the LeagueUpdate builder.
+func (lu *LeagueUpdate) Where(ps ...predicate.League) *LeagueUpdate {
+	lu.mutation.predicates = append(lu.mutation.predicates, ps...)
+	return lu
+}
+
+// SetName sets the "name" field.
+func (lu *LeagueUpdate) SetName(s string) *LeagueUpdate {
+	lu.mutation.SetName(s)
+	return lu
+}
+
+// SetDescription sets the "description" field.
+func (lu *LeagueUpdate) SetDescription(s string) *LeagueUpdate {
+	lu.mutation.SetDescription(s)
+	return lu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (lu *LeagueUpdate) SetNillableDescription(s *string) *LeagueUpdate {
+	if s != nil {
+		lu.SetDescription(*s)
+	}
+	return lu
+}
+
+// SetMaxMembers sets the "maxMembers" field.
+func (lu *LeagueUpdate) SetMaxMembers(i int) *LeagueUpdate {
+	lu.mutation.ResetMaxMembers()
+	lu.mutation.SetMaxMembers(i)
+	return lu
+}
+
+// SetNillableMaxMembers sets the "maxMembers" field if the given value is not nil.
+func (lu *LeagueUpdate) SetNillableMaxMembers(i *int) *LeagueUpdate {
+	if i != nil {
+		lu.SetMaxMembers(*i)
+	}
+	return lu
+}
+
+// AddMaxMembers adds i to the "maxMembers" field.
+func (lu *LeagueUpdate) AddMaxMembers(i int) *LeagueUpdate {
+	lu.mutation.AddMaxMembers(i)
+	return lu
+}
+
+// SetStatWeights sets the "statWeights" field.
+func (lu *LeagueUpdate) SetStatWeights(sw schematype.StatWeights) *LeagueUpdate {
+	lu.mutation.SetStatWeights(sw)
+	return lu
+}
+
+// SetDraftRounds sets the "draftRounds" field.
+func (lu *LeagueUpdate) SetDraftRounds(i int) *LeagueUpdate {
+	lu.mutation.ResetDraftRounds()
+	lu.mutation.SetDraftRounds(i)
+	return lu
+}
+
+// SetNillableDraftRounds sets the "draftRounds" field if the given value is not nil.
+func (lu *LeagueUpdate) SetNillableDraftRounds(i *int) *LeagueUpdate {
+	if i != nil {
+		lu.SetDraftRounds(*i)
+	}
+	return lu
+}
+
+// AddDraftRounds adds i to the "draftRounds" field.
+func (lu *LeagueUpdate) AddDraftRounds(i int) *LeagueUpdate {
+	lu.mutation.AddDraftRounds(i)
+	return lu
+}
+
+// SetPickTimeLimit sets the "pickTimeLimit" field.
+func (lu *LeagueUpdate) SetPickTimeLimit(i int) *LeagueUpdate {
+	lu.mutation.ResetPickTimeLimit()
+	lu.mutation.SetPickTimeLimit(i)
+	return lu
+}
+
+// SetNillablePickTimeLimit sets the "pickTimeLimit" field if the given value is not nil.
+func (lu *LeagueUpdate) SetNillablePickTimeLimit(i *int) *LeagueUpdate {
+	if i != nil {
+		lu.SetPickTimeLimit(*i)
+	}
+	return lu
+}
+
+// AddPickTimeLimit adds i to the "pickTimeLimit" field.
+func (lu *LeagueUpdate) AddPickTimeLimit(i int) *LeagueUpdate {
+	lu.mutation.AddPickTimeLimit(i)
+	return lu
+}
+
+// AddMembershipIDs adds the "memberships" edge to the LeagueMembership entity by IDs.
+func (lu *LeagueUpdate) AddMembershipIDs(ids ...int) *LeagueUpdate {
+	lu.mutation.AddMembershipIDs(ids...)
+	return lu
+}
+
+// AddMemberships adds the "memberships" edges to the LeagueMembership entity.
+func (lu *LeagueUpdate) AddMemberships(l ...*LeagueMembership) *LeagueUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lu.AddMembershipIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
+func (lu *LeagueUpdate) AddInvitationIDs(ids ...int) *LeagueUpdate {
+	lu.mutation.AddInvitationIDs(ids...)
+	return lu
+}
+
+// AddInvitations adds the "invitations" edges to the Invitation entity.
+func (lu *LeagueUpdate) AddInvitations(i ...*Invitation) *LeagueUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return lu.AddInvitationIDs(ids...)
+}
+
+// AddContestIDs adds the "contests" edge to the Contest entity by IDs.
+func (lu *LeagueUpdate) AddContestIDs(ids ...int) *LeagueUpdate {
+	lu.mutation.AddContestIDs(ids...)
+	return lu
+}
+
+// AddContests adds the "contests" edges to the Contest entity.
+func (lu *LeagueUpdate) AddContests(c ...*Contest) *LeagueUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return lu.AddContestIDs(ids...)
+}
+
+// Mutation returns the LeagueMutation object of the builder.
+func (lu *LeagueUpdate) Mutation() *LeagueMutation {
+	return lu.mutation
+}
+
+// ClearMemberships clears all "memberships" edges to the LeagueMembership entity.
+func (lu *LeagueUpdate) ClearMemberships() *LeagueUpdate {
+	lu.mutation.ClearMemberships()
+	return lu
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to LeagueMembership entities by IDs.
+func (lu *LeagueUpdate) RemoveMembershipIDs(ids ...int) *LeagueUpdate {
+	lu.mutation.RemoveMembershipIDs(ids...)
+	return lu
+}
+
+// RemoveMemberships removes "memberships" edges to LeagueMembership entities.
+func (lu *LeagueUpdate) RemoveMemberships(l ...*LeagueMembership) *LeagueUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lu.RemoveMembershipIDs(ids...)
+}
+
+// ClearInvitations clears all "invitations" edges to the Invitation entity.
+func (lu *LeagueUpdate) ClearInvitations() *LeagueUpdate {
+	lu.mutation.ClearInvitations()
+	return lu
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to Invitation entities by IDs.
+func (lu *LeagueUpdate) RemoveInvitationIDs(ids ...int) *LeagueUpdate {
+	lu.mutation.RemoveInvitationIDs(ids...)
+	return lu
+}
+
+// RemoveInvitations removes "invitations" edges to Invitation entities.
+func (lu *LeagueUpdate) RemoveInvitations(i ...*Invitation) *LeagueUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return lu.RemoveInvitationIDs(ids...)
+}
+
+// ClearContests clears all "contests" edges to the Contest entity.
+func (lu *LeagueUpdate) ClearContests() *LeagueUpdate {
+	lu.mutation.ClearContests()
+	return lu
+}
+
+// RemoveContestIDs removes the "contests" edge to Contest entities by IDs.
+func (lu *LeagueUpdate) RemoveContestIDs(ids ...int) *LeagueUpdate {
+	lu.mutation.RemoveContestIDs(ids...)
+	return lu
+}
+
+// RemoveContests removes "contests" edges to Contest entities.
+func (lu *LeagueUpdate) RemoveContests(c ...*Contest) *LeagueUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return lu.RemoveContestIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lu *LeagueUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(lu.hooks) == 0 {
+		if err = lu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = lu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LeagueMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = lu.check(); err != nil {
+				return 0, err
+			}
+			lu.mutation = mutation
+			affected, err = lu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(lu.hooks) - 1; i >= 0; i-- {
+			mut = lu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, lu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lu *LeagueUpdate) SaveX(ctx context.Context) int {
+	affected, err := lu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lu *LeagueUpdate) Exec(ctx context.Context) error {
+	_, err := lu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lu *LeagueUpdate) ExecX(ctx context.Context) {
+	if err := lu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lu *LeagueUpdate) check() error {
+	if v, ok := lu.mutation.Name(); ok {
+		if err := league.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("db: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if v, ok := lu.mutation.MaxMembers(); ok {
+		if err := league.MaxMembersValidator(v); err != nil {
+			return &ValidationError{Name: "maxMembers", err: fmt.Errorf("db: validator failed for field \"maxMembers\": %w", err)}
+		}
+	}
+	if v, ok := lu.mutation.DraftRounds(); ok {
+		if err := league.DraftRoundsValidator(v); err != nil {
+			return &ValidationError{Name: "draftRounds", err: fmt.Errorf("db: validator failed for field \"draftRounds\": %w", err)}
+		}
+	}
+	if v, ok := lu.mutation.PickTimeLimit(); ok {
+		if err := league.PickTimeLimitValidator(v); err != nil {
+			return &ValidationError{Name: "pickTimeLimit", err: fmt.Errorf("db: validator failed for field \"pickTimeLimit\": %w", err)}
+		}
+	}
+	return nil
+}
+
+func (lu *LeagueUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   league.Table,
+			Columns: league.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: league.FieldID,
+			},
+		},
+	}
+	if ps := lu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: league.FieldName,
+		})
+	}
+	if value, ok := lu.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: league.FieldDescription,
+		})
+	}
+	if value, ok := lu.mutation.MaxMembers(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: league.FieldMaxMembers,
+		})
+	}
+	if value, ok := lu.mutation.AddedMaxMembers(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: league.FieldMaxMembers,
+		})
+	}
+	if value, ok := lu.mutation.StatWeights(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: league.FieldStatWeights,
+		})
+	}
+	if value, ok := lu.mutation.DraftRounds(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: league.FieldDraftRounds,
+		})
+	}
+	if value, ok := lu.mutation.AddedDraftRounds(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: league.FieldDraftRounds,
+		})
+	}
+	if value, ok := lu.mutation.PickTimeLimit(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: league.FieldPickTimeLimit,
+		})
+	}
+	if value, ok := lu.mutation.AddedPickTimeLimit(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: league.FieldPickTimeLimit,
+		})
+	}
+	if lu.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   league.MembershipsTable,
+			Columns: []string{league.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: leaguemembership.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !lu.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   league.MembershipsTable,
+			Columns: []string{league.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: leaguemembership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   league.MembershipsTable,
+			Columns: []string{league.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: leaguemembership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lu.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   league.InvitationsTable,
+			Columns: []string{league.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invitation.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !lu.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   league.InvitationsTable,
+			Columns: []string{league.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invitation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   league.InvitationsTable,
+			Columns: []string{league.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invitation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lu.mutation.ContestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   league.ContestsTable,
+			Columns: []string{league.ContestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contest.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.RemovedContestsIDs(); len(nodes) > 0 && !lu.mutation.ContestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   league.ContestsTable,
+			Columns: []string{league.ContestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contest.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.ContestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   league.ContestsTable,
+			Columns: []string{league.ContestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contest.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{league.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// LeagueUpdateOne is the builder for updating a single League entity.
+type LeagueUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *LeagueMutation
+}
+
+// SetName sets the "name" field.
+func (luo *LeagueUpdateOne) SetName(s string) *LeagueUpdateOne {
+	luo.mutation.SetName(s)
+	return luo
+}
+
+// SetDescription sets the "description" field.
+func (luo *LeagueUpdateOne) SetDescription(s string) *LeagueUpdateOne {
+	luo.mutation.SetDescription(s)
+	return luo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (luo *LeagueUpdateOne) SetNillableDescription(s *string) *LeagueUpdateOne {
+	if s != nil {
+		luo.SetDescription(*s)
+	}
+	return luo
+}
+
+// SetMaxMembers sets the "maxMembers" field.
+func (luo *LeagueUpdateOne) SetMaxMembers(i int) *LeagueUpdateOne {
+	luo.mutation.ResetMaxMembers()
+	luo.mutation.SetMaxMembers(i)
+	return luo
+}
+
+// SetNillableMaxMembers sets the "maxMembers" field if the given value is not nil.
+func (luo *LeagueUpdateOne) SetNillableMaxMembers(i *int) *LeagueUpdateOne {
+	if i != nil {
+		luo.SetMaxMembers(*i)
+	}
+	return luo
+}
+
+// AddMaxMembers adds i to the "maxMembers" field.
+func (luo *LeagueUpdateOne) AddMaxMembers(i int) *LeagueUpdateOne {
+	luo.mutation.AddMaxMembers(i)
+	return luo
+}
+
+// SetStatWeights sets the "statWeights" field.
+func (luo *LeagueUpdateOne) SetStatWeights(sw schematype.StatWeights) *LeagueUpdateOne {
+	luo.mutation.SetStatWeights(sw)
+	return luo
+}
+
+// SetDraftRounds sets the "draftRounds" field.
+func (luo *LeagueUpdateOne) SetDraftRounds(i int) *LeagueUpdateOne {
+	luo.mutation.ResetDraftRounds()
+	luo.mutation.SetDraftRounds(i)
+	return luo
+}
+
+// SetNillableDraftRounds sets the "draftRounds" field if the given value is not nil.
+func (luo *LeagueUpdateOne) SetNillableDraftRounds(i *int) *LeagueUpdateOne {
+	if i != nil {
+		luo.SetDraftRounds(*i)
+	}
+	return luo
+}
+
+// AddDraftRounds adds i to the "draftRounds" field.
+func (luo *LeagueUpdateOne) AddDraftRounds(i int) *LeagueUpdateOne {
+	luo.mutation.AddDraftRounds(i)
+	return luo
+}
+
+// SetPickTimeLimit sets the "pickTimeLimit" field.
+func (luo *LeagueUpdateOne) SetPickTimeLimit(i int) *LeagueUpdateOne {
+	luo.mutation.ResetPickTimeLimit()
+	luo.mutation.SetPickTimeLimit(i)
+	return luo
+}
+
+// SetNillablePickTimeLimit sets the "pickTimeLimit" field if the given value is not nil.
+func (luo *LeagueUpdateOne) SetNillablePickTimeLimit(i *int) *LeagueUpdateOne {
+	if i != nil {
+		luo.SetPickTimeLimit(*i)
+	}
+	return luo
+}
+
+// AddPickTimeLimit adds i to the "pickTimeLimit" field.
+func (luo *LeagueUpdateOne) AddPickTimeLimit(i int) *LeagueUpdateOne {
+	luo.mutation.AddPickTimeLimit(i)
+	return luo
+}
+
+// AddMembershipIDs adds the "memberships" edge to the LeagueMembership entity by IDs.
+func (luo *LeagueUpdateOne) AddMembershipIDs(ids ...int) *LeagueUpdateOne {
+	luo.mutation.AddMembershipIDs(ids...)
+	return luo
+}
+
+// AddMemberships adds the "memberships" edges to the LeagueMembership entity.
+func (luo *LeagueUpdateOne) AddMemberships(l ...*LeagueMembership) *LeagueUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return luo.AddMembershipIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
+func (luo *LeagueUpdateOne) AddInvitationIDs(ids ...int) *LeagueUpdateOne {
+	luo.mutation.AddInvitationIDs(ids...)
+	return luo
+}
+
+// AddInvitations adds the "invitations" edges to the Invitation entity.
+func (luo *LeagueUpdateOne) AddInvitations(i ...*Invitation) *LeagueUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return luo.AddInvitationIDs(ids...)
+}
+
+// AddContestIDs adds the "contests" edge to the Contest entity by IDs.
+func (luo *LeagueUpdateOne) AddContestIDs(ids ...int) *LeagueUpdateOne {
+	luo.mutation.AddContestIDs(ids...)
+	return luo
+}
+
+// AddContests adds the "contests" edges to the Contest entity.
+func (luo *LeagueUpdateOne) AddContests(c ...*Contest) *LeagueUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return luo.AddContestIDs(ids...)
+}
+
+// Mutation returns the LeagueMutation object of the builder.
+func (luo *LeagueUpdateOne) Mutation() *LeagueMutation {
+	return luo.mutation
+}
+
+// ClearMemberships clears all "memberships" edges to the LeagueMembership entity.
+func (luo *LeagueUpdateOne) ClearMemberships() *LeagueUpdateOne {
+	luo.mutation.ClearMemberships()
+	return luo
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to LeagueMembership entities by IDs.
+func (luo *LeagueUpdateOne) RemoveMembershipIDs(ids ...int) *LeagueUpdateOne {
+	luo.mutation.RemoveMembershipIDs(ids...)
+	return luo
+}
+
+// RemoveMemberships removes "memberships" edges to LeagueMembership entities.
+func (luo *LeagueUpdateOne) RemoveMemberships(l ...*LeagueMembership) *LeagueUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return luo.RemoveMembershipIDs(ids...)
+}
+
+// ClearInvitations clears all "invitations" edges to the Invitation entity.
+func (luo *LeagueUpdateOne) ClearInvitations() *LeagueUpdateOne {
+	luo.mutation.ClearInvitations()
+	return luo
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to Invitation entities by IDs.
+func (luo *LeagueUpdateOne) RemoveInvitationIDs(ids ...int) *LeagueUpdateOne {
+	luo.mutation.RemoveInvitationIDs(ids...)
+	return luo
+}
+
+// RemoveInvitations removes "invitations" edges to Invitation entities.
+func (luo *LeagueUpdateOne) RemoveInvitations(i ...*Invitation) *LeagueUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return luo.RemoveInvitationIDs(ids...)
+}
+
+// ClearContests clears all "contests" edges to the Contest entity.
+func (luo *LeagueUpdateOne) ClearContests() *LeagueUpdateOne {
+	luo.mutation.ClearContests()
+	return luo
+}
+
+// RemoveContestIDs removes the "contests" edge to Contest entities by IDs.
+func (luo *LeagueUpdateOne) RemoveContestIDs(ids ...int) *LeagueUpdateOne {
+	luo.mutation.RemoveContestIDs(ids...)
+	return luo
+}
+
+// RemoveContests removes "contests" edges to Contest entities.
+func (luo *LeagueUpdateOne) RemoveContests(c ...*Contest) *LeagueUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return luo.RemoveContestIDs(ids...)
+}
+
+// Save executes the query and returns the updated League entity.
+func (luo *LeagueUpdateOne) Save(ctx context.Context) (*League, error) {
+	var (
+		err  error
+		node *League
+	)
+	if len(luo.hooks) == 0 {
+		if err = luo.check(); err != nil {
+			return nil, err
+		}
+		node, err = luo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LeagueMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = luo.check(); err != nil {
+				return nil, err
+			}
+			luo.mutation = mutation
+			node, err = luo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(luo.hooks) - 1; i >= 0; i-- {
+			mut = luo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, luo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (luo *LeagueUpdateOne) SaveX(ctx context.Context) *League {
+	node, err := luo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (luo *LeagueUpdateOne) Exec(ctx context.Context) error {
+	_, err := luo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (luo *LeagueUpdateOne) ExecX(ctx context.Context) {
+	if err := luo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (luo *LeagueUpdateOne) check() error {
+	if v, ok := luo.mutation.Name(); ok {
+		if err := league.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("db: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if v, ok := luo.mutation.MaxMembers(); ok {
+		if err := league.MaxMembersValidator(v); err != nil {
+			return &ValidationError{Name: "maxMembers", err: fmt.Errorf("db: validator failed for field \"maxMembers\": %w", err)}
+		}
+	}
+	if v, ok := luo.mutation.DraftRounds(); ok {
+		if err := league.DraftRoundsValidator(v); err != nil {
+			return &ValidationError{Name: "draftRounds", err: fmt.Errorf("db: validator failed for field \"draftRounds\": %w", err)}
+		}
+	}
+	if v, ok := luo.mutation.PickTimeLimit(); ok {
+		if err := league.PickTimeLimitValidator(v); err != nil {
+			return &ValidationError{Name: "pickTimeLimit", err: fmt.Errorf("db: validator failed for field \"pickTimeLimit\": %w", err)}
+		}
+	}
+	return nil
+}
+
+func (luo *LeagueUpdateOne) sqlSave(ctx context.Context) (_node *League, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   league.Table,
+			Columns: league.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: league.FieldID,
+			},
+		},
+	}
+	id, ok := luo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing League.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := luo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := luo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: league.FieldName,
+		})
+	}
+	if value, ok := luo.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: league.FieldDescription,
+		})
+	}
+	if value, ok := luo.mutation.MaxMembers(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: league.FieldMaxMembers,
+		})
+	}
+	if value, ok := luo.mutation.AddedMaxMembers(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: league.FieldMaxMembers,
+		})
+	}
+	if value, ok := luo.mutation.StatWeights(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: league.FieldStatWeights,
+		})
+	}
+	if value, ok := luo.mutation.DraftRounds(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: league.FieldDraftRounds,
+		})
+	}
+	if value, ok := luo.mutation.AddedDraftRounds(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: league.FieldDraftRounds,
+		})
+	}
+	if value, ok := luo.mutation.PickTimeLimit(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: league.FieldPickTimeLimit,
+		})
+	}
+	if value, ok := luo.mutation.AddedPickTimeLimit(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: league.FieldPickTimeLimit,
+		})
+	}
+	if luo.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   league.MembershipsTable,
+			Columns: []string{league.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: leaguemembership.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !luo.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   league.MembershipsTable,
+			Columns: []string{league.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: leaguemembership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   league.MembershipsTable,
+			Columns: []string{league.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: leaguemembership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if luo.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   league.InvitationsTable,
+			Columns: []string{league.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invitation.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !luo.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   league.InvitationsTable,
+			Columns: []string{league.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invitation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   league.InvitationsTable,
+			Columns: []string{league.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invitation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if luo.mutation.ContestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   league.ContestsTable,
+			Columns: []string{league.ContestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contest.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.RemovedContestsIDs(); len(nodes) > 0 && !luo.mutation.ContestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   league.ContestsTable,
+			Columns: []string{league.ContestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contest.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.ContestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   league.ContestsTable,
+			Columns: []string{league.ContestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contest.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &League{config: luo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, luo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{league.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
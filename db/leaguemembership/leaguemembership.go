@@ -0,0 +1,79 @@
+// Code generated by entc, DO NOT EDIT.
+
+package leaguemembership
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the leaguemembership type in the database.
+	Label = "league_membership"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldIsCommissioner holds the string denoting the iscommissioner field in the database.
+	FieldIsCommissioner = "is_commissioner"
+	// FieldCanInvite holds the string denoting the caninvite field in the database.
+	FieldCanInvite = "can_invite"
+	// FieldJoined holds the string denoting the joined field in the database.
+	FieldJoined = "joined"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeLeague holds the string denoting the league edge name in mutations.
+	EdgeLeague = "league"
+	// Table holds the table name of the leaguemembership in the database.
+	Table = "league_memberships"
+	// UserTable is the table the holds the user relation/edge.
+	UserTable = "league_memberships"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_memberships"
+	// LeagueTable is the table the holds the league relation/edge.
+	LeagueTable = "league_memberships"
+	// LeagueInverseTable is the table name for the League entity.
+	// It exists in this package in order to avoid circular dependency with the "league" package.
+	LeagueInverseTable = "leagues"
+	// LeagueColumn is the table column denoting the league relation/edge.
+	LeagueColumn = "league_memberships"
+)
+
+// Columns holds all SQL columns for leaguemembership fields.
+var Columns = []string{
+	FieldID,
+	FieldIsCommissioner,
+	FieldCanInvite,
+	FieldJoined,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "league_memberships"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"league_memberships",
+	"user_memberships",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultIsCommissioner holds the default value on creation for the "isCommissioner" field.
+	DefaultIsCommissioner bool
+	// DefaultCanInvite holds the default value on creation for the "canInvite" field.
+	DefaultCanInvite bool
+	// DefaultJoined holds the default value on creation for the "joined" field.
+	DefaultJoined func() time.Time
+)
@@ -0,0 +1,307 @@
+// Code generated by entc, DO NOT EDIT.
+
+package leaguemembership
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// IsCommissioner applies equality check predicate on the "isCommissioner" field. It's identical to IsCommissionerEQ.
+func IsCommissioner(v bool) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIsCommissioner), v))
+	})
+}
+
+// CanInvite applies equality check predicate on the "canInvite" field. It's identical to CanInviteEQ.
+func CanInvite(v bool) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCanInvite), v))
+	})
+}
+
+// Joined applies equality check predicate on the "joined" field. It's identical to JoinedEQ.
+func Joined(v time.Time) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldJoined), v))
+	})
+}
+
+// IsCommissionerEQ applies the EQ predicate on the "isCommissioner" field.
+func IsCommissionerEQ(v bool) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIsCommissioner), v))
+	})
+}
+
+// IsCommissionerNEQ applies the NEQ predicate on the "isCommissioner" field.
+func IsCommissionerNEQ(v bool) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldIsCommissioner), v))
+	})
+}
+
+// CanInviteEQ applies the EQ predicate on the "canInvite" field.
+func CanInviteEQ(v bool) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCanInvite), v))
+	})
+}
+
+// CanInviteNEQ applies the NEQ predicate on the "canInvite" field.
+func CanInviteNEQ(v bool) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCanInvite), v))
+	})
+}
+
+// JoinedEQ applies the EQ predicate on the "joined" field.
+func JoinedEQ(v time.Time) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldJoined), v))
+	})
+}
+
+// JoinedNEQ applies the NEQ predicate on the "joined" field.
+func JoinedNEQ(v time.Time) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldJoined), v))
+	})
+}
+
+// JoinedIn applies the In predicate on the "joined" field.
+func JoinedIn(vs ...time.Time) predicate.LeagueMembership {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldJoined), v...))
+	})
+}
+
+// JoinedNotIn applies the NotIn predicate on the "joined" field.
+func JoinedNotIn(vs ...time.Time) predicate.LeagueMembership {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldJoined), v...))
+	})
+}
+
+// JoinedGT applies the GT predicate on the "joined" field.
+func JoinedGT(v time.Time) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldJoined), v))
+	})
+}
+
+// JoinedGTE applies the GTE predicate on the "joined" field.
+func JoinedGTE(v time.Time) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldJoined), v))
+	})
+}
+
+// JoinedLT applies the LT predicate on the "joined" field.
+func JoinedLT(v time.Time) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldJoined), v))
+	})
+}
+
+// JoinedLTE applies the LTE predicate on the "joined" field.
+func JoinedLTE(v time.Time) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldJoined), v))
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLeague applies the HasEdge predicate on the "league" edge.
+func HasLeague() predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(LeagueTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LeagueTable, LeagueColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLeagueWith applies the HasEdge predicate on the "league" edge with a given conditions (other predicates).
+func HasLeagueWith(preds ...predicate.League) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(LeagueInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LeagueTable, LeagueColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LeagueMembership) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LeagueMembership) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LeagueMembership) predicate.LeagueMembership {
+	return predicate.LeagueMembership(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
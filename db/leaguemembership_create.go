@@ -0,0 +1,331 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/league"
+	"github.com/NickDubelman/fantasy-bball/db/leaguemembership"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+)
+
+// LeagueMembershipCreate is the builder for creating a LeagueMembership entity.
+type LeagueMembershipCreate struct {
+	config
+	mutation *LeagueMembershipMutation
+	hooks    []Hook
+}
+
+// SetIsCommissioner sets the "isCommissioner" field.
+func (lmc *LeagueMembershipCreate) SetIsCommissioner(b bool) *LeagueMembershipCreate {
+	lmc.mutation.SetIsCommissioner(b)
+	return lmc
+}
+
+// SetNillableIsCommissioner sets the "isCommissioner" field if the given value is not nil.
+func (lmc *LeagueMembershipCreate) SetNillableIsCommissioner(b *bool) *LeagueMembershipCreate {
+	if b != nil {
+		lmc.SetIsCommissioner(*b)
+	}
+	return lmc
+}
+
+// SetCanInvite sets the "canInvite" field.
+func (lmc *LeagueMembershipCreate) SetCanInvite(b bool) *LeagueMembershipCreate {
+	lmc.mutation.SetCanInvite(b)
+	return lmc
+}
+
+// SetNillableCanInvite sets the "canInvite" field if the given value is not nil.
+func (lmc *LeagueMembershipCreate) SetNillableCanInvite(b *bool) *LeagueMembershipCreate {
+	if b != nil {
+		lmc.SetCanInvite(*b)
+	}
+	return lmc
+}
+
+// SetJoined sets the "joined" field.
+func (lmc *LeagueMembershipCreate) SetJoined(t time.Time) *LeagueMembershipCreate {
+	lmc.mutation.SetJoined(t)
+	return lmc
+}
+
+// SetNillableJoined sets the "joined" field if the given value is not nil.
+func (lmc *LeagueMembershipCreate) SetNillableJoined(t *time.Time) *LeagueMembershipCreate {
+	if t != nil {
+		lmc.SetJoined(*t)
+	}
+	return lmc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (lmc *LeagueMembershipCreate) SetUserID(id int) *LeagueMembershipCreate {
+	lmc.mutation.SetUserID(id)
+	return lmc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (lmc *LeagueMembershipCreate) SetUser(u *User) *LeagueMembershipCreate {
+	return lmc.SetUserID(u.ID)
+}
+
+// SetLeagueID sets the "league" edge to the League entity by ID.
+func (lmc *LeagueMembershipCreate) SetLeagueID(id int) *LeagueMembershipCreate {
+	lmc.mutation.SetLeagueID(id)
+	return lmc
+}
+
+// SetLeague sets the "league" edge to the League entity.
+func (lmc *LeagueMembershipCreate) SetLeague(l *League) *LeagueMembershipCreate {
+	return lmc.SetLeagueID(l.ID)
+}
+
+// Mutation returns the LeagueMembershipMutation object of the builder.
+func (lmc *LeagueMembershipCreate) Mutation() *LeagueMembershipMutation {
+	return lmc.mutation
+}
+
+// Save creates the LeagueMembership in the database.
+func (lmc *LeagueMembershipCreate) Save(ctx context.Context) (*LeagueMembership, error) {
+	var (
+		err  error
+		node *LeagueMembership
+	)
+	lmc.defaults()
+	if len(lmc.hooks) == 0 {
+		if err = lmc.check(); err != nil {
+			return nil, err
+		}
+		node, err = lmc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LeagueMembershipMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = lmc.check(); err != nil {
+				return nil, err
+			}
+			lmc.mutation = mutation
+			node, err = lmc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(lmc.hooks) - 1; i >= 0; i-- {
+			mut = lmc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, lmc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lmc *LeagueMembershipCreate) SaveX(ctx context.Context) *LeagueMembership {
+	v, err := lmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (lmc *LeagueMembershipCreate) defaults() {
+	if _, ok := lmc.mutation.IsCommissioner(); !ok {
+		v := leaguemembership.DefaultIsCommissioner
+		lmc.mutation.SetIsCommissioner(v)
+	}
+	if _, ok := lmc.mutation.CanInvite(); !ok {
+		v := leaguemembership.DefaultCanInvite
+		lmc.mutation.SetCanInvite(v)
+	}
+	if _, ok := lmc.mutation.Joined(); !ok {
+		v := leaguemembership.DefaultJoined()
+		lmc.mutation.SetJoined(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lmc *LeagueMembershipCreate) check() error {
+	if _, ok := lmc.mutation.IsCommissioner(); !ok {
+		return &ValidationError{Name: "isCommissioner", err: errors.New("db: missing required field \"isCommissioner\"")}
+	}
+	if _, ok := lmc.mutation.CanInvite(); !ok {
+		return &ValidationError{Name: "canInvite", err: errors.New("db: missing required field \"canInvite\"")}
+	}
+	if _, ok := lmc.mutation.Joined(); !ok {
+		return &ValidationError{Name: "joined", err: errors.New("db: missing required field \"joined\"")}
+	}
+	if _, ok := lmc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New("db: missing required edge \"user\"")}
+	}
+	if _, ok := lmc.mutation.LeagueID(); !ok {
+		return &ValidationError{Name: "league", err: errors.New("db: missing required edge \"league\"")}
+	}
+	return nil
+}
+
+func (lmc *LeagueMembershipCreate) sqlSave(ctx context.Context) (*LeagueMembership, error) {
+	_node, _spec := lmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lmc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (lmc *LeagueMembershipCreate) createSpec() (*LeagueMembership, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LeagueMembership{config: lmc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: leaguemembership.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: leaguemembership.FieldID,
+			},
+		}
+	)
+	if value, ok := lmc.mutation.IsCommissioner(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: leaguemembership.FieldIsCommissioner,
+		})
+		_node.IsCommissioner = value
+	}
+	if value, ok := lmc.mutation.CanInvite(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: leaguemembership.FieldCanInvite,
+		})
+		_node.CanInvite = value
+	}
+	if value, ok := lmc.mutation.Joined(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: leaguemembership.FieldJoined,
+		})
+		_node.Joined = value
+	}
+	if nodes := lmc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaguemembership.UserTable,
+			Columns: []string{leaguemembership.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_memberships = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lmc.mutation.LeagueIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaguemembership.LeagueTable,
+			Columns: []string{leaguemembership.LeagueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: league.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.league_memberships = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LeagueMembershipCreateBulk is the builder for creating many LeagueMembership entities in bulk.
+type LeagueMembershipCreateBulk struct {
+	config
+	builders []*LeagueMembershipCreate
+}
+
+// Save creates the LeagueMembership entities in the database.
+func (lmcb *LeagueMembershipCreateBulk) Save(ctx context.Context) ([]*LeagueMembership, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(lmcb.builders))
+	nodes := make([]*LeagueMembership, len(lmcb.builders))
+	mutators := make([]Mutator, len(lmcb.builders))
+	for i := range lmcb.builders {
+		func(i int, root context.Context) {
+			builder := lmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LeagueMembershipMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lmcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lmcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lmcb *LeagueMembershipCreateBulk) SaveX(ctx context.Context) []*LeagueMembership {
+	v, err := lmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
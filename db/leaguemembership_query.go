@@ -0,0 +1,1032 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/league"
+	"github.com/NickDubelman/fantasy-bball/db/leaguemembership"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+)
+
+// LeagueMembershipQuery is the builder for querying LeagueMembership entities.
+type LeagueMembershipQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.LeagueMembership
+	// eager-loading edges.
+	withUser   *UserQuery
+	withLeague *LeagueQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LeagueMembershipQuery builder.
+func (lmq *LeagueMembershipQuery) Where(ps ...predicate.LeagueMembership) *LeagueMembershipQuery {
+	lmq.predicates = append(lmq.predicates, ps...)
+	return lmq
+}
+
+// Limit adds a limit step to the query.
+func (lmq *LeagueMembershipQuery) Limit(limit int) *LeagueMembershipQuery {
+	lmq.limit = &limit
+	return lmq
+}
+
+// Offset adds an offset step to the query.
+func (lmq *LeagueMembershipQuery) Offset(offset int) *LeagueMembershipQuery {
+	lmq.offset = &offset
+	return lmq
+}
+
+// Order adds an order step to the query.
+func (lmq *LeagueMembershipQuery) Order(o ...OrderFunc) *LeagueMembershipQuery {
+	lmq.order = append(lmq.order, o...)
+	return lmq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (lmq *LeagueMembershipQuery) QueryUser() *UserQuery {
+	query := &UserQuery{config: lmq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leaguemembership.Table, leaguemembership.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leaguemembership.UserTable, leaguemembership.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLeague chains the current query on the "league" edge.
+func (lmq *LeagueMembershipQuery) QueryLeague() *LeagueQuery {
+	query := &LeagueQuery{config: lmq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leaguemembership.Table, leaguemembership.FieldID, selector),
+			sqlgraph.To(league.Table, league.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leaguemembership.LeagueTable, leaguemembership.LeagueColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first LeagueMembership entity from the query.
+// Returns a *NotFoundError when no LeagueMembership was found.
+func (lmq *LeagueMembershipQuery) First(ctx context.Context) (*LeagueMembership, error) {
+	nodes, err := lmq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{leaguemembership.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (lmq *LeagueMembershipQuery) FirstX(ctx context.Context) *LeagueMembership {
+	node, err := lmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LeagueMembership ID from the query.
+// Returns a *NotFoundError when no LeagueMembership ID was found.
+func (lmq *LeagueMembershipQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = lmq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{leaguemembership.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (lmq *LeagueMembershipQuery) FirstIDX(ctx context.Context) int {
+	id, err := lmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LeagueMembership entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one LeagueMembership entity is not found.
+// Returns a *NotFoundError when no LeagueMembership entities are found.
+func (lmq *LeagueMembershipQuery) Only(ctx context.Context) (*LeagueMembership, error) {
+	nodes, err := lmq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{leaguemembership.Label}
+	default:
+		return nil, &NotSingularError{leaguemembership.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (lmq *LeagueMembershipQuery) OnlyX(ctx context.Context) *LeagueMembership {
+	node, err := lmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LeagueMembership ID in the query.
+// Returns a *NotSingularError when exactly one LeagueMembership ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (lmq *LeagueMembershipQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = lmq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{leaguemembership.Label}
+	default:
+		err = &NotSingularError{leaguemembership.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (lmq *LeagueMembershipQuery) OnlyIDX(ctx context.Context) int {
+	id, err := lmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LeagueMemberships.
+func (lmq *LeagueMembershipQuery) All(ctx context.Context) ([]*LeagueMembership, error) {
+	if err := lmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return lmq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (lmq *LeagueMembershipQuery) AllX(ctx context.Context) []*LeagueMembership {
+	nodes, err := lmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LeagueMembership IDs.
+func (lmq *LeagueMembershipQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := lmq.Select(leaguemembership.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (lmq *LeagueMembershipQuery) IDsX(ctx context.Context) []int {
+	ids, err := lmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (lmq *LeagueMembershipQuery) Count(ctx context.Context) (int, error) {
+	if err := lmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return lmq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (lmq *LeagueMembershipQuery) CountX(ctx context.Context) int {
+	count, err := lmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (lmq *LeagueMembershipQuery) Exist(ctx context.Context) (bool, error) {
+	if err := lmq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return lmq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (lmq *LeagueMembershipQuery) ExistX(ctx context.Context) bool {
+	exist, err := lmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LeagueMembershipQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (lmq *LeagueMembershipQuery) Clone() *LeagueMembershipQuery {
+	if lmq == nil {
+		return nil
+	}
+	return &LeagueMembershipQuery{
+		config:     lmq.config,
+		limit:      lmq.limit,
+		offset:     lmq.offset,
+		order:      append([]OrderFunc{}, lmq.order...),
+		predicates: append([]predicate.LeagueMembership{}, lmq.predicates...),
+		withUser:   lmq.withUser.Clone(),
+		withLeague: lmq.withLeague.Clone(),
+		// clone intermediate query.
+		sql:  lmq.sql.Clone(),
+		path: lmq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (lmq *LeagueMembershipQuery) WithUser(opts ...func(*UserQuery)) *LeagueMembershipQuery {
+	query := &UserQuery{config: lmq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	lmq.withUser = query
+	return lmq
+}
+
+// WithLeague tells the query-builder to eager-load the nodes that are connected to
+// the "league" edge. The optional arguments are used to configure the query builder of the edge.
+func (lmq *LeagueMembershipQuery) WithLeague(opts ...func(*LeagueQuery)) *LeagueMembershipQuery {
+	query := &LeagueQuery{config: lmq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	lmq.withLeague = query
+	return lmq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		IsCommissioner bool `json:"isCommissioner,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LeagueMembership.Query().
+//		GroupBy(leaguemembership.FieldIsCommissioner).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (lmq *LeagueMembershipQuery) GroupBy(field string, fields ...string) *LeagueMembershipGroupBy {
+	group := &LeagueMembershipGroupBy{config: lmq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := lmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return lmq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		IsCommissioner bool `json:"isCommissioner,omitempty"`
+//	}
+//
+//	client.LeagueMembership.Query().
+//		Select(leaguemembership.FieldIsCommissioner).
+//		Scan(ctx, &v)
+func (lmq *LeagueMembershipQuery) Select(field string, fields ...string) *LeagueMembershipSelect {
+	lmq.fields = append([]string{field}, fields...)
+	return &LeagueMembershipSelect{LeagueMembershipQuery: lmq}
+}
+
+func (lmq *LeagueMembershipQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range lmq.fields {
+		if !leaguemembership.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if lmq.path != nil {
+		prev, err := lmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		lmq.sql = prev
+	}
+	return nil
+}
+
+func (lmq *LeagueMembershipQuery) sqlAll(ctx context.Context) ([]*LeagueMembership, error) {
+	var (
+		nodes       = []*LeagueMembership{}
+		withFKs     = lmq.withFKs
+		_spec       = lmq.querySpec()
+		loadedTypes = [2]bool{
+			lmq.withUser != nil,
+			lmq.withLeague != nil,
+		}
+	)
+	if lmq.withUser != nil || lmq.withLeague != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, leaguemembership.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &LeagueMembership{config: lmq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("db: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, lmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := lmq.withUser; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*LeagueMembership)
+		for i := range nodes {
+			fk := nodes[i].user_memberships
+			if fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(user.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_memberships" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.User = n
+			}
+		}
+	}
+
+	if query := lmq.withLeague; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*LeagueMembership)
+		for i := range nodes {
+			fk := nodes[i].league_memberships
+			if fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(league.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "league_memberships" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.League = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (lmq *LeagueMembershipQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := lmq.querySpec()
+	return sqlgraph.CountNodes(ctx, lmq.driver, _spec)
+}
+
+func (lmq *LeagueMembershipQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := lmq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("db: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (lmq *LeagueMembershipQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   leaguemembership.Table,
+			Columns: leaguemembership.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: leaguemembership.FieldID,
+			},
+		},
+		From:   lmq.sql,
+		Unique: true,
+	}
+	if fields := lmq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, leaguemembership.FieldID)
+		for i := range fields {
+			if fields[i] != leaguemembership.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := lmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := lmq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := lmq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := lmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, leaguemembership.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (lmq *LeagueMembershipQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(lmq.driver.Dialect())
+	t1 := builder.Table(leaguemembership.Table)
+	selector := builder.Select(t1.Columns(leaguemembership.Columns...)...).From(t1)
+	if lmq.sql != nil {
+		selector = lmq.sql
+		selector.Select(selector.Columns(leaguemembership.Columns...)...)
+	}
+	for _, p := range lmq.predicates {
+		p(selector)
+	}
+	for _, p := range lmq.order {
+		p(selector, leaguemembership.ValidColumn)
+	}
+	if offset := lmq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := lmq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// LeagueMembershipGroupBy is the group-by builder for LeagueMembership entities.
+type LeagueMembershipGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (lmgb *LeagueMembershipGroupBy) Aggregate(fns ...AggregateFunc) *LeagueMembershipGroupBy {
+	lmgb.fns = append(lmgb.fns, fns...)
+	return lmgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (lmgb *LeagueMembershipGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := lmgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	lmgb.sql = query
+	return lmgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (lmgb *LeagueMembershipGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := lmgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (lmgb *LeagueMembershipGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(lmgb.fields) > 1 {
+		return nil, errors.New("db: LeagueMembershipGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := lmgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (lmgb *LeagueMembershipGroupBy) StringsX(ctx context.Context) []string {
+	v, err := lmgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (lmgb *LeagueMembershipGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = lmgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{leaguemembership.Label}
+	default:
+		err = fmt.Errorf("db: LeagueMembershipGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (lmgb *LeagueMembershipGroupBy) StringX(ctx context.Context) string {
+	v, err := lmgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (lmgb *LeagueMembershipGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(lmgb.fields) > 1 {
+		return nil, errors.New("db: LeagueMembershipGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := lmgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (lmgb *LeagueMembershipGroupBy) IntsX(ctx context.Context) []int {
+	v, err := lmgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (lmgb *LeagueMembershipGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = lmgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{leaguemembership.Label}
+	default:
+		err = fmt.Errorf("db: LeagueMembershipGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (lmgb *LeagueMembershipGroupBy) IntX(ctx context.Context) int {
+	v, err := lmgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (lmgb *LeagueMembershipGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(lmgb.fields) > 1 {
+		return nil, errors.New("db: LeagueMembershipGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := lmgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (lmgb *LeagueMembershipGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := lmgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (lmgb *LeagueMembershipGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = lmgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{leaguemembership.Label}
+	default:
+		err = fmt.Errorf("db: LeagueMembershipGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (lmgb *LeagueMembershipGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := lmgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (lmgb *LeagueMembershipGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(lmgb.fields) > 1 {
+		return nil, errors.New("db: LeagueMembershipGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := lmgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (lmgb *LeagueMembershipGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := lmgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (lmgb *LeagueMembershipGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = lmgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{leaguemembership.Label}
+	default:
+		err = fmt.Errorf("db: LeagueMembershipGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (lmgb *LeagueMembershipGroupBy) BoolX(ctx context.Context) bool {
+	v, err := lmgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (lmgb *LeagueMembershipGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range lmgb.fields {
+		if !leaguemembership.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := lmgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lmgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (lmgb *LeagueMembershipGroupBy) sqlQuery() *sql.Selector {
+	selector := lmgb.sql
+	columns := make([]string, 0, len(lmgb.fields)+len(lmgb.fns))
+	columns = append(columns, lmgb.fields...)
+	for _, fn := range lmgb.fns {
+		columns = append(columns, fn(selector, leaguemembership.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(lmgb.fields...)
+}
+
+// LeagueMembershipSelect is the builder for selecting fields of LeagueMembership entities.
+type LeagueMembershipSelect struct {
+	*LeagueMembershipQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lms *LeagueMembershipSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := lms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	lms.sql = lms.LeagueMembershipQuery.sqlQuery(ctx)
+	return lms.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (lms *LeagueMembershipSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := lms.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (lms *LeagueMembershipSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(lms.fields) > 1 {
+		return nil, errors.New("db: LeagueMembershipSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := lms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (lms *LeagueMembershipSelect) StringsX(ctx context.Context) []string {
+	v, err := lms.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (lms *LeagueMembershipSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = lms.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{leaguemembership.Label}
+	default:
+		err = fmt.Errorf("db: LeagueMembershipSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (lms *LeagueMembershipSelect) StringX(ctx context.Context) string {
+	v, err := lms.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (lms *LeagueMembershipSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(lms.fields) > 1 {
+		return nil, errors.New("db: LeagueMembershipSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := lms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (lms *LeagueMembershipSelect) IntsX(ctx context.Context) []int {
+	v, err := lms.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (lms *LeagueMembershipSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = lms.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{leaguemembership.Label}
+	default:
+		err = fmt.Errorf("db: LeagueMembershipSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (lms *LeagueMembershipSelect) IntX(ctx context.Context) int {
+	v, err := lms.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (lms *LeagueMembershipSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(lms.fields) > 1 {
+		return nil, errors.New("db: LeagueMembershipSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := lms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (lms *LeagueMembershipSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := lms.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (lms *LeagueMembershipSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = lms.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{leaguemembership.Label}
+	default:
+		err = fmt.Errorf("db: LeagueMembershipSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (lms *LeagueMembershipSelect) Float64X(ctx context.Context) float64 {
+	v, err := lms.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (lms *LeagueMembershipSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(lms.fields) > 1 {
+		return nil, errors.New("db: LeagueMembershipSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := lms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (lms *LeagueMembershipSelect) BoolsX(ctx context.Context) []bool {
+	v, err := lms.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (lms *LeagueMembershipSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = lms.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{leaguemembership.Label}
+	default:
+		err = fmt.Errorf("db: LeagueMembershipSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (lms *LeagueMembershipSelect) BoolX(ctx context.Context) bool {
+	v, err := lms.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (lms *LeagueMembershipSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := lms.sqlQuery().Query()
+	if err := lms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (lms *LeagueMembershipSelect) sqlQuery() sql.Querier {
+	selector := lms.sql
+	selector.Select(selector.Columns(lms.fields...)...)
+	return selector
+}
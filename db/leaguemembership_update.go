@@ -0,0 +1,541 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/league"
+	"github.com/NickDubelman/fantasy-bball/db/leaguemembership"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+)
+
+// LeagueMembershipUpdate is the builder for updating LeagueMembership entities.
+type LeagueMembershipUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LeagueMembershipMutation
+}
+
+// Where adds a new predicate for the LeagueMembershipUpdate builder.
+func (lmu *LeagueMembershipUpdate) Where(ps ...predicate.LeagueMembership) *LeagueMembershipUpdate {
+	lmu.mutation.predicates = append(lmu.mutation.predicates, ps...)
+	return lmu
+}
+
+// SetIsCommissioner sets the "isCommissioner" field.
+func (lmu *LeagueMembershipUpdate) SetIsCommissioner(b bool) *LeagueMembershipUpdate {
+	lmu.mutation.SetIsCommissioner(b)
+	return lmu
+}
+
+// SetNillableIsCommissioner sets the "isCommissioner" field if the given value is not nil.
+func (lmu *LeagueMembershipUpdate) SetNillableIsCommissioner(b *bool) *LeagueMembershipUpdate {
+	if b != nil {
+		lmu.SetIsCommissioner(*b)
+	}
+	return lmu
+}
+
+// SetCanInvite sets the "canInvite" field.
+func (lmu *LeagueMembershipUpdate) SetCanInvite(b bool) *LeagueMembershipUpdate {
+	lmu.mutation.SetCanInvite(b)
+	return lmu
+}
+
+// SetNillableCanInvite sets the "canInvite" field if the given value is not nil.
+func (lmu *LeagueMembershipUpdate) SetNillableCanInvite(b *bool) *LeagueMembershipUpdate {
+	if b != nil {
+		lmu.SetCanInvite(*b)
+	}
+	return lmu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (lmu *LeagueMembershipUpdate) SetUserID(id int) *LeagueMembershipUpdate {
+	lmu.mutation.SetUserID(id)
+	return lmu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (lmu *LeagueMembershipUpdate) SetUser(u *User) *LeagueMembershipUpdate {
+	return lmu.SetUserID(u.ID)
+}
+
+// SetLeagueID sets the "league" edge to the League entity by ID.
+func (lmu *LeagueMembershipUpdate) SetLeagueID(id int) *LeagueMembershipUpdate {
+	lmu.mutation.SetLeagueID(id)
+	return lmu
+}
+
+// SetLeague sets the "league" edge to the League entity.
+func (lmu *LeagueMembershipUpdate) SetLeague(l *League) *LeagueMembershipUpdate {
+	return lmu.SetLeagueID(l.ID)
+}
+
+// Mutation returns the LeagueMembershipMutation object of the builder.
+func (lmu *LeagueMembershipUpdate) Mutation() *LeagueMembershipMutation {
+	return lmu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (lmu *LeagueMembershipUpdate) ClearUser() *LeagueMembershipUpdate {
+	lmu.mutation.ClearUser()
+	return lmu
+}
+
+// ClearLeague clears the "league" edge to the League entity.
+func (lmu *LeagueMembershipUpdate) ClearLeague() *LeagueMembershipUpdate {
+	lmu.mutation.ClearLeague()
+	return lmu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lmu *LeagueMembershipUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(lmu.hooks) == 0 {
+		if err = lmu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = lmu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LeagueMembershipMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = lmu.check(); err != nil {
+				return 0, err
+			}
+			lmu.mutation = mutation
+			affected, err = lmu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(lmu.hooks) - 1; i >= 0; i-- {
+			mut = lmu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, lmu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lmu *LeagueMembershipUpdate) SaveX(ctx context.Context) int {
+	affected, err := lmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lmu *LeagueMembershipUpdate) Exec(ctx context.Context) error {
+	_, err := lmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lmu *LeagueMembershipUpdate) ExecX(ctx context.Context) {
+	if err := lmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lmu *LeagueMembershipUpdate) check() error {
+	if _, ok := lmu.mutation.UserID(); lmu.mutation.UserCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"user\"")
+	}
+	if _, ok := lmu.mutation.LeagueID(); lmu.mutation.LeagueCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"league\"")
+	}
+	return nil
+}
+
+func (lmu *LeagueMembershipUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   leaguemembership.Table,
+			Columns: leaguemembership.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: leaguemembership.FieldID,
+			},
+		},
+	}
+	if ps := lmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lmu.mutation.IsCommissioner(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: leaguemembership.FieldIsCommissioner,
+		})
+	}
+	if value, ok := lmu.mutation.CanInvite(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: leaguemembership.FieldCanInvite,
+		})
+	}
+	if lmu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaguemembership.UserTable,
+			Columns: []string{leaguemembership.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lmu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaguemembership.UserTable,
+			Columns: []string{leaguemembership.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lmu.mutation.LeagueCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaguemembership.LeagueTable,
+			Columns: []string{leaguemembership.LeagueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: league.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lmu.mutation.LeagueIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaguemembership.LeagueTable,
+			Columns: []string{leaguemembership.LeagueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: league.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leaguemembership.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// LeagueMembershipUpdateOne is the builder for updating a single LeagueMembership entity.
+type LeagueMembershipUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *LeagueMembershipMutation
+}
+
+// SetIsCommissioner sets the "isCommissioner" field.
+func (lmuo *LeagueMembershipUpdateOne) SetIsCommissioner(b bool) *LeagueMembershipUpdateOne {
+	lmuo.mutation.SetIsCommissioner(b)
+	return lmuo
+}
+
+// SetNillableIsCommissioner sets the "isCommissioner" field if the given value is not nil.
+func (lmuo *LeagueMembershipUpdateOne) SetNillableIsCommissioner(b *bool) *LeagueMembershipUpdateOne {
+	if b != nil {
+		lmuo.SetIsCommissioner(*b)
+	}
+	return lmuo
+}
+
+// SetCanInvite sets the "canInvite" field.
+func (lmuo *LeagueMembershipUpdateOne) SetCanInvite(b bool) *LeagueMembershipUpdateOne {
+	lmuo.mutation.SetCanInvite(b)
+	return lmuo
+}
+
+// SetNillableCanInvite sets the "canInvite" field if the given value is not nil.
+func (lmuo *LeagueMembershipUpdateOne) SetNillableCanInvite(b *bool) *LeagueMembershipUpdateOne {
+	if b != nil {
+		lmuo.SetCanInvite(*b)
+	}
+	return lmuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (lmuo *LeagueMembershipUpdateOne) SetUserID(id int) *LeagueMembershipUpdateOne {
+	lmuo.mutation.SetUserID(id)
+	return lmuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (lmuo *LeagueMembershipUpdateOne) SetUser(u *User) *LeagueMembershipUpdateOne {
+	return lmuo.SetUserID(u.ID)
+}
+
+// SetLeagueID sets the "league" edge to the League entity by ID.
+func (lmuo *LeagueMembershipUpdateOne) SetLeagueID(id int) *LeagueMembershipUpdateOne {
+	lmuo.mutation.SetLeagueID(id)
+	return lmuo
+}
+
+// SetLeague sets the "league" edge to the League entity.
+func (lmuo *LeagueMembershipUpdateOne) SetLeague(l *League) *LeagueMembershipUpdateOne {
+	return lmuo.SetLeagueID(l.ID)
+}
+
+// Mutation returns the LeagueMembershipMutation object of the builder.
+func (lmuo *LeagueMembershipUpdateOne) Mutation() *LeagueMembershipMutation {
+	return lmuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (lmuo *LeagueMembershipUpdateOne) ClearUser() *LeagueMembershipUpdateOne {
+	lmuo.mutation.ClearUser()
+	return lmuo
+}
+
+// ClearLeague clears the "league" edge to the League entity.
+func (lmuo *LeagueMembershipUpdateOne) ClearLeague() *LeagueMembershipUpdateOne {
+	lmuo.mutation.ClearLeague()
+	return lmuo
+}
+
+// Save executes the query and returns the updated LeagueMembership entity.
+func (lmuo *LeagueMembershipUpdateOne) Save(ctx context.Context) (*LeagueMembership, error) {
+	var (
+		err  error
+		node *LeagueMembership
+	)
+	if len(lmuo.hooks) == 0 {
+		if err = lmuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = lmuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LeagueMembershipMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = lmuo.check(); err != nil {
+				return nil, err
+			}
+			lmuo.mutation = mutation
+			node, err = lmuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(lmuo.hooks) - 1; i >= 0; i-- {
+			mut = lmuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, lmuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lmuo *LeagueMembershipUpdateOne) SaveX(ctx context.Context) *LeagueMembership {
+	node, err := lmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lmuo *LeagueMembershipUpdateOne) Exec(ctx context.Context) error {
+	_, err := lmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lmuo *LeagueMembershipUpdateOne) ExecX(ctx context.Context) {
+	if err := lmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lmuo *LeagueMembershipUpdateOne) check() error {
+	if _, ok := lmuo.mutation.UserID(); lmuo.mutation.UserCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"user\"")
+	}
+	if _, ok := lmuo.mutation.LeagueID(); lmuo.mutation.LeagueCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"league\"")
+	}
+	return nil
+}
+
+func (lmuo *LeagueMembershipUpdateOne) sqlSave(ctx context.Context) (_node *LeagueMembership, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   leaguemembership.Table,
+			Columns: leaguemembership.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: leaguemembership.FieldID,
+			},
+		},
+	}
+	id, ok := lmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing LeagueMembership.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := lmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lmuo.mutation.IsCommissioner(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: leaguemembership.FieldIsCommissioner,
+		})
+	}
+	if value, ok := lmuo.mutation.CanInvite(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: leaguemembership.FieldCanInvite,
+		})
+	}
+	if lmuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaguemembership.UserTable,
+			Columns: []string{leaguemembership.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lmuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaguemembership.UserTable,
+			Columns: []string{leaguemembership.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lmuo.mutation.LeagueCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaguemembership.LeagueTable,
+			Columns: []string{leaguemembership.LeagueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: league.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lmuo.mutation.LeagueIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaguemembership.LeagueTable,
+			Columns: []string{leaguemembership.LeagueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: league.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LeagueMembership{config: lmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leaguemembership.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -8,6 +8,540 @@ import (
 )
 
 var (
+	// AuthCodesColumns holds the columns for the "auth_codes" table.
+	AuthCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code_hash", Type: field.TypeString, Unique: true},
+		{Name: "expires", Type: field.TypeTime},
+		{Name: "user_auth_codes", Type: field.TypeInt, Nullable: true},
+	}
+	// AuthCodesTable holds the schema information for the "auth_codes" table.
+	AuthCodesTable = &schema.Table{
+		Name:       "auth_codes",
+		Columns:    AuthCodesColumns,
+		PrimaryKey: []*schema.Column{AuthCodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "auth_codes_users_authCodes",
+				Columns:    []*schema.Column{AuthCodesColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "authcode_expires",
+				Unique:  false,
+				Columns: []*schema.Column{AuthCodesColumns[2]},
+			},
+		},
+	}
+	// ContestsColumns holds the columns for the "contests" table.
+	ContestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "day", Type: field.TypeTime},
+		{Name: "closed", Type: field.TypeTime, Nullable: true},
+		{Name: "league_contests", Type: field.TypeInt, Nullable: true},
+		{Name: "user_won_contests", Type: field.TypeInt, Nullable: true},
+	}
+	// ContestsTable holds the schema information for the "contests" table.
+	ContestsTable = &schema.Table{
+		Name:       "contests",
+		Columns:    ContestsColumns,
+		PrimaryKey: []*schema.Column{ContestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "contests_leagues_contests",
+				Columns:    []*schema.Column{ContestsColumns[3]},
+				RefColumns: []*schema.Column{LeaguesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "contests_users_wonContests",
+				Columns:    []*schema.Column{ContestsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "contest_day_league_contests",
+				Unique:  true,
+				Columns: []*schema.Column{ContestsColumns[1], ContestsColumns[3]},
+			},
+		},
+	}
+	// ContestDraftsColumns holds the columns for the "contest_drafts" table.
+	ContestDraftsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "rounds", Type: field.TypeInt},
+		{Name: "pick_time_limit", Type: field.TypeInt},
+		{Name: "started", Type: field.TypeTime},
+		{Name: "completed", Type: field.TypeTime, Nullable: true},
+		{Name: "contest_draft", Type: field.TypeInt, Unique: true, Nullable: true},
+	}
+	// ContestDraftsTable holds the schema information for the "contest_drafts" table.
+	ContestDraftsTable = &schema.Table{
+		Name:       "contest_drafts",
+		Columns:    ContestDraftsColumns,
+		PrimaryKey: []*schema.Column{ContestDraftsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "contest_drafts_contests_draft",
+				Columns:    []*schema.Column{ContestDraftsColumns[5]},
+				RefColumns: []*schema.Column{ContestsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// ContestDraftPicksColumns holds the columns for the "contest_draft_picks" table.
+	ContestDraftPicksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "round", Type: field.TypeInt},
+		{Name: "created", Type: field.TypeTime},
+		{Name: "auto", Type: field.TypeBool, Default: false},
+		{Name: "contest_draft_picks", Type: field.TypeInt, Nullable: true},
+		{Name: "player_draft_picks", Type: field.TypeInt, Nullable: true},
+		{Name: "user_draft_picks", Type: field.TypeInt, Nullable: true},
+	}
+	// ContestDraftPicksTable holds the schema information for the "contest_draft_picks" table.
+	ContestDraftPicksTable = &schema.Table{
+		Name:       "contest_draft_picks",
+		Columns:    ContestDraftPicksColumns,
+		PrimaryKey: []*schema.Column{ContestDraftPicksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "contest_draft_picks_contest_drafts_picks",
+				Columns:    []*schema.Column{ContestDraftPicksColumns[4]},
+				RefColumns: []*schema.Column{ContestDraftsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "contest_draft_picks_players_draftPicks",
+				Columns:    []*schema.Column{ContestDraftPicksColumns[5]},
+				RefColumns: []*schema.Column{PlayersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "contest_draft_picks_users_draftPicks",
+				Columns:    []*schema.Column{ContestDraftPicksColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "contestdraftpick_round_contest_draft_picks_user_draft_picks",
+				Unique:  true,
+				Columns: []*schema.Column{ContestDraftPicksColumns[1], ContestDraftPicksColumns[4], ContestDraftPicksColumns[6]},
+			},
+			{
+				Name:    "contestdraftpick_contest_draft_picks_player_draft_picks",
+				Unique:  true,
+				Columns: []*schema.Column{ContestDraftPicksColumns[4], ContestDraftPicksColumns[5]},
+			},
+		},
+	}
+	// ContestEntriesColumns holds the columns for the "contest_entries" table.
+	ContestEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "total_points", Type: field.TypeInt, Default: 0},
+		{Name: "contest_entries", Type: field.TypeInt, Nullable: true},
+		{Name: "user_contest_entries", Type: field.TypeInt, Nullable: true},
+	}
+	// ContestEntriesTable holds the schema information for the "contest_entries" table.
+	ContestEntriesTable = &schema.Table{
+		Name:       "contest_entries",
+		Columns:    ContestEntriesColumns,
+		PrimaryKey: []*schema.Column{ContestEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "contest_entries_contests_entries",
+				Columns:    []*schema.Column{ContestEntriesColumns[2]},
+				RefColumns: []*schema.Column{ContestsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "contest_entries_users_contestEntries",
+				Columns:    []*schema.Column{ContestEntriesColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "contestentry_contest_entries_user_contest_entries",
+				Unique:  true,
+				Columns: []*schema.Column{ContestEntriesColumns[2], ContestEntriesColumns[3]},
+			},
+		},
+	}
+	// ContestEntryCorrectionsColumns holds the columns for the "contest_entry_corrections" table.
+	ContestEntryCorrectionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "old_total_points", Type: field.TypeInt},
+		{Name: "new_total_points", Type: field.TypeInt},
+		{Name: "created", Type: field.TypeTime},
+		{Name: "contest_entry_corrections", Type: field.TypeInt, Nullable: true},
+	}
+	// ContestEntryCorrectionsTable holds the schema information for the "contest_entry_corrections" table.
+	ContestEntryCorrectionsTable = &schema.Table{
+		Name:       "contest_entry_corrections",
+		Columns:    ContestEntryCorrectionsColumns,
+		PrimaryKey: []*schema.Column{ContestEntryCorrectionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "contest_entry_corrections_contest_entries_corrections",
+				Columns:    []*schema.Column{ContestEntryCorrectionsColumns[4]},
+				RefColumns: []*schema.Column{ContestEntriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// GamesColumns holds the columns for the "games" table.
+	GamesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "external_id", Type: field.TypeString, Unique: true},
+		{Name: "time", Type: field.TypeTime},
+		{Name: "postponed", Type: field.TypeBool, Default: false},
+		{Name: "final", Type: field.TypeBool, Default: false},
+		{Name: "team_home_games", Type: field.TypeInt, Nullable: true},
+		{Name: "team_away_games", Type: field.TypeInt, Nullable: true},
+	}
+	// GamesTable holds the schema information for the "games" table.
+	GamesTable = &schema.Table{
+		Name:       "games",
+		Columns:    GamesColumns,
+		PrimaryKey: []*schema.Column{GamesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "games_teams_homeGames",
+				Columns:    []*schema.Column{GamesColumns[5]},
+				RefColumns: []*schema.Column{TeamsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "games_teams_awayGames",
+				Columns:    []*schema.Column{GamesColumns[6]},
+				RefColumns: []*schema.Column{TeamsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "game_time",
+				Unique:  false,
+				Columns: []*schema.Column{GamesColumns[2]},
+			},
+			{
+				Name:    "game_time_team_home_games",
+				Unique:  false,
+				Columns: []*schema.Column{GamesColumns[2], GamesColumns[5]},
+			},
+			{
+				Name:    "game_time_team_away_games",
+				Unique:  false,
+				Columns: []*schema.Column{GamesColumns[2], GamesColumns[6]},
+			},
+		},
+	}
+	// GameResultsColumns holds the columns for the "game_results" table.
+	GameResultsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "home_score", Type: field.TypeInt, Default: 0},
+		{Name: "away_score", Type: field.TypeInt, Default: 0},
+		{Name: "game_result", Type: field.TypeInt, Unique: true, Nullable: true},
+		{Name: "team_won_games", Type: field.TypeInt, Nullable: true},
+	}
+	// GameResultsTable holds the schema information for the "game_results" table.
+	GameResultsTable = &schema.Table{
+		Name:       "game_results",
+		Columns:    GameResultsColumns,
+		PrimaryKey: []*schema.Column{GameResultsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "game_results_games_result",
+				Columns:    []*schema.Column{GameResultsColumns[3]},
+				RefColumns: []*schema.Column{GamesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "game_results_teams_wonGames",
+				Columns:    []*schema.Column{GameResultsColumns[4]},
+				RefColumns: []*schema.Column{TeamsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// IdentitiesColumns holds the columns for the "identities" table.
+	IdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "email", Type: field.TypeString},
+		{Name: "created", Type: field.TypeTime},
+		{Name: "user_identities", Type: field.TypeInt, Nullable: true},
+	}
+	// IdentitiesTable holds the schema information for the "identities" table.
+	IdentitiesTable = &schema.Table{
+		Name:       "identities",
+		Columns:    IdentitiesColumns,
+		PrimaryKey: []*schema.Column{IdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "identities_users_identities",
+				Columns:    []*schema.Column{IdentitiesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "identity_provider_subject",
+				Unique:  true,
+				Columns: []*schema.Column{IdentitiesColumns[1], IdentitiesColumns[2]},
+			},
+		},
+	}
+	// IngestionRunsColumns holds the columns for the "ingestion_runs" table.
+	IngestionRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "started", Type: field.TypeTime},
+		{Name: "finished", Type: field.TypeTime, Nullable: true},
+		{Name: "rows_changed", Type: field.TypeInt, Default: 0},
+		{Name: "errors", Type: field.TypeJSON, Nullable: true},
+	}
+	// IngestionRunsTable holds the schema information for the "ingestion_runs" table.
+	IngestionRunsTable = &schema.Table{
+		Name:        "ingestion_runs",
+		Columns:     IngestionRunsColumns,
+		PrimaryKey:  []*schema.Column{IngestionRunsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ingestionrun_started",
+				Unique:  false,
+				Columns: []*schema.Column{IngestionRunsColumns[1]},
+			},
+		},
+	}
+	// InvitationsColumns holds the columns for the "invitations" table.
+	InvitationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PENDING", "ACCEPTED", "DECLINED", "REVOKED"}, Default: "PENDING"},
+		{Name: "created", Type: field.TypeTime},
+		{Name: "expires", Type: field.TypeTime},
+		{Name: "league_invitations", Type: field.TypeInt, Nullable: true},
+		{Name: "user_sent_invitations", Type: field.TypeInt, Nullable: true},
+	}
+	// InvitationsTable holds the schema information for the "invitations" table.
+	InvitationsTable = &schema.Table{
+		Name:       "invitations",
+		Columns:    InvitationsColumns,
+		PrimaryKey: []*schema.Column{InvitationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invitations_leagues_invitations",
+				Columns:    []*schema.Column{InvitationsColumns[6]},
+				RefColumns: []*schema.Column{LeaguesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "invitations_users_sentInvitations",
+				Columns:    []*schema.Column{InvitationsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invitation_email_status",
+				Unique:  false,
+				Columns: []*schema.Column{InvitationsColumns[1], InvitationsColumns[3]},
+			},
+		},
+	}
+	// LeaguesColumns holds the columns for the "leagues" table.
+	LeaguesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "max_members", Type: field.TypeInt, Default: 12},
+		{Name: "stat_weights", Type: field.TypeJSON},
+		{Name: "draft_rounds", Type: field.TypeInt, Default: 5},
+		{Name: "pick_time_limit", Type: field.TypeInt, Default: 120},
+		{Name: "created", Type: field.TypeTime},
+	}
+	// LeaguesTable holds the schema information for the "leagues" table.
+	LeaguesTable = &schema.Table{
+		Name:        "leagues",
+		Columns:     LeaguesColumns,
+		PrimaryKey:  []*schema.Column{LeaguesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+	}
+	// LeagueMembershipsColumns holds the columns for the "league_memberships" table.
+	LeagueMembershipsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "is_commissioner", Type: field.TypeBool, Default: false},
+		{Name: "can_invite", Type: field.TypeBool, Default: false},
+		{Name: "joined", Type: field.TypeTime},
+		{Name: "league_memberships", Type: field.TypeInt, Nullable: true},
+		{Name: "user_memberships", Type: field.TypeInt, Nullable: true},
+	}
+	// LeagueMembershipsTable holds the schema information for the "league_memberships" table.
+	LeagueMembershipsTable = &schema.Table{
+		Name:       "league_memberships",
+		Columns:    LeagueMembershipsColumns,
+		PrimaryKey: []*schema.Column{LeagueMembershipsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "league_memberships_leagues_memberships",
+				Columns:    []*schema.Column{LeagueMembershipsColumns[4]},
+				RefColumns: []*schema.Column{LeaguesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "league_memberships_users_memberships",
+				Columns:    []*schema.Column{LeagueMembershipsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "leaguemembership_user_memberships_league_memberships",
+				Unique:  true,
+				Columns: []*schema.Column{LeagueMembershipsColumns[5], LeagueMembershipsColumns[4]},
+			},
+		},
+	}
+	// PlayersColumns holds the columns for the "players" table.
+	PlayersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "external_id", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "team_players", Type: field.TypeInt, Nullable: true},
+	}
+	// PlayersTable holds the schema information for the "players" table.
+	PlayersTable = &schema.Table{
+		Name:       "players",
+		Columns:    PlayersColumns,
+		PrimaryKey: []*schema.Column{PlayersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "players_teams_players",
+				Columns:    []*schema.Column{PlayersColumns[3]},
+				RefColumns: []*schema.Column{TeamsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// PlayerPerformancesColumns holds the columns for the "player_performances" table.
+	PlayerPerformancesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "minutes", Type: field.TypeInt, Nullable: true},
+		{Name: "points", Type: field.TypeInt, Default: 0},
+		{Name: "rebounds", Type: field.TypeInt, Default: 0},
+		{Name: "assists", Type: field.TypeInt, Default: 0},
+		{Name: "steals", Type: field.TypeInt, Default: 0},
+		{Name: "blocks", Type: field.TypeInt, Default: 0},
+		{Name: "turnovers", Type: field.TypeInt, Default: 0},
+		{Name: "game_performances", Type: field.TypeInt, Nullable: true},
+		{Name: "player_performances", Type: field.TypeInt, Nullable: true},
+		{Name: "team_performances", Type: field.TypeInt, Nullable: true},
+	}
+	// PlayerPerformancesTable holds the schema information for the "player_performances" table.
+	PlayerPerformancesTable = &schema.Table{
+		Name:       "player_performances",
+		Columns:    PlayerPerformancesColumns,
+		PrimaryKey: []*schema.Column{PlayerPerformancesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "player_performances_games_performances",
+				Columns:    []*schema.Column{PlayerPerformancesColumns[8]},
+				RefColumns: []*schema.Column{GamesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "player_performances_players_performances",
+				Columns:    []*schema.Column{PlayerPerformancesColumns[9]},
+				RefColumns: []*schema.Column{PlayersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "player_performances_teams_performances",
+				Columns:    []*schema.Column{PlayerPerformancesColumns[10]},
+				RefColumns: []*schema.Column{TeamsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "playerperformance_player_performances_game_performances",
+				Unique:  true,
+				Columns: []*schema.Column{PlayerPerformancesColumns[9], PlayerPerformancesColumns[8]},
+			},
+			{
+				Name:    "playerperformance_game_performances_team_performances",
+				Unique:  false,
+				Columns: []*schema.Column{PlayerPerformancesColumns[8], PlayerPerformancesColumns[10]},
+			},
+		},
+	}
+	// RefreshTokensColumns holds the columns for the "refresh_tokens" table.
+	RefreshTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "family", Type: field.TypeString},
+		{Name: "created", Type: field.TypeTime},
+		{Name: "expires", Type: field.TypeTime},
+		{Name: "rotated", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked", Type: field.TypeTime, Nullable: true},
+		{Name: "user_refresh_tokens", Type: field.TypeInt, Nullable: true},
+	}
+	// RefreshTokensTable holds the schema information for the "refresh_tokens" table.
+	RefreshTokensTable = &schema.Table{
+		Name:       "refresh_tokens",
+		Columns:    RefreshTokensColumns,
+		PrimaryKey: []*schema.Column{RefreshTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "refresh_tokens_users_refreshTokens",
+				Columns:    []*schema.Column{RefreshTokensColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "refreshtoken_family",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[2]},
+			},
+			{
+				Name:    "refreshtoken_expires",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[4]},
+			},
+		},
+	}
+	// TeamsColumns holds the columns for the "teams" table.
+	TeamsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "external_id", Type: field.TypeString, Unique: true},
+		{Name: "short_name", Type: field.TypeString},
+		{Name: "location", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+	}
+	// TeamsTable holds the schema information for the "teams" table.
+	TeamsTable = &schema.Table{
+		Name:        "teams",
+		Columns:     TeamsColumns,
+		PrimaryKey:  []*schema.Column{TeamsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -26,9 +560,50 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuthCodesTable,
+		ContestsTable,
+		ContestDraftsTable,
+		ContestDraftPicksTable,
+		ContestEntriesTable,
+		ContestEntryCorrectionsTable,
+		GamesTable,
+		GameResultsTable,
+		IdentitiesTable,
+		IngestionRunsTable,
+		InvitationsTable,
+		LeaguesTable,
+		LeagueMembershipsTable,
+		PlayersTable,
+		PlayerPerformancesTable,
+		RefreshTokensTable,
+		TeamsTable,
 		UsersTable,
 	}
 )
 
 func init() {
+	AuthCodesTable.ForeignKeys[0].RefTable = UsersTable
+	ContestsTable.ForeignKeys[0].RefTable = LeaguesTable
+	ContestsTable.ForeignKeys[1].RefTable = UsersTable
+	ContestDraftsTable.ForeignKeys[0].RefTable = ContestsTable
+	ContestDraftPicksTable.ForeignKeys[0].RefTable = ContestDraftsTable
+	ContestDraftPicksTable.ForeignKeys[1].RefTable = PlayersTable
+	ContestDraftPicksTable.ForeignKeys[2].RefTable = UsersTable
+	ContestEntriesTable.ForeignKeys[0].RefTable = ContestsTable
+	ContestEntriesTable.ForeignKeys[1].RefTable = UsersTable
+	ContestEntryCorrectionsTable.ForeignKeys[0].RefTable = ContestEntriesTable
+	GamesTable.ForeignKeys[0].RefTable = TeamsTable
+	GamesTable.ForeignKeys[1].RefTable = TeamsTable
+	GameResultsTable.ForeignKeys[0].RefTable = GamesTable
+	GameResultsTable.ForeignKeys[1].RefTable = TeamsTable
+	IdentitiesTable.ForeignKeys[0].RefTable = UsersTable
+	InvitationsTable.ForeignKeys[0].RefTable = LeaguesTable
+	InvitationsTable.ForeignKeys[1].RefTable = UsersTable
+	LeagueMembershipsTable.ForeignKeys[0].RefTable = LeaguesTable
+	LeagueMembershipsTable.ForeignKeys[1].RefTable = UsersTable
+	PlayersTable.ForeignKeys[0].RefTable = TeamsTable
+	PlayerPerformancesTable.ForeignKeys[0].RefTable = GamesTable
+	PlayerPerformancesTable.ForeignKeys[1].RefTable = PlayersTable
+	PlayerPerformancesTable.ForeignKeys[2].RefTable = TeamsTable
+	RefreshTokensTable.ForeignKeys[0].RefTable = UsersTable
 }
@@ -8,7 +8,25 @@ import (
 	"sync"
 	"time"
 
+	"github.com/NickDubelman/fantasy-bball/db/authcode"
+	"github.com/NickDubelman/fantasy-bball/db/contest"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraft"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraftpick"
+	"github.com/NickDubelman/fantasy-bball/db/contestentry"
+	"github.com/NickDubelman/fantasy-bball/db/contestentrycorrection"
+	"github.com/NickDubelman/fantasy-bball/db/game"
+	"github.com/NickDubelman/fantasy-bball/db/gameresult"
+	"github.com/NickDubelman/fantasy-bball/db/identity"
+	"github.com/NickDubelman/fantasy-bball/db/ingestionrun"
+	"github.com/NickDubelman/fantasy-bball/db/invitation"
+	"github.com/NickDubelman/fantasy-bball/db/league"
+	"github.com/NickDubelman/fantasy-bball/db/leaguemembership"
+	"github.com/NickDubelman/fantasy-bball/db/player"
+	"github.com/NickDubelman/fantasy-bball/db/playerperformance"
 	"github.com/NickDubelman/fantasy-bball/db/predicate"
+	"github.com/NickDubelman/fantasy-bball/db/refreshtoken"
+	"github.com/NickDubelman/fantasy-bball/db/schema/schematype"
+	"github.com/NickDubelman/fantasy-bball/db/team"
 	"github.com/NickDubelman/fantasy-bball/db/user"
 
 	"entgo.io/ent"
@@ -23,24 +41,11111 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeUser = "User"
+	TypeAuthCode               = "AuthCode"
+	TypeContest                = "Contest"
+	TypeContestDraft           = "ContestDraft"
+	TypeContestDraftPick       = "ContestDraftPick"
+	TypeContestEntry           = "ContestEntry"
+	TypeContestEntryCorrection = "ContestEntryCorrection"
+	TypeGame                   = "Game"
+	TypeGameResult             = "GameResult"
+	TypeIdentity               = "Identity"
+	TypeIngestionRun           = "IngestionRun"
+	TypeInvitation             = "Invitation"
+	TypeLeague                 = "League"
+	TypeLeagueMembership       = "LeagueMembership"
+	TypePlayer                 = "Player"
+	TypePlayerPerformance      = "PlayerPerformance"
+	TypeRefreshToken           = "RefreshToken"
+	TypeTeam                   = "Team"
+	TypeUser                   = "User"
 )
 
+// AuthCodeMutation represents an operation that mutates the AuthCode nodes in the graph.
+type AuthCodeMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	codeHash      *string
+	expires       *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*AuthCode, error)
+	predicates    []predicate.AuthCode
+}
+
+var _ ent.Mutation = (*AuthCodeMutation)(nil)
+
+// authcodeOption allows management of the mutation configuration using functional options.
+type authcodeOption func(*AuthCodeMutation)
+
+// newAuthCodeMutation creates new mutation for the AuthCode entity.
+func newAuthCodeMutation(c config, op Op, opts ...authcodeOption) *AuthCodeMutation {
+	m := &AuthCodeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAuthCode,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAuthCodeID sets the ID field of the mutation.
+func withAuthCodeID(id int) authcodeOption {
+	return func(m *AuthCodeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AuthCode
+		)
+		m.oldValue = func(ctx context.Context) (*AuthCode, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AuthCode.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAuthCode sets the old AuthCode of the mutation.
+func withAuthCode(node *AuthCode) authcodeOption {
+	return func(m *AuthCodeMutation) {
+		m.oldValue = func(context.Context) (*AuthCode, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AuthCodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AuthCodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *AuthCodeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetCodeHash sets the "codeHash" field.
+func (m *AuthCodeMutation) SetCodeHash(s string) {
+	m.codeHash = &s
+}
+
+// CodeHash returns the value of the "codeHash" field in the mutation.
+func (m *AuthCodeMutation) CodeHash() (r string, exists bool) {
+	v := m.codeHash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCodeHash returns the old "codeHash" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthCodeMutation) OldCodeHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCodeHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCodeHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCodeHash: %w", err)
+	}
+	return oldValue.CodeHash, nil
+}
+
+// ResetCodeHash resets all changes to the "codeHash" field.
+func (m *AuthCodeMutation) ResetCodeHash() {
+	m.codeHash = nil
+}
+
+// SetExpires sets the "expires" field.
+func (m *AuthCodeMutation) SetExpires(t time.Time) {
+	m.expires = &t
+}
+
+// Expires returns the value of the "expires" field in the mutation.
+func (m *AuthCodeMutation) Expires() (r time.Time, exists bool) {
+	v := m.expires
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpires returns the old "expires" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthCodeMutation) OldExpires(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldExpires is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldExpires requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpires: %w", err)
+	}
+	return oldValue.Expires, nil
+}
+
+// ResetExpires resets all changes to the "expires" field.
+func (m *AuthCodeMutation) ResetExpires() {
+	m.expires = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *AuthCodeMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *AuthCodeMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared returns if the "user" edge to the User entity was cleared.
+func (m *AuthCodeMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *AuthCodeMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *AuthCodeMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *AuthCodeMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Op returns the operation name.
+func (m *AuthCodeMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (AuthCode).
+func (m *AuthCodeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuthCodeMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.codeHash != nil {
+		fields = append(fields, authcode.FieldCodeHash)
+	}
+	if m.expires != nil {
+		fields = append(fields, authcode.FieldExpires)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuthCodeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case authcode.FieldCodeHash:
+		return m.CodeHash()
+	case authcode.FieldExpires:
+		return m.Expires()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuthCodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case authcode.FieldCodeHash:
+		return m.OldCodeHash(ctx)
+	case authcode.FieldExpires:
+		return m.OldExpires(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuthCode field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuthCodeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case authcode.FieldCodeHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCodeHash(v)
+		return nil
+	case authcode.FieldExpires:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpires(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuthCode field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuthCodeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuthCodeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuthCodeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown AuthCode numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuthCodeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuthCodeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuthCodeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AuthCode nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuthCodeMutation) ResetField(name string) error {
+	switch name {
+	case authcode.FieldCodeHash:
+		m.ResetCodeHash()
+		return nil
+	case authcode.FieldExpires:
+		m.ResetExpires()
+		return nil
+	}
+	return fmt.Errorf("unknown AuthCode field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuthCodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, authcode.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuthCodeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case authcode.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuthCodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuthCodeMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuthCodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, authcode.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuthCodeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case authcode.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuthCodeMutation) ClearEdge(name string) error {
+	switch name {
+	case authcode.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown AuthCode unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuthCodeMutation) ResetEdge(name string) error {
+	switch name {
+	case authcode.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown AuthCode edge %s", name)
+}
+
+// ContestMutation represents an operation that mutates the Contest nodes in the graph.
+type ContestMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	day            *time.Time
+	closed         *time.Time
+	clearedFields  map[string]struct{}
+	league         *int
+	clearedleague  bool
+	winner         *int
+	clearedwinner  bool
+	draft          *int
+	cleareddraft   bool
+	entries        map[int]struct{}
+	removedentries map[int]struct{}
+	clearedentries bool
+	done           bool
+	oldValue       func(context.Context) (*Contest, error)
+	predicates     []predicate.Contest
+}
+
+var _ ent.Mutation = (*ContestMutation)(nil)
+
+// contestOption allows management of the mutation configuration using functional options.
+type contestOption func(*ContestMutation)
+
+// newContestMutation creates new mutation for the Contest entity.
+func newContestMutation(c config, op Op, opts ...contestOption) *ContestMutation {
+	m := &ContestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeContest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withContestID sets the ID field of the mutation.
+func withContestID(id int) contestOption {
+	return func(m *ContestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Contest
+		)
+		m.oldValue = func(ctx context.Context) (*Contest, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Contest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withContest sets the old Contest of the mutation.
+func withContest(node *Contest) contestOption {
+	return func(m *ContestMutation) {
+		m.oldValue = func(context.Context) (*Contest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ContestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ContestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *ContestMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetDay sets the "day" field.
+func (m *ContestMutation) SetDay(t time.Time) {
+	m.day = &t
+}
+
+// Day returns the value of the "day" field in the mutation.
+func (m *ContestMutation) Day() (r time.Time, exists bool) {
+	v := m.day
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDay returns the old "day" field's value of the Contest entity.
+// If the Contest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContestMutation) OldDay(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldDay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldDay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDay: %w", err)
+	}
+	return oldValue.Day, nil
+}
+
+// ResetDay resets all changes to the "day" field.
+func (m *ContestMutation) ResetDay() {
+	m.day = nil
+}
+
+// SetClosed sets the "closed" field.
+func (m *ContestMutation) SetClosed(t time.Time) {
+	m.closed = &t
+}
+
+// Closed returns the value of the "closed" field in the mutation.
+func (m *ContestMutation) Closed() (r time.Time, exists bool) {
+	v := m.closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClosed returns the old "closed" field's value of the Contest entity.
+// If the Contest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContestMutation) OldClosed(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldClosed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldClosed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClosed: %w", err)
+	}
+	return oldValue.Closed, nil
+}
+
+// ClearClosed clears the value of the "closed" field.
+func (m *ContestMutation) ClearClosed() {
+	m.closed = nil
+	m.clearedFields[contest.FieldClosed] = struct{}{}
+}
+
+// ClosedCleared returns if the "closed" field was cleared in this mutation.
+func (m *ContestMutation) ClosedCleared() bool {
+	_, ok := m.clearedFields[contest.FieldClosed]
+	return ok
+}
+
+// ResetClosed resets all changes to the "closed" field.
+func (m *ContestMutation) ResetClosed() {
+	m.closed = nil
+	delete(m.clearedFields, contest.FieldClosed)
+}
+
+// SetLeagueID sets the "league" edge to the League entity by id.
+func (m *ContestMutation) SetLeagueID(id int) {
+	m.league = &id
+}
+
+// ClearLeague clears the "league" edge to the League entity.
+func (m *ContestMutation) ClearLeague() {
+	m.clearedleague = true
+}
+
+// LeagueCleared returns if the "league" edge to the League entity was cleared.
+func (m *ContestMutation) LeagueCleared() bool {
+	return m.clearedleague
+}
+
+// LeagueID returns the "league" edge ID in the mutation.
+func (m *ContestMutation) LeagueID() (id int, exists bool) {
+	if m.league != nil {
+		return *m.league, true
+	}
+	return
+}
+
+// LeagueIDs returns the "league" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LeagueID instead. It exists only for internal usage by the builders.
+func (m *ContestMutation) LeagueIDs() (ids []int) {
+	if id := m.league; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLeague resets all changes to the "league" edge.
+func (m *ContestMutation) ResetLeague() {
+	m.league = nil
+	m.clearedleague = false
+}
+
+// SetWinnerID sets the "winner" edge to the User entity by id.
+func (m *ContestMutation) SetWinnerID(id int) {
+	m.winner = &id
+}
+
+// ClearWinner clears the "winner" edge to the User entity.
+func (m *ContestMutation) ClearWinner() {
+	m.clearedwinner = true
+}
+
+// WinnerCleared returns if the "winner" edge to the User entity was cleared.
+func (m *ContestMutation) WinnerCleared() bool {
+	return m.clearedwinner
+}
+
+// WinnerID returns the "winner" edge ID in the mutation.
+func (m *ContestMutation) WinnerID() (id int, exists bool) {
+	if m.winner != nil {
+		return *m.winner, true
+	}
+	return
+}
+
+// WinnerIDs returns the "winner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WinnerID instead. It exists only for internal usage by the builders.
+func (m *ContestMutation) WinnerIDs() (ids []int) {
+	if id := m.winner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWinner resets all changes to the "winner" edge.
+func (m *ContestMutation) ResetWinner() {
+	m.winner = nil
+	m.clearedwinner = false
+}
+
+// SetDraftID sets the "draft" edge to the ContestDraft entity by id.
+func (m *ContestMutation) SetDraftID(id int) {
+	m.draft = &id
+}
+
+// ClearDraft clears the "draft" edge to the ContestDraft entity.
+func (m *ContestMutation) ClearDraft() {
+	m.cleareddraft = true
+}
+
+// DraftCleared returns if the "draft" edge to the ContestDraft entity was cleared.
+func (m *ContestMutation) DraftCleared() bool {
+	return m.cleareddraft
+}
+
+// DraftID returns the "draft" edge ID in the mutation.
+func (m *ContestMutation) DraftID() (id int, exists bool) {
+	if m.draft != nil {
+		return *m.draft, true
+	}
+	return
+}
+
+// DraftIDs returns the "draft" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DraftID instead. It exists only for internal usage by the builders.
+func (m *ContestMutation) DraftIDs() (ids []int) {
+	if id := m.draft; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDraft resets all changes to the "draft" edge.
+func (m *ContestMutation) ResetDraft() {
+	m.draft = nil
+	m.cleareddraft = false
+}
+
+// AddEntryIDs adds the "entries" edge to the ContestEntry entity by ids.
+func (m *ContestMutation) AddEntryIDs(ids ...int) {
+	if m.entries == nil {
+		m.entries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.entries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEntries clears the "entries" edge to the ContestEntry entity.
+func (m *ContestMutation) ClearEntries() {
+	m.clearedentries = true
+}
+
+// EntriesCleared returns if the "entries" edge to the ContestEntry entity was cleared.
+func (m *ContestMutation) EntriesCleared() bool {
+	return m.clearedentries
+}
+
+// RemoveEntryIDs removes the "entries" edge to the ContestEntry entity by IDs.
+func (m *ContestMutation) RemoveEntryIDs(ids ...int) {
+	if m.removedentries == nil {
+		m.removedentries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedentries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEntries returns the removed IDs of the "entries" edge to the ContestEntry entity.
+func (m *ContestMutation) RemovedEntriesIDs() (ids []int) {
+	for id := range m.removedentries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EntriesIDs returns the "entries" edge IDs in the mutation.
+func (m *ContestMutation) EntriesIDs() (ids []int) {
+	for id := range m.entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEntries resets all changes to the "entries" edge.
+func (m *ContestMutation) ResetEntries() {
+	m.entries = nil
+	m.clearedentries = false
+	m.removedentries = nil
+}
+
+// Op returns the operation name.
+func (m *ContestMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Contest).
+func (m *ContestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ContestMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.day != nil {
+		fields = append(fields, contest.FieldDay)
+	}
+	if m.closed != nil {
+		fields = append(fields, contest.FieldClosed)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ContestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case contest.FieldDay:
+		return m.Day()
+	case contest.FieldClosed:
+		return m.Closed()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ContestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case contest.FieldDay:
+		return m.OldDay(ctx)
+	case contest.FieldClosed:
+		return m.OldClosed(ctx)
+	}
+	return nil, fmt.Errorf("unknown Contest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ContestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case contest.FieldDay:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDay(v)
+		return nil
+	case contest.FieldClosed:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClosed(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Contest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ContestMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ContestMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ContestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Contest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ContestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(contest.FieldClosed) {
+		fields = append(fields, contest.FieldClosed)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ContestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ContestMutation) ClearField(name string) error {
+	switch name {
+	case contest.FieldClosed:
+		m.ClearClosed()
+		return nil
+	}
+	return fmt.Errorf("unknown Contest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ContestMutation) ResetField(name string) error {
+	switch name {
+	case contest.FieldDay:
+		m.ResetDay()
+		return nil
+	case contest.FieldClosed:
+		m.ResetClosed()
+		return nil
+	}
+	return fmt.Errorf("unknown Contest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ContestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.league != nil {
+		edges = append(edges, contest.EdgeLeague)
+	}
+	if m.winner != nil {
+		edges = append(edges, contest.EdgeWinner)
+	}
+	if m.draft != nil {
+		edges = append(edges, contest.EdgeDraft)
+	}
+	if m.entries != nil {
+		edges = append(edges, contest.EdgeEntries)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ContestMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case contest.EdgeLeague:
+		if id := m.league; id != nil {
+			return []ent.Value{*id}
+		}
+	case contest.EdgeWinner:
+		if id := m.winner; id != nil {
+			return []ent.Value{*id}
+		}
+	case contest.EdgeDraft:
+		if id := m.draft; id != nil {
+			return []ent.Value{*id}
+		}
+	case contest.EdgeEntries:
+		ids := make([]ent.Value, 0, len(m.entries))
+		for id := range m.entries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ContestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedentries != nil {
+		edges = append(edges, contest.EdgeEntries)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ContestMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case contest.EdgeEntries:
+		ids := make([]ent.Value, 0, len(m.removedentries))
+		for id := range m.removedentries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ContestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedleague {
+		edges = append(edges, contest.EdgeLeague)
+	}
+	if m.clearedwinner {
+		edges = append(edges, contest.EdgeWinner)
+	}
+	if m.cleareddraft {
+		edges = append(edges, contest.EdgeDraft)
+	}
+	if m.clearedentries {
+		edges = append(edges, contest.EdgeEntries)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ContestMutation) EdgeCleared(name string) bool {
+	switch name {
+	case contest.EdgeLeague:
+		return m.clearedleague
+	case contest.EdgeWinner:
+		return m.clearedwinner
+	case contest.EdgeDraft:
+		return m.cleareddraft
+	case contest.EdgeEntries:
+		return m.clearedentries
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ContestMutation) ClearEdge(name string) error {
+	switch name {
+	case contest.EdgeLeague:
+		m.ClearLeague()
+		return nil
+	case contest.EdgeWinner:
+		m.ClearWinner()
+		return nil
+	case contest.EdgeDraft:
+		m.ClearDraft()
+		return nil
+	}
+	return fmt.Errorf("unknown Contest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ContestMutation) ResetEdge(name string) error {
+	switch name {
+	case contest.EdgeLeague:
+		m.ResetLeague()
+		return nil
+	case contest.EdgeWinner:
+		m.ResetWinner()
+		return nil
+	case contest.EdgeDraft:
+		m.ResetDraft()
+		return nil
+	case contest.EdgeEntries:
+		m.ResetEntries()
+		return nil
+	}
+	return fmt.Errorf("unknown Contest edge %s", name)
+}
+
+// ContestDraftMutation represents an operation that mutates the ContestDraft nodes in the graph.
+type ContestDraftMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	rounds           *int
+	addrounds        *int
+	pickTimeLimit    *int
+	addpickTimeLimit *int
+	started          *time.Time
+	completed        *time.Time
+	clearedFields    map[string]struct{}
+	contest          *int
+	clearedcontest   bool
+	picks            map[int]struct{}
+	removedpicks     map[int]struct{}
+	clearedpicks     bool
+	done             bool
+	oldValue         func(context.Context) (*ContestDraft, error)
+	predicates       []predicate.ContestDraft
+}
+
+var _ ent.Mutation = (*ContestDraftMutation)(nil)
+
+// contestdraftOption allows management of the mutation configuration using functional options.
+type contestdraftOption func(*ContestDraftMutation)
+
+// newContestDraftMutation creates new mutation for the ContestDraft entity.
+func newContestDraftMutation(c config, op Op, opts ...contestdraftOption) *ContestDraftMutation {
+	m := &ContestDraftMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeContestDraft,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withContestDraftID sets the ID field of the mutation.
+func withContestDraftID(id int) contestdraftOption {
+	return func(m *ContestDraftMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ContestDraft
+		)
+		m.oldValue = func(ctx context.Context) (*ContestDraft, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ContestDraft.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withContestDraft sets the old ContestDraft of the mutation.
+func withContestDraft(node *ContestDraft) contestdraftOption {
+	return func(m *ContestDraftMutation) {
+		m.oldValue = func(context.Context) (*ContestDraft, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ContestDraftMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ContestDraftMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *ContestDraftMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetRounds sets the "rounds" field.
+func (m *ContestDraftMutation) SetRounds(i int) {
+	m.rounds = &i
+	m.addrounds = nil
+}
+
+// Rounds returns the value of the "rounds" field in the mutation.
+func (m *ContestDraftMutation) Rounds() (r int, exists bool) {
+	v := m.rounds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRounds returns the old "rounds" field's value of the ContestDraft entity.
+// If the ContestDraft object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContestDraftMutation) OldRounds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRounds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRounds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRounds: %w", err)
+	}
+	return oldValue.Rounds, nil
+}
+
+// AddRounds adds i to the "rounds" field.
+func (m *ContestDraftMutation) AddRounds(i int) {
+	if m.addrounds != nil {
+		*m.addrounds += i
+	} else {
+		m.addrounds = &i
+	}
+}
+
+// AddedRounds returns the value that was added to the "rounds" field in this mutation.
+func (m *ContestDraftMutation) AddedRounds() (r int, exists bool) {
+	v := m.addrounds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRounds resets all changes to the "rounds" field.
+func (m *ContestDraftMutation) ResetRounds() {
+	m.rounds = nil
+	m.addrounds = nil
+}
+
+// SetPickTimeLimit sets the "pickTimeLimit" field.
+func (m *ContestDraftMutation) SetPickTimeLimit(i int) {
+	m.pickTimeLimit = &i
+	m.addpickTimeLimit = nil
+}
+
+// PickTimeLimit returns the value of the "pickTimeLimit" field in the mutation.
+func (m *ContestDraftMutation) PickTimeLimit() (r int, exists bool) {
+	v := m.pickTimeLimit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPickTimeLimit returns the old "pickTimeLimit" field's value of the ContestDraft entity.
+// If the ContestDraft object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContestDraftMutation) OldPickTimeLimit(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldPickTimeLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldPickTimeLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPickTimeLimit: %w", err)
+	}
+	return oldValue.PickTimeLimit, nil
+}
+
+// AddPickTimeLimit adds i to the "pickTimeLimit" field.
+func (m *ContestDraftMutation) AddPickTimeLimit(i int) {
+	if m.addpickTimeLimit != nil {
+		*m.addpickTimeLimit += i
+	} else {
+		m.addpickTimeLimit = &i
+	}
+}
+
+// AddedPickTimeLimit returns the value that was added to the "pickTimeLimit" field in this mutation.
+func (m *ContestDraftMutation) AddedPickTimeLimit() (r int, exists bool) {
+	v := m.addpickTimeLimit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPickTimeLimit resets all changes to the "pickTimeLimit" field.
+func (m *ContestDraftMutation) ResetPickTimeLimit() {
+	m.pickTimeLimit = nil
+	m.addpickTimeLimit = nil
+}
+
+// SetStarted sets the "started" field.
+func (m *ContestDraftMutation) SetStarted(t time.Time) {
+	m.started = &t
+}
+
+// Started returns the value of the "started" field in the mutation.
+func (m *ContestDraftMutation) Started() (r time.Time, exists bool) {
+	v := m.started
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStarted returns the old "started" field's value of the ContestDraft entity.
+// If the ContestDraft object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContestDraftMutation) OldStarted(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldStarted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldStarted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStarted: %w", err)
+	}
+	return oldValue.Started, nil
+}
+
+// ResetStarted resets all changes to the "started" field.
+func (m *ContestDraftMutation) ResetStarted() {
+	m.started = nil
+}
+
+// SetCompleted sets the "completed" field.
+func (m *ContestDraftMutation) SetCompleted(t time.Time) {
+	m.completed = &t
+}
+
+// Completed returns the value of the "completed" field in the mutation.
+func (m *ContestDraftMutation) Completed() (r time.Time, exists bool) {
+	v := m.completed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompleted returns the old "completed" field's value of the ContestDraft entity.
+// If the ContestDraft object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContestDraftMutation) OldCompleted(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCompleted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCompleted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompleted: %w", err)
+	}
+	return oldValue.Completed, nil
+}
+
+// ClearCompleted clears the value of the "completed" field.
+func (m *ContestDraftMutation) ClearCompleted() {
+	m.completed = nil
+	m.clearedFields[contestdraft.FieldCompleted] = struct{}{}
+}
+
+// CompletedCleared returns if the "completed" field was cleared in this mutation.
+func (m *ContestDraftMutation) CompletedCleared() bool {
+	_, ok := m.clearedFields[contestdraft.FieldCompleted]
+	return ok
+}
+
+// ResetCompleted resets all changes to the "completed" field.
+func (m *ContestDraftMutation) ResetCompleted() {
+	m.completed = nil
+	delete(m.clearedFields, contestdraft.FieldCompleted)
+}
+
+// SetContestID sets the "contest" edge to the Contest entity by id.
+func (m *ContestDraftMutation) SetContestID(id int) {
+	m.contest = &id
+}
+
+// ClearContest clears the "contest" edge to the Contest entity.
+func (m *ContestDraftMutation) ClearContest() {
+	m.clearedcontest = true
+}
+
+// ContestCleared returns if the "contest" edge to the Contest entity was cleared.
+func (m *ContestDraftMutation) ContestCleared() bool {
+	return m.clearedcontest
+}
+
+// ContestID returns the "contest" edge ID in the mutation.
+func (m *ContestDraftMutation) ContestID() (id int, exists bool) {
+	if m.contest != nil {
+		return *m.contest, true
+	}
+	return
+}
+
+// ContestIDs returns the "contest" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ContestID instead. It exists only for internal usage by the builders.
+func (m *ContestDraftMutation) ContestIDs() (ids []int) {
+	if id := m.contest; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetContest resets all changes to the "contest" edge.
+func (m *ContestDraftMutation) ResetContest() {
+	m.contest = nil
+	m.clearedcontest = false
+}
+
+// AddPickIDs adds the "picks" edge to the ContestDraftPick entity by ids.
+func (m *ContestDraftMutation) AddPickIDs(ids ...int) {
+	if m.picks == nil {
+		m.picks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.picks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPicks clears the "picks" edge to the ContestDraftPick entity.
+func (m *ContestDraftMutation) ClearPicks() {
+	m.clearedpicks = true
+}
+
+// PicksCleared returns if the "picks" edge to the ContestDraftPick entity was cleared.
+func (m *ContestDraftMutation) PicksCleared() bool {
+	return m.clearedpicks
+}
+
+// RemovePickIDs removes the "picks" edge to the ContestDraftPick entity by IDs.
+func (m *ContestDraftMutation) RemovePickIDs(ids ...int) {
+	if m.removedpicks == nil {
+		m.removedpicks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedpicks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPicks returns the removed IDs of the "picks" edge to the ContestDraftPick entity.
+func (m *ContestDraftMutation) RemovedPicksIDs() (ids []int) {
+	for id := range m.removedpicks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PicksIDs returns the "picks" edge IDs in the mutation.
+func (m *ContestDraftMutation) PicksIDs() (ids []int) {
+	for id := range m.picks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPicks resets all changes to the "picks" edge.
+func (m *ContestDraftMutation) ResetPicks() {
+	m.picks = nil
+	m.clearedpicks = false
+	m.removedpicks = nil
+}
+
+// Op returns the operation name.
+func (m *ContestDraftMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (ContestDraft).
+func (m *ContestDraftMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ContestDraftMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.rounds != nil {
+		fields = append(fields, contestdraft.FieldRounds)
+	}
+	if m.pickTimeLimit != nil {
+		fields = append(fields, contestdraft.FieldPickTimeLimit)
+	}
+	if m.started != nil {
+		fields = append(fields, contestdraft.FieldStarted)
+	}
+	if m.completed != nil {
+		fields = append(fields, contestdraft.FieldCompleted)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ContestDraftMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case contestdraft.FieldRounds:
+		return m.Rounds()
+	case contestdraft.FieldPickTimeLimit:
+		return m.PickTimeLimit()
+	case contestdraft.FieldStarted:
+		return m.Started()
+	case contestdraft.FieldCompleted:
+		return m.Completed()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ContestDraftMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case contestdraft.FieldRounds:
+		return m.OldRounds(ctx)
+	case contestdraft.FieldPickTimeLimit:
+		return m.OldPickTimeLimit(ctx)
+	case contestdraft.FieldStarted:
+		return m.OldStarted(ctx)
+	case contestdraft.FieldCompleted:
+		return m.OldCompleted(ctx)
+	}
+	return nil, fmt.Errorf("unknown ContestDraft field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ContestDraftMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case contestdraft.FieldRounds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRounds(v)
+		return nil
+	case contestdraft.FieldPickTimeLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPickTimeLimit(v)
+		return nil
+	case contestdraft.FieldStarted:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStarted(v)
+		return nil
+	case contestdraft.FieldCompleted:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompleted(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ContestDraft field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ContestDraftMutation) AddedFields() []string {
+	var fields []string
+	if m.addrounds != nil {
+		fields = append(fields, contestdraft.FieldRounds)
+	}
+	if m.addpickTimeLimit != nil {
+		fields = append(fields, contestdraft.FieldPickTimeLimit)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ContestDraftMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case contestdraft.FieldRounds:
+		return m.AddedRounds()
+	case contestdraft.FieldPickTimeLimit:
+		return m.AddedPickTimeLimit()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ContestDraftMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case contestdraft.FieldRounds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRounds(v)
+		return nil
+	case contestdraft.FieldPickTimeLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPickTimeLimit(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ContestDraft numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ContestDraftMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(contestdraft.FieldCompleted) {
+		fields = append(fields, contestdraft.FieldCompleted)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ContestDraftMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ContestDraftMutation) ClearField(name string) error {
+	switch name {
+	case contestdraft.FieldCompleted:
+		m.ClearCompleted()
+		return nil
+	}
+	return fmt.Errorf("unknown ContestDraft nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ContestDraftMutation) ResetField(name string) error {
+	switch name {
+	case contestdraft.FieldRounds:
+		m.ResetRounds()
+		return nil
+	case contestdraft.FieldPickTimeLimit:
+		m.ResetPickTimeLimit()
+		return nil
+	case contestdraft.FieldStarted:
+		m.ResetStarted()
+		return nil
+	case contestdraft.FieldCompleted:
+		m.ResetCompleted()
+		return nil
+	}
+	return fmt.Errorf("unknown ContestDraft field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ContestDraftMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.contest != nil {
+		edges = append(edges, contestdraft.EdgeContest)
+	}
+	if m.picks != nil {
+		edges = append(edges, contestdraft.EdgePicks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ContestDraftMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case contestdraft.EdgeContest:
+		if id := m.contest; id != nil {
+			return []ent.Value{*id}
+		}
+	case contestdraft.EdgePicks:
+		ids := make([]ent.Value, 0, len(m.picks))
+		for id := range m.picks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ContestDraftMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedpicks != nil {
+		edges = append(edges, contestdraft.EdgePicks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ContestDraftMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case contestdraft.EdgePicks:
+		ids := make([]ent.Value, 0, len(m.removedpicks))
+		for id := range m.removedpicks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ContestDraftMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcontest {
+		edges = append(edges, contestdraft.EdgeContest)
+	}
+	if m.clearedpicks {
+		edges = append(edges, contestdraft.EdgePicks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ContestDraftMutation) EdgeCleared(name string) bool {
+	switch name {
+	case contestdraft.EdgeContest:
+		return m.clearedcontest
+	case contestdraft.EdgePicks:
+		return m.clearedpicks
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ContestDraftMutation) ClearEdge(name string) error {
+	switch name {
+	case contestdraft.EdgeContest:
+		m.ClearContest()
+		return nil
+	}
+	return fmt.Errorf("unknown ContestDraft unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ContestDraftMutation) ResetEdge(name string) error {
+	switch name {
+	case contestdraft.EdgeContest:
+		m.ResetContest()
+		return nil
+	case contestdraft.EdgePicks:
+		m.ResetPicks()
+		return nil
+	}
+	return fmt.Errorf("unknown ContestDraft edge %s", name)
+}
+
+// ContestDraftPickMutation represents an operation that mutates the ContestDraftPick nodes in the graph.
+type ContestDraftPickMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	round         *int
+	addround      *int
+	created       *time.Time
+	auto          *bool
+	clearedFields map[string]struct{}
+	draft         *int
+	cleareddraft  bool
+	user          *int
+	cleareduser   bool
+	player        *int
+	clearedplayer bool
+	done          bool
+	oldValue      func(context.Context) (*ContestDraftPick, error)
+	predicates    []predicate.ContestDraftPick
+}
+
+var _ ent.Mutation = (*ContestDraftPickMutation)(nil)
+
+// contestdraftpickOption allows management of the mutation configuration using functional options.
+type contestdraftpickOption func(*ContestDraftPickMutation)
+
+// newContestDraftPickMutation creates new mutation for the ContestDraftPick entity.
+func newContestDraftPickMutation(c config, op Op, opts ...contestdraftpickOption) *ContestDraftPickMutation {
+	m := &ContestDraftPickMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeContestDraftPick,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withContestDraftPickID sets the ID field of the mutation.
+func withContestDraftPickID(id int) contestdraftpickOption {
+	return func(m *ContestDraftPickMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ContestDraftPick
+		)
+		m.oldValue = func(ctx context.Context) (*ContestDraftPick, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ContestDraftPick.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withContestDraftPick sets the old ContestDraftPick of the mutation.
+func withContestDraftPick(node *ContestDraftPick) contestdraftpickOption {
+	return func(m *ContestDraftPickMutation) {
+		m.oldValue = func(context.Context) (*ContestDraftPick, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ContestDraftPickMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ContestDraftPickMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *ContestDraftPickMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetRound sets the "round" field.
+func (m *ContestDraftPickMutation) SetRound(i int) {
+	m.round = &i
+	m.addround = nil
+}
+
+// Round returns the value of the "round" field in the mutation.
+func (m *ContestDraftPickMutation) Round() (r int, exists bool) {
+	v := m.round
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRound returns the old "round" field's value of the ContestDraftPick entity.
+// If the ContestDraftPick object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContestDraftPickMutation) OldRound(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRound is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRound requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRound: %w", err)
+	}
+	return oldValue.Round, nil
+}
+
+// AddRound adds i to the "round" field.
+func (m *ContestDraftPickMutation) AddRound(i int) {
+	if m.addround != nil {
+		*m.addround += i
+	} else {
+		m.addround = &i
+	}
+}
+
+// AddedRound returns the value that was added to the "round" field in this mutation.
+func (m *ContestDraftPickMutation) AddedRound() (r int, exists bool) {
+	v := m.addround
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRound resets all changes to the "round" field.
+func (m *ContestDraftPickMutation) ResetRound() {
+	m.round = nil
+	m.addround = nil
+}
+
+// SetCreated sets the "created" field.
+func (m *ContestDraftPickMutation) SetCreated(t time.Time) {
+	m.created = &t
+}
+
+// Created returns the value of the "created" field in the mutation.
+func (m *ContestDraftPickMutation) Created() (r time.Time, exists bool) {
+	v := m.created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreated returns the old "created" field's value of the ContestDraftPick entity.
+// If the ContestDraftPick object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContestDraftPickMutation) OldCreated(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreated: %w", err)
+	}
+	return oldValue.Created, nil
+}
+
+// ResetCreated resets all changes to the "created" field.
+func (m *ContestDraftPickMutation) ResetCreated() {
+	m.created = nil
+}
+
+// SetAuto sets the "auto" field.
+func (m *ContestDraftPickMutation) SetAuto(b bool) {
+	m.auto = &b
+}
+
+// Auto returns the value of the "auto" field in the mutation.
+func (m *ContestDraftPickMutation) Auto() (r bool, exists bool) {
+	v := m.auto
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuto returns the old "auto" field's value of the ContestDraftPick entity.
+// If the ContestDraftPick object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContestDraftPickMutation) OldAuto(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldAuto is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldAuto requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuto: %w", err)
+	}
+	return oldValue.Auto, nil
+}
+
+// ResetAuto resets all changes to the "auto" field.
+func (m *ContestDraftPickMutation) ResetAuto() {
+	m.auto = nil
+}
+
+// SetDraftID sets the "draft" edge to the ContestDraft entity by id.
+func (m *ContestDraftPickMutation) SetDraftID(id int) {
+	m.draft = &id
+}
+
+// ClearDraft clears the "draft" edge to the ContestDraft entity.
+func (m *ContestDraftPickMutation) ClearDraft() {
+	m.cleareddraft = true
+}
+
+// DraftCleared returns if the "draft" edge to the ContestDraft entity was cleared.
+func (m *ContestDraftPickMutation) DraftCleared() bool {
+	return m.cleareddraft
+}
+
+// DraftID returns the "draft" edge ID in the mutation.
+func (m *ContestDraftPickMutation) DraftID() (id int, exists bool) {
+	if m.draft != nil {
+		return *m.draft, true
+	}
+	return
+}
+
+// DraftIDs returns the "draft" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DraftID instead. It exists only for internal usage by the builders.
+func (m *ContestDraftPickMutation) DraftIDs() (ids []int) {
+	if id := m.draft; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDraft resets all changes to the "draft" edge.
+func (m *ContestDraftPickMutation) ResetDraft() {
+	m.draft = nil
+	m.cleareddraft = false
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *ContestDraftPickMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ContestDraftPickMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared returns if the "user" edge to the User entity was cleared.
+func (m *ContestDraftPickMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *ContestDraftPickMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ContestDraftPickMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *ContestDraftPickMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by id.
+func (m *ContestDraftPickMutation) SetPlayerID(id int) {
+	m.player = &id
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (m *ContestDraftPickMutation) ClearPlayer() {
+	m.clearedplayer = true
+}
+
+// PlayerCleared returns if the "player" edge to the Player entity was cleared.
+func (m *ContestDraftPickMutation) PlayerCleared() bool {
+	return m.clearedplayer
+}
+
+// PlayerID returns the "player" edge ID in the mutation.
+func (m *ContestDraftPickMutation) PlayerID() (id int, exists bool) {
+	if m.player != nil {
+		return *m.player, true
+	}
+	return
+}
+
+// PlayerIDs returns the "player" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PlayerID instead. It exists only for internal usage by the builders.
+func (m *ContestDraftPickMutation) PlayerIDs() (ids []int) {
+	if id := m.player; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPlayer resets all changes to the "player" edge.
+func (m *ContestDraftPickMutation) ResetPlayer() {
+	m.player = nil
+	m.clearedplayer = false
+}
+
+// Op returns the operation name.
+func (m *ContestDraftPickMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (ContestDraftPick).
+func (m *ContestDraftPickMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ContestDraftPickMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.round != nil {
+		fields = append(fields, contestdraftpick.FieldRound)
+	}
+	if m.created != nil {
+		fields = append(fields, contestdraftpick.FieldCreated)
+	}
+	if m.auto != nil {
+		fields = append(fields, contestdraftpick.FieldAuto)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ContestDraftPickMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case contestdraftpick.FieldRound:
+		return m.Round()
+	case contestdraftpick.FieldCreated:
+		return m.Created()
+	case contestdraftpick.FieldAuto:
+		return m.Auto()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ContestDraftPickMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case contestdraftpick.FieldRound:
+		return m.OldRound(ctx)
+	case contestdraftpick.FieldCreated:
+		return m.OldCreated(ctx)
+	case contestdraftpick.FieldAuto:
+		return m.OldAuto(ctx)
+	}
+	return nil, fmt.Errorf("unknown ContestDraftPick field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ContestDraftPickMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case contestdraftpick.FieldRound:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRound(v)
+		return nil
+	case contestdraftpick.FieldCreated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreated(v)
+		return nil
+	case contestdraftpick.FieldAuto:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuto(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ContestDraftPick field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ContestDraftPickMutation) AddedFields() []string {
+	var fields []string
+	if m.addround != nil {
+		fields = append(fields, contestdraftpick.FieldRound)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ContestDraftPickMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case contestdraftpick.FieldRound:
+		return m.AddedRound()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ContestDraftPickMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case contestdraftpick.FieldRound:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRound(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ContestDraftPick numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ContestDraftPickMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ContestDraftPickMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ContestDraftPickMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ContestDraftPick nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ContestDraftPickMutation) ResetField(name string) error {
+	switch name {
+	case contestdraftpick.FieldRound:
+		m.ResetRound()
+		return nil
+	case contestdraftpick.FieldCreated:
+		m.ResetCreated()
+		return nil
+	case contestdraftpick.FieldAuto:
+		m.ResetAuto()
+		return nil
+	}
+	return fmt.Errorf("unknown ContestDraftPick field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ContestDraftPickMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.draft != nil {
+		edges = append(edges, contestdraftpick.EdgeDraft)
+	}
+	if m.user != nil {
+		edges = append(edges, contestdraftpick.EdgeUser)
+	}
+	if m.player != nil {
+		edges = append(edges, contestdraftpick.EdgePlayer)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ContestDraftPickMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case contestdraftpick.EdgeDraft:
+		if id := m.draft; id != nil {
+			return []ent.Value{*id}
+		}
+	case contestdraftpick.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case contestdraftpick.EdgePlayer:
+		if id := m.player; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ContestDraftPickMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ContestDraftPickMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ContestDraftPickMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleareddraft {
+		edges = append(edges, contestdraftpick.EdgeDraft)
+	}
+	if m.cleareduser {
+		edges = append(edges, contestdraftpick.EdgeUser)
+	}
+	if m.clearedplayer {
+		edges = append(edges, contestdraftpick.EdgePlayer)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ContestDraftPickMutation) EdgeCleared(name string) bool {
+	switch name {
+	case contestdraftpick.EdgeDraft:
+		return m.cleareddraft
+	case contestdraftpick.EdgeUser:
+		return m.cleareduser
+	case contestdraftpick.EdgePlayer:
+		return m.clearedplayer
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ContestDraftPickMutation) ClearEdge(name string) error {
+	switch name {
+	case contestdraftpick.EdgeDraft:
+		m.ClearDraft()
+		return nil
+	case contestdraftpick.EdgeUser:
+		m.ClearUser()
+		return nil
+	case contestdraftpick.EdgePlayer:
+		m.ClearPlayer()
+		return nil
+	}
+	return fmt.Errorf("unknown ContestDraftPick unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ContestDraftPickMutation) ResetEdge(name string) error {
+	switch name {
+	case contestdraftpick.EdgeDraft:
+		m.ResetDraft()
+		return nil
+	case contestdraftpick.EdgeUser:
+		m.ResetUser()
+		return nil
+	case contestdraftpick.EdgePlayer:
+		m.ResetPlayer()
+		return nil
+	}
+	return fmt.Errorf("unknown ContestDraftPick edge %s", name)
+}
+
+// ContestEntryMutation represents an operation that mutates the ContestEntry nodes in the graph.
+type ContestEntryMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	totalPoints        *int
+	addtotalPoints     *int
+	clearedFields      map[string]struct{}
+	contest            *int
+	clearedcontest     bool
+	user               *int
+	cleareduser        bool
+	corrections        map[int]struct{}
+	removedcorrections map[int]struct{}
+	clearedcorrections bool
+	done               bool
+	oldValue           func(context.Context) (*ContestEntry, error)
+	predicates         []predicate.ContestEntry
+}
+
+var _ ent.Mutation = (*ContestEntryMutation)(nil)
+
+// contestentryOption allows management of the mutation configuration using functional options.
+type contestentryOption func(*ContestEntryMutation)
+
+// newContestEntryMutation creates new mutation for the ContestEntry entity.
+func newContestEntryMutation(c config, op Op, opts ...contestentryOption) *ContestEntryMutation {
+	m := &ContestEntryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeContestEntry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withContestEntryID sets the ID field of the mutation.
+func withContestEntryID(id int) contestentryOption {
+	return func(m *ContestEntryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ContestEntry
+		)
+		m.oldValue = func(ctx context.Context) (*ContestEntry, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ContestEntry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withContestEntry sets the old ContestEntry of the mutation.
+func withContestEntry(node *ContestEntry) contestentryOption {
+	return func(m *ContestEntryMutation) {
+		m.oldValue = func(context.Context) (*ContestEntry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ContestEntryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ContestEntryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *ContestEntryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetTotalPoints sets the "totalPoints" field.
+func (m *ContestEntryMutation) SetTotalPoints(i int) {
+	m.totalPoints = &i
+	m.addtotalPoints = nil
+}
+
+// TotalPoints returns the value of the "totalPoints" field in the mutation.
+func (m *ContestEntryMutation) TotalPoints() (r int, exists bool) {
+	v := m.totalPoints
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalPoints returns the old "totalPoints" field's value of the ContestEntry entity.
+// If the ContestEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContestEntryMutation) OldTotalPoints(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTotalPoints is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTotalPoints requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalPoints: %w", err)
+	}
+	return oldValue.TotalPoints, nil
+}
+
+// AddTotalPoints adds i to the "totalPoints" field.
+func (m *ContestEntryMutation) AddTotalPoints(i int) {
+	if m.addtotalPoints != nil {
+		*m.addtotalPoints += i
+	} else {
+		m.addtotalPoints = &i
+	}
+}
+
+// AddedTotalPoints returns the value that was added to the "totalPoints" field in this mutation.
+func (m *ContestEntryMutation) AddedTotalPoints() (r int, exists bool) {
+	v := m.addtotalPoints
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalPoints resets all changes to the "totalPoints" field.
+func (m *ContestEntryMutation) ResetTotalPoints() {
+	m.totalPoints = nil
+	m.addtotalPoints = nil
+}
+
+// SetContestID sets the "contest" edge to the Contest entity by id.
+func (m *ContestEntryMutation) SetContestID(id int) {
+	m.contest = &id
+}
+
+// ClearContest clears the "contest" edge to the Contest entity.
+func (m *ContestEntryMutation) ClearContest() {
+	m.clearedcontest = true
+}
+
+// ContestCleared returns if the "contest" edge to the Contest entity was cleared.
+func (m *ContestEntryMutation) ContestCleared() bool {
+	return m.clearedcontest
+}
+
+// ContestID returns the "contest" edge ID in the mutation.
+func (m *ContestEntryMutation) ContestID() (id int, exists bool) {
+	if m.contest != nil {
+		return *m.contest, true
+	}
+	return
+}
+
+// ContestIDs returns the "contest" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ContestID instead. It exists only for internal usage by the builders.
+func (m *ContestEntryMutation) ContestIDs() (ids []int) {
+	if id := m.contest; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetContest resets all changes to the "contest" edge.
+func (m *ContestEntryMutation) ResetContest() {
+	m.contest = nil
+	m.clearedcontest = false
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *ContestEntryMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ContestEntryMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared returns if the "user" edge to the User entity was cleared.
+func (m *ContestEntryMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *ContestEntryMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ContestEntryMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *ContestEntryMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddCorrectionIDs adds the "corrections" edge to the ContestEntryCorrection entity by ids.
+func (m *ContestEntryMutation) AddCorrectionIDs(ids ...int) {
+	if m.corrections == nil {
+		m.corrections = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.corrections[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCorrections clears the "corrections" edge to the ContestEntryCorrection entity.
+func (m *ContestEntryMutation) ClearCorrections() {
+	m.clearedcorrections = true
+}
+
+// CorrectionsCleared returns if the "corrections" edge to the ContestEntryCorrection entity was cleared.
+func (m *ContestEntryMutation) CorrectionsCleared() bool {
+	return m.clearedcorrections
+}
+
+// RemoveCorrectionIDs removes the "corrections" edge to the ContestEntryCorrection entity by IDs.
+func (m *ContestEntryMutation) RemoveCorrectionIDs(ids ...int) {
+	if m.removedcorrections == nil {
+		m.removedcorrections = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedcorrections[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCorrections returns the removed IDs of the "corrections" edge to the ContestEntryCorrection entity.
+func (m *ContestEntryMutation) RemovedCorrectionsIDs() (ids []int) {
+	for id := range m.removedcorrections {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CorrectionsIDs returns the "corrections" edge IDs in the mutation.
+func (m *ContestEntryMutation) CorrectionsIDs() (ids []int) {
+	for id := range m.corrections {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCorrections resets all changes to the "corrections" edge.
+func (m *ContestEntryMutation) ResetCorrections() {
+	m.corrections = nil
+	m.clearedcorrections = false
+	m.removedcorrections = nil
+}
+
+// Op returns the operation name.
+func (m *ContestEntryMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (ContestEntry).
+func (m *ContestEntryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ContestEntryMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.totalPoints != nil {
+		fields = append(fields, contestentry.FieldTotalPoints)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ContestEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case contestentry.FieldTotalPoints:
+		return m.TotalPoints()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ContestEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case contestentry.FieldTotalPoints:
+		return m.OldTotalPoints(ctx)
+	}
+	return nil, fmt.Errorf("unknown ContestEntry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ContestEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case contestentry.FieldTotalPoints:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalPoints(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ContestEntry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ContestEntryMutation) AddedFields() []string {
+	var fields []string
+	if m.addtotalPoints != nil {
+		fields = append(fields, contestentry.FieldTotalPoints)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ContestEntryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case contestentry.FieldTotalPoints:
+		return m.AddedTotalPoints()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ContestEntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case contestentry.FieldTotalPoints:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalPoints(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ContestEntry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ContestEntryMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ContestEntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ContestEntryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ContestEntry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ContestEntryMutation) ResetField(name string) error {
+	switch name {
+	case contestentry.FieldTotalPoints:
+		m.ResetTotalPoints()
+		return nil
+	}
+	return fmt.Errorf("unknown ContestEntry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ContestEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.contest != nil {
+		edges = append(edges, contestentry.EdgeContest)
+	}
+	if m.user != nil {
+		edges = append(edges, contestentry.EdgeUser)
+	}
+	if m.corrections != nil {
+		edges = append(edges, contestentry.EdgeCorrections)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ContestEntryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case contestentry.EdgeContest:
+		if id := m.contest; id != nil {
+			return []ent.Value{*id}
+		}
+	case contestentry.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case contestentry.EdgeCorrections:
+		ids := make([]ent.Value, 0, len(m.corrections))
+		for id := range m.corrections {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ContestEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedcorrections != nil {
+		edges = append(edges, contestentry.EdgeCorrections)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ContestEntryMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case contestentry.EdgeCorrections:
+		ids := make([]ent.Value, 0, len(m.removedcorrections))
+		for id := range m.removedcorrections {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ContestEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedcontest {
+		edges = append(edges, contestentry.EdgeContest)
+	}
+	if m.cleareduser {
+		edges = append(edges, contestentry.EdgeUser)
+	}
+	if m.clearedcorrections {
+		edges = append(edges, contestentry.EdgeCorrections)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ContestEntryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case contestentry.EdgeContest:
+		return m.clearedcontest
+	case contestentry.EdgeUser:
+		return m.cleareduser
+	case contestentry.EdgeCorrections:
+		return m.clearedcorrections
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ContestEntryMutation) ClearEdge(name string) error {
+	switch name {
+	case contestentry.EdgeContest:
+		m.ClearContest()
+		return nil
+	case contestentry.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ContestEntry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ContestEntryMutation) ResetEdge(name string) error {
+	switch name {
+	case contestentry.EdgeContest:
+		m.ResetContest()
+		return nil
+	case contestentry.EdgeUser:
+		m.ResetUser()
+		return nil
+	case contestentry.EdgeCorrections:
+		m.ResetCorrections()
+		return nil
+	}
+	return fmt.Errorf("unknown ContestEntry edge %s", name)
+}
+
+// ContestEntryCorrectionMutation represents an operation that mutates the ContestEntryCorrection nodes in the graph.
+type ContestEntryCorrectionMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	oldTotalPoints    *int
+	addoldTotalPoints *int
+	newTotalPoints    *int
+	addnewTotalPoints *int
+	created           *time.Time
+	clearedFields     map[string]struct{}
+	entry             *int
+	clearedentry      bool
+	done              bool
+	oldValue          func(context.Context) (*ContestEntryCorrection, error)
+	predicates        []predicate.ContestEntryCorrection
+}
+
+var _ ent.Mutation = (*ContestEntryCorrectionMutation)(nil)
+
+// contestentrycorrectionOption allows management of the mutation configuration using functional options.
+type contestentrycorrectionOption func(*ContestEntryCorrectionMutation)
+
+// newContestEntryCorrectionMutation creates new mutation for the ContestEntryCorrection entity.
+func newContestEntryCorrectionMutation(c config, op Op, opts ...contestentrycorrectionOption) *ContestEntryCorrectionMutation {
+	m := &ContestEntryCorrectionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeContestEntryCorrection,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withContestEntryCorrectionID sets the ID field of the mutation.
+func withContestEntryCorrectionID(id int) contestentrycorrectionOption {
+	return func(m *ContestEntryCorrectionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ContestEntryCorrection
+		)
+		m.oldValue = func(ctx context.Context) (*ContestEntryCorrection, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ContestEntryCorrection.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withContestEntryCorrection sets the old ContestEntryCorrection of the mutation.
+func withContestEntryCorrection(node *ContestEntryCorrection) contestentrycorrectionOption {
+	return func(m *ContestEntryCorrectionMutation) {
+		m.oldValue = func(context.Context) (*ContestEntryCorrection, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ContestEntryCorrectionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ContestEntryCorrectionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *ContestEntryCorrectionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetOldTotalPoints sets the "oldTotalPoints" field.
+func (m *ContestEntryCorrectionMutation) SetOldTotalPoints(i int) {
+	m.oldTotalPoints = &i
+	m.addoldTotalPoints = nil
+}
+
+// OldTotalPoints returns the value of the "oldTotalPoints" field in the mutation.
+func (m *ContestEntryCorrectionMutation) OldTotalPoints() (r int, exists bool) {
+	v := m.oldTotalPoints
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOldTotalPoints returns the old "oldTotalPoints" field's value of the ContestEntryCorrection entity.
+// If the ContestEntryCorrection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContestEntryCorrectionMutation) OldOldTotalPoints(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldOldTotalPoints is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldOldTotalPoints requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOldTotalPoints: %w", err)
+	}
+	return oldValue.OldTotalPoints, nil
+}
+
+// AddOldTotalPoints adds i to the "oldTotalPoints" field.
+func (m *ContestEntryCorrectionMutation) AddOldTotalPoints(i int) {
+	if m.addoldTotalPoints != nil {
+		*m.addoldTotalPoints += i
+	} else {
+		m.addoldTotalPoints = &i
+	}
+}
+
+// AddedOldTotalPoints returns the value that was added to the "oldTotalPoints" field in this mutation.
+func (m *ContestEntryCorrectionMutation) AddedOldTotalPoints() (r int, exists bool) {
+	v := m.addoldTotalPoints
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOldTotalPoints resets all changes to the "oldTotalPoints" field.
+func (m *ContestEntryCorrectionMutation) ResetOldTotalPoints() {
+	m.oldTotalPoints = nil
+	m.addoldTotalPoints = nil
+}
+
+// SetNewTotalPoints sets the "newTotalPoints" field.
+func (m *ContestEntryCorrectionMutation) SetNewTotalPoints(i int) {
+	m.newTotalPoints = &i
+	m.addnewTotalPoints = nil
+}
+
+// NewTotalPoints returns the value of the "newTotalPoints" field in the mutation.
+func (m *ContestEntryCorrectionMutation) NewTotalPoints() (r int, exists bool) {
+	v := m.newTotalPoints
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNewTotalPoints returns the old "newTotalPoints" field's value of the ContestEntryCorrection entity.
+// If the ContestEntryCorrection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContestEntryCorrectionMutation) OldNewTotalPoints(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldNewTotalPoints is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldNewTotalPoints requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNewTotalPoints: %w", err)
+	}
+	return oldValue.NewTotalPoints, nil
+}
+
+// AddNewTotalPoints adds i to the "newTotalPoints" field.
+func (m *ContestEntryCorrectionMutation) AddNewTotalPoints(i int) {
+	if m.addnewTotalPoints != nil {
+		*m.addnewTotalPoints += i
+	} else {
+		m.addnewTotalPoints = &i
+	}
+}
+
+// AddedNewTotalPoints returns the value that was added to the "newTotalPoints" field in this mutation.
+func (m *ContestEntryCorrectionMutation) AddedNewTotalPoints() (r int, exists bool) {
+	v := m.addnewTotalPoints
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNewTotalPoints resets all changes to the "newTotalPoints" field.
+func (m *ContestEntryCorrectionMutation) ResetNewTotalPoints() {
+	m.newTotalPoints = nil
+	m.addnewTotalPoints = nil
+}
+
+// SetCreated sets the "created" field.
+func (m *ContestEntryCorrectionMutation) SetCreated(t time.Time) {
+	m.created = &t
+}
+
+// Created returns the value of the "created" field in the mutation.
+func (m *ContestEntryCorrectionMutation) Created() (r time.Time, exists bool) {
+	v := m.created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreated returns the old "created" field's value of the ContestEntryCorrection entity.
+// If the ContestEntryCorrection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContestEntryCorrectionMutation) OldCreated(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreated: %w", err)
+	}
+	return oldValue.Created, nil
+}
+
+// ResetCreated resets all changes to the "created" field.
+func (m *ContestEntryCorrectionMutation) ResetCreated() {
+	m.created = nil
+}
+
+// SetEntryID sets the "entry" edge to the ContestEntry entity by id.
+func (m *ContestEntryCorrectionMutation) SetEntryID(id int) {
+	m.entry = &id
+}
+
+// ClearEntry clears the "entry" edge to the ContestEntry entity.
+func (m *ContestEntryCorrectionMutation) ClearEntry() {
+	m.clearedentry = true
+}
+
+// EntryCleared returns if the "entry" edge to the ContestEntry entity was cleared.
+func (m *ContestEntryCorrectionMutation) EntryCleared() bool {
+	return m.clearedentry
+}
+
+// EntryID returns the "entry" edge ID in the mutation.
+func (m *ContestEntryCorrectionMutation) EntryID() (id int, exists bool) {
+	if m.entry != nil {
+		return *m.entry, true
+	}
+	return
+}
+
+// EntryIDs returns the "entry" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EntryID instead. It exists only for internal usage by the builders.
+func (m *ContestEntryCorrectionMutation) EntryIDs() (ids []int) {
+	if id := m.entry; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEntry resets all changes to the "entry" edge.
+func (m *ContestEntryCorrectionMutation) ResetEntry() {
+	m.entry = nil
+	m.clearedentry = false
+}
+
+// Op returns the operation name.
+func (m *ContestEntryCorrectionMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (ContestEntryCorrection).
+func (m *ContestEntryCorrectionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ContestEntryCorrectionMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.oldTotalPoints != nil {
+		fields = append(fields, contestentrycorrection.FieldOldTotalPoints)
+	}
+	if m.newTotalPoints != nil {
+		fields = append(fields, contestentrycorrection.FieldNewTotalPoints)
+	}
+	if m.created != nil {
+		fields = append(fields, contestentrycorrection.FieldCreated)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ContestEntryCorrectionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case contestentrycorrection.FieldOldTotalPoints:
+		return m.OldTotalPoints()
+	case contestentrycorrection.FieldNewTotalPoints:
+		return m.NewTotalPoints()
+	case contestentrycorrection.FieldCreated:
+		return m.Created()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ContestEntryCorrectionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case contestentrycorrection.FieldOldTotalPoints:
+		return m.OldOldTotalPoints(ctx)
+	case contestentrycorrection.FieldNewTotalPoints:
+		return m.OldNewTotalPoints(ctx)
+	case contestentrycorrection.FieldCreated:
+		return m.OldCreated(ctx)
+	}
+	return nil, fmt.Errorf("unknown ContestEntryCorrection field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ContestEntryCorrectionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case contestentrycorrection.FieldOldTotalPoints:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOldTotalPoints(v)
+		return nil
+	case contestentrycorrection.FieldNewTotalPoints:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNewTotalPoints(v)
+		return nil
+	case contestentrycorrection.FieldCreated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreated(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ContestEntryCorrection field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ContestEntryCorrectionMutation) AddedFields() []string {
+	var fields []string
+	if m.addoldTotalPoints != nil {
+		fields = append(fields, contestentrycorrection.FieldOldTotalPoints)
+	}
+	if m.addnewTotalPoints != nil {
+		fields = append(fields, contestentrycorrection.FieldNewTotalPoints)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ContestEntryCorrectionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case contestentrycorrection.FieldOldTotalPoints:
+		return m.AddedOldTotalPoints()
+	case contestentrycorrection.FieldNewTotalPoints:
+		return m.AddedNewTotalPoints()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ContestEntryCorrectionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case contestentrycorrection.FieldOldTotalPoints:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOldTotalPoints(v)
+		return nil
+	case contestentrycorrection.FieldNewTotalPoints:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNewTotalPoints(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ContestEntryCorrection numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ContestEntryCorrectionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ContestEntryCorrectionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ContestEntryCorrectionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ContestEntryCorrection nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ContestEntryCorrectionMutation) ResetField(name string) error {
+	switch name {
+	case contestentrycorrection.FieldOldTotalPoints:
+		m.ResetOldTotalPoints()
+		return nil
+	case contestentrycorrection.FieldNewTotalPoints:
+		m.ResetNewTotalPoints()
+		return nil
+	case contestentrycorrection.FieldCreated:
+		m.ResetCreated()
+		return nil
+	}
+	return fmt.Errorf("unknown ContestEntryCorrection field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ContestEntryCorrectionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.entry != nil {
+		edges = append(edges, contestentrycorrection.EdgeEntry)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ContestEntryCorrectionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case contestentrycorrection.EdgeEntry:
+		if id := m.entry; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ContestEntryCorrectionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ContestEntryCorrectionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ContestEntryCorrectionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedentry {
+		edges = append(edges, contestentrycorrection.EdgeEntry)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ContestEntryCorrectionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case contestentrycorrection.EdgeEntry:
+		return m.clearedentry
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ContestEntryCorrectionMutation) ClearEdge(name string) error {
+	switch name {
+	case contestentrycorrection.EdgeEntry:
+		m.ClearEntry()
+		return nil
+	}
+	return fmt.Errorf("unknown ContestEntryCorrection unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ContestEntryCorrectionMutation) ResetEdge(name string) error {
+	switch name {
+	case contestentrycorrection.EdgeEntry:
+		m.ResetEntry()
+		return nil
+	}
+	return fmt.Errorf("unknown ContestEntryCorrection edge %s", name)
+}
+
+// GameMutation represents an operation that mutates the Game nodes in the graph.
+type GameMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	externalID          *string
+	time                *time.Time
+	postponed           *bool
+	final               *bool
+	clearedFields       map[string]struct{}
+	homeTeam            *int
+	clearedhomeTeam     bool
+	awayTeam            *int
+	clearedawayTeam     bool
+	result              *int
+	clearedresult       bool
+	performances        map[int]struct{}
+	removedperformances map[int]struct{}
+	clearedperformances bool
+	done                bool
+	oldValue            func(context.Context) (*Game, error)
+	predicates          []predicate.Game
+}
+
+var _ ent.Mutation = (*GameMutation)(nil)
+
+// gameOption allows management of the mutation configuration using functional options.
+type gameOption func(*GameMutation)
+
+// newGameMutation creates new mutation for the Game entity.
+func newGameMutation(c config, op Op, opts ...gameOption) *GameMutation {
+	m := &GameMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGame,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGameID sets the ID field of the mutation.
+func withGameID(id int) gameOption {
+	return func(m *GameMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Game
+		)
+		m.oldValue = func(ctx context.Context) (*Game, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Game.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGame sets the old Game of the mutation.
+func withGame(node *Game) gameOption {
+	return func(m *GameMutation) {
+		m.oldValue = func(context.Context) (*Game, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GameMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GameMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *GameMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetExternalID sets the "externalID" field.
+func (m *GameMutation) SetExternalID(s string) {
+	m.externalID = &s
+}
+
+// ExternalID returns the value of the "externalID" field in the mutation.
+func (m *GameMutation) ExternalID() (r string, exists bool) {
+	v := m.externalID
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalID returns the old "externalID" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldExternalID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldExternalID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldExternalID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalID: %w", err)
+	}
+	return oldValue.ExternalID, nil
+}
+
+// ResetExternalID resets all changes to the "externalID" field.
+func (m *GameMutation) ResetExternalID() {
+	m.externalID = nil
+}
+
+// SetTime sets the "time" field.
+func (m *GameMutation) SetTime(t time.Time) {
+	m.time = &t
+}
+
+// Time returns the value of the "time" field in the mutation.
+func (m *GameMutation) Time() (r time.Time, exists bool) {
+	v := m.time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTime returns the old "time" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTime: %w", err)
+	}
+	return oldValue.Time, nil
+}
+
+// ResetTime resets all changes to the "time" field.
+func (m *GameMutation) ResetTime() {
+	m.time = nil
+}
+
+// SetPostponed sets the "postponed" field.
+func (m *GameMutation) SetPostponed(b bool) {
+	m.postponed = &b
+}
+
+// Postponed returns the value of the "postponed" field in the mutation.
+func (m *GameMutation) Postponed() (r bool, exists bool) {
+	v := m.postponed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPostponed returns the old "postponed" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldPostponed(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldPostponed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldPostponed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPostponed: %w", err)
+	}
+	return oldValue.Postponed, nil
+}
+
+// ResetPostponed resets all changes to the "postponed" field.
+func (m *GameMutation) ResetPostponed() {
+	m.postponed = nil
+}
+
+// SetFinal sets the "final" field.
+func (m *GameMutation) SetFinal(b bool) {
+	m.final = &b
+}
+
+// Final returns the value of the "final" field in the mutation.
+func (m *GameMutation) Final() (r bool, exists bool) {
+	v := m.final
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinal returns the old "final" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldFinal(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldFinal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldFinal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinal: %w", err)
+	}
+	return oldValue.Final, nil
+}
+
+// ResetFinal resets all changes to the "final" field.
+func (m *GameMutation) ResetFinal() {
+	m.final = nil
+}
+
+// SetHomeTeamID sets the "homeTeam" edge to the Team entity by id.
+func (m *GameMutation) SetHomeTeamID(id int) {
+	m.homeTeam = &id
+}
+
+// ClearHomeTeam clears the "homeTeam" edge to the Team entity.
+func (m *GameMutation) ClearHomeTeam() {
+	m.clearedhomeTeam = true
+}
+
+// HomeTeamCleared returns if the "homeTeam" edge to the Team entity was cleared.
+func (m *GameMutation) HomeTeamCleared() bool {
+	return m.clearedhomeTeam
+}
+
+// HomeTeamID returns the "homeTeam" edge ID in the mutation.
+func (m *GameMutation) HomeTeamID() (id int, exists bool) {
+	if m.homeTeam != nil {
+		return *m.homeTeam, true
+	}
+	return
+}
+
+// HomeTeamIDs returns the "homeTeam" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// HomeTeamID instead. It exists only for internal usage by the builders.
+func (m *GameMutation) HomeTeamIDs() (ids []int) {
+	if id := m.homeTeam; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetHomeTeam resets all changes to the "homeTeam" edge.
+func (m *GameMutation) ResetHomeTeam() {
+	m.homeTeam = nil
+	m.clearedhomeTeam = false
+}
+
+// SetAwayTeamID sets the "awayTeam" edge to the Team entity by id.
+func (m *GameMutation) SetAwayTeamID(id int) {
+	m.awayTeam = &id
+}
+
+// ClearAwayTeam clears the "awayTeam" edge to the Team entity.
+func (m *GameMutation) ClearAwayTeam() {
+	m.clearedawayTeam = true
+}
+
+// AwayTeamCleared returns if the "awayTeam" edge to the Team entity was cleared.
+func (m *GameMutation) AwayTeamCleared() bool {
+	return m.clearedawayTeam
+}
+
+// AwayTeamID returns the "awayTeam" edge ID in the mutation.
+func (m *GameMutation) AwayTeamID() (id int, exists bool) {
+	if m.awayTeam != nil {
+		return *m.awayTeam, true
+	}
+	return
+}
+
+// AwayTeamIDs returns the "awayTeam" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AwayTeamID instead. It exists only for internal usage by the builders.
+func (m *GameMutation) AwayTeamIDs() (ids []int) {
+	if id := m.awayTeam; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAwayTeam resets all changes to the "awayTeam" edge.
+func (m *GameMutation) ResetAwayTeam() {
+	m.awayTeam = nil
+	m.clearedawayTeam = false
+}
+
+// SetResultID sets the "result" edge to the GameResult entity by id.
+func (m *GameMutation) SetResultID(id int) {
+	m.result = &id
+}
+
+// ClearResult clears the "result" edge to the GameResult entity.
+func (m *GameMutation) ClearResult() {
+	m.clearedresult = true
+}
+
+// ResultCleared returns if the "result" edge to the GameResult entity was cleared.
+func (m *GameMutation) ResultCleared() bool {
+	return m.clearedresult
+}
+
+// ResultID returns the "result" edge ID in the mutation.
+func (m *GameMutation) ResultID() (id int, exists bool) {
+	if m.result != nil {
+		return *m.result, true
+	}
+	return
+}
+
+// ResultIDs returns the "result" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ResultID instead. It exists only for internal usage by the builders.
+func (m *GameMutation) ResultIDs() (ids []int) {
+	if id := m.result; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetResult resets all changes to the "result" edge.
+func (m *GameMutation) ResetResult() {
+	m.result = nil
+	m.clearedresult = false
+}
+
+// AddPerformanceIDs adds the "performances" edge to the PlayerPerformance entity by ids.
+func (m *GameMutation) AddPerformanceIDs(ids ...int) {
+	if m.performances == nil {
+		m.performances = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.performances[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPerformances clears the "performances" edge to the PlayerPerformance entity.
+func (m *GameMutation) ClearPerformances() {
+	m.clearedperformances = true
+}
+
+// PerformancesCleared returns if the "performances" edge to the PlayerPerformance entity was cleared.
+func (m *GameMutation) PerformancesCleared() bool {
+	return m.clearedperformances
+}
+
+// RemovePerformanceIDs removes the "performances" edge to the PlayerPerformance entity by IDs.
+func (m *GameMutation) RemovePerformanceIDs(ids ...int) {
+	if m.removedperformances == nil {
+		m.removedperformances = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedperformances[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPerformances returns the removed IDs of the "performances" edge to the PlayerPerformance entity.
+func (m *GameMutation) RemovedPerformancesIDs() (ids []int) {
+	for id := range m.removedperformances {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PerformancesIDs returns the "performances" edge IDs in the mutation.
+func (m *GameMutation) PerformancesIDs() (ids []int) {
+	for id := range m.performances {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPerformances resets all changes to the "performances" edge.
+func (m *GameMutation) ResetPerformances() {
+	m.performances = nil
+	m.clearedperformances = false
+	m.removedperformances = nil
+}
+
+// Op returns the operation name.
+func (m *GameMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Game).
+func (m *GameMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GameMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.externalID != nil {
+		fields = append(fields, game.FieldExternalID)
+	}
+	if m.time != nil {
+		fields = append(fields, game.FieldTime)
+	}
+	if m.postponed != nil {
+		fields = append(fields, game.FieldPostponed)
+	}
+	if m.final != nil {
+		fields = append(fields, game.FieldFinal)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GameMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case game.FieldExternalID:
+		return m.ExternalID()
+	case game.FieldTime:
+		return m.Time()
+	case game.FieldPostponed:
+		return m.Postponed()
+	case game.FieldFinal:
+		return m.Final()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GameMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case game.FieldExternalID:
+		return m.OldExternalID(ctx)
+	case game.FieldTime:
+		return m.OldTime(ctx)
+	case game.FieldPostponed:
+		return m.OldPostponed(ctx)
+	case game.FieldFinal:
+		return m.OldFinal(ctx)
+	}
+	return nil, fmt.Errorf("unknown Game field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GameMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case game.FieldExternalID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalID(v)
+		return nil
+	case game.FieldTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTime(v)
+		return nil
+	case game.FieldPostponed:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPostponed(v)
+		return nil
+	case game.FieldFinal:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinal(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Game field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GameMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GameMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GameMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Game numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GameMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GameMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GameMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Game nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GameMutation) ResetField(name string) error {
+	switch name {
+	case game.FieldExternalID:
+		m.ResetExternalID()
+		return nil
+	case game.FieldTime:
+		m.ResetTime()
+		return nil
+	case game.FieldPostponed:
+		m.ResetPostponed()
+		return nil
+	case game.FieldFinal:
+		m.ResetFinal()
+		return nil
+	}
+	return fmt.Errorf("unknown Game field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GameMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.homeTeam != nil {
+		edges = append(edges, game.EdgeHomeTeam)
+	}
+	if m.awayTeam != nil {
+		edges = append(edges, game.EdgeAwayTeam)
+	}
+	if m.result != nil {
+		edges = append(edges, game.EdgeResult)
+	}
+	if m.performances != nil {
+		edges = append(edges, game.EdgePerformances)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GameMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case game.EdgeHomeTeam:
+		if id := m.homeTeam; id != nil {
+			return []ent.Value{*id}
+		}
+	case game.EdgeAwayTeam:
+		if id := m.awayTeam; id != nil {
+			return []ent.Value{*id}
+		}
+	case game.EdgeResult:
+		if id := m.result; id != nil {
+			return []ent.Value{*id}
+		}
+	case game.EdgePerformances:
+		ids := make([]ent.Value, 0, len(m.performances))
+		for id := range m.performances {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GameMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedperformances != nil {
+		edges = append(edges, game.EdgePerformances)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GameMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case game.EdgePerformances:
+		ids := make([]ent.Value, 0, len(m.removedperformances))
+		for id := range m.removedperformances {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GameMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedhomeTeam {
+		edges = append(edges, game.EdgeHomeTeam)
+	}
+	if m.clearedawayTeam {
+		edges = append(edges, game.EdgeAwayTeam)
+	}
+	if m.clearedresult {
+		edges = append(edges, game.EdgeResult)
+	}
+	if m.clearedperformances {
+		edges = append(edges, game.EdgePerformances)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GameMutation) EdgeCleared(name string) bool {
+	switch name {
+	case game.EdgeHomeTeam:
+		return m.clearedhomeTeam
+	case game.EdgeAwayTeam:
+		return m.clearedawayTeam
+	case game.EdgeResult:
+		return m.clearedresult
+	case game.EdgePerformances:
+		return m.clearedperformances
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GameMutation) ClearEdge(name string) error {
+	switch name {
+	case game.EdgeHomeTeam:
+		m.ClearHomeTeam()
+		return nil
+	case game.EdgeAwayTeam:
+		m.ClearAwayTeam()
+		return nil
+	case game.EdgeResult:
+		m.ClearResult()
+		return nil
+	}
+	return fmt.Errorf("unknown Game unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GameMutation) ResetEdge(name string) error {
+	switch name {
+	case game.EdgeHomeTeam:
+		m.ResetHomeTeam()
+		return nil
+	case game.EdgeAwayTeam:
+		m.ResetAwayTeam()
+		return nil
+	case game.EdgeResult:
+		m.ResetResult()
+		return nil
+	case game.EdgePerformances:
+		m.ResetPerformances()
+		return nil
+	}
+	return fmt.Errorf("unknown Game edge %s", name)
+}
+
+// GameResultMutation represents an operation that mutates the GameResult nodes in the graph.
+type GameResultMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	homeScore     *int
+	addhomeScore  *int
+	awayScore     *int
+	addawayScore  *int
+	clearedFields map[string]struct{}
+	game          *int
+	clearedgame   bool
+	winner        *int
+	clearedwinner bool
+	done          bool
+	oldValue      func(context.Context) (*GameResult, error)
+	predicates    []predicate.GameResult
+}
+
+var _ ent.Mutation = (*GameResultMutation)(nil)
+
+// gameresultOption allows management of the mutation configuration using functional options.
+type gameresultOption func(*GameResultMutation)
+
+// newGameResultMutation creates new mutation for the GameResult entity.
+func newGameResultMutation(c config, op Op, opts ...gameresultOption) *GameResultMutation {
+	m := &GameResultMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGameResult,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGameResultID sets the ID field of the mutation.
+func withGameResultID(id int) gameresultOption {
+	return func(m *GameResultMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GameResult
+		)
+		m.oldValue = func(ctx context.Context) (*GameResult, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GameResult.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGameResult sets the old GameResult of the mutation.
+func withGameResult(node *GameResult) gameresultOption {
+	return func(m *GameResultMutation) {
+		m.oldValue = func(context.Context) (*GameResult, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GameResultMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GameResultMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *GameResultMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetHomeScore sets the "homeScore" field.
+func (m *GameResultMutation) SetHomeScore(i int) {
+	m.homeScore = &i
+	m.addhomeScore = nil
+}
+
+// HomeScore returns the value of the "homeScore" field in the mutation.
+func (m *GameResultMutation) HomeScore() (r int, exists bool) {
+	v := m.homeScore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHomeScore returns the old "homeScore" field's value of the GameResult entity.
+// If the GameResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameResultMutation) OldHomeScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldHomeScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldHomeScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHomeScore: %w", err)
+	}
+	return oldValue.HomeScore, nil
+}
+
+// AddHomeScore adds i to the "homeScore" field.
+func (m *GameResultMutation) AddHomeScore(i int) {
+	if m.addhomeScore != nil {
+		*m.addhomeScore += i
+	} else {
+		m.addhomeScore = &i
+	}
+}
+
+// AddedHomeScore returns the value that was added to the "homeScore" field in this mutation.
+func (m *GameResultMutation) AddedHomeScore() (r int, exists bool) {
+	v := m.addhomeScore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHomeScore resets all changes to the "homeScore" field.
+func (m *GameResultMutation) ResetHomeScore() {
+	m.homeScore = nil
+	m.addhomeScore = nil
+}
+
+// SetAwayScore sets the "awayScore" field.
+func (m *GameResultMutation) SetAwayScore(i int) {
+	m.awayScore = &i
+	m.addawayScore = nil
+}
+
+// AwayScore returns the value of the "awayScore" field in the mutation.
+func (m *GameResultMutation) AwayScore() (r int, exists bool) {
+	v := m.awayScore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAwayScore returns the old "awayScore" field's value of the GameResult entity.
+// If the GameResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameResultMutation) OldAwayScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldAwayScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldAwayScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAwayScore: %w", err)
+	}
+	return oldValue.AwayScore, nil
+}
+
+// AddAwayScore adds i to the "awayScore" field.
+func (m *GameResultMutation) AddAwayScore(i int) {
+	if m.addawayScore != nil {
+		*m.addawayScore += i
+	} else {
+		m.addawayScore = &i
+	}
+}
+
+// AddedAwayScore returns the value that was added to the "awayScore" field in this mutation.
+func (m *GameResultMutation) AddedAwayScore() (r int, exists bool) {
+	v := m.addawayScore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAwayScore resets all changes to the "awayScore" field.
+func (m *GameResultMutation) ResetAwayScore() {
+	m.awayScore = nil
+	m.addawayScore = nil
+}
+
+// SetGameID sets the "game" edge to the Game entity by id.
+func (m *GameResultMutation) SetGameID(id int) {
+	m.game = &id
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (m *GameResultMutation) ClearGame() {
+	m.clearedgame = true
+}
+
+// GameCleared returns if the "game" edge to the Game entity was cleared.
+func (m *GameResultMutation) GameCleared() bool {
+	return m.clearedgame
+}
+
+// GameID returns the "game" edge ID in the mutation.
+func (m *GameResultMutation) GameID() (id int, exists bool) {
+	if m.game != nil {
+		return *m.game, true
+	}
+	return
+}
+
+// GameIDs returns the "game" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GameID instead. It exists only for internal usage by the builders.
+func (m *GameResultMutation) GameIDs() (ids []int) {
+	if id := m.game; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGame resets all changes to the "game" edge.
+func (m *GameResultMutation) ResetGame() {
+	m.game = nil
+	m.clearedgame = false
+}
+
+// SetWinnerID sets the "winner" edge to the Team entity by id.
+func (m *GameResultMutation) SetWinnerID(id int) {
+	m.winner = &id
+}
+
+// ClearWinner clears the "winner" edge to the Team entity.
+func (m *GameResultMutation) ClearWinner() {
+	m.clearedwinner = true
+}
+
+// WinnerCleared returns if the "winner" edge to the Team entity was cleared.
+func (m *GameResultMutation) WinnerCleared() bool {
+	return m.clearedwinner
+}
+
+// WinnerID returns the "winner" edge ID in the mutation.
+func (m *GameResultMutation) WinnerID() (id int, exists bool) {
+	if m.winner != nil {
+		return *m.winner, true
+	}
+	return
+}
+
+// WinnerIDs returns the "winner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WinnerID instead. It exists only for internal usage by the builders.
+func (m *GameResultMutation) WinnerIDs() (ids []int) {
+	if id := m.winner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWinner resets all changes to the "winner" edge.
+func (m *GameResultMutation) ResetWinner() {
+	m.winner = nil
+	m.clearedwinner = false
+}
+
+// Op returns the operation name.
+func (m *GameResultMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (GameResult).
+func (m *GameResultMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GameResultMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.homeScore != nil {
+		fields = append(fields, gameresult.FieldHomeScore)
+	}
+	if m.awayScore != nil {
+		fields = append(fields, gameresult.FieldAwayScore)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GameResultMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case gameresult.FieldHomeScore:
+		return m.HomeScore()
+	case gameresult.FieldAwayScore:
+		return m.AwayScore()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GameResultMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case gameresult.FieldHomeScore:
+		return m.OldHomeScore(ctx)
+	case gameresult.FieldAwayScore:
+		return m.OldAwayScore(ctx)
+	}
+	return nil, fmt.Errorf("unknown GameResult field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GameResultMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case gameresult.FieldHomeScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHomeScore(v)
+		return nil
+	case gameresult.FieldAwayScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAwayScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GameResult field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GameResultMutation) AddedFields() []string {
+	var fields []string
+	if m.addhomeScore != nil {
+		fields = append(fields, gameresult.FieldHomeScore)
+	}
+	if m.addawayScore != nil {
+		fields = append(fields, gameresult.FieldAwayScore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GameResultMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case gameresult.FieldHomeScore:
+		return m.AddedHomeScore()
+	case gameresult.FieldAwayScore:
+		return m.AddedAwayScore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GameResultMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case gameresult.FieldHomeScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHomeScore(v)
+		return nil
+	case gameresult.FieldAwayScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAwayScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GameResult numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GameResultMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GameResultMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GameResultMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown GameResult nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GameResultMutation) ResetField(name string) error {
+	switch name {
+	case gameresult.FieldHomeScore:
+		m.ResetHomeScore()
+		return nil
+	case gameresult.FieldAwayScore:
+		m.ResetAwayScore()
+		return nil
+	}
+	return fmt.Errorf("unknown GameResult field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GameResultMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.game != nil {
+		edges = append(edges, gameresult.EdgeGame)
+	}
+	if m.winner != nil {
+		edges = append(edges, gameresult.EdgeWinner)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GameResultMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case gameresult.EdgeGame:
+		if id := m.game; id != nil {
+			return []ent.Value{*id}
+		}
+	case gameresult.EdgeWinner:
+		if id := m.winner; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GameResultMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GameResultMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GameResultMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedgame {
+		edges = append(edges, gameresult.EdgeGame)
+	}
+	if m.clearedwinner {
+		edges = append(edges, gameresult.EdgeWinner)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GameResultMutation) EdgeCleared(name string) bool {
+	switch name {
+	case gameresult.EdgeGame:
+		return m.clearedgame
+	case gameresult.EdgeWinner:
+		return m.clearedwinner
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GameResultMutation) ClearEdge(name string) error {
+	switch name {
+	case gameresult.EdgeGame:
+		m.ClearGame()
+		return nil
+	case gameresult.EdgeWinner:
+		m.ClearWinner()
+		return nil
+	}
+	return fmt.Errorf("unknown GameResult unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GameResultMutation) ResetEdge(name string) error {
+	switch name {
+	case gameresult.EdgeGame:
+		m.ResetGame()
+		return nil
+	case gameresult.EdgeWinner:
+		m.ResetWinner()
+		return nil
+	}
+	return fmt.Errorf("unknown GameResult edge %s", name)
+}
+
+// IdentityMutation represents an operation that mutates the Identity nodes in the graph.
+type IdentityMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	provider      *string
+	subject       *string
+	email         *string
+	created       *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*Identity, error)
+	predicates    []predicate.Identity
+}
+
+var _ ent.Mutation = (*IdentityMutation)(nil)
+
+// identityOption allows management of the mutation configuration using functional options.
+type identityOption func(*IdentityMutation)
+
+// newIdentityMutation creates new mutation for the Identity entity.
+func newIdentityMutation(c config, op Op, opts ...identityOption) *IdentityMutation {
+	m := &IdentityMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIdentity,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIdentityID sets the ID field of the mutation.
+func withIdentityID(id int) identityOption {
+	return func(m *IdentityMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Identity
+		)
+		m.oldValue = func(ctx context.Context) (*Identity, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Identity.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIdentity sets the old Identity of the mutation.
+func withIdentity(node *Identity) identityOption {
+	return func(m *IdentityMutation) {
+		m.oldValue = func(context.Context) (*Identity, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IdentityMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IdentityMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *IdentityMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetProvider sets the "provider" field.
+func (m *IdentityMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *IdentityMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *IdentityMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetSubject sets the "subject" field.
+func (m *IdentityMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *IdentityMutation) Subject() (r string, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubject returns the old "subject" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+	}
+	return oldValue.Subject, nil
+}
+
+// ResetSubject resets all changes to the "subject" field.
+func (m *IdentityMutation) ResetSubject() {
+	m.subject = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *IdentityMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *IdentityMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *IdentityMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetCreated sets the "created" field.
+func (m *IdentityMutation) SetCreated(t time.Time) {
+	m.created = &t
+}
+
+// Created returns the value of the "created" field in the mutation.
+func (m *IdentityMutation) Created() (r time.Time, exists bool) {
+	v := m.created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreated returns the old "created" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldCreated(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreated: %w", err)
+	}
+	return oldValue.Created, nil
+}
+
+// ResetCreated resets all changes to the "created" field.
+func (m *IdentityMutation) ResetCreated() {
+	m.created = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *IdentityMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *IdentityMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared returns if the "user" edge to the User entity was cleared.
+func (m *IdentityMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *IdentityMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *IdentityMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *IdentityMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Op returns the operation name.
+func (m *IdentityMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Identity).
+func (m *IdentityMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IdentityMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.provider != nil {
+		fields = append(fields, identity.FieldProvider)
+	}
+	if m.subject != nil {
+		fields = append(fields, identity.FieldSubject)
+	}
+	if m.email != nil {
+		fields = append(fields, identity.FieldEmail)
+	}
+	if m.created != nil {
+		fields = append(fields, identity.FieldCreated)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IdentityMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case identity.FieldProvider:
+		return m.Provider()
+	case identity.FieldSubject:
+		return m.Subject()
+	case identity.FieldEmail:
+		return m.Email()
+	case identity.FieldCreated:
+		return m.Created()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IdentityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case identity.FieldProvider:
+		return m.OldProvider(ctx)
+	case identity.FieldSubject:
+		return m.OldSubject(ctx)
+	case identity.FieldEmail:
+		return m.OldEmail(ctx)
+	case identity.FieldCreated:
+		return m.OldCreated(ctx)
+	}
+	return nil, fmt.Errorf("unknown Identity field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdentityMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case identity.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case identity.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
+	case identity.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case identity.FieldCreated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreated(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Identity field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IdentityMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IdentityMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdentityMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Identity numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IdentityMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IdentityMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IdentityMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Identity nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IdentityMutation) ResetField(name string) error {
+	switch name {
+	case identity.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case identity.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case identity.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case identity.FieldCreated:
+		m.ResetCreated()
+		return nil
+	}
+	return fmt.Errorf("unknown Identity field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IdentityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, identity.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IdentityMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case identity.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IdentityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IdentityMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IdentityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, identity.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IdentityMutation) EdgeCleared(name string) bool {
+	switch name {
+	case identity.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IdentityMutation) ClearEdge(name string) error {
+	switch name {
+	case identity.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Identity unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IdentityMutation) ResetEdge(name string) error {
+	switch name {
+	case identity.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Identity edge %s", name)
+}
+
+// IngestionRunMutation represents an operation that mutates the IngestionRun nodes in the graph.
+type IngestionRunMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	started        *time.Time
+	finished       *time.Time
+	rowsChanged    *int
+	addrowsChanged *int
+	errors         *[]string
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*IngestionRun, error)
+	predicates     []predicate.IngestionRun
+}
+
+var _ ent.Mutation = (*IngestionRunMutation)(nil)
+
+// ingestionrunOption allows management of the mutation configuration using functional options.
+type ingestionrunOption func(*IngestionRunMutation)
+
+// newIngestionRunMutation creates new mutation for the IngestionRun entity.
+func newIngestionRunMutation(c config, op Op, opts ...ingestionrunOption) *IngestionRunMutation {
+	m := &IngestionRunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIngestionRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIngestionRunID sets the ID field of the mutation.
+func withIngestionRunID(id int) ingestionrunOption {
+	return func(m *IngestionRunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IngestionRun
+		)
+		m.oldValue = func(ctx context.Context) (*IngestionRun, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IngestionRun.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIngestionRun sets the old IngestionRun of the mutation.
+func withIngestionRun(node *IngestionRun) ingestionrunOption {
+	return func(m *IngestionRunMutation) {
+		m.oldValue = func(context.Context) (*IngestionRun, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IngestionRunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IngestionRunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *IngestionRunMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetStarted sets the "started" field.
+func (m *IngestionRunMutation) SetStarted(t time.Time) {
+	m.started = &t
+}
+
+// Started returns the value of the "started" field in the mutation.
+func (m *IngestionRunMutation) Started() (r time.Time, exists bool) {
+	v := m.started
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStarted returns the old "started" field's value of the IngestionRun entity.
+// If the IngestionRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngestionRunMutation) OldStarted(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldStarted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldStarted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStarted: %w", err)
+	}
+	return oldValue.Started, nil
+}
+
+// ResetStarted resets all changes to the "started" field.
+func (m *IngestionRunMutation) ResetStarted() {
+	m.started = nil
+}
+
+// SetFinished sets the "finished" field.
+func (m *IngestionRunMutation) SetFinished(t time.Time) {
+	m.finished = &t
+}
+
+// Finished returns the value of the "finished" field in the mutation.
+func (m *IngestionRunMutation) Finished() (r time.Time, exists bool) {
+	v := m.finished
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinished returns the old "finished" field's value of the IngestionRun entity.
+// If the IngestionRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngestionRunMutation) OldFinished(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldFinished is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldFinished requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinished: %w", err)
+	}
+	return oldValue.Finished, nil
+}
+
+// ClearFinished clears the value of the "finished" field.
+func (m *IngestionRunMutation) ClearFinished() {
+	m.finished = nil
+	m.clearedFields[ingestionrun.FieldFinished] = struct{}{}
+}
+
+// FinishedCleared returns if the "finished" field was cleared in this mutation.
+func (m *IngestionRunMutation) FinishedCleared() bool {
+	_, ok := m.clearedFields[ingestionrun.FieldFinished]
+	return ok
+}
+
+// ResetFinished resets all changes to the "finished" field.
+func (m *IngestionRunMutation) ResetFinished() {
+	m.finished = nil
+	delete(m.clearedFields, ingestionrun.FieldFinished)
+}
+
+// SetRowsChanged sets the "rowsChanged" field.
+func (m *IngestionRunMutation) SetRowsChanged(i int) {
+	m.rowsChanged = &i
+	m.addrowsChanged = nil
+}
+
+// RowsChanged returns the value of the "rowsChanged" field in the mutation.
+func (m *IngestionRunMutation) RowsChanged() (r int, exists bool) {
+	v := m.rowsChanged
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRowsChanged returns the old "rowsChanged" field's value of the IngestionRun entity.
+// If the IngestionRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngestionRunMutation) OldRowsChanged(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRowsChanged is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRowsChanged requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRowsChanged: %w", err)
+	}
+	return oldValue.RowsChanged, nil
+}
+
+// AddRowsChanged adds i to the "rowsChanged" field.
+func (m *IngestionRunMutation) AddRowsChanged(i int) {
+	if m.addrowsChanged != nil {
+		*m.addrowsChanged += i
+	} else {
+		m.addrowsChanged = &i
+	}
+}
+
+// AddedRowsChanged returns the value that was added to the "rowsChanged" field in this mutation.
+func (m *IngestionRunMutation) AddedRowsChanged() (r int, exists bool) {
+	v := m.addrowsChanged
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRowsChanged resets all changes to the "rowsChanged" field.
+func (m *IngestionRunMutation) ResetRowsChanged() {
+	m.rowsChanged = nil
+	m.addrowsChanged = nil
+}
+
+// SetErrors sets the "errors" field.
+func (m *IngestionRunMutation) SetErrors(s []string) {
+	m.errors = &s
+}
+
+// Errors returns the value of the "errors" field in the mutation.
+func (m *IngestionRunMutation) Errors() (r []string, exists bool) {
+	v := m.errors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrors returns the old "errors" field's value of the IngestionRun entity.
+// If the IngestionRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngestionRunMutation) OldErrors(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldErrors is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldErrors requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrors: %w", err)
+	}
+	return oldValue.Errors, nil
+}
+
+// ClearErrors clears the value of the "errors" field.
+func (m *IngestionRunMutation) ClearErrors() {
+	m.errors = nil
+	m.clearedFields[ingestionrun.FieldErrors] = struct{}{}
+}
+
+// ErrorsCleared returns if the "errors" field was cleared in this mutation.
+func (m *IngestionRunMutation) ErrorsCleared() bool {
+	_, ok := m.clearedFields[ingestionrun.FieldErrors]
+	return ok
+}
+
+// ResetErrors resets all changes to the "errors" field.
+func (m *IngestionRunMutation) ResetErrors() {
+	m.errors = nil
+	delete(m.clearedFields, ingestionrun.FieldErrors)
+}
+
+// Op returns the operation name.
+func (m *IngestionRunMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (IngestionRun).
+func (m *IngestionRunMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IngestionRunMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.started != nil {
+		fields = append(fields, ingestionrun.FieldStarted)
+	}
+	if m.finished != nil {
+		fields = append(fields, ingestionrun.FieldFinished)
+	}
+	if m.rowsChanged != nil {
+		fields = append(fields, ingestionrun.FieldRowsChanged)
+	}
+	if m.errors != nil {
+		fields = append(fields, ingestionrun.FieldErrors)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IngestionRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ingestionrun.FieldStarted:
+		return m.Started()
+	case ingestionrun.FieldFinished:
+		return m.Finished()
+	case ingestionrun.FieldRowsChanged:
+		return m.RowsChanged()
+	case ingestionrun.FieldErrors:
+		return m.Errors()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IngestionRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ingestionrun.FieldStarted:
+		return m.OldStarted(ctx)
+	case ingestionrun.FieldFinished:
+		return m.OldFinished(ctx)
+	case ingestionrun.FieldRowsChanged:
+		return m.OldRowsChanged(ctx)
+	case ingestionrun.FieldErrors:
+		return m.OldErrors(ctx)
+	}
+	return nil, fmt.Errorf("unknown IngestionRun field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IngestionRunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ingestionrun.FieldStarted:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStarted(v)
+		return nil
+	case ingestionrun.FieldFinished:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinished(v)
+		return nil
+	case ingestionrun.FieldRowsChanged:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRowsChanged(v)
+		return nil
+	case ingestionrun.FieldErrors:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrors(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IngestionRun field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IngestionRunMutation) AddedFields() []string {
+	var fields []string
+	if m.addrowsChanged != nil {
+		fields = append(fields, ingestionrun.FieldRowsChanged)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IngestionRunMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case ingestionrun.FieldRowsChanged:
+		return m.AddedRowsChanged()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IngestionRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case ingestionrun.FieldRowsChanged:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRowsChanged(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IngestionRun numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IngestionRunMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(ingestionrun.FieldFinished) {
+		fields = append(fields, ingestionrun.FieldFinished)
+	}
+	if m.FieldCleared(ingestionrun.FieldErrors) {
+		fields = append(fields, ingestionrun.FieldErrors)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IngestionRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IngestionRunMutation) ClearField(name string) error {
+	switch name {
+	case ingestionrun.FieldFinished:
+		m.ClearFinished()
+		return nil
+	case ingestionrun.FieldErrors:
+		m.ClearErrors()
+		return nil
+	}
+	return fmt.Errorf("unknown IngestionRun nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IngestionRunMutation) ResetField(name string) error {
+	switch name {
+	case ingestionrun.FieldStarted:
+		m.ResetStarted()
+		return nil
+	case ingestionrun.FieldFinished:
+		m.ResetFinished()
+		return nil
+	case ingestionrun.FieldRowsChanged:
+		m.ResetRowsChanged()
+		return nil
+	case ingestionrun.FieldErrors:
+		m.ResetErrors()
+		return nil
+	}
+	return fmt.Errorf("unknown IngestionRun field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IngestionRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IngestionRunMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IngestionRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IngestionRunMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IngestionRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IngestionRunMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IngestionRunMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown IngestionRun unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IngestionRunMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown IngestionRun edge %s", name)
+}
+
+// InvitationMutation represents an operation that mutates the Invitation nodes in the graph.
+type InvitationMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	email          *string
+	tokenHash      *string
+	status         *invitation.Status
+	created        *time.Time
+	expires        *time.Time
+	clearedFields  map[string]struct{}
+	league         *int
+	clearedleague  bool
+	inviter        *int
+	clearedinviter bool
+	done           bool
+	oldValue       func(context.Context) (*Invitation, error)
+	predicates     []predicate.Invitation
+}
+
+var _ ent.Mutation = (*InvitationMutation)(nil)
+
+// invitationOption allows management of the mutation configuration using functional options.
+type invitationOption func(*InvitationMutation)
+
+// newInvitationMutation creates new mutation for the Invitation entity.
+func newInvitationMutation(c config, op Op, opts ...invitationOption) *InvitationMutation {
+	m := &InvitationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeInvitation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withInvitationID sets the ID field of the mutation.
+func withInvitationID(id int) invitationOption {
+	return func(m *InvitationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Invitation
+		)
+		m.oldValue = func(ctx context.Context) (*Invitation, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Invitation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withInvitation sets the old Invitation of the mutation.
+func withInvitation(node *Invitation) invitationOption {
+	return func(m *InvitationMutation) {
+		m.oldValue = func(context.Context) (*Invitation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m InvitationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m InvitationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *InvitationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetEmail sets the "email" field.
+func (m *InvitationMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *InvitationMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the Invitation entity.
+// If the Invitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvitationMutation) OldEmail(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ClearEmail clears the value of the "email" field.
+func (m *InvitationMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[invitation.FieldEmail] = struct{}{}
+}
+
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *InvitationMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[invitation.FieldEmail]
+	return ok
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *InvitationMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, invitation.FieldEmail)
+}
+
+// SetTokenHash sets the "tokenHash" field.
+func (m *InvitationMutation) SetTokenHash(s string) {
+	m.tokenHash = &s
+}
+
+// TokenHash returns the value of the "tokenHash" field in the mutation.
+func (m *InvitationMutation) TokenHash() (r string, exists bool) {
+	v := m.tokenHash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "tokenHash" field's value of the Invitation entity.
+// If the Invitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvitationMutation) OldTokenHash(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ClearTokenHash clears the value of the "tokenHash" field.
+func (m *InvitationMutation) ClearTokenHash() {
+	m.tokenHash = nil
+	m.clearedFields[invitation.FieldTokenHash] = struct{}{}
+}
+
+// TokenHashCleared returns if the "tokenHash" field was cleared in this mutation.
+func (m *InvitationMutation) TokenHashCleared() bool {
+	_, ok := m.clearedFields[invitation.FieldTokenHash]
+	return ok
+}
+
+// ResetTokenHash resets all changes to the "tokenHash" field.
+func (m *InvitationMutation) ResetTokenHash() {
+	m.tokenHash = nil
+	delete(m.clearedFields, invitation.FieldTokenHash)
+}
+
+// SetStatus sets the "status" field.
+func (m *InvitationMutation) SetStatus(i invitation.Status) {
+	m.status = &i
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *InvitationMutation) Status() (r invitation.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Invitation entity.
+// If the Invitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvitationMutation) OldStatus(ctx context.Context) (v invitation.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *InvitationMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCreated sets the "created" field.
+func (m *InvitationMutation) SetCreated(t time.Time) {
+	m.created = &t
+}
+
+// Created returns the value of the "created" field in the mutation.
+func (m *InvitationMutation) Created() (r time.Time, exists bool) {
+	v := m.created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreated returns the old "created" field's value of the Invitation entity.
+// If the Invitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvitationMutation) OldCreated(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreated: %w", err)
+	}
+	return oldValue.Created, nil
+}
+
+// ResetCreated resets all changes to the "created" field.
+func (m *InvitationMutation) ResetCreated() {
+	m.created = nil
+}
+
+// SetExpires sets the "expires" field.
+func (m *InvitationMutation) SetExpires(t time.Time) {
+	m.expires = &t
+}
+
+// Expires returns the value of the "expires" field in the mutation.
+func (m *InvitationMutation) Expires() (r time.Time, exists bool) {
+	v := m.expires
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpires returns the old "expires" field's value of the Invitation entity.
+// If the Invitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvitationMutation) OldExpires(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldExpires is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldExpires requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpires: %w", err)
+	}
+	return oldValue.Expires, nil
+}
+
+// ResetExpires resets all changes to the "expires" field.
+func (m *InvitationMutation) ResetExpires() {
+	m.expires = nil
+}
+
+// SetLeagueID sets the "league" edge to the League entity by id.
+func (m *InvitationMutation) SetLeagueID(id int) {
+	m.league = &id
+}
+
+// ClearLeague clears the "league" edge to the League entity.
+func (m *InvitationMutation) ClearLeague() {
+	m.clearedleague = true
+}
+
+// LeagueCleared returns if the "league" edge to the League entity was cleared.
+func (m *InvitationMutation) LeagueCleared() bool {
+	return m.clearedleague
+}
+
+// LeagueID returns the "league" edge ID in the mutation.
+func (m *InvitationMutation) LeagueID() (id int, exists bool) {
+	if m.league != nil {
+		return *m.league, true
+	}
+	return
+}
+
+// LeagueIDs returns the "league" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LeagueID instead. It exists only for internal usage by the builders.
+func (m *InvitationMutation) LeagueIDs() (ids []int) {
+	if id := m.league; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLeague resets all changes to the "league" edge.
+func (m *InvitationMutation) ResetLeague() {
+	m.league = nil
+	m.clearedleague = false
+}
+
+// SetInviterID sets the "inviter" edge to the User entity by id.
+func (m *InvitationMutation) SetInviterID(id int) {
+	m.inviter = &id
+}
+
+// ClearInviter clears the "inviter" edge to the User entity.
+func (m *InvitationMutation) ClearInviter() {
+	m.clearedinviter = true
+}
+
+// InviterCleared returns if the "inviter" edge to the User entity was cleared.
+func (m *InvitationMutation) InviterCleared() bool {
+	return m.clearedinviter
+}
+
+// InviterID returns the "inviter" edge ID in the mutation.
+func (m *InvitationMutation) InviterID() (id int, exists bool) {
+	if m.inviter != nil {
+		return *m.inviter, true
+	}
+	return
+}
+
+// InviterIDs returns the "inviter" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// InviterID instead. It exists only for internal usage by the builders.
+func (m *InvitationMutation) InviterIDs() (ids []int) {
+	if id := m.inviter; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetInviter resets all changes to the "inviter" edge.
+func (m *InvitationMutation) ResetInviter() {
+	m.inviter = nil
+	m.clearedinviter = false
+}
+
+// Op returns the operation name.
+func (m *InvitationMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Invitation).
+func (m *InvitationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *InvitationMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.email != nil {
+		fields = append(fields, invitation.FieldEmail)
+	}
+	if m.tokenHash != nil {
+		fields = append(fields, invitation.FieldTokenHash)
+	}
+	if m.status != nil {
+		fields = append(fields, invitation.FieldStatus)
+	}
+	if m.created != nil {
+		fields = append(fields, invitation.FieldCreated)
+	}
+	if m.expires != nil {
+		fields = append(fields, invitation.FieldExpires)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *InvitationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case invitation.FieldEmail:
+		return m.Email()
+	case invitation.FieldTokenHash:
+		return m.TokenHash()
+	case invitation.FieldStatus:
+		return m.Status()
+	case invitation.FieldCreated:
+		return m.Created()
+	case invitation.FieldExpires:
+		return m.Expires()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *InvitationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case invitation.FieldEmail:
+		return m.OldEmail(ctx)
+	case invitation.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case invitation.FieldStatus:
+		return m.OldStatus(ctx)
+	case invitation.FieldCreated:
+		return m.OldCreated(ctx)
+	case invitation.FieldExpires:
+		return m.OldExpires(ctx)
+	}
+	return nil, fmt.Errorf("unknown Invitation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InvitationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case invitation.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case invitation.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case invitation.FieldStatus:
+		v, ok := value.(invitation.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case invitation.FieldCreated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreated(v)
+		return nil
+	case invitation.FieldExpires:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpires(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Invitation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *InvitationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *InvitationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InvitationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Invitation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *InvitationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(invitation.FieldEmail) {
+		fields = append(fields, invitation.FieldEmail)
+	}
+	if m.FieldCleared(invitation.FieldTokenHash) {
+		fields = append(fields, invitation.FieldTokenHash)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *InvitationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *InvitationMutation) ClearField(name string) error {
+	switch name {
+	case invitation.FieldEmail:
+		m.ClearEmail()
+		return nil
+	case invitation.FieldTokenHash:
+		m.ClearTokenHash()
+		return nil
+	}
+	return fmt.Errorf("unknown Invitation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *InvitationMutation) ResetField(name string) error {
+	switch name {
+	case invitation.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case invitation.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case invitation.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case invitation.FieldCreated:
+		m.ResetCreated()
+		return nil
+	case invitation.FieldExpires:
+		m.ResetExpires()
+		return nil
+	}
+	return fmt.Errorf("unknown Invitation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *InvitationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.league != nil {
+		edges = append(edges, invitation.EdgeLeague)
+	}
+	if m.inviter != nil {
+		edges = append(edges, invitation.EdgeInviter)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *InvitationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case invitation.EdgeLeague:
+		if id := m.league; id != nil {
+			return []ent.Value{*id}
+		}
+	case invitation.EdgeInviter:
+		if id := m.inviter; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *InvitationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *InvitationMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *InvitationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedleague {
+		edges = append(edges, invitation.EdgeLeague)
+	}
+	if m.clearedinviter {
+		edges = append(edges, invitation.EdgeInviter)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *InvitationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case invitation.EdgeLeague:
+		return m.clearedleague
+	case invitation.EdgeInviter:
+		return m.clearedinviter
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *InvitationMutation) ClearEdge(name string) error {
+	switch name {
+	case invitation.EdgeLeague:
+		m.ClearLeague()
+		return nil
+	case invitation.EdgeInviter:
+		m.ClearInviter()
+		return nil
+	}
+	return fmt.Errorf("unknown Invitation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *InvitationMutation) ResetEdge(name string) error {
+	switch name {
+	case invitation.EdgeLeague:
+		m.ResetLeague()
+		return nil
+	case invitation.EdgeInviter:
+		m.ResetInviter()
+		return nil
+	}
+	return fmt.Errorf("unknown Invitation edge %s", name)
+}
+
+// LeagueMutation represents an operation that mutates the League nodes in the graph.
+type LeagueMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	name               *string
+	description        *string
+	maxMembers         *int
+	addmaxMembers      *int
+	statWeights        *schematype.StatWeights
+	draftRounds        *int
+	adddraftRounds     *int
+	pickTimeLimit      *int
+	addpickTimeLimit   *int
+	created            *time.Time
+	clearedFields      map[string]struct{}
+	memberships        map[int]struct{}
+	removedmemberships map[int]struct{}
+	clearedmemberships bool
+	invitations        map[int]struct{}
+	removedinvitations map[int]struct{}
+	clearedinvitations bool
+	contests           map[int]struct{}
+	removedcontests    map[int]struct{}
+	clearedcontests    bool
+	done               bool
+	oldValue           func(context.Context) (*League, error)
+	predicates         []predicate.League
+}
+
+var _ ent.Mutation = (*LeagueMutation)(nil)
+
+// leagueOption allows management of the mutation configuration using functional options.
+type leagueOption func(*LeagueMutation)
+
+// newLeagueMutation creates new mutation for the League entity.
+func newLeagueMutation(c config, op Op, opts ...leagueOption) *LeagueMutation {
+	m := &LeagueMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLeague,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLeagueID sets the ID field of the mutation.
+func withLeagueID(id int) leagueOption {
+	return func(m *LeagueMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *League
+		)
+		m.oldValue = func(ctx context.Context) (*League, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().League.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLeague sets the old League of the mutation.
+func withLeague(node *League) leagueOption {
+	return func(m *LeagueMutation) {
+		m.oldValue = func(context.Context) (*League, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LeagueMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LeagueMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *LeagueMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetName sets the "name" field.
+func (m *LeagueMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *LeagueMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the League entity.
+// If the League object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeagueMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *LeagueMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *LeagueMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *LeagueMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the League entity.
+// If the League object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeagueMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *LeagueMutation) ResetDescription() {
+	m.description = nil
+}
+
+// SetMaxMembers sets the "maxMembers" field.
+func (m *LeagueMutation) SetMaxMembers(i int) {
+	m.maxMembers = &i
+	m.addmaxMembers = nil
+}
+
+// MaxMembers returns the value of the "maxMembers" field in the mutation.
+func (m *LeagueMutation) MaxMembers() (r int, exists bool) {
+	v := m.maxMembers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxMembers returns the old "maxMembers" field's value of the League entity.
+// If the League object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeagueMutation) OldMaxMembers(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldMaxMembers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldMaxMembers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxMembers: %w", err)
+	}
+	return oldValue.MaxMembers, nil
+}
+
+// AddMaxMembers adds i to the "maxMembers" field.
+func (m *LeagueMutation) AddMaxMembers(i int) {
+	if m.addmaxMembers != nil {
+		*m.addmaxMembers += i
+	} else {
+		m.addmaxMembers = &i
+	}
+}
+
+// AddedMaxMembers returns the value that was added to the "maxMembers" field in this mutation.
+func (m *LeagueMutation) AddedMaxMembers() (r int, exists bool) {
+	v := m.addmaxMembers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxMembers resets all changes to the "maxMembers" field.
+func (m *LeagueMutation) ResetMaxMembers() {
+	m.maxMembers = nil
+	m.addmaxMembers = nil
+}
+
+// SetStatWeights sets the "statWeights" field.
+func (m *LeagueMutation) SetStatWeights(sw schematype.StatWeights) {
+	m.statWeights = &sw
+}
+
+// StatWeights returns the value of the "statWeights" field in the mutation.
+func (m *LeagueMutation) StatWeights() (r schematype.StatWeights, exists bool) {
+	v := m.statWeights
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatWeights returns the old "statWeights" field's value of the League entity.
+// If the League object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeagueMutation) OldStatWeights(ctx context.Context) (v schematype.StatWeights, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldStatWeights is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldStatWeights requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatWeights: %w", err)
+	}
+	return oldValue.StatWeights, nil
+}
+
+// ResetStatWeights resets all changes to the "statWeights" field.
+func (m *LeagueMutation) ResetStatWeights() {
+	m.statWeights = nil
+}
+
+// SetDraftRounds sets the "draftRounds" field.
+func (m *LeagueMutation) SetDraftRounds(i int) {
+	m.draftRounds = &i
+	m.adddraftRounds = nil
+}
+
+// DraftRounds returns the value of the "draftRounds" field in the mutation.
+func (m *LeagueMutation) DraftRounds() (r int, exists bool) {
+	v := m.draftRounds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDraftRounds returns the old "draftRounds" field's value of the League entity.
+// If the League object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeagueMutation) OldDraftRounds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldDraftRounds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldDraftRounds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDraftRounds: %w", err)
+	}
+	return oldValue.DraftRounds, nil
+}
+
+// AddDraftRounds adds i to the "draftRounds" field.
+func (m *LeagueMutation) AddDraftRounds(i int) {
+	if m.adddraftRounds != nil {
+		*m.adddraftRounds += i
+	} else {
+		m.adddraftRounds = &i
+	}
+}
+
+// AddedDraftRounds returns the value that was added to the "draftRounds" field in this mutation.
+func (m *LeagueMutation) AddedDraftRounds() (r int, exists bool) {
+	v := m.adddraftRounds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDraftRounds resets all changes to the "draftRounds" field.
+func (m *LeagueMutation) ResetDraftRounds() {
+	m.draftRounds = nil
+	m.adddraftRounds = nil
+}
+
+// SetPickTimeLimit sets the "pickTimeLimit" field.
+func (m *LeagueMutation) SetPickTimeLimit(i int) {
+	m.pickTimeLimit = &i
+	m.addpickTimeLimit = nil
+}
+
+// PickTimeLimit returns the value of the "pickTimeLimit" field in the mutation.
+func (m *LeagueMutation) PickTimeLimit() (r int, exists bool) {
+	v := m.pickTimeLimit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPickTimeLimit returns the old "pickTimeLimit" field's value of the League entity.
+// If the League object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeagueMutation) OldPickTimeLimit(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldPickTimeLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldPickTimeLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPickTimeLimit: %w", err)
+	}
+	return oldValue.PickTimeLimit, nil
+}
+
+// AddPickTimeLimit adds i to the "pickTimeLimit" field.
+func (m *LeagueMutation) AddPickTimeLimit(i int) {
+	if m.addpickTimeLimit != nil {
+		*m.addpickTimeLimit += i
+	} else {
+		m.addpickTimeLimit = &i
+	}
+}
+
+// AddedPickTimeLimit returns the value that was added to the "pickTimeLimit" field in this mutation.
+func (m *LeagueMutation) AddedPickTimeLimit() (r int, exists bool) {
+	v := m.addpickTimeLimit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPickTimeLimit resets all changes to the "pickTimeLimit" field.
+func (m *LeagueMutation) ResetPickTimeLimit() {
+	m.pickTimeLimit = nil
+	m.addpickTimeLimit = nil
+}
+
+// SetCreated sets the "created" field.
+func (m *LeagueMutation) SetCreated(t time.Time) {
+	m.created = &t
+}
+
+// Created returns the value of the "created" field in the mutation.
+func (m *LeagueMutation) Created() (r time.Time, exists bool) {
+	v := m.created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreated returns the old "created" field's value of the League entity.
+// If the League object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeagueMutation) OldCreated(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreated: %w", err)
+	}
+	return oldValue.Created, nil
+}
+
+// ResetCreated resets all changes to the "created" field.
+func (m *LeagueMutation) ResetCreated() {
+	m.created = nil
+}
+
+// AddMembershipIDs adds the "memberships" edge to the LeagueMembership entity by ids.
+func (m *LeagueMutation) AddMembershipIDs(ids ...int) {
+	if m.memberships == nil {
+		m.memberships = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.memberships[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMemberships clears the "memberships" edge to the LeagueMembership entity.
+func (m *LeagueMutation) ClearMemberships() {
+	m.clearedmemberships = true
+}
+
+// MembershipsCleared returns if the "memberships" edge to the LeagueMembership entity was cleared.
+func (m *LeagueMutation) MembershipsCleared() bool {
+	return m.clearedmemberships
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to the LeagueMembership entity by IDs.
+func (m *LeagueMutation) RemoveMembershipIDs(ids ...int) {
+	if m.removedmemberships == nil {
+		m.removedmemberships = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedmemberships[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMemberships returns the removed IDs of the "memberships" edge to the LeagueMembership entity.
+func (m *LeagueMutation) RemovedMembershipsIDs() (ids []int) {
+	for id := range m.removedmemberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembershipsIDs returns the "memberships" edge IDs in the mutation.
+func (m *LeagueMutation) MembershipsIDs() (ids []int) {
+	for id := range m.memberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMemberships resets all changes to the "memberships" edge.
+func (m *LeagueMutation) ResetMemberships() {
+	m.memberships = nil
+	m.clearedmemberships = false
+	m.removedmemberships = nil
+}
+
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by ids.
+func (m *LeagueMutation) AddInvitationIDs(ids ...int) {
+	if m.invitations == nil {
+		m.invitations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.invitations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInvitations clears the "invitations" edge to the Invitation entity.
+func (m *LeagueMutation) ClearInvitations() {
+	m.clearedinvitations = true
+}
+
+// InvitationsCleared returns if the "invitations" edge to the Invitation entity was cleared.
+func (m *LeagueMutation) InvitationsCleared() bool {
+	return m.clearedinvitations
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to the Invitation entity by IDs.
+func (m *LeagueMutation) RemoveInvitationIDs(ids ...int) {
+	if m.removedinvitations == nil {
+		m.removedinvitations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedinvitations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInvitations returns the removed IDs of the "invitations" edge to the Invitation entity.
+func (m *LeagueMutation) RemovedInvitationsIDs() (ids []int) {
+	for id := range m.removedinvitations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InvitationsIDs returns the "invitations" edge IDs in the mutation.
+func (m *LeagueMutation) InvitationsIDs() (ids []int) {
+	for id := range m.invitations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInvitations resets all changes to the "invitations" edge.
+func (m *LeagueMutation) ResetInvitations() {
+	m.invitations = nil
+	m.clearedinvitations = false
+	m.removedinvitations = nil
+}
+
+// AddContestIDs adds the "contests" edge to the Contest entity by ids.
+func (m *LeagueMutation) AddContestIDs(ids ...int) {
+	if m.contests == nil {
+		m.contests = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.contests[ids[i]] = struct{}{}
+	}
+}
+
+// ClearContests clears the "contests" edge to the Contest entity.
+func (m *LeagueMutation) ClearContests() {
+	m.clearedcontests = true
+}
+
+// ContestsCleared returns if the "contests" edge to the Contest entity was cleared.
+func (m *LeagueMutation) ContestsCleared() bool {
+	return m.clearedcontests
+}
+
+// RemoveContestIDs removes the "contests" edge to the Contest entity by IDs.
+func (m *LeagueMutation) RemoveContestIDs(ids ...int) {
+	if m.removedcontests == nil {
+		m.removedcontests = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedcontests[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedContests returns the removed IDs of the "contests" edge to the Contest entity.
+func (m *LeagueMutation) RemovedContestsIDs() (ids []int) {
+	for id := range m.removedcontests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ContestsIDs returns the "contests" edge IDs in the mutation.
+func (m *LeagueMutation) ContestsIDs() (ids []int) {
+	for id := range m.contests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetContests resets all changes to the "contests" edge.
+func (m *LeagueMutation) ResetContests() {
+	m.contests = nil
+	m.clearedcontests = false
+	m.removedcontests = nil
+}
+
+// Op returns the operation name.
+func (m *LeagueMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (League).
+func (m *LeagueMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LeagueMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.name != nil {
+		fields = append(fields, league.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, league.FieldDescription)
+	}
+	if m.maxMembers != nil {
+		fields = append(fields, league.FieldMaxMembers)
+	}
+	if m.statWeights != nil {
+		fields = append(fields, league.FieldStatWeights)
+	}
+	if m.draftRounds != nil {
+		fields = append(fields, league.FieldDraftRounds)
+	}
+	if m.pickTimeLimit != nil {
+		fields = append(fields, league.FieldPickTimeLimit)
+	}
+	if m.created != nil {
+		fields = append(fields, league.FieldCreated)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LeagueMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case league.FieldName:
+		return m.Name()
+	case league.FieldDescription:
+		return m.Description()
+	case league.FieldMaxMembers:
+		return m.MaxMembers()
+	case league.FieldStatWeights:
+		return m.StatWeights()
+	case league.FieldDraftRounds:
+		return m.DraftRounds()
+	case league.FieldPickTimeLimit:
+		return m.PickTimeLimit()
+	case league.FieldCreated:
+		return m.Created()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LeagueMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case league.FieldName:
+		return m.OldName(ctx)
+	case league.FieldDescription:
+		return m.OldDescription(ctx)
+	case league.FieldMaxMembers:
+		return m.OldMaxMembers(ctx)
+	case league.FieldStatWeights:
+		return m.OldStatWeights(ctx)
+	case league.FieldDraftRounds:
+		return m.OldDraftRounds(ctx)
+	case league.FieldPickTimeLimit:
+		return m.OldPickTimeLimit(ctx)
+	case league.FieldCreated:
+		return m.OldCreated(ctx)
+	}
+	return nil, fmt.Errorf("unknown League field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LeagueMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case league.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case league.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case league.FieldMaxMembers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxMembers(v)
+		return nil
+	case league.FieldStatWeights:
+		v, ok := value.(schematype.StatWeights)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatWeights(v)
+		return nil
+	case league.FieldDraftRounds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDraftRounds(v)
+		return nil
+	case league.FieldPickTimeLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPickTimeLimit(v)
+		return nil
+	case league.FieldCreated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreated(v)
+		return nil
+	}
+	return fmt.Errorf("unknown League field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LeagueMutation) AddedFields() []string {
+	var fields []string
+	if m.addmaxMembers != nil {
+		fields = append(fields, league.FieldMaxMembers)
+	}
+	if m.adddraftRounds != nil {
+		fields = append(fields, league.FieldDraftRounds)
+	}
+	if m.addpickTimeLimit != nil {
+		fields = append(fields, league.FieldPickTimeLimit)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LeagueMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case league.FieldMaxMembers:
+		return m.AddedMaxMembers()
+	case league.FieldDraftRounds:
+		return m.AddedDraftRounds()
+	case league.FieldPickTimeLimit:
+		return m.AddedPickTimeLimit()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LeagueMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case league.FieldMaxMembers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxMembers(v)
+		return nil
+	case league.FieldDraftRounds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDraftRounds(v)
+		return nil
+	case league.FieldPickTimeLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPickTimeLimit(v)
+		return nil
+	}
+	return fmt.Errorf("unknown League numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LeagueMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LeagueMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LeagueMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown League nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LeagueMutation) ResetField(name string) error {
+	switch name {
+	case league.FieldName:
+		m.ResetName()
+		return nil
+	case league.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case league.FieldMaxMembers:
+		m.ResetMaxMembers()
+		return nil
+	case league.FieldStatWeights:
+		m.ResetStatWeights()
+		return nil
+	case league.FieldDraftRounds:
+		m.ResetDraftRounds()
+		return nil
+	case league.FieldPickTimeLimit:
+		m.ResetPickTimeLimit()
+		return nil
+	case league.FieldCreated:
+		m.ResetCreated()
+		return nil
+	}
+	return fmt.Errorf("unknown League field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LeagueMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.memberships != nil {
+		edges = append(edges, league.EdgeMemberships)
+	}
+	if m.invitations != nil {
+		edges = append(edges, league.EdgeInvitations)
+	}
+	if m.contests != nil {
+		edges = append(edges, league.EdgeContests)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LeagueMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case league.EdgeMemberships:
+		ids := make([]ent.Value, 0, len(m.memberships))
+		for id := range m.memberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case league.EdgeInvitations:
+		ids := make([]ent.Value, 0, len(m.invitations))
+		for id := range m.invitations {
+			ids = append(ids, id)
+		}
+		return ids
+	case league.EdgeContests:
+		ids := make([]ent.Value, 0, len(m.contests))
+		for id := range m.contests {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LeagueMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedmemberships != nil {
+		edges = append(edges, league.EdgeMemberships)
+	}
+	if m.removedinvitations != nil {
+		edges = append(edges, league.EdgeInvitations)
+	}
+	if m.removedcontests != nil {
+		edges = append(edges, league.EdgeContests)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LeagueMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case league.EdgeMemberships:
+		ids := make([]ent.Value, 0, len(m.removedmemberships))
+		for id := range m.removedmemberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case league.EdgeInvitations:
+		ids := make([]ent.Value, 0, len(m.removedinvitations))
+		for id := range m.removedinvitations {
+			ids = append(ids, id)
+		}
+		return ids
+	case league.EdgeContests:
+		ids := make([]ent.Value, 0, len(m.removedcontests))
+		for id := range m.removedcontests {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LeagueMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedmemberships {
+		edges = append(edges, league.EdgeMemberships)
+	}
+	if m.clearedinvitations {
+		edges = append(edges, league.EdgeInvitations)
+	}
+	if m.clearedcontests {
+		edges = append(edges, league.EdgeContests)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LeagueMutation) EdgeCleared(name string) bool {
+	switch name {
+	case league.EdgeMemberships:
+		return m.clearedmemberships
+	case league.EdgeInvitations:
+		return m.clearedinvitations
+	case league.EdgeContests:
+		return m.clearedcontests
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LeagueMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown League unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LeagueMutation) ResetEdge(name string) error {
+	switch name {
+	case league.EdgeMemberships:
+		m.ResetMemberships()
+		return nil
+	case league.EdgeInvitations:
+		m.ResetInvitations()
+		return nil
+	case league.EdgeContests:
+		m.ResetContests()
+		return nil
+	}
+	return fmt.Errorf("unknown League edge %s", name)
+}
+
+// LeagueMembershipMutation represents an operation that mutates the LeagueMembership nodes in the graph.
+type LeagueMembershipMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	isCommissioner *bool
+	canInvite      *bool
+	joined         *time.Time
+	clearedFields  map[string]struct{}
+	user           *int
+	cleareduser    bool
+	league         *int
+	clearedleague  bool
+	done           bool
+	oldValue       func(context.Context) (*LeagueMembership, error)
+	predicates     []predicate.LeagueMembership
+}
+
+var _ ent.Mutation = (*LeagueMembershipMutation)(nil)
+
+// leaguemembershipOption allows management of the mutation configuration using functional options.
+type leaguemembershipOption func(*LeagueMembershipMutation)
+
+// newLeagueMembershipMutation creates new mutation for the LeagueMembership entity.
+func newLeagueMembershipMutation(c config, op Op, opts ...leaguemembershipOption) *LeagueMembershipMutation {
+	m := &LeagueMembershipMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLeagueMembership,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLeagueMembershipID sets the ID field of the mutation.
+func withLeagueMembershipID(id int) leaguemembershipOption {
+	return func(m *LeagueMembershipMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LeagueMembership
+		)
+		m.oldValue = func(ctx context.Context) (*LeagueMembership, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LeagueMembership.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLeagueMembership sets the old LeagueMembership of the mutation.
+func withLeagueMembership(node *LeagueMembership) leaguemembershipOption {
+	return func(m *LeagueMembershipMutation) {
+		m.oldValue = func(context.Context) (*LeagueMembership, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LeagueMembershipMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LeagueMembershipMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *LeagueMembershipMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetIsCommissioner sets the "isCommissioner" field.
+func (m *LeagueMembershipMutation) SetIsCommissioner(b bool) {
+	m.isCommissioner = &b
+}
+
+// IsCommissioner returns the value of the "isCommissioner" field in the mutation.
+func (m *LeagueMembershipMutation) IsCommissioner() (r bool, exists bool) {
+	v := m.isCommissioner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsCommissioner returns the old "isCommissioner" field's value of the LeagueMembership entity.
+// If the LeagueMembership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeagueMembershipMutation) OldIsCommissioner(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldIsCommissioner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldIsCommissioner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsCommissioner: %w", err)
+	}
+	return oldValue.IsCommissioner, nil
+}
+
+// ResetIsCommissioner resets all changes to the "isCommissioner" field.
+func (m *LeagueMembershipMutation) ResetIsCommissioner() {
+	m.isCommissioner = nil
+}
+
+// SetCanInvite sets the "canInvite" field.
+func (m *LeagueMembershipMutation) SetCanInvite(b bool) {
+	m.canInvite = &b
+}
+
+// CanInvite returns the value of the "canInvite" field in the mutation.
+func (m *LeagueMembershipMutation) CanInvite() (r bool, exists bool) {
+	v := m.canInvite
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCanInvite returns the old "canInvite" field's value of the LeagueMembership entity.
+// If the LeagueMembership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeagueMembershipMutation) OldCanInvite(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCanInvite is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCanInvite requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCanInvite: %w", err)
+	}
+	return oldValue.CanInvite, nil
+}
+
+// ResetCanInvite resets all changes to the "canInvite" field.
+func (m *LeagueMembershipMutation) ResetCanInvite() {
+	m.canInvite = nil
+}
+
+// SetJoined sets the "joined" field.
+func (m *LeagueMembershipMutation) SetJoined(t time.Time) {
+	m.joined = &t
+}
+
+// Joined returns the value of the "joined" field in the mutation.
+func (m *LeagueMembershipMutation) Joined() (r time.Time, exists bool) {
+	v := m.joined
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJoined returns the old "joined" field's value of the LeagueMembership entity.
+// If the LeagueMembership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeagueMembershipMutation) OldJoined(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldJoined is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldJoined requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJoined: %w", err)
+	}
+	return oldValue.Joined, nil
+}
+
+// ResetJoined resets all changes to the "joined" field.
+func (m *LeagueMembershipMutation) ResetJoined() {
+	m.joined = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *LeagueMembershipMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *LeagueMembershipMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared returns if the "user" edge to the User entity was cleared.
+func (m *LeagueMembershipMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *LeagueMembershipMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *LeagueMembershipMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *LeagueMembershipMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetLeagueID sets the "league" edge to the League entity by id.
+func (m *LeagueMembershipMutation) SetLeagueID(id int) {
+	m.league = &id
+}
+
+// ClearLeague clears the "league" edge to the League entity.
+func (m *LeagueMembershipMutation) ClearLeague() {
+	m.clearedleague = true
+}
+
+// LeagueCleared returns if the "league" edge to the League entity was cleared.
+func (m *LeagueMembershipMutation) LeagueCleared() bool {
+	return m.clearedleague
+}
+
+// LeagueID returns the "league" edge ID in the mutation.
+func (m *LeagueMembershipMutation) LeagueID() (id int, exists bool) {
+	if m.league != nil {
+		return *m.league, true
+	}
+	return
+}
+
+// LeagueIDs returns the "league" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LeagueID instead. It exists only for internal usage by the builders.
+func (m *LeagueMembershipMutation) LeagueIDs() (ids []int) {
+	if id := m.league; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLeague resets all changes to the "league" edge.
+func (m *LeagueMembershipMutation) ResetLeague() {
+	m.league = nil
+	m.clearedleague = false
+}
+
+// Op returns the operation name.
+func (m *LeagueMembershipMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (LeagueMembership).
+func (m *LeagueMembershipMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LeagueMembershipMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.isCommissioner != nil {
+		fields = append(fields, leaguemembership.FieldIsCommissioner)
+	}
+	if m.canInvite != nil {
+		fields = append(fields, leaguemembership.FieldCanInvite)
+	}
+	if m.joined != nil {
+		fields = append(fields, leaguemembership.FieldJoined)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LeagueMembershipMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case leaguemembership.FieldIsCommissioner:
+		return m.IsCommissioner()
+	case leaguemembership.FieldCanInvite:
+		return m.CanInvite()
+	case leaguemembership.FieldJoined:
+		return m.Joined()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LeagueMembershipMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case leaguemembership.FieldIsCommissioner:
+		return m.OldIsCommissioner(ctx)
+	case leaguemembership.FieldCanInvite:
+		return m.OldCanInvite(ctx)
+	case leaguemembership.FieldJoined:
+		return m.OldJoined(ctx)
+	}
+	return nil, fmt.Errorf("unknown LeagueMembership field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LeagueMembershipMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case leaguemembership.FieldIsCommissioner:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsCommissioner(v)
+		return nil
+	case leaguemembership.FieldCanInvite:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCanInvite(v)
+		return nil
+	case leaguemembership.FieldJoined:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJoined(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LeagueMembership field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LeagueMembershipMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LeagueMembershipMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LeagueMembershipMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown LeagueMembership numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LeagueMembershipMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LeagueMembershipMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LeagueMembershipMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LeagueMembership nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LeagueMembershipMutation) ResetField(name string) error {
+	switch name {
+	case leaguemembership.FieldIsCommissioner:
+		m.ResetIsCommissioner()
+		return nil
+	case leaguemembership.FieldCanInvite:
+		m.ResetCanInvite()
+		return nil
+	case leaguemembership.FieldJoined:
+		m.ResetJoined()
+		return nil
+	}
+	return fmt.Errorf("unknown LeagueMembership field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LeagueMembershipMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, leaguemembership.EdgeUser)
+	}
+	if m.league != nil {
+		edges = append(edges, leaguemembership.EdgeLeague)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LeagueMembershipMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case leaguemembership.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case leaguemembership.EdgeLeague:
+		if id := m.league; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LeagueMembershipMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LeagueMembershipMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LeagueMembershipMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, leaguemembership.EdgeUser)
+	}
+	if m.clearedleague {
+		edges = append(edges, leaguemembership.EdgeLeague)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LeagueMembershipMutation) EdgeCleared(name string) bool {
+	switch name {
+	case leaguemembership.EdgeUser:
+		return m.cleareduser
+	case leaguemembership.EdgeLeague:
+		return m.clearedleague
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LeagueMembershipMutation) ClearEdge(name string) error {
+	switch name {
+	case leaguemembership.EdgeUser:
+		m.ClearUser()
+		return nil
+	case leaguemembership.EdgeLeague:
+		m.ClearLeague()
+		return nil
+	}
+	return fmt.Errorf("unknown LeagueMembership unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LeagueMembershipMutation) ResetEdge(name string) error {
+	switch name {
+	case leaguemembership.EdgeUser:
+		m.ResetUser()
+		return nil
+	case leaguemembership.EdgeLeague:
+		m.ResetLeague()
+		return nil
+	}
+	return fmt.Errorf("unknown LeagueMembership edge %s", name)
+}
+
+// PlayerMutation represents an operation that mutates the Player nodes in the graph.
+type PlayerMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	externalID          *string
+	name                *string
+	clearedFields       map[string]struct{}
+	team                *int
+	clearedteam         bool
+	performances        map[int]struct{}
+	removedperformances map[int]struct{}
+	clearedperformances bool
+	draftPicks          map[int]struct{}
+	removeddraftPicks   map[int]struct{}
+	cleareddraftPicks   bool
+	done                bool
+	oldValue            func(context.Context) (*Player, error)
+	predicates          []predicate.Player
+}
+
+var _ ent.Mutation = (*PlayerMutation)(nil)
+
+// playerOption allows management of the mutation configuration using functional options.
+type playerOption func(*PlayerMutation)
+
+// newPlayerMutation creates new mutation for the Player entity.
+func newPlayerMutation(c config, op Op, opts ...playerOption) *PlayerMutation {
+	m := &PlayerMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePlayer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPlayerID sets the ID field of the mutation.
+func withPlayerID(id int) playerOption {
+	return func(m *PlayerMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Player
+		)
+		m.oldValue = func(ctx context.Context) (*Player, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Player.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPlayer sets the old Player of the mutation.
+func withPlayer(node *Player) playerOption {
+	return func(m *PlayerMutation) {
+		m.oldValue = func(context.Context) (*Player, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PlayerMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PlayerMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *PlayerMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetExternalID sets the "externalID" field.
+func (m *PlayerMutation) SetExternalID(s string) {
+	m.externalID = &s
+}
+
+// ExternalID returns the value of the "externalID" field in the mutation.
+func (m *PlayerMutation) ExternalID() (r string, exists bool) {
+	v := m.externalID
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalID returns the old "externalID" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldExternalID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldExternalID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldExternalID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalID: %w", err)
+	}
+	return oldValue.ExternalID, nil
+}
+
+// ResetExternalID resets all changes to the "externalID" field.
+func (m *PlayerMutation) ResetExternalID() {
+	m.externalID = nil
+}
+
+// SetName sets the "name" field.
+func (m *PlayerMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PlayerMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PlayerMutation) ResetName() {
+	m.name = nil
+}
+
+// SetTeamID sets the "team" edge to the Team entity by id.
+func (m *PlayerMutation) SetTeamID(id int) {
+	m.team = &id
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (m *PlayerMutation) ClearTeam() {
+	m.clearedteam = true
+}
+
+// TeamCleared returns if the "team" edge to the Team entity was cleared.
+func (m *PlayerMutation) TeamCleared() bool {
+	return m.clearedteam
+}
+
+// TeamID returns the "team" edge ID in the mutation.
+func (m *PlayerMutation) TeamID() (id int, exists bool) {
+	if m.team != nil {
+		return *m.team, true
+	}
+	return
+}
+
+// TeamIDs returns the "team" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *PlayerMutation) TeamIDs() (ids []int) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTeam resets all changes to the "team" edge.
+func (m *PlayerMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
+}
+
+// AddPerformanceIDs adds the "performances" edge to the PlayerPerformance entity by ids.
+func (m *PlayerMutation) AddPerformanceIDs(ids ...int) {
+	if m.performances == nil {
+		m.performances = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.performances[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPerformances clears the "performances" edge to the PlayerPerformance entity.
+func (m *PlayerMutation) ClearPerformances() {
+	m.clearedperformances = true
+}
+
+// PerformancesCleared returns if the "performances" edge to the PlayerPerformance entity was cleared.
+func (m *PlayerMutation) PerformancesCleared() bool {
+	return m.clearedperformances
+}
+
+// RemovePerformanceIDs removes the "performances" edge to the PlayerPerformance entity by IDs.
+func (m *PlayerMutation) RemovePerformanceIDs(ids ...int) {
+	if m.removedperformances == nil {
+		m.removedperformances = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedperformances[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPerformances returns the removed IDs of the "performances" edge to the PlayerPerformance entity.
+func (m *PlayerMutation) RemovedPerformancesIDs() (ids []int) {
+	for id := range m.removedperformances {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PerformancesIDs returns the "performances" edge IDs in the mutation.
+func (m *PlayerMutation) PerformancesIDs() (ids []int) {
+	for id := range m.performances {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPerformances resets all changes to the "performances" edge.
+func (m *PlayerMutation) ResetPerformances() {
+	m.performances = nil
+	m.clearedperformances = false
+	m.removedperformances = nil
+}
+
+// AddDraftPickIDs adds the "draftPicks" edge to the ContestDraftPick entity by ids.
+func (m *PlayerMutation) AddDraftPickIDs(ids ...int) {
+	if m.draftPicks == nil {
+		m.draftPicks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.draftPicks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDraftPicks clears the "draftPicks" edge to the ContestDraftPick entity.
+func (m *PlayerMutation) ClearDraftPicks() {
+	m.cleareddraftPicks = true
+}
+
+// DraftPicksCleared returns if the "draftPicks" edge to the ContestDraftPick entity was cleared.
+func (m *PlayerMutation) DraftPicksCleared() bool {
+	return m.cleareddraftPicks
+}
+
+// RemoveDraftPickIDs removes the "draftPicks" edge to the ContestDraftPick entity by IDs.
+func (m *PlayerMutation) RemoveDraftPickIDs(ids ...int) {
+	if m.removeddraftPicks == nil {
+		m.removeddraftPicks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removeddraftPicks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDraftPicks returns the removed IDs of the "draftPicks" edge to the ContestDraftPick entity.
+func (m *PlayerMutation) RemovedDraftPicksIDs() (ids []int) {
+	for id := range m.removeddraftPicks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DraftPicksIDs returns the "draftPicks" edge IDs in the mutation.
+func (m *PlayerMutation) DraftPicksIDs() (ids []int) {
+	for id := range m.draftPicks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDraftPicks resets all changes to the "draftPicks" edge.
+func (m *PlayerMutation) ResetDraftPicks() {
+	m.draftPicks = nil
+	m.cleareddraftPicks = false
+	m.removeddraftPicks = nil
+}
+
+// Op returns the operation name.
+func (m *PlayerMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Player).
+func (m *PlayerMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PlayerMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.externalID != nil {
+		fields = append(fields, player.FieldExternalID)
+	}
+	if m.name != nil {
+		fields = append(fields, player.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PlayerMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case player.FieldExternalID:
+		return m.ExternalID()
+	case player.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PlayerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case player.FieldExternalID:
+		return m.OldExternalID(ctx)
+	case player.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown Player field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlayerMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case player.FieldExternalID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalID(v)
+		return nil
+	case player.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Player field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PlayerMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PlayerMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlayerMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Player numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PlayerMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PlayerMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PlayerMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Player nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PlayerMutation) ResetField(name string) error {
+	switch name {
+	case player.FieldExternalID:
+		m.ResetExternalID()
+		return nil
+	case player.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown Player field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PlayerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.team != nil {
+		edges = append(edges, player.EdgeTeam)
+	}
+	if m.performances != nil {
+		edges = append(edges, player.EdgePerformances)
+	}
+	if m.draftPicks != nil {
+		edges = append(edges, player.EdgeDraftPicks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PlayerMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case player.EdgeTeam:
+		if id := m.team; id != nil {
+			return []ent.Value{*id}
+		}
+	case player.EdgePerformances:
+		ids := make([]ent.Value, 0, len(m.performances))
+		for id := range m.performances {
+			ids = append(ids, id)
+		}
+		return ids
+	case player.EdgeDraftPicks:
+		ids := make([]ent.Value, 0, len(m.draftPicks))
+		for id := range m.draftPicks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PlayerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedperformances != nil {
+		edges = append(edges, player.EdgePerformances)
+	}
+	if m.removeddraftPicks != nil {
+		edges = append(edges, player.EdgeDraftPicks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PlayerMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case player.EdgePerformances:
+		ids := make([]ent.Value, 0, len(m.removedperformances))
+		for id := range m.removedperformances {
+			ids = append(ids, id)
+		}
+		return ids
+	case player.EdgeDraftPicks:
+		ids := make([]ent.Value, 0, len(m.removeddraftPicks))
+		for id := range m.removeddraftPicks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PlayerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedteam {
+		edges = append(edges, player.EdgeTeam)
+	}
+	if m.clearedperformances {
+		edges = append(edges, player.EdgePerformances)
+	}
+	if m.cleareddraftPicks {
+		edges = append(edges, player.EdgeDraftPicks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PlayerMutation) EdgeCleared(name string) bool {
+	switch name {
+	case player.EdgeTeam:
+		return m.clearedteam
+	case player.EdgePerformances:
+		return m.clearedperformances
+	case player.EdgeDraftPicks:
+		return m.cleareddraftPicks
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PlayerMutation) ClearEdge(name string) error {
+	switch name {
+	case player.EdgeTeam:
+		m.ClearTeam()
+		return nil
+	}
+	return fmt.Errorf("unknown Player unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PlayerMutation) ResetEdge(name string) error {
+	switch name {
+	case player.EdgeTeam:
+		m.ResetTeam()
+		return nil
+	case player.EdgePerformances:
+		m.ResetPerformances()
+		return nil
+	case player.EdgeDraftPicks:
+		m.ResetDraftPicks()
+		return nil
+	}
+	return fmt.Errorf("unknown Player edge %s", name)
+}
+
+// PlayerPerformanceMutation represents an operation that mutates the PlayerPerformance nodes in the graph.
+type PlayerPerformanceMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	minutes       *int
+	addminutes    *int
+	points        *int
+	addpoints     *int
+	rebounds      *int
+	addrebounds   *int
+	assists       *int
+	addassists    *int
+	steals        *int
+	addsteals     *int
+	blocks        *int
+	addblocks     *int
+	turnovers     *int
+	addturnovers  *int
+	clearedFields map[string]struct{}
+	player        *int
+	clearedplayer bool
+	game          *int
+	clearedgame   bool
+	team          *int
+	clearedteam   bool
+	done          bool
+	oldValue      func(context.Context) (*PlayerPerformance, error)
+	predicates    []predicate.PlayerPerformance
+}
+
+var _ ent.Mutation = (*PlayerPerformanceMutation)(nil)
+
+// playerperformanceOption allows management of the mutation configuration using functional options.
+type playerperformanceOption func(*PlayerPerformanceMutation)
+
+// newPlayerPerformanceMutation creates new mutation for the PlayerPerformance entity.
+func newPlayerPerformanceMutation(c config, op Op, opts ...playerperformanceOption) *PlayerPerformanceMutation {
+	m := &PlayerPerformanceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePlayerPerformance,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPlayerPerformanceID sets the ID field of the mutation.
+func withPlayerPerformanceID(id int) playerperformanceOption {
+	return func(m *PlayerPerformanceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PlayerPerformance
+		)
+		m.oldValue = func(ctx context.Context) (*PlayerPerformance, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PlayerPerformance.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPlayerPerformance sets the old PlayerPerformance of the mutation.
+func withPlayerPerformance(node *PlayerPerformance) playerperformanceOption {
+	return func(m *PlayerPerformanceMutation) {
+		m.oldValue = func(context.Context) (*PlayerPerformance, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PlayerPerformanceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PlayerPerformanceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *PlayerPerformanceMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetMinutes sets the "minutes" field.
+func (m *PlayerPerformanceMutation) SetMinutes(i int) {
+	m.minutes = &i
+	m.addminutes = nil
+}
+
+// Minutes returns the value of the "minutes" field in the mutation.
+func (m *PlayerPerformanceMutation) Minutes() (r int, exists bool) {
+	v := m.minutes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMinutes returns the old "minutes" field's value of the PlayerPerformance entity.
+// If the PlayerPerformance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerPerformanceMutation) OldMinutes(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldMinutes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldMinutes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMinutes: %w", err)
+	}
+	return oldValue.Minutes, nil
+}
+
+// AddMinutes adds i to the "minutes" field.
+func (m *PlayerPerformanceMutation) AddMinutes(i int) {
+	if m.addminutes != nil {
+		*m.addminutes += i
+	} else {
+		m.addminutes = &i
+	}
+}
+
+// AddedMinutes returns the value that was added to the "minutes" field in this mutation.
+func (m *PlayerPerformanceMutation) AddedMinutes() (r int, exists bool) {
+	v := m.addminutes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMinutes clears the value of the "minutes" field.
+func (m *PlayerPerformanceMutation) ClearMinutes() {
+	m.minutes = nil
+	m.addminutes = nil
+	m.clearedFields[playerperformance.FieldMinutes] = struct{}{}
+}
+
+// MinutesCleared returns if the "minutes" field was cleared in this mutation.
+func (m *PlayerPerformanceMutation) MinutesCleared() bool {
+	_, ok := m.clearedFields[playerperformance.FieldMinutes]
+	return ok
+}
+
+// ResetMinutes resets all changes to the "minutes" field.
+func (m *PlayerPerformanceMutation) ResetMinutes() {
+	m.minutes = nil
+	m.addminutes = nil
+	delete(m.clearedFields, playerperformance.FieldMinutes)
+}
+
+// SetPoints sets the "points" field.
+func (m *PlayerPerformanceMutation) SetPoints(i int) {
+	m.points = &i
+	m.addpoints = nil
+}
+
+// Points returns the value of the "points" field in the mutation.
+func (m *PlayerPerformanceMutation) Points() (r int, exists bool) {
+	v := m.points
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPoints returns the old "points" field's value of the PlayerPerformance entity.
+// If the PlayerPerformance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerPerformanceMutation) OldPoints(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldPoints is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldPoints requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPoints: %w", err)
+	}
+	return oldValue.Points, nil
+}
+
+// AddPoints adds i to the "points" field.
+func (m *PlayerPerformanceMutation) AddPoints(i int) {
+	if m.addpoints != nil {
+		*m.addpoints += i
+	} else {
+		m.addpoints = &i
+	}
+}
+
+// AddedPoints returns the value that was added to the "points" field in this mutation.
+func (m *PlayerPerformanceMutation) AddedPoints() (r int, exists bool) {
+	v := m.addpoints
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPoints resets all changes to the "points" field.
+func (m *PlayerPerformanceMutation) ResetPoints() {
+	m.points = nil
+	m.addpoints = nil
+}
+
+// SetRebounds sets the "rebounds" field.
+func (m *PlayerPerformanceMutation) SetRebounds(i int) {
+	m.rebounds = &i
+	m.addrebounds = nil
+}
+
+// Rebounds returns the value of the "rebounds" field in the mutation.
+func (m *PlayerPerformanceMutation) Rebounds() (r int, exists bool) {
+	v := m.rebounds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRebounds returns the old "rebounds" field's value of the PlayerPerformance entity.
+// If the PlayerPerformance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerPerformanceMutation) OldRebounds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRebounds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRebounds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRebounds: %w", err)
+	}
+	return oldValue.Rebounds, nil
+}
+
+// AddRebounds adds i to the "rebounds" field.
+func (m *PlayerPerformanceMutation) AddRebounds(i int) {
+	if m.addrebounds != nil {
+		*m.addrebounds += i
+	} else {
+		m.addrebounds = &i
+	}
+}
+
+// AddedRebounds returns the value that was added to the "rebounds" field in this mutation.
+func (m *PlayerPerformanceMutation) AddedRebounds() (r int, exists bool) {
+	v := m.addrebounds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRebounds resets all changes to the "rebounds" field.
+func (m *PlayerPerformanceMutation) ResetRebounds() {
+	m.rebounds = nil
+	m.addrebounds = nil
+}
+
+// SetAssists sets the "assists" field.
+func (m *PlayerPerformanceMutation) SetAssists(i int) {
+	m.assists = &i
+	m.addassists = nil
+}
+
+// Assists returns the value of the "assists" field in the mutation.
+func (m *PlayerPerformanceMutation) Assists() (r int, exists bool) {
+	v := m.assists
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssists returns the old "assists" field's value of the PlayerPerformance entity.
+// If the PlayerPerformance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerPerformanceMutation) OldAssists(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldAssists is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldAssists requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssists: %w", err)
+	}
+	return oldValue.Assists, nil
+}
+
+// AddAssists adds i to the "assists" field.
+func (m *PlayerPerformanceMutation) AddAssists(i int) {
+	if m.addassists != nil {
+		*m.addassists += i
+	} else {
+		m.addassists = &i
+	}
+}
+
+// AddedAssists returns the value that was added to the "assists" field in this mutation.
+func (m *PlayerPerformanceMutation) AddedAssists() (r int, exists bool) {
+	v := m.addassists
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAssists resets all changes to the "assists" field.
+func (m *PlayerPerformanceMutation) ResetAssists() {
+	m.assists = nil
+	m.addassists = nil
+}
+
+// SetSteals sets the "steals" field.
+func (m *PlayerPerformanceMutation) SetSteals(i int) {
+	m.steals = &i
+	m.addsteals = nil
+}
+
+// Steals returns the value of the "steals" field in the mutation.
+func (m *PlayerPerformanceMutation) Steals() (r int, exists bool) {
+	v := m.steals
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSteals returns the old "steals" field's value of the PlayerPerformance entity.
+// If the PlayerPerformance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerPerformanceMutation) OldSteals(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSteals is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSteals requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSteals: %w", err)
+	}
+	return oldValue.Steals, nil
+}
+
+// AddSteals adds i to the "steals" field.
+func (m *PlayerPerformanceMutation) AddSteals(i int) {
+	if m.addsteals != nil {
+		*m.addsteals += i
+	} else {
+		m.addsteals = &i
+	}
+}
+
+// AddedSteals returns the value that was added to the "steals" field in this mutation.
+func (m *PlayerPerformanceMutation) AddedSteals() (r int, exists bool) {
+	v := m.addsteals
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSteals resets all changes to the "steals" field.
+func (m *PlayerPerformanceMutation) ResetSteals() {
+	m.steals = nil
+	m.addsteals = nil
+}
+
+// SetBlocks sets the "blocks" field.
+func (m *PlayerPerformanceMutation) SetBlocks(i int) {
+	m.blocks = &i
+	m.addblocks = nil
+}
+
+// Blocks returns the value of the "blocks" field in the mutation.
+func (m *PlayerPerformanceMutation) Blocks() (r int, exists bool) {
+	v := m.blocks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlocks returns the old "blocks" field's value of the PlayerPerformance entity.
+// If the PlayerPerformance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerPerformanceMutation) OldBlocks(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldBlocks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldBlocks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlocks: %w", err)
+	}
+	return oldValue.Blocks, nil
+}
+
+// AddBlocks adds i to the "blocks" field.
+func (m *PlayerPerformanceMutation) AddBlocks(i int) {
+	if m.addblocks != nil {
+		*m.addblocks += i
+	} else {
+		m.addblocks = &i
+	}
+}
+
+// AddedBlocks returns the value that was added to the "blocks" field in this mutation.
+func (m *PlayerPerformanceMutation) AddedBlocks() (r int, exists bool) {
+	v := m.addblocks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBlocks resets all changes to the "blocks" field.
+func (m *PlayerPerformanceMutation) ResetBlocks() {
+	m.blocks = nil
+	m.addblocks = nil
+}
+
+// SetTurnovers sets the "turnovers" field.
+func (m *PlayerPerformanceMutation) SetTurnovers(i int) {
+	m.turnovers = &i
+	m.addturnovers = nil
+}
+
+// Turnovers returns the value of the "turnovers" field in the mutation.
+func (m *PlayerPerformanceMutation) Turnovers() (r int, exists bool) {
+	v := m.turnovers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTurnovers returns the old "turnovers" field's value of the PlayerPerformance entity.
+// If the PlayerPerformance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerPerformanceMutation) OldTurnovers(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTurnovers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTurnovers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTurnovers: %w", err)
+	}
+	return oldValue.Turnovers, nil
+}
+
+// AddTurnovers adds i to the "turnovers" field.
+func (m *PlayerPerformanceMutation) AddTurnovers(i int) {
+	if m.addturnovers != nil {
+		*m.addturnovers += i
+	} else {
+		m.addturnovers = &i
+	}
+}
+
+// AddedTurnovers returns the value that was added to the "turnovers" field in this mutation.
+func (m *PlayerPerformanceMutation) AddedTurnovers() (r int, exists bool) {
+	v := m.addturnovers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTurnovers resets all changes to the "turnovers" field.
+func (m *PlayerPerformanceMutation) ResetTurnovers() {
+	m.turnovers = nil
+	m.addturnovers = nil
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by id.
+func (m *PlayerPerformanceMutation) SetPlayerID(id int) {
+	m.player = &id
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (m *PlayerPerformanceMutation) ClearPlayer() {
+	m.clearedplayer = true
+}
+
+// PlayerCleared returns if the "player" edge to the Player entity was cleared.
+func (m *PlayerPerformanceMutation) PlayerCleared() bool {
+	return m.clearedplayer
+}
+
+// PlayerID returns the "player" edge ID in the mutation.
+func (m *PlayerPerformanceMutation) PlayerID() (id int, exists bool) {
+	if m.player != nil {
+		return *m.player, true
+	}
+	return
+}
+
+// PlayerIDs returns the "player" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PlayerID instead. It exists only for internal usage by the builders.
+func (m *PlayerPerformanceMutation) PlayerIDs() (ids []int) {
+	if id := m.player; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPlayer resets all changes to the "player" edge.
+func (m *PlayerPerformanceMutation) ResetPlayer() {
+	m.player = nil
+	m.clearedplayer = false
+}
+
+// SetGameID sets the "game" edge to the Game entity by id.
+func (m *PlayerPerformanceMutation) SetGameID(id int) {
+	m.game = &id
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (m *PlayerPerformanceMutation) ClearGame() {
+	m.clearedgame = true
+}
+
+// GameCleared returns if the "game" edge to the Game entity was cleared.
+func (m *PlayerPerformanceMutation) GameCleared() bool {
+	return m.clearedgame
+}
+
+// GameID returns the "game" edge ID in the mutation.
+func (m *PlayerPerformanceMutation) GameID() (id int, exists bool) {
+	if m.game != nil {
+		return *m.game, true
+	}
+	return
+}
+
+// GameIDs returns the "game" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GameID instead. It exists only for internal usage by the builders.
+func (m *PlayerPerformanceMutation) GameIDs() (ids []int) {
+	if id := m.game; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGame resets all changes to the "game" edge.
+func (m *PlayerPerformanceMutation) ResetGame() {
+	m.game = nil
+	m.clearedgame = false
+}
+
+// SetTeamID sets the "team" edge to the Team entity by id.
+func (m *PlayerPerformanceMutation) SetTeamID(id int) {
+	m.team = &id
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (m *PlayerPerformanceMutation) ClearTeam() {
+	m.clearedteam = true
+}
+
+// TeamCleared returns if the "team" edge to the Team entity was cleared.
+func (m *PlayerPerformanceMutation) TeamCleared() bool {
+	return m.clearedteam
+}
+
+// TeamID returns the "team" edge ID in the mutation.
+func (m *PlayerPerformanceMutation) TeamID() (id int, exists bool) {
+	if m.team != nil {
+		return *m.team, true
+	}
+	return
+}
+
+// TeamIDs returns the "team" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *PlayerPerformanceMutation) TeamIDs() (ids []int) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTeam resets all changes to the "team" edge.
+func (m *PlayerPerformanceMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
+}
+
+// Op returns the operation name.
+func (m *PlayerPerformanceMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (PlayerPerformance).
+func (m *PlayerPerformanceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PlayerPerformanceMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.minutes != nil {
+		fields = append(fields, playerperformance.FieldMinutes)
+	}
+	if m.points != nil {
+		fields = append(fields, playerperformance.FieldPoints)
+	}
+	if m.rebounds != nil {
+		fields = append(fields, playerperformance.FieldRebounds)
+	}
+	if m.assists != nil {
+		fields = append(fields, playerperformance.FieldAssists)
+	}
+	if m.steals != nil {
+		fields = append(fields, playerperformance.FieldSteals)
+	}
+	if m.blocks != nil {
+		fields = append(fields, playerperformance.FieldBlocks)
+	}
+	if m.turnovers != nil {
+		fields = append(fields, playerperformance.FieldTurnovers)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PlayerPerformanceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case playerperformance.FieldMinutes:
+		return m.Minutes()
+	case playerperformance.FieldPoints:
+		return m.Points()
+	case playerperformance.FieldRebounds:
+		return m.Rebounds()
+	case playerperformance.FieldAssists:
+		return m.Assists()
+	case playerperformance.FieldSteals:
+		return m.Steals()
+	case playerperformance.FieldBlocks:
+		return m.Blocks()
+	case playerperformance.FieldTurnovers:
+		return m.Turnovers()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PlayerPerformanceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case playerperformance.FieldMinutes:
+		return m.OldMinutes(ctx)
+	case playerperformance.FieldPoints:
+		return m.OldPoints(ctx)
+	case playerperformance.FieldRebounds:
+		return m.OldRebounds(ctx)
+	case playerperformance.FieldAssists:
+		return m.OldAssists(ctx)
+	case playerperformance.FieldSteals:
+		return m.OldSteals(ctx)
+	case playerperformance.FieldBlocks:
+		return m.OldBlocks(ctx)
+	case playerperformance.FieldTurnovers:
+		return m.OldTurnovers(ctx)
+	}
+	return nil, fmt.Errorf("unknown PlayerPerformance field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlayerPerformanceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case playerperformance.FieldMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinutes(v)
+		return nil
+	case playerperformance.FieldPoints:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPoints(v)
+		return nil
+	case playerperformance.FieldRebounds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRebounds(v)
+		return nil
+	case playerperformance.FieldAssists:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssists(v)
+		return nil
+	case playerperformance.FieldSteals:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSteals(v)
+		return nil
+	case playerperformance.FieldBlocks:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlocks(v)
+		return nil
+	case playerperformance.FieldTurnovers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTurnovers(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PlayerPerformance field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PlayerPerformanceMutation) AddedFields() []string {
+	var fields []string
+	if m.addminutes != nil {
+		fields = append(fields, playerperformance.FieldMinutes)
+	}
+	if m.addpoints != nil {
+		fields = append(fields, playerperformance.FieldPoints)
+	}
+	if m.addrebounds != nil {
+		fields = append(fields, playerperformance.FieldRebounds)
+	}
+	if m.addassists != nil {
+		fields = append(fields, playerperformance.FieldAssists)
+	}
+	if m.addsteals != nil {
+		fields = append(fields, playerperformance.FieldSteals)
+	}
+	if m.addblocks != nil {
+		fields = append(fields, playerperformance.FieldBlocks)
+	}
+	if m.addturnovers != nil {
+		fields = append(fields, playerperformance.FieldTurnovers)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PlayerPerformanceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case playerperformance.FieldMinutes:
+		return m.AddedMinutes()
+	case playerperformance.FieldPoints:
+		return m.AddedPoints()
+	case playerperformance.FieldRebounds:
+		return m.AddedRebounds()
+	case playerperformance.FieldAssists:
+		return m.AddedAssists()
+	case playerperformance.FieldSteals:
+		return m.AddedSteals()
+	case playerperformance.FieldBlocks:
+		return m.AddedBlocks()
+	case playerperformance.FieldTurnovers:
+		return m.AddedTurnovers()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlayerPerformanceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case playerperformance.FieldMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMinutes(v)
+		return nil
+	case playerperformance.FieldPoints:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPoints(v)
+		return nil
+	case playerperformance.FieldRebounds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRebounds(v)
+		return nil
+	case playerperformance.FieldAssists:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAssists(v)
+		return nil
+	case playerperformance.FieldSteals:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSteals(v)
+		return nil
+	case playerperformance.FieldBlocks:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBlocks(v)
+		return nil
+	case playerperformance.FieldTurnovers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTurnovers(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PlayerPerformance numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PlayerPerformanceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(playerperformance.FieldMinutes) {
+		fields = append(fields, playerperformance.FieldMinutes)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PlayerPerformanceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PlayerPerformanceMutation) ClearField(name string) error {
+	switch name {
+	case playerperformance.FieldMinutes:
+		m.ClearMinutes()
+		return nil
+	}
+	return fmt.Errorf("unknown PlayerPerformance nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PlayerPerformanceMutation) ResetField(name string) error {
+	switch name {
+	case playerperformance.FieldMinutes:
+		m.ResetMinutes()
+		return nil
+	case playerperformance.FieldPoints:
+		m.ResetPoints()
+		return nil
+	case playerperformance.FieldRebounds:
+		m.ResetRebounds()
+		return nil
+	case playerperformance.FieldAssists:
+		m.ResetAssists()
+		return nil
+	case playerperformance.FieldSteals:
+		m.ResetSteals()
+		return nil
+	case playerperformance.FieldBlocks:
+		m.ResetBlocks()
+		return nil
+	case playerperformance.FieldTurnovers:
+		m.ResetTurnovers()
+		return nil
+	}
+	return fmt.Errorf("unknown PlayerPerformance field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PlayerPerformanceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.player != nil {
+		edges = append(edges, playerperformance.EdgePlayer)
+	}
+	if m.game != nil {
+		edges = append(edges, playerperformance.EdgeGame)
+	}
+	if m.team != nil {
+		edges = append(edges, playerperformance.EdgeTeam)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PlayerPerformanceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case playerperformance.EdgePlayer:
+		if id := m.player; id != nil {
+			return []ent.Value{*id}
+		}
+	case playerperformance.EdgeGame:
+		if id := m.game; id != nil {
+			return []ent.Value{*id}
+		}
+	case playerperformance.EdgeTeam:
+		if id := m.team; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PlayerPerformanceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PlayerPerformanceMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PlayerPerformanceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedplayer {
+		edges = append(edges, playerperformance.EdgePlayer)
+	}
+	if m.clearedgame {
+		edges = append(edges, playerperformance.EdgeGame)
+	}
+	if m.clearedteam {
+		edges = append(edges, playerperformance.EdgeTeam)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PlayerPerformanceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case playerperformance.EdgePlayer:
+		return m.clearedplayer
+	case playerperformance.EdgeGame:
+		return m.clearedgame
+	case playerperformance.EdgeTeam:
+		return m.clearedteam
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PlayerPerformanceMutation) ClearEdge(name string) error {
+	switch name {
+	case playerperformance.EdgePlayer:
+		m.ClearPlayer()
+		return nil
+	case playerperformance.EdgeGame:
+		m.ClearGame()
+		return nil
+	case playerperformance.EdgeTeam:
+		m.ClearTeam()
+		return nil
+	}
+	return fmt.Errorf("unknown PlayerPerformance unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PlayerPerformanceMutation) ResetEdge(name string) error {
+	switch name {
+	case playerperformance.EdgePlayer:
+		m.ResetPlayer()
+		return nil
+	case playerperformance.EdgeGame:
+		m.ResetGame()
+		return nil
+	case playerperformance.EdgeTeam:
+		m.ResetTeam()
+		return nil
+	}
+	return fmt.Errorf("unknown PlayerPerformance edge %s", name)
+}
+
+// RefreshTokenMutation represents an operation that mutates the RefreshToken nodes in the graph.
+type RefreshTokenMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	tokenHash     *string
+	family        *string
+	created       *time.Time
+	expires       *time.Time
+	rotated       *time.Time
+	revoked       *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*RefreshToken, error)
+	predicates    []predicate.RefreshToken
+}
+
+var _ ent.Mutation = (*RefreshTokenMutation)(nil)
+
+// refreshtokenOption allows management of the mutation configuration using functional options.
+type refreshtokenOption func(*RefreshTokenMutation)
+
+// newRefreshTokenMutation creates new mutation for the RefreshToken entity.
+func newRefreshTokenMutation(c config, op Op, opts ...refreshtokenOption) *RefreshTokenMutation {
+	m := &RefreshTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRefreshToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRefreshTokenID sets the ID field of the mutation.
+func withRefreshTokenID(id int) refreshtokenOption {
+	return func(m *RefreshTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RefreshToken
+		)
+		m.oldValue = func(ctx context.Context) (*RefreshToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RefreshToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRefreshToken sets the old RefreshToken of the mutation.
+func withRefreshToken(node *RefreshToken) refreshtokenOption {
+	return func(m *RefreshTokenMutation) {
+		m.oldValue = func(context.Context) (*RefreshToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RefreshTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RefreshTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *RefreshTokenMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetTokenHash sets the "tokenHash" field.
+func (m *RefreshTokenMutation) SetTokenHash(s string) {
+	m.tokenHash = &s
+}
+
+// TokenHash returns the value of the "tokenHash" field in the mutation.
+func (m *RefreshTokenMutation) TokenHash() (r string, exists bool) {
+	v := m.tokenHash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "tokenHash" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ResetTokenHash resets all changes to the "tokenHash" field.
+func (m *RefreshTokenMutation) ResetTokenHash() {
+	m.tokenHash = nil
+}
+
+// SetFamily sets the "family" field.
+func (m *RefreshTokenMutation) SetFamily(s string) {
+	m.family = &s
+}
+
+// Family returns the value of the "family" field in the mutation.
+func (m *RefreshTokenMutation) Family() (r string, exists bool) {
+	v := m.family
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFamily returns the old "family" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldFamily(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldFamily is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldFamily requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFamily: %w", err)
+	}
+	return oldValue.Family, nil
+}
+
+// ResetFamily resets all changes to the "family" field.
+func (m *RefreshTokenMutation) ResetFamily() {
+	m.family = nil
+}
+
+// SetCreated sets the "created" field.
+func (m *RefreshTokenMutation) SetCreated(t time.Time) {
+	m.created = &t
+}
+
+// Created returns the value of the "created" field in the mutation.
+func (m *RefreshTokenMutation) Created() (r time.Time, exists bool) {
+	v := m.created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreated returns the old "created" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldCreated(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreated: %w", err)
+	}
+	return oldValue.Created, nil
+}
+
+// ResetCreated resets all changes to the "created" field.
+func (m *RefreshTokenMutation) ResetCreated() {
+	m.created = nil
+}
+
+// SetExpires sets the "expires" field.
+func (m *RefreshTokenMutation) SetExpires(t time.Time) {
+	m.expires = &t
+}
+
+// Expires returns the value of the "expires" field in the mutation.
+func (m *RefreshTokenMutation) Expires() (r time.Time, exists bool) {
+	v := m.expires
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpires returns the old "expires" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldExpires(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldExpires is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldExpires requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpires: %w", err)
+	}
+	return oldValue.Expires, nil
+}
+
+// ResetExpires resets all changes to the "expires" field.
+func (m *RefreshTokenMutation) ResetExpires() {
+	m.expires = nil
+}
+
+// SetRotated sets the "rotated" field.
+func (m *RefreshTokenMutation) SetRotated(t time.Time) {
+	m.rotated = &t
+}
+
+// Rotated returns the value of the "rotated" field in the mutation.
+func (m *RefreshTokenMutation) Rotated() (r time.Time, exists bool) {
+	v := m.rotated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRotated returns the old "rotated" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldRotated(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRotated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRotated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRotated: %w", err)
+	}
+	return oldValue.Rotated, nil
+}
+
+// ClearRotated clears the value of the "rotated" field.
+func (m *RefreshTokenMutation) ClearRotated() {
+	m.rotated = nil
+	m.clearedFields[refreshtoken.FieldRotated] = struct{}{}
+}
+
+// RotatedCleared returns if the "rotated" field was cleared in this mutation.
+func (m *RefreshTokenMutation) RotatedCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldRotated]
+	return ok
+}
+
+// ResetRotated resets all changes to the "rotated" field.
+func (m *RefreshTokenMutation) ResetRotated() {
+	m.rotated = nil
+	delete(m.clearedFields, refreshtoken.FieldRotated)
+}
+
+// SetRevoked sets the "revoked" field.
+func (m *RefreshTokenMutation) SetRevoked(t time.Time) {
+	m.revoked = &t
+}
+
+// Revoked returns the value of the "revoked" field in the mutation.
+func (m *RefreshTokenMutation) Revoked() (r time.Time, exists bool) {
+	v := m.revoked
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevoked returns the old "revoked" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldRevoked(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRevoked is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRevoked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevoked: %w", err)
+	}
+	return oldValue.Revoked, nil
+}
+
+// ClearRevoked clears the value of the "revoked" field.
+func (m *RefreshTokenMutation) ClearRevoked() {
+	m.revoked = nil
+	m.clearedFields[refreshtoken.FieldRevoked] = struct{}{}
+}
+
+// RevokedCleared returns if the "revoked" field was cleared in this mutation.
+func (m *RefreshTokenMutation) RevokedCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldRevoked]
+	return ok
+}
+
+// ResetRevoked resets all changes to the "revoked" field.
+func (m *RefreshTokenMutation) ResetRevoked() {
+	m.revoked = nil
+	delete(m.clearedFields, refreshtoken.FieldRevoked)
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *RefreshTokenMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *RefreshTokenMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared returns if the "user" edge to the User entity was cleared.
+func (m *RefreshTokenMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *RefreshTokenMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RefreshTokenMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *RefreshTokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Op returns the operation name.
+func (m *RefreshTokenMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (RefreshToken).
+func (m *RefreshTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RefreshTokenMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.tokenHash != nil {
+		fields = append(fields, refreshtoken.FieldTokenHash)
+	}
+	if m.family != nil {
+		fields = append(fields, refreshtoken.FieldFamily)
+	}
+	if m.created != nil {
+		fields = append(fields, refreshtoken.FieldCreated)
+	}
+	if m.expires != nil {
+		fields = append(fields, refreshtoken.FieldExpires)
+	}
+	if m.rotated != nil {
+		fields = append(fields, refreshtoken.FieldRotated)
+	}
+	if m.revoked != nil {
+		fields = append(fields, refreshtoken.FieldRevoked)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RefreshTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case refreshtoken.FieldTokenHash:
+		return m.TokenHash()
+	case refreshtoken.FieldFamily:
+		return m.Family()
+	case refreshtoken.FieldCreated:
+		return m.Created()
+	case refreshtoken.FieldExpires:
+		return m.Expires()
+	case refreshtoken.FieldRotated:
+		return m.Rotated()
+	case refreshtoken.FieldRevoked:
+		return m.Revoked()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RefreshTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case refreshtoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case refreshtoken.FieldFamily:
+		return m.OldFamily(ctx)
+	case refreshtoken.FieldCreated:
+		return m.OldCreated(ctx)
+	case refreshtoken.FieldExpires:
+		return m.OldExpires(ctx)
+	case refreshtoken.FieldRotated:
+		return m.OldRotated(ctx)
+	case refreshtoken.FieldRevoked:
+		return m.OldRevoked(ctx)
+	}
+	return nil, fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RefreshTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case refreshtoken.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case refreshtoken.FieldFamily:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFamily(v)
+		return nil
+	case refreshtoken.FieldCreated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreated(v)
+		return nil
+	case refreshtoken.FieldExpires:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpires(v)
+		return nil
+	case refreshtoken.FieldRotated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRotated(v)
+		return nil
+	case refreshtoken.FieldRevoked:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevoked(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RefreshTokenMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RefreshTokenMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RefreshTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RefreshToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RefreshTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(refreshtoken.FieldRotated) {
+		fields = append(fields, refreshtoken.FieldRotated)
+	}
+	if m.FieldCleared(refreshtoken.FieldRevoked) {
+		fields = append(fields, refreshtoken.FieldRevoked)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RefreshTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RefreshTokenMutation) ClearField(name string) error {
+	switch name {
+	case refreshtoken.FieldRotated:
+		m.ClearRotated()
+		return nil
+	case refreshtoken.FieldRevoked:
+		m.ClearRevoked()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RefreshTokenMutation) ResetField(name string) error {
+	switch name {
+	case refreshtoken.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case refreshtoken.FieldFamily:
+		m.ResetFamily()
+		return nil
+	case refreshtoken.FieldCreated:
+		m.ResetCreated()
+		return nil
+	case refreshtoken.FieldExpires:
+		m.ResetExpires()
+		return nil
+	case refreshtoken.FieldRotated:
+		m.ResetRotated()
+		return nil
+	case refreshtoken.FieldRevoked:
+		m.ResetRevoked()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RefreshTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, refreshtoken.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RefreshTokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case refreshtoken.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RefreshTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RefreshTokenMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RefreshTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, refreshtoken.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RefreshTokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case refreshtoken.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RefreshTokenMutation) ClearEdge(name string) error {
+	switch name {
+	case refreshtoken.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RefreshTokenMutation) ResetEdge(name string) error {
+	switch name {
+	case refreshtoken.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken edge %s", name)
+}
+
+// TeamMutation represents an operation that mutates the Team nodes in the graph.
+type TeamMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	externalID          *string
+	shortName           *string
+	location            *string
+	name                *string
+	clearedFields       map[string]struct{}
+	players             map[int]struct{}
+	removedplayers      map[int]struct{}
+	clearedplayers      bool
+	homeGames           map[int]struct{}
+	removedhomeGames    map[int]struct{}
+	clearedhomeGames    bool
+	awayGames           map[int]struct{}
+	removedawayGames    map[int]struct{}
+	clearedawayGames    bool
+	wonGames            map[int]struct{}
+	removedwonGames     map[int]struct{}
+	clearedwonGames     bool
+	performances        map[int]struct{}
+	removedperformances map[int]struct{}
+	clearedperformances bool
+	done                bool
+	oldValue            func(context.Context) (*Team, error)
+	predicates          []predicate.Team
+}
+
+var _ ent.Mutation = (*TeamMutation)(nil)
+
+// teamOption allows management of the mutation configuration using functional options.
+type teamOption func(*TeamMutation)
+
+// newTeamMutation creates new mutation for the Team entity.
+func newTeamMutation(c config, op Op, opts ...teamOption) *TeamMutation {
+	m := &TeamMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTeam,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTeamID sets the ID field of the mutation.
+func withTeamID(id int) teamOption {
+	return func(m *TeamMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Team
+		)
+		m.oldValue = func(ctx context.Context) (*Team, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Team.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTeam sets the old Team of the mutation.
+func withTeam(node *Team) teamOption {
+	return func(m *TeamMutation) {
+		m.oldValue = func(context.Context) (*Team, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TeamMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TeamMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *TeamMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetExternalID sets the "externalID" field.
+func (m *TeamMutation) SetExternalID(s string) {
+	m.externalID = &s
+}
+
+// ExternalID returns the value of the "externalID" field in the mutation.
+func (m *TeamMutation) ExternalID() (r string, exists bool) {
+	v := m.externalID
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalID returns the old "externalID" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldExternalID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldExternalID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldExternalID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalID: %w", err)
+	}
+	return oldValue.ExternalID, nil
+}
+
+// ResetExternalID resets all changes to the "externalID" field.
+func (m *TeamMutation) ResetExternalID() {
+	m.externalID = nil
+}
+
+// SetShortName sets the "shortName" field.
+func (m *TeamMutation) SetShortName(s string) {
+	m.shortName = &s
+}
+
+// ShortName returns the value of the "shortName" field in the mutation.
+func (m *TeamMutation) ShortName() (r string, exists bool) {
+	v := m.shortName
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShortName returns the old "shortName" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldShortName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldShortName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldShortName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShortName: %w", err)
+	}
+	return oldValue.ShortName, nil
+}
+
+// ResetShortName resets all changes to the "shortName" field.
+func (m *TeamMutation) ResetShortName() {
+	m.shortName = nil
+}
+
+// SetLocation sets the "location" field.
+func (m *TeamMutation) SetLocation(s string) {
+	m.location = &s
+}
+
+// Location returns the value of the "location" field in the mutation.
+func (m *TeamMutation) Location() (r string, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocation returns the old "location" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldLocation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldLocation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldLocation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+	}
+	return oldValue.Location, nil
+}
+
+// ResetLocation resets all changes to the "location" field.
+func (m *TeamMutation) ResetLocation() {
+	m.location = nil
+}
+
+// SetName sets the "name" field.
+func (m *TeamMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TeamMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TeamMutation) ResetName() {
+	m.name = nil
+}
+
+// AddPlayerIDs adds the "players" edge to the Player entity by ids.
+func (m *TeamMutation) AddPlayerIDs(ids ...int) {
+	if m.players == nil {
+		m.players = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.players[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPlayers clears the "players" edge to the Player entity.
+func (m *TeamMutation) ClearPlayers() {
+	m.clearedplayers = true
+}
+
+// PlayersCleared returns if the "players" edge to the Player entity was cleared.
+func (m *TeamMutation) PlayersCleared() bool {
+	return m.clearedplayers
+}
+
+// RemovePlayerIDs removes the "players" edge to the Player entity by IDs.
+func (m *TeamMutation) RemovePlayerIDs(ids ...int) {
+	if m.removedplayers == nil {
+		m.removedplayers = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedplayers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPlayers returns the removed IDs of the "players" edge to the Player entity.
+func (m *TeamMutation) RemovedPlayersIDs() (ids []int) {
+	for id := range m.removedplayers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PlayersIDs returns the "players" edge IDs in the mutation.
+func (m *TeamMutation) PlayersIDs() (ids []int) {
+	for id := range m.players {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPlayers resets all changes to the "players" edge.
+func (m *TeamMutation) ResetPlayers() {
+	m.players = nil
+	m.clearedplayers = false
+	m.removedplayers = nil
+}
+
+// AddHomeGameIDs adds the "homeGames" edge to the Game entity by ids.
+func (m *TeamMutation) AddHomeGameIDs(ids ...int) {
+	if m.homeGames == nil {
+		m.homeGames = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.homeGames[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHomeGames clears the "homeGames" edge to the Game entity.
+func (m *TeamMutation) ClearHomeGames() {
+	m.clearedhomeGames = true
+}
+
+// HomeGamesCleared returns if the "homeGames" edge to the Game entity was cleared.
+func (m *TeamMutation) HomeGamesCleared() bool {
+	return m.clearedhomeGames
+}
+
+// RemoveHomeGameIDs removes the "homeGames" edge to the Game entity by IDs.
+func (m *TeamMutation) RemoveHomeGameIDs(ids ...int) {
+	if m.removedhomeGames == nil {
+		m.removedhomeGames = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedhomeGames[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHomeGames returns the removed IDs of the "homeGames" edge to the Game entity.
+func (m *TeamMutation) RemovedHomeGamesIDs() (ids []int) {
+	for id := range m.removedhomeGames {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HomeGamesIDs returns the "homeGames" edge IDs in the mutation.
+func (m *TeamMutation) HomeGamesIDs() (ids []int) {
+	for id := range m.homeGames {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHomeGames resets all changes to the "homeGames" edge.
+func (m *TeamMutation) ResetHomeGames() {
+	m.homeGames = nil
+	m.clearedhomeGames = false
+	m.removedhomeGames = nil
+}
+
+// AddAwayGameIDs adds the "awayGames" edge to the Game entity by ids.
+func (m *TeamMutation) AddAwayGameIDs(ids ...int) {
+	if m.awayGames == nil {
+		m.awayGames = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.awayGames[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAwayGames clears the "awayGames" edge to the Game entity.
+func (m *TeamMutation) ClearAwayGames() {
+	m.clearedawayGames = true
+}
+
+// AwayGamesCleared returns if the "awayGames" edge to the Game entity was cleared.
+func (m *TeamMutation) AwayGamesCleared() bool {
+	return m.clearedawayGames
+}
+
+// RemoveAwayGameIDs removes the "awayGames" edge to the Game entity by IDs.
+func (m *TeamMutation) RemoveAwayGameIDs(ids ...int) {
+	if m.removedawayGames == nil {
+		m.removedawayGames = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedawayGames[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAwayGames returns the removed IDs of the "awayGames" edge to the Game entity.
+func (m *TeamMutation) RemovedAwayGamesIDs() (ids []int) {
+	for id := range m.removedawayGames {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AwayGamesIDs returns the "awayGames" edge IDs in the mutation.
+func (m *TeamMutation) AwayGamesIDs() (ids []int) {
+	for id := range m.awayGames {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAwayGames resets all changes to the "awayGames" edge.
+func (m *TeamMutation) ResetAwayGames() {
+	m.awayGames = nil
+	m.clearedawayGames = false
+	m.removedawayGames = nil
+}
+
+// AddWonGameIDs adds the "wonGames" edge to the GameResult entity by ids.
+func (m *TeamMutation) AddWonGameIDs(ids ...int) {
+	if m.wonGames == nil {
+		m.wonGames = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.wonGames[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWonGames clears the "wonGames" edge to the GameResult entity.
+func (m *TeamMutation) ClearWonGames() {
+	m.clearedwonGames = true
+}
+
+// WonGamesCleared returns if the "wonGames" edge to the GameResult entity was cleared.
+func (m *TeamMutation) WonGamesCleared() bool {
+	return m.clearedwonGames
+}
+
+// RemoveWonGameIDs removes the "wonGames" edge to the GameResult entity by IDs.
+func (m *TeamMutation) RemoveWonGameIDs(ids ...int) {
+	if m.removedwonGames == nil {
+		m.removedwonGames = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedwonGames[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWonGames returns the removed IDs of the "wonGames" edge to the GameResult entity.
+func (m *TeamMutation) RemovedWonGamesIDs() (ids []int) {
+	for id := range m.removedwonGames {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WonGamesIDs returns the "wonGames" edge IDs in the mutation.
+func (m *TeamMutation) WonGamesIDs() (ids []int) {
+	for id := range m.wonGames {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWonGames resets all changes to the "wonGames" edge.
+func (m *TeamMutation) ResetWonGames() {
+	m.wonGames = nil
+	m.clearedwonGames = false
+	m.removedwonGames = nil
+}
+
+// AddPerformanceIDs adds the "performances" edge to the PlayerPerformance entity by ids.
+func (m *TeamMutation) AddPerformanceIDs(ids ...int) {
+	if m.performances == nil {
+		m.performances = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.performances[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPerformances clears the "performances" edge to the PlayerPerformance entity.
+func (m *TeamMutation) ClearPerformances() {
+	m.clearedperformances = true
+}
+
+// PerformancesCleared returns if the "performances" edge to the PlayerPerformance entity was cleared.
+func (m *TeamMutation) PerformancesCleared() bool {
+	return m.clearedperformances
+}
+
+// RemovePerformanceIDs removes the "performances" edge to the PlayerPerformance entity by IDs.
+func (m *TeamMutation) RemovePerformanceIDs(ids ...int) {
+	if m.removedperformances == nil {
+		m.removedperformances = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedperformances[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPerformances returns the removed IDs of the "performances" edge to the PlayerPerformance entity.
+func (m *TeamMutation) RemovedPerformancesIDs() (ids []int) {
+	for id := range m.removedperformances {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PerformancesIDs returns the "performances" edge IDs in the mutation.
+func (m *TeamMutation) PerformancesIDs() (ids []int) {
+	for id := range m.performances {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPerformances resets all changes to the "performances" edge.
+func (m *TeamMutation) ResetPerformances() {
+	m.performances = nil
+	m.clearedperformances = false
+	m.removedperformances = nil
+}
+
+// Op returns the operation name.
+func (m *TeamMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Team).
+func (m *TeamMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TeamMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.externalID != nil {
+		fields = append(fields, team.FieldExternalID)
+	}
+	if m.shortName != nil {
+		fields = append(fields, team.FieldShortName)
+	}
+	if m.location != nil {
+		fields = append(fields, team.FieldLocation)
+	}
+	if m.name != nil {
+		fields = append(fields, team.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TeamMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case team.FieldExternalID:
+		return m.ExternalID()
+	case team.FieldShortName:
+		return m.ShortName()
+	case team.FieldLocation:
+		return m.Location()
+	case team.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TeamMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case team.FieldExternalID:
+		return m.OldExternalID(ctx)
+	case team.FieldShortName:
+		return m.OldShortName(ctx)
+	case team.FieldLocation:
+		return m.OldLocation(ctx)
+	case team.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown Team field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case team.FieldExternalID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalID(v)
+		return nil
+	case team.FieldShortName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShortName(v)
+		return nil
+	case team.FieldLocation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocation(v)
+		return nil
+	case team.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Team field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TeamMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TeamMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Team numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TeamMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TeamMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TeamMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Team nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TeamMutation) ResetField(name string) error {
+	switch name {
+	case team.FieldExternalID:
+		m.ResetExternalID()
+		return nil
+	case team.FieldShortName:
+		m.ResetShortName()
+		return nil
+	case team.FieldLocation:
+		m.ResetLocation()
+		return nil
+	case team.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown Team field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TeamMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.players != nil {
+		edges = append(edges, team.EdgePlayers)
+	}
+	if m.homeGames != nil {
+		edges = append(edges, team.EdgeHomeGames)
+	}
+	if m.awayGames != nil {
+		edges = append(edges, team.EdgeAwayGames)
+	}
+	if m.wonGames != nil {
+		edges = append(edges, team.EdgeWonGames)
+	}
+	if m.performances != nil {
+		edges = append(edges, team.EdgePerformances)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TeamMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case team.EdgePlayers:
+		ids := make([]ent.Value, 0, len(m.players))
+		for id := range m.players {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeHomeGames:
+		ids := make([]ent.Value, 0, len(m.homeGames))
+		for id := range m.homeGames {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeAwayGames:
+		ids := make([]ent.Value, 0, len(m.awayGames))
+		for id := range m.awayGames {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeWonGames:
+		ids := make([]ent.Value, 0, len(m.wonGames))
+		for id := range m.wonGames {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgePerformances:
+		ids := make([]ent.Value, 0, len(m.performances))
+		for id := range m.performances {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TeamMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedplayers != nil {
+		edges = append(edges, team.EdgePlayers)
+	}
+	if m.removedhomeGames != nil {
+		edges = append(edges, team.EdgeHomeGames)
+	}
+	if m.removedawayGames != nil {
+		edges = append(edges, team.EdgeAwayGames)
+	}
+	if m.removedwonGames != nil {
+		edges = append(edges, team.EdgeWonGames)
+	}
+	if m.removedperformances != nil {
+		edges = append(edges, team.EdgePerformances)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TeamMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case team.EdgePlayers:
+		ids := make([]ent.Value, 0, len(m.removedplayers))
+		for id := range m.removedplayers {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeHomeGames:
+		ids := make([]ent.Value, 0, len(m.removedhomeGames))
+		for id := range m.removedhomeGames {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeAwayGames:
+		ids := make([]ent.Value, 0, len(m.removedawayGames))
+		for id := range m.removedawayGames {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeWonGames:
+		ids := make([]ent.Value, 0, len(m.removedwonGames))
+		for id := range m.removedwonGames {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgePerformances:
+		ids := make([]ent.Value, 0, len(m.removedperformances))
+		for id := range m.removedperformances {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TeamMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedplayers {
+		edges = append(edges, team.EdgePlayers)
+	}
+	if m.clearedhomeGames {
+		edges = append(edges, team.EdgeHomeGames)
+	}
+	if m.clearedawayGames {
+		edges = append(edges, team.EdgeAwayGames)
+	}
+	if m.clearedwonGames {
+		edges = append(edges, team.EdgeWonGames)
+	}
+	if m.clearedperformances {
+		edges = append(edges, team.EdgePerformances)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TeamMutation) EdgeCleared(name string) bool {
+	switch name {
+	case team.EdgePlayers:
+		return m.clearedplayers
+	case team.EdgeHomeGames:
+		return m.clearedhomeGames
+	case team.EdgeAwayGames:
+		return m.clearedawayGames
+	case team.EdgeWonGames:
+		return m.clearedwonGames
+	case team.EdgePerformances:
+		return m.clearedperformances
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TeamMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Team unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TeamMutation) ResetEdge(name string) error {
+	switch name {
+	case team.EdgePlayers:
+		m.ResetPlayers()
+		return nil
+	case team.EdgeHomeGames:
+		m.ResetHomeGames()
+		return nil
+	case team.EdgeAwayGames:
+		m.ResetAwayGames()
+		return nil
+	case team.EdgeWonGames:
+		m.ResetWonGames()
+		return nil
+	case team.EdgePerformances:
+		m.ResetPerformances()
+		return nil
+	}
+	return fmt.Errorf("unknown Team edge %s", name)
+}
+
 // UserMutation represents an operation that mutates the User nodes in the graph.
 type UserMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	name          *string
-	email         *string
-	picture       *string
-	joined        *time.Time
-	lastActive    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*User, error)
-	predicates    []predicate.User
+	op                     Op
+	typ                    string
+	id                     *int
+	name                   *string
+	email                  *string
+	picture                *string
+	joined                 *time.Time
+	lastActive             *time.Time
+	clearedFields          map[string]struct{}
+	memberships            map[int]struct{}
+	removedmemberships     map[int]struct{}
+	clearedmemberships     bool
+	sentInvitations        map[int]struct{}
+	removedsentInvitations map[int]struct{}
+	clearedsentInvitations bool
+	identities             map[int]struct{}
+	removedidentities      map[int]struct{}
+	clearedidentities      bool
+	authCodes              map[int]struct{}
+	removedauthCodes       map[int]struct{}
+	clearedauthCodes       bool
+	refreshTokens          map[int]struct{}
+	removedrefreshTokens   map[int]struct{}
+	clearedrefreshTokens   bool
+	draftPicks             map[int]struct{}
+	removeddraftPicks      map[int]struct{}
+	cleareddraftPicks      bool
+	contestEntries         map[int]struct{}
+	removedcontestEntries  map[int]struct{}
+	clearedcontestEntries  bool
+	wonContests            map[int]struct{}
+	removedwonContests     map[int]struct{}
+	clearedwonContests     bool
+	done                   bool
+	oldValue               func(context.Context) (*User, error)
+	predicates             []predicate.User
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -341,6 +11446,430 @@ func (m *UserMutation) ResetLastActive() {
 	delete(m.clearedFields, user.FieldLastActive)
 }
 
+// AddMembershipIDs adds the "memberships" edge to the LeagueMembership entity by ids.
+func (m *UserMutation) AddMembershipIDs(ids ...int) {
+	if m.memberships == nil {
+		m.memberships = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.memberships[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMemberships clears the "memberships" edge to the LeagueMembership entity.
+func (m *UserMutation) ClearMemberships() {
+	m.clearedmemberships = true
+}
+
+// MembershipsCleared returns if the "memberships" edge to the LeagueMembership entity was cleared.
+func (m *UserMutation) MembershipsCleared() bool {
+	return m.clearedmemberships
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to the LeagueMembership entity by IDs.
+func (m *UserMutation) RemoveMembershipIDs(ids ...int) {
+	if m.removedmemberships == nil {
+		m.removedmemberships = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedmemberships[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMemberships returns the removed IDs of the "memberships" edge to the LeagueMembership entity.
+func (m *UserMutation) RemovedMembershipsIDs() (ids []int) {
+	for id := range m.removedmemberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembershipsIDs returns the "memberships" edge IDs in the mutation.
+func (m *UserMutation) MembershipsIDs() (ids []int) {
+	for id := range m.memberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMemberships resets all changes to the "memberships" edge.
+func (m *UserMutation) ResetMemberships() {
+	m.memberships = nil
+	m.clearedmemberships = false
+	m.removedmemberships = nil
+}
+
+// AddSentInvitationIDs adds the "sentInvitations" edge to the Invitation entity by ids.
+func (m *UserMutation) AddSentInvitationIDs(ids ...int) {
+	if m.sentInvitations == nil {
+		m.sentInvitations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.sentInvitations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSentInvitations clears the "sentInvitations" edge to the Invitation entity.
+func (m *UserMutation) ClearSentInvitations() {
+	m.clearedsentInvitations = true
+}
+
+// SentInvitationsCleared returns if the "sentInvitations" edge to the Invitation entity was cleared.
+func (m *UserMutation) SentInvitationsCleared() bool {
+	return m.clearedsentInvitations
+}
+
+// RemoveSentInvitationIDs removes the "sentInvitations" edge to the Invitation entity by IDs.
+func (m *UserMutation) RemoveSentInvitationIDs(ids ...int) {
+	if m.removedsentInvitations == nil {
+		m.removedsentInvitations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedsentInvitations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSentInvitations returns the removed IDs of the "sentInvitations" edge to the Invitation entity.
+func (m *UserMutation) RemovedSentInvitationsIDs() (ids []int) {
+	for id := range m.removedsentInvitations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SentInvitationsIDs returns the "sentInvitations" edge IDs in the mutation.
+func (m *UserMutation) SentInvitationsIDs() (ids []int) {
+	for id := range m.sentInvitations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSentInvitations resets all changes to the "sentInvitations" edge.
+func (m *UserMutation) ResetSentInvitations() {
+	m.sentInvitations = nil
+	m.clearedsentInvitations = false
+	m.removedsentInvitations = nil
+}
+
+// AddIdentityIDs adds the "identities" edge to the Identity entity by ids.
+func (m *UserMutation) AddIdentityIDs(ids ...int) {
+	if m.identities == nil {
+		m.identities = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.identities[ids[i]] = struct{}{}
+	}
+}
+
+// ClearIdentities clears the "identities" edge to the Identity entity.
+func (m *UserMutation) ClearIdentities() {
+	m.clearedidentities = true
+}
+
+// IdentitiesCleared returns if the "identities" edge to the Identity entity was cleared.
+func (m *UserMutation) IdentitiesCleared() bool {
+	return m.clearedidentities
+}
+
+// RemoveIdentityIDs removes the "identities" edge to the Identity entity by IDs.
+func (m *UserMutation) RemoveIdentityIDs(ids ...int) {
+	if m.removedidentities == nil {
+		m.removedidentities = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedidentities[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedIdentities returns the removed IDs of the "identities" edge to the Identity entity.
+func (m *UserMutation) RemovedIdentitiesIDs() (ids []int) {
+	for id := range m.removedidentities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// IdentitiesIDs returns the "identities" edge IDs in the mutation.
+func (m *UserMutation) IdentitiesIDs() (ids []int) {
+	for id := range m.identities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetIdentities resets all changes to the "identities" edge.
+func (m *UserMutation) ResetIdentities() {
+	m.identities = nil
+	m.clearedidentities = false
+	m.removedidentities = nil
+}
+
+// AddAuthCodeIDs adds the "authCodes" edge to the AuthCode entity by ids.
+func (m *UserMutation) AddAuthCodeIDs(ids ...int) {
+	if m.authCodes == nil {
+		m.authCodes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.authCodes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAuthCodes clears the "authCodes" edge to the AuthCode entity.
+func (m *UserMutation) ClearAuthCodes() {
+	m.clearedauthCodes = true
+}
+
+// AuthCodesCleared returns if the "authCodes" edge to the AuthCode entity was cleared.
+func (m *UserMutation) AuthCodesCleared() bool {
+	return m.clearedauthCodes
+}
+
+// RemoveAuthCodeIDs removes the "authCodes" edge to the AuthCode entity by IDs.
+func (m *UserMutation) RemoveAuthCodeIDs(ids ...int) {
+	if m.removedauthCodes == nil {
+		m.removedauthCodes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedauthCodes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAuthCodes returns the removed IDs of the "authCodes" edge to the AuthCode entity.
+func (m *UserMutation) RemovedAuthCodesIDs() (ids []int) {
+	for id := range m.removedauthCodes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AuthCodesIDs returns the "authCodes" edge IDs in the mutation.
+func (m *UserMutation) AuthCodesIDs() (ids []int) {
+	for id := range m.authCodes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAuthCodes resets all changes to the "authCodes" edge.
+func (m *UserMutation) ResetAuthCodes() {
+	m.authCodes = nil
+	m.clearedauthCodes = false
+	m.removedauthCodes = nil
+}
+
+// AddRefreshTokenIDs adds the "refreshTokens" edge to the RefreshToken entity by ids.
+func (m *UserMutation) AddRefreshTokenIDs(ids ...int) {
+	if m.refreshTokens == nil {
+		m.refreshTokens = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.refreshTokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRefreshTokens clears the "refreshTokens" edge to the RefreshToken entity.
+func (m *UserMutation) ClearRefreshTokens() {
+	m.clearedrefreshTokens = true
+}
+
+// RefreshTokensCleared returns if the "refreshTokens" edge to the RefreshToken entity was cleared.
+func (m *UserMutation) RefreshTokensCleared() bool {
+	return m.clearedrefreshTokens
+}
+
+// RemoveRefreshTokenIDs removes the "refreshTokens" edge to the RefreshToken entity by IDs.
+func (m *UserMutation) RemoveRefreshTokenIDs(ids ...int) {
+	if m.removedrefreshTokens == nil {
+		m.removedrefreshTokens = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedrefreshTokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRefreshTokens returns the removed IDs of the "refreshTokens" edge to the RefreshToken entity.
+func (m *UserMutation) RemovedRefreshTokensIDs() (ids []int) {
+	for id := range m.removedrefreshTokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RefreshTokensIDs returns the "refreshTokens" edge IDs in the mutation.
+func (m *UserMutation) RefreshTokensIDs() (ids []int) {
+	for id := range m.refreshTokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRefreshTokens resets all changes to the "refreshTokens" edge.
+func (m *UserMutation) ResetRefreshTokens() {
+	m.refreshTokens = nil
+	m.clearedrefreshTokens = false
+	m.removedrefreshTokens = nil
+}
+
+// AddDraftPickIDs adds the "draftPicks" edge to the ContestDraftPick entity by ids.
+func (m *UserMutation) AddDraftPickIDs(ids ...int) {
+	if m.draftPicks == nil {
+		m.draftPicks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.draftPicks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDraftPicks clears the "draftPicks" edge to the ContestDraftPick entity.
+func (m *UserMutation) ClearDraftPicks() {
+	m.cleareddraftPicks = true
+}
+
+// DraftPicksCleared returns if the "draftPicks" edge to the ContestDraftPick entity was cleared.
+func (m *UserMutation) DraftPicksCleared() bool {
+	return m.cleareddraftPicks
+}
+
+// RemoveDraftPickIDs removes the "draftPicks" edge to the ContestDraftPick entity by IDs.
+func (m *UserMutation) RemoveDraftPickIDs(ids ...int) {
+	if m.removeddraftPicks == nil {
+		m.removeddraftPicks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removeddraftPicks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDraftPicks returns the removed IDs of the "draftPicks" edge to the ContestDraftPick entity.
+func (m *UserMutation) RemovedDraftPicksIDs() (ids []int) {
+	for id := range m.removeddraftPicks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DraftPicksIDs returns the "draftPicks" edge IDs in the mutation.
+func (m *UserMutation) DraftPicksIDs() (ids []int) {
+	for id := range m.draftPicks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDraftPicks resets all changes to the "draftPicks" edge.
+func (m *UserMutation) ResetDraftPicks() {
+	m.draftPicks = nil
+	m.cleareddraftPicks = false
+	m.removeddraftPicks = nil
+}
+
+// AddContestEntryIDs adds the "contestEntries" edge to the ContestEntry entity by ids.
+func (m *UserMutation) AddContestEntryIDs(ids ...int) {
+	if m.contestEntries == nil {
+		m.contestEntries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.contestEntries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearContestEntries clears the "contestEntries" edge to the ContestEntry entity.
+func (m *UserMutation) ClearContestEntries() {
+	m.clearedcontestEntries = true
+}
+
+// ContestEntriesCleared returns if the "contestEntries" edge to the ContestEntry entity was cleared.
+func (m *UserMutation) ContestEntriesCleared() bool {
+	return m.clearedcontestEntries
+}
+
+// RemoveContestEntryIDs removes the "contestEntries" edge to the ContestEntry entity by IDs.
+func (m *UserMutation) RemoveContestEntryIDs(ids ...int) {
+	if m.removedcontestEntries == nil {
+		m.removedcontestEntries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedcontestEntries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedContestEntries returns the removed IDs of the "contestEntries" edge to the ContestEntry entity.
+func (m *UserMutation) RemovedContestEntriesIDs() (ids []int) {
+	for id := range m.removedcontestEntries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ContestEntriesIDs returns the "contestEntries" edge IDs in the mutation.
+func (m *UserMutation) ContestEntriesIDs() (ids []int) {
+	for id := range m.contestEntries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetContestEntries resets all changes to the "contestEntries" edge.
+func (m *UserMutation) ResetContestEntries() {
+	m.contestEntries = nil
+	m.clearedcontestEntries = false
+	m.removedcontestEntries = nil
+}
+
+// AddWonContestIDs adds the "wonContests" edge to the Contest entity by ids.
+func (m *UserMutation) AddWonContestIDs(ids ...int) {
+	if m.wonContests == nil {
+		m.wonContests = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.wonContests[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWonContests clears the "wonContests" edge to the Contest entity.
+func (m *UserMutation) ClearWonContests() {
+	m.clearedwonContests = true
+}
+
+// WonContestsCleared returns if the "wonContests" edge to the Contest entity was cleared.
+func (m *UserMutation) WonContestsCleared() bool {
+	return m.clearedwonContests
+}
+
+// RemoveWonContestIDs removes the "wonContests" edge to the Contest entity by IDs.
+func (m *UserMutation) RemoveWonContestIDs(ids ...int) {
+	if m.removedwonContests == nil {
+		m.removedwonContests = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedwonContests[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWonContests returns the removed IDs of the "wonContests" edge to the Contest entity.
+func (m *UserMutation) RemovedWonContestsIDs() (ids []int) {
+	for id := range m.removedwonContests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WonContestsIDs returns the "wonContests" edge IDs in the mutation.
+func (m *UserMutation) WonContestsIDs() (ids []int) {
+	for id := range m.wonContests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWonContests resets all changes to the "wonContests" edge.
+func (m *UserMutation) ResetWonContests() {
+	m.wonContests = nil
+	m.clearedwonContests = false
+	m.removedwonContests = nil
+}
+
 // Op returns the operation name.
 func (m *UserMutation) Op() Op {
 	return m.op
@@ -543,48 +12072,266 @@ func (m *UserMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 8)
+	if m.memberships != nil {
+		edges = append(edges, user.EdgeMemberships)
+	}
+	if m.sentInvitations != nil {
+		edges = append(edges, user.EdgeSentInvitations)
+	}
+	if m.identities != nil {
+		edges = append(edges, user.EdgeIdentities)
+	}
+	if m.authCodes != nil {
+		edges = append(edges, user.EdgeAuthCodes)
+	}
+	if m.refreshTokens != nil {
+		edges = append(edges, user.EdgeRefreshTokens)
+	}
+	if m.draftPicks != nil {
+		edges = append(edges, user.EdgeDraftPicks)
+	}
+	if m.contestEntries != nil {
+		edges = append(edges, user.EdgeContestEntries)
+	}
+	if m.wonContests != nil {
+		edges = append(edges, user.EdgeWonContests)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
 func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeMemberships:
+		ids := make([]ent.Value, 0, len(m.memberships))
+		for id := range m.memberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSentInvitations:
+		ids := make([]ent.Value, 0, len(m.sentInvitations))
+		for id := range m.sentInvitations {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeIdentities:
+		ids := make([]ent.Value, 0, len(m.identities))
+		for id := range m.identities {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAuthCodes:
+		ids := make([]ent.Value, 0, len(m.authCodes))
+		for id := range m.authCodes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRefreshTokens:
+		ids := make([]ent.Value, 0, len(m.refreshTokens))
+		for id := range m.refreshTokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeDraftPicks:
+		ids := make([]ent.Value, 0, len(m.draftPicks))
+		for id := range m.draftPicks {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeContestEntries:
+		ids := make([]ent.Value, 0, len(m.contestEntries))
+		for id := range m.contestEntries {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeWonContests:
+		ids := make([]ent.Value, 0, len(m.wonContests))
+		for id := range m.wonContests {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 8)
+	if m.removedmemberships != nil {
+		edges = append(edges, user.EdgeMemberships)
+	}
+	if m.removedsentInvitations != nil {
+		edges = append(edges, user.EdgeSentInvitations)
+	}
+	if m.removedidentities != nil {
+		edges = append(edges, user.EdgeIdentities)
+	}
+	if m.removedauthCodes != nil {
+		edges = append(edges, user.EdgeAuthCodes)
+	}
+	if m.removedrefreshTokens != nil {
+		edges = append(edges, user.EdgeRefreshTokens)
+	}
+	if m.removeddraftPicks != nil {
+		edges = append(edges, user.EdgeDraftPicks)
+	}
+	if m.removedcontestEntries != nil {
+		edges = append(edges, user.EdgeContestEntries)
+	}
+	if m.removedwonContests != nil {
+		edges = append(edges, user.EdgeWonContests)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
 func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeMemberships:
+		ids := make([]ent.Value, 0, len(m.removedmemberships))
+		for id := range m.removedmemberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSentInvitations:
+		ids := make([]ent.Value, 0, len(m.removedsentInvitations))
+		for id := range m.removedsentInvitations {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeIdentities:
+		ids := make([]ent.Value, 0, len(m.removedidentities))
+		for id := range m.removedidentities {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAuthCodes:
+		ids := make([]ent.Value, 0, len(m.removedauthCodes))
+		for id := range m.removedauthCodes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRefreshTokens:
+		ids := make([]ent.Value, 0, len(m.removedrefreshTokens))
+		for id := range m.removedrefreshTokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeDraftPicks:
+		ids := make([]ent.Value, 0, len(m.removeddraftPicks))
+		for id := range m.removeddraftPicks {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeContestEntries:
+		ids := make([]ent.Value, 0, len(m.removedcontestEntries))
+		for id := range m.removedcontestEntries {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeWonContests:
+		ids := make([]ent.Value, 0, len(m.removedwonContests))
+		for id := range m.removedwonContests {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 8)
+	if m.clearedmemberships {
+		edges = append(edges, user.EdgeMemberships)
+	}
+	if m.clearedsentInvitations {
+		edges = append(edges, user.EdgeSentInvitations)
+	}
+	if m.clearedidentities {
+		edges = append(edges, user.EdgeIdentities)
+	}
+	if m.clearedauthCodes {
+		edges = append(edges, user.EdgeAuthCodes)
+	}
+	if m.clearedrefreshTokens {
+		edges = append(edges, user.EdgeRefreshTokens)
+	}
+	if m.cleareddraftPicks {
+		edges = append(edges, user.EdgeDraftPicks)
+	}
+	if m.clearedcontestEntries {
+		edges = append(edges, user.EdgeContestEntries)
+	}
+	if m.clearedwonContests {
+		edges = append(edges, user.EdgeWonContests)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
 func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeMemberships:
+		return m.clearedmemberships
+	case user.EdgeSentInvitations:
+		return m.clearedsentInvitations
+	case user.EdgeIdentities:
+		return m.clearedidentities
+	case user.EdgeAuthCodes:
+		return m.clearedauthCodes
+	case user.EdgeRefreshTokens:
+		return m.clearedrefreshTokens
+	case user.EdgeDraftPicks:
+		return m.cleareddraftPicks
+	case user.EdgeContestEntries:
+		return m.clearedcontestEntries
+	case user.EdgeWonContests:
+		return m.clearedwonContests
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
 func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	}
 	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
 func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeMemberships:
+		m.ResetMemberships()
+		return nil
+	case user.EdgeSentInvitations:
+		m.ResetSentInvitations()
+		return nil
+	case user.EdgeIdentities:
+		m.ResetIdentities()
+		return nil
+	case user.EdgeAuthCodes:
+		m.ResetAuthCodes()
+		return nil
+	case user.EdgeRefreshTokens:
+		m.ResetRefreshTokens()
+		return nil
+	case user.EdgeDraftPicks:
+		m.ResetDraftPicks()
+		return nil
+	case user.EdgeContestEntries:
+		m.ResetContestEntries()
+		return nil
+	case user.EdgeWonContests:
+		m.ResetWonContests()
+		return nil
+	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
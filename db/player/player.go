@@ -0,0 +1,71 @@
+// Code generated by entc, DO NOT EDIT.
+
+package player
+
+const (
+	// Label holds the string label denoting the player type in the database.
+	Label = "player"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldExternalID holds the string denoting the externalid field in the database.
+	FieldExternalID = "external_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// EdgeTeam holds the string denoting the team edge name in mutations.
+	EdgeTeam = "team"
+	// EdgePerformances holds the string denoting the performances edge name in mutations.
+	EdgePerformances = "performances"
+	// EdgeDraftPicks holds the string denoting the draftpicks edge name in mutations.
+	EdgeDraftPicks = "draftPicks"
+	// Table holds the table name of the player in the database.
+	Table = "players"
+	// TeamTable is the table the holds the team relation/edge.
+	TeamTable = "players"
+	// TeamInverseTable is the table name for the Team entity.
+	// It exists in this package in order to avoid circular dependency with the "team" package.
+	TeamInverseTable = "teams"
+	// TeamColumn is the table column denoting the team relation/edge.
+	TeamColumn = "team_players"
+	// PerformancesTable is the table the holds the performances relation/edge.
+	PerformancesTable = "player_performances"
+	// PerformancesInverseTable is the table name for the PlayerPerformance entity.
+	// It exists in this package in order to avoid circular dependency with the "playerperformance" package.
+	PerformancesInverseTable = "player_performances"
+	// PerformancesColumn is the table column denoting the performances relation/edge.
+	PerformancesColumn = "player_performances"
+	// DraftPicksTable is the table the holds the draftPicks relation/edge.
+	DraftPicksTable = "contest_draft_picks"
+	// DraftPicksInverseTable is the table name for the ContestDraftPick entity.
+	// It exists in this package in order to avoid circular dependency with the "contestdraftpick" package.
+	DraftPicksInverseTable = "contest_draft_picks"
+	// DraftPicksColumn is the table column denoting the draftPicks relation/edge.
+	DraftPicksColumn = "player_draft_picks"
+)
+
+// Columns holds all SQL columns for player fields.
+var Columns = []string{
+	FieldID,
+	FieldExternalID,
+	FieldName,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "players"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"team_players",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
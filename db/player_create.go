@@ -0,0 +1,311 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraftpick"
+	"github.com/NickDubelman/fantasy-bball/db/player"
+	"github.com/NickDubelman/fantasy-bball/db/playerperformance"
+	"github.com/NickDubelman/fantasy-bball/db/team"
+)
+
+// PlayerCreate is the builder for creating a Player entity.
+type PlayerCreate struct {
+	config
+	mutation *PlayerMutation
+	hooks    []Hook
+}
+
+// SetExternalID sets the "externalID" field.
+func (pc *PlayerCreate) SetExternalID(s string) *PlayerCreate {
+	pc.mutation.SetExternalID(s)
+	return pc
+}
+
+// SetName sets the "name" field.
+func (pc *PlayerCreate) SetName(s string) *PlayerCreate {
+	pc.mutation.SetName(s)
+	return pc
+}
+
+// SetTeamID sets the "team" edge to the Team entity by ID.
+func (pc *PlayerCreate) SetTeamID(id int) *PlayerCreate {
+	pc.mutation.SetTeamID(id)
+	return pc
+}
+
+// SetNillableTeamID sets the "team" edge to the Team entity by ID if the given value is not nil.
+func (pc *PlayerCreate) SetNillableTeamID(id *int) *PlayerCreate {
+	if id != nil {
+		pc = pc.SetTeamID(*id)
+	}
+	return pc
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (pc *PlayerCreate) SetTeam(t *Team) *PlayerCreate {
+	return pc.SetTeamID(t.ID)
+}
+
+// AddPerformanceIDs adds the "performances" edge to the PlayerPerformance entity by IDs.
+func (pc *PlayerCreate) AddPerformanceIDs(ids ...int) *PlayerCreate {
+	pc.mutation.AddPerformanceIDs(ids...)
+	return pc
+}
+
+// AddPerformances adds the "performances" edges to the PlayerPerformance entity.
+func (pc *PlayerCreate) AddPerformances(p ...*PlayerPerformance) *PlayerCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddPerformanceIDs(ids...)
+}
+
+// AddDraftPickIDs adds the "draftPicks" edge to the ContestDraftPick entity by IDs.
+func (pc *PlayerCreate) AddDraftPickIDs(ids ...int) *PlayerCreate {
+	pc.mutation.AddDraftPickIDs(ids...)
+	return pc
+}
+
+// AddDraftPicks adds the "draftPicks" edges to the ContestDraftPick entity.
+func (pc *PlayerCreate) AddDraftPicks(c ...*ContestDraftPick) *PlayerCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pc.AddDraftPickIDs(ids...)
+}
+
+// Mutation returns the PlayerMutation object of the builder.
+func (pc *PlayerCreate) Mutation() *PlayerMutation {
+	return pc.mutation
+}
+
+// Save creates the Player in the database.
+func (pc *PlayerCreate) Save(ctx context.Context) (*Player, error) {
+	var (
+		err  error
+		node *Player
+	)
+	if len(pc.hooks) == 0 {
+		if err = pc.check(); err != nil {
+			return nil, err
+		}
+		node, err = pc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PlayerMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pc.check(); err != nil {
+				return nil, err
+			}
+			pc.mutation = mutation
+			node, err = pc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pc.hooks) - 1; i >= 0; i-- {
+			mut = pc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *PlayerCreate) SaveX(ctx context.Context) *Player {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *PlayerCreate) check() error {
+	if _, ok := pc.mutation.ExternalID(); !ok {
+		return &ValidationError{Name: "externalID", err: errors.New("db: missing required field \"externalID\"")}
+	}
+	if _, ok := pc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New("db: missing required field \"name\"")}
+	}
+	return nil
+}
+
+func (pc *PlayerCreate) sqlSave(ctx context.Context) (*Player, error) {
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (pc *PlayerCreate) createSpec() (*Player, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Player{config: pc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: player.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: player.FieldID,
+			},
+		}
+	)
+	if value, ok := pc.mutation.ExternalID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: player.FieldExternalID,
+		})
+		_node.ExternalID = value
+	}
+	if value, ok := pc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: player.FieldName,
+		})
+		_node.Name = value
+	}
+	if nodes := pc.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   player.TeamTable,
+			Columns: []string{player.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.team_players = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.PerformancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.PerformancesTable,
+			Columns: []string{player.PerformancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: playerperformance.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.DraftPicksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.DraftPicksTable,
+			Columns: []string{player.DraftPicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraftpick.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PlayerCreateBulk is the builder for creating many Player entities in bulk.
+type PlayerCreateBulk struct {
+	config
+	builders []*PlayerCreate
+}
+
+// Save creates the Player entities in the database.
+func (pcb *PlayerCreateBulk) Save(ctx context.Context) ([]*Player, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Player, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlayerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *PlayerCreateBulk) SaveX(ctx context.Context) []*Player {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,747 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraftpick"
+	"github.com/NickDubelman/fantasy-bball/db/player"
+	"github.com/NickDubelman/fantasy-bball/db/playerperformance"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+	"github.com/NickDubelman/fantasy-bball/db/team"
+)
+
+// PlayerUpdate is the builder for updating Player entities.
+type PlayerUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlayerMutation
+}
+
+// Where adds a new predicate for the PlayerUpdate builder.
+func (pu *PlayerUpdate) Where(ps ...predicate.Player) *PlayerUpdate {
+	pu.mutation.predicates = append(pu.mutation.predicates, ps...)
+	return pu
+}
+
+// SetExternalID sets the "externalID" field.
+func (pu *PlayerUpdate) SetExternalID(s string) *PlayerUpdate {
+	pu.mutation.SetExternalID(s)
+	return pu
+}
+
+// SetName sets the "name" field.
+func (pu *PlayerUpdate) SetName(s string) *PlayerUpdate {
+	pu.mutation.SetName(s)
+	return pu
+}
+
+// SetTeamID sets the "team" edge to the Team entity by ID.
+func (pu *PlayerUpdate) SetTeamID(id int) *PlayerUpdate {
+	pu.mutation.SetTeamID(id)
+	return pu
+}
+
+// SetNillableTeamID sets the "team" edge to the Team entity by ID if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableTeamID(id *int) *PlayerUpdate {
+	if id != nil {
+		pu = pu.SetTeamID(*id)
+	}
+	return pu
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (pu *PlayerUpdate) SetTeam(t *Team) *PlayerUpdate {
+	return pu.SetTeamID(t.ID)
+}
+
+// AddPerformanceIDs adds the "performances" edge to the PlayerPerformance entity by IDs.
+func (pu *PlayerUpdate) AddPerformanceIDs(ids ...int) *PlayerUpdate {
+	pu.mutation.AddPerformanceIDs(ids...)
+	return pu
+}
+
+// AddPerformances adds the "performances" edges to the PlayerPerformance entity.
+func (pu *PlayerUpdate) AddPerformances(p ...*PlayerPerformance) *PlayerUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddPerformanceIDs(ids...)
+}
+
+// AddDraftPickIDs adds the "draftPicks" edge to the ContestDraftPick entity by IDs.
+func (pu *PlayerUpdate) AddDraftPickIDs(ids ...int) *PlayerUpdate {
+	pu.mutation.AddDraftPickIDs(ids...)
+	return pu
+}
+
+// AddDraftPicks adds the "draftPicks" edges to the ContestDraftPick entity.
+func (pu *PlayerUpdate) AddDraftPicks(c ...*ContestDraftPick) *PlayerUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pu.AddDraftPickIDs(ids...)
+}
+
+// Mutation returns the PlayerMutation object of the builder.
+func (pu *PlayerUpdate) Mutation() *PlayerMutation {
+	return pu.mutation
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (pu *PlayerUpdate) ClearTeam() *PlayerUpdate {
+	pu.mutation.ClearTeam()
+	return pu
+}
+
+// ClearPerformances clears all "performances" edges to the PlayerPerformance entity.
+func (pu *PlayerUpdate) ClearPerformances() *PlayerUpdate {
+	pu.mutation.ClearPerformances()
+	return pu
+}
+
+// RemovePerformanceIDs removes the "performances" edge to PlayerPerformance entities by IDs.
+func (pu *PlayerUpdate) RemovePerformanceIDs(ids ...int) *PlayerUpdate {
+	pu.mutation.RemovePerformanceIDs(ids...)
+	return pu
+}
+
+// RemovePerformances removes "performances" edges to PlayerPerformance entities.
+func (pu *PlayerUpdate) RemovePerformances(p ...*PlayerPerformance) *PlayerUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemovePerformanceIDs(ids...)
+}
+
+// ClearDraftPicks clears all "draftPicks" edges to the ContestDraftPick entity.
+func (pu *PlayerUpdate) ClearDraftPicks() *PlayerUpdate {
+	pu.mutation.ClearDraftPicks()
+	return pu
+}
+
+// RemoveDraftPickIDs removes the "draftPicks" edge to ContestDraftPick entities by IDs.
+func (pu *PlayerUpdate) RemoveDraftPickIDs(ids ...int) *PlayerUpdate {
+	pu.mutation.RemoveDraftPickIDs(ids...)
+	return pu
+}
+
+// RemoveDraftPicks removes "draftPicks" edges to ContestDraftPick entities.
+func (pu *PlayerUpdate) RemoveDraftPicks(c ...*ContestDraftPick) *PlayerUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pu.RemoveDraftPickIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PlayerUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(pu.hooks) == 0 {
+		affected, err = pu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PlayerMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			pu.mutation = mutation
+			affected, err = pu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(pu.hooks) - 1; i >= 0; i-- {
+			mut = pu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PlayerUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PlayerUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PlayerUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (pu *PlayerUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   player.Table,
+			Columns: player.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: player.FieldID,
+			},
+		},
+	}
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.ExternalID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: player.FieldExternalID,
+		})
+	}
+	if value, ok := pu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: player.FieldName,
+		})
+	}
+	if pu.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   player.TeamTable,
+			Columns: []string{player.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   player.TeamTable,
+			Columns: []string{player.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.PerformancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.PerformancesTable,
+			Columns: []string{player.PerformancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: playerperformance.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedPerformancesIDs(); len(nodes) > 0 && !pu.mutation.PerformancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.PerformancesTable,
+			Columns: []string{player.PerformancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: playerperformance.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.PerformancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.PerformancesTable,
+			Columns: []string{player.PerformancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: playerperformance.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.DraftPicksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.DraftPicksTable,
+			Columns: []string{player.DraftPicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraftpick.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedDraftPicksIDs(); len(nodes) > 0 && !pu.mutation.DraftPicksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.DraftPicksTable,
+			Columns: []string{player.DraftPicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraftpick.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.DraftPicksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.DraftPicksTable,
+			Columns: []string{player.DraftPicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraftpick.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{player.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// PlayerUpdateOne is the builder for updating a single Player entity.
+type PlayerUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *PlayerMutation
+}
+
+// SetExternalID sets the "externalID" field.
+func (puo *PlayerUpdateOne) SetExternalID(s string) *PlayerUpdateOne {
+	puo.mutation.SetExternalID(s)
+	return puo
+}
+
+// SetName sets the "name" field.
+func (puo *PlayerUpdateOne) SetName(s string) *PlayerUpdateOne {
+	puo.mutation.SetName(s)
+	return puo
+}
+
+// SetTeamID sets the "team" edge to the Team entity by ID.
+func (puo *PlayerUpdateOne) SetTeamID(id int) *PlayerUpdateOne {
+	puo.mutation.SetTeamID(id)
+	return puo
+}
+
+// SetNillableTeamID sets the "team" edge to the Team entity by ID if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableTeamID(id *int) *PlayerUpdateOne {
+	if id != nil {
+		puo = puo.SetTeamID(*id)
+	}
+	return puo
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (puo *PlayerUpdateOne) SetTeam(t *Team) *PlayerUpdateOne {
+	return puo.SetTeamID(t.ID)
+}
+
+// AddPerformanceIDs adds the "performances" edge to the PlayerPerformance entity by IDs.
+func (puo *PlayerUpdateOne) AddPerformanceIDs(ids ...int) *PlayerUpdateOne {
+	puo.mutation.AddPerformanceIDs(ids...)
+	return puo
+}
+
+// AddPerformances adds the "performances" edges to the PlayerPerformance entity.
+func (puo *PlayerUpdateOne) AddPerformances(p ...*PlayerPerformance) *PlayerUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddPerformanceIDs(ids...)
+}
+
+// AddDraftPickIDs adds the "draftPicks" edge to the ContestDraftPick entity by IDs.
+func (puo *PlayerUpdateOne) AddDraftPickIDs(ids ...int) *PlayerUpdateOne {
+	puo.mutation.AddDraftPickIDs(ids...)
+	return puo
+}
+
+// AddDraftPicks adds the "draftPicks" edges to the ContestDraftPick entity.
+func (puo *PlayerUpdateOne) AddDraftPicks(c ...*ContestDraftPick) *PlayerUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return puo.AddDraftPickIDs(ids...)
+}
+
+// Mutation returns the PlayerMutation object of the builder.
+func (puo *PlayerUpdateOne) Mutation() *PlayerMutation {
+	return puo.mutation
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (puo *PlayerUpdateOne) ClearTeam() *PlayerUpdateOne {
+	puo.mutation.ClearTeam()
+	return puo
+}
+
+// ClearPerformances clears all "performances" edges to the PlayerPerformance entity.
+func (puo *PlayerUpdateOne) ClearPerformances() *PlayerUpdateOne {
+	puo.mutation.ClearPerformances()
+	return puo
+}
+
+// RemovePerformanceIDs removes the "performances" edge to PlayerPerformance entities by IDs.
+func (puo *PlayerUpdateOne) RemovePerformanceIDs(ids ...int) *PlayerUpdateOne {
+	puo.mutation.RemovePerformanceIDs(ids...)
+	return puo
+}
+
+// RemovePerformances removes "performances" edges to PlayerPerformance entities.
+func (puo *PlayerUpdateOne) RemovePerformances(p ...*PlayerPerformance) *PlayerUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemovePerformanceIDs(ids...)
+}
+
+// ClearDraftPicks clears all "draftPicks" edges to the ContestDraftPick entity.
+func (puo *PlayerUpdateOne) ClearDraftPicks() *PlayerUpdateOne {
+	puo.mutation.ClearDraftPicks()
+	return puo
+}
+
+// RemoveDraftPickIDs removes the "draftPicks" edge to ContestDraftPick entities by IDs.
+func (puo *PlayerUpdateOne) RemoveDraftPickIDs(ids ...int) *PlayerUpdateOne {
+	puo.mutation.RemoveDraftPickIDs(ids...)
+	return puo
+}
+
+// RemoveDraftPicks removes "draftPicks" edges to ContestDraftPick entities.
+func (puo *PlayerUpdateOne) RemoveDraftPicks(c ...*ContestDraftPick) *PlayerUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return puo.RemoveDraftPickIDs(ids...)
+}
+
+// Save executes the query and returns the updated Player entity.
+func (puo *PlayerUpdateOne) Save(ctx context.Context) (*Player, error) {
+	var (
+		err  error
+		node *Player
+	)
+	if len(puo.hooks) == 0 {
+		node, err = puo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PlayerMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			puo.mutation = mutation
+			node, err = puo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(puo.hooks) - 1; i >= 0; i-- {
+			mut = puo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, puo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PlayerUpdateOne) SaveX(ctx context.Context) *Player {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PlayerUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PlayerUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (puo *PlayerUpdateOne) sqlSave(ctx context.Context) (_node *Player, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   player.Table,
+			Columns: player.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: player.FieldID,
+			},
+		},
+	}
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Player.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.ExternalID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: player.FieldExternalID,
+		})
+	}
+	if value, ok := puo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: player.FieldName,
+		})
+	}
+	if puo.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   player.TeamTable,
+			Columns: []string{player.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   player.TeamTable,
+			Columns: []string{player.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.PerformancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.PerformancesTable,
+			Columns: []string{player.PerformancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: playerperformance.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedPerformancesIDs(); len(nodes) > 0 && !puo.mutation.PerformancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.PerformancesTable,
+			Columns: []string{player.PerformancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: playerperformance.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.PerformancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.PerformancesTable,
+			Columns: []string{player.PerformancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: playerperformance.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.DraftPicksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.DraftPicksTable,
+			Columns: []string{player.DraftPicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraftpick.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedDraftPicksIDs(); len(nodes) > 0 && !puo.mutation.DraftPicksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.DraftPicksTable,
+			Columns: []string{player.DraftPicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraftpick.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.DraftPicksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.DraftPicksTable,
+			Columns: []string{player.DraftPicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraftpick.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Player{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{player.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,803 @@
+// Code generated by entc, DO NOT EDIT.
+
+package playerperformance
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Minutes applies equality check predicate on the "minutes" field. It's identical to MinutesEQ.
+func Minutes(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMinutes), v))
+	})
+}
+
+// Points applies equality check predicate on the "points" field. It's identical to PointsEQ.
+func Points(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPoints), v))
+	})
+}
+
+// Rebounds applies equality check predicate on the "rebounds" field. It's identical to ReboundsEQ.
+func Rebounds(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRebounds), v))
+	})
+}
+
+// Assists applies equality check predicate on the "assists" field. It's identical to AssistsEQ.
+func Assists(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAssists), v))
+	})
+}
+
+// Steals applies equality check predicate on the "steals" field. It's identical to StealsEQ.
+func Steals(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSteals), v))
+	})
+}
+
+// Blocks applies equality check predicate on the "blocks" field. It's identical to BlocksEQ.
+func Blocks(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBlocks), v))
+	})
+}
+
+// Turnovers applies equality check predicate on the "turnovers" field. It's identical to TurnoversEQ.
+func Turnovers(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTurnovers), v))
+	})
+}
+
+// MinutesEQ applies the EQ predicate on the "minutes" field.
+func MinutesEQ(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMinutes), v))
+	})
+}
+
+// MinutesNEQ applies the NEQ predicate on the "minutes" field.
+func MinutesNEQ(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMinutes), v))
+	})
+}
+
+// MinutesIn applies the In predicate on the "minutes" field.
+func MinutesIn(vs ...int) predicate.PlayerPerformance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMinutes), v...))
+	})
+}
+
+// MinutesNotIn applies the NotIn predicate on the "minutes" field.
+func MinutesNotIn(vs ...int) predicate.PlayerPerformance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMinutes), v...))
+	})
+}
+
+// MinutesGT applies the GT predicate on the "minutes" field.
+func MinutesGT(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMinutes), v))
+	})
+}
+
+// MinutesGTE applies the GTE predicate on the "minutes" field.
+func MinutesGTE(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMinutes), v))
+	})
+}
+
+// MinutesLT applies the LT predicate on the "minutes" field.
+func MinutesLT(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMinutes), v))
+	})
+}
+
+// MinutesLTE applies the LTE predicate on the "minutes" field.
+func MinutesLTE(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMinutes), v))
+	})
+}
+
+// MinutesIsNil applies the IsNil predicate on the "minutes" field.
+func MinutesIsNil() predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldMinutes)))
+	})
+}
+
+// MinutesNotNil applies the NotNil predicate on the "minutes" field.
+func MinutesNotNil() predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldMinutes)))
+	})
+}
+
+// PointsEQ applies the EQ predicate on the "points" field.
+func PointsEQ(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPoints), v))
+	})
+}
+
+// PointsNEQ applies the NEQ predicate on the "points" field.
+func PointsNEQ(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPoints), v))
+	})
+}
+
+// PointsIn applies the In predicate on the "points" field.
+func PointsIn(vs ...int) predicate.PlayerPerformance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPoints), v...))
+	})
+}
+
+// PointsNotIn applies the NotIn predicate on the "points" field.
+func PointsNotIn(vs ...int) predicate.PlayerPerformance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPoints), v...))
+	})
+}
+
+// PointsGT applies the GT predicate on the "points" field.
+func PointsGT(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPoints), v))
+	})
+}
+
+// PointsGTE applies the GTE predicate on the "points" field.
+func PointsGTE(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPoints), v))
+	})
+}
+
+// PointsLT applies the LT predicate on the "points" field.
+func PointsLT(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPoints), v))
+	})
+}
+
+// PointsLTE applies the LTE predicate on the "points" field.
+func PointsLTE(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPoints), v))
+	})
+}
+
+// ReboundsEQ applies the EQ predicate on the "rebounds" field.
+func ReboundsEQ(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRebounds), v))
+	})
+}
+
+// ReboundsNEQ applies the NEQ predicate on the "rebounds" field.
+func ReboundsNEQ(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRebounds), v))
+	})
+}
+
+// ReboundsIn applies the In predicate on the "rebounds" field.
+func ReboundsIn(vs ...int) predicate.PlayerPerformance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRebounds), v...))
+	})
+}
+
+// ReboundsNotIn applies the NotIn predicate on the "rebounds" field.
+func ReboundsNotIn(vs ...int) predicate.PlayerPerformance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRebounds), v...))
+	})
+}
+
+// ReboundsGT applies the GT predicate on the "rebounds" field.
+func ReboundsGT(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRebounds), v))
+	})
+}
+
+// ReboundsGTE applies the GTE predicate on the "rebounds" field.
+func ReboundsGTE(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRebounds), v))
+	})
+}
+
+// ReboundsLT applies the LT predicate on the "rebounds" field.
+func ReboundsLT(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRebounds), v))
+	})
+}
+
+// ReboundsLTE applies the LTE predicate on the "rebounds" field.
+func ReboundsLTE(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRebounds), v))
+	})
+}
+
+// AssistsEQ applies the EQ predicate on the "assists" field.
+func AssistsEQ(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAssists), v))
+	})
+}
+
+// AssistsNEQ applies the NEQ predicate on the "assists" field.
+func AssistsNEQ(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAssists), v))
+	})
+}
+
+// AssistsIn applies the In predicate on the "assists" field.
+func AssistsIn(vs ...int) predicate.PlayerPerformance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAssists), v...))
+	})
+}
+
+// AssistsNotIn applies the NotIn predicate on the "assists" field.
+func AssistsNotIn(vs ...int) predicate.PlayerPerformance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAssists), v...))
+	})
+}
+
+// AssistsGT applies the GT predicate on the "assists" field.
+func AssistsGT(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAssists), v))
+	})
+}
+
+// AssistsGTE applies the GTE predicate on the "assists" field.
+func AssistsGTE(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAssists), v))
+	})
+}
+
+// AssistsLT applies the LT predicate on the "assists" field.
+func AssistsLT(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAssists), v))
+	})
+}
+
+// AssistsLTE applies the LTE predicate on the "assists" field.
+func AssistsLTE(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAssists), v))
+	})
+}
+
+// StealsEQ applies the EQ predicate on the "steals" field.
+func StealsEQ(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSteals), v))
+	})
+}
+
+// StealsNEQ applies the NEQ predicate on the "steals" field.
+func StealsNEQ(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSteals), v))
+	})
+}
+
+// StealsIn applies the In predicate on the "steals" field.
+func StealsIn(vs ...int) predicate.PlayerPerformance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSteals), v...))
+	})
+}
+
+// StealsNotIn applies the NotIn predicate on the "steals" field.
+func StealsNotIn(vs ...int) predicate.PlayerPerformance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSteals), v...))
+	})
+}
+
+// StealsGT applies the GT predicate on the "steals" field.
+func StealsGT(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSteals), v))
+	})
+}
+
+// StealsGTE applies the GTE predicate on the "steals" field.
+func StealsGTE(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSteals), v))
+	})
+}
+
+// StealsLT applies the LT predicate on the "steals" field.
+func StealsLT(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSteals), v))
+	})
+}
+
+// StealsLTE applies the LTE predicate on the "steals" field.
+func StealsLTE(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSteals), v))
+	})
+}
+
+// BlocksEQ applies the EQ predicate on the "blocks" field.
+func BlocksEQ(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBlocks), v))
+	})
+}
+
+// BlocksNEQ applies the NEQ predicate on the "blocks" field.
+func BlocksNEQ(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBlocks), v))
+	})
+}
+
+// BlocksIn applies the In predicate on the "blocks" field.
+func BlocksIn(vs ...int) predicate.PlayerPerformance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBlocks), v...))
+	})
+}
+
+// BlocksNotIn applies the NotIn predicate on the "blocks" field.
+func BlocksNotIn(vs ...int) predicate.PlayerPerformance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBlocks), v...))
+	})
+}
+
+// BlocksGT applies the GT predicate on the "blocks" field.
+func BlocksGT(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBlocks), v))
+	})
+}
+
+// BlocksGTE applies the GTE predicate on the "blocks" field.
+func BlocksGTE(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBlocks), v))
+	})
+}
+
+// BlocksLT applies the LT predicate on the "blocks" field.
+func BlocksLT(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBlocks), v))
+	})
+}
+
+// BlocksLTE applies the LTE predicate on the "blocks" field.
+func BlocksLTE(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBlocks), v))
+	})
+}
+
+// TurnoversEQ applies the EQ predicate on the "turnovers" field.
+func TurnoversEQ(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTurnovers), v))
+	})
+}
+
+// TurnoversNEQ applies the NEQ predicate on the "turnovers" field.
+func TurnoversNEQ(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTurnovers), v))
+	})
+}
+
+// TurnoversIn applies the In predicate on the "turnovers" field.
+func TurnoversIn(vs ...int) predicate.PlayerPerformance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTurnovers), v...))
+	})
+}
+
+// TurnoversNotIn applies the NotIn predicate on the "turnovers" field.
+func TurnoversNotIn(vs ...int) predicate.PlayerPerformance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTurnovers), v...))
+	})
+}
+
+// TurnoversGT applies the GT predicate on the "turnovers" field.
+func TurnoversGT(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTurnovers), v))
+	})
+}
+
+// TurnoversGTE applies the GTE predicate on the "turnovers" field.
+func TurnoversGTE(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTurnovers), v))
+	})
+}
+
+// TurnoversLT applies the LT predicate on the "turnovers" field.
+func TurnoversLT(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTurnovers), v))
+	})
+}
+
+// TurnoversLTE applies the LTE predicate on the "turnovers" field.
+func TurnoversLTE(v int) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTurnovers), v))
+	})
+}
+
+// HasPlayer applies the HasEdge predicate on the "player" edge.
+func HasPlayer() predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PlayerTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PlayerTable, PlayerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlayerWith applies the HasEdge predicate on the "player" edge with a given conditions (other predicates).
+func HasPlayerWith(preds ...predicate.Player) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PlayerInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PlayerTable, PlayerColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGame applies the HasEdge predicate on the "game" edge.
+func HasGame() predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(GameTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GameTable, GameColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGameWith applies the HasEdge predicate on the "game" edge with a given conditions (other predicates).
+func HasGameWith(preds ...predicate.Game) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(GameInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GameTable, GameColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTeam applies the HasEdge predicate on the "team" edge.
+func HasTeam() predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TeamTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TeamTable, TeamColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTeamWith applies the HasEdge predicate on the "team" edge with a given conditions (other predicates).
+func HasTeamWith(preds ...predicate.Team) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TeamInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TeamTable, TeamColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PlayerPerformance) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PlayerPerformance) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PlayerPerformance) predicate.PlayerPerformance {
+	return predicate.PlayerPerformance(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
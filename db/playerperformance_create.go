@@ -0,0 +1,474 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/game"
+	"github.com/NickDubelman/fantasy-bball/db/player"
+	"github.com/NickDubelman/fantasy-bball/db/playerperformance"
+	"github.com/NickDubelman/fantasy-bball/db/team"
+)
+
+// PlayerPerformanceCreate is the builder for creating a PlayerPerformance entity.
+type PlayerPerformanceCreate struct {
+	config
+	mutation *PlayerPerformanceMutation
+	hooks    []Hook
+}
+
+// SetMinutes sets the "minutes" field.
+func (ppc *PlayerPerformanceCreate) SetMinutes(i int) *PlayerPerformanceCreate {
+	ppc.mutation.SetMinutes(i)
+	return ppc
+}
+
+// SetNillableMinutes sets the "minutes" field if the given value is not nil.
+func (ppc *PlayerPerformanceCreate) SetNillableMinutes(i *int) *PlayerPerformanceCreate {
+	if i != nil {
+		ppc.SetMinutes(*i)
+	}
+	return ppc
+}
+
+// SetPoints sets the "points" field.
+func (ppc *PlayerPerformanceCreate) SetPoints(i int) *PlayerPerformanceCreate {
+	ppc.mutation.SetPoints(i)
+	return ppc
+}
+
+// SetNillablePoints sets the "points" field if the given value is not nil.
+func (ppc *PlayerPerformanceCreate) SetNillablePoints(i *int) *PlayerPerformanceCreate {
+	if i != nil {
+		ppc.SetPoints(*i)
+	}
+	return ppc
+}
+
+// SetRebounds sets the "rebounds" field.
+func (ppc *PlayerPerformanceCreate) SetRebounds(i int) *PlayerPerformanceCreate {
+	ppc.mutation.SetRebounds(i)
+	return ppc
+}
+
+// SetNillableRebounds sets the "rebounds" field if the given value is not nil.
+func (ppc *PlayerPerformanceCreate) SetNillableRebounds(i *int) *PlayerPerformanceCreate {
+	if i != nil {
+		ppc.SetRebounds(*i)
+	}
+	return ppc
+}
+
+// SetAssists sets the "assists" field.
+func (ppc *PlayerPerformanceCreate) SetAssists(i int) *PlayerPerformanceCreate {
+	ppc.mutation.SetAssists(i)
+	return ppc
+}
+
+// SetNillableAssists sets the "assists" field if the given value is not nil.
+func (ppc *PlayerPerformanceCreate) SetNillableAssists(i *int) *PlayerPerformanceCreate {
+	if i != nil {
+		ppc.SetAssists(*i)
+	}
+	return ppc
+}
+
+// SetSteals sets the "steals" field.
+func (ppc *PlayerPerformanceCreate) SetSteals(i int) *PlayerPerformanceCreate {
+	ppc.mutation.SetSteals(i)
+	return ppc
+}
+
+// SetNillableSteals sets the "steals" field if the given value is not nil.
+func (ppc *PlayerPerformanceCreate) SetNillableSteals(i *int) *PlayerPerformanceCreate {
+	if i != nil {
+		ppc.SetSteals(*i)
+	}
+	return ppc
+}
+
+// SetBlocks sets the "blocks" field.
+func (ppc *PlayerPerformanceCreate) SetBlocks(i int) *PlayerPerformanceCreate {
+	ppc.mutation.SetBlocks(i)
+	return ppc
+}
+
+// SetNillableBlocks sets the "blocks" field if the given value is not nil.
+func (ppc *PlayerPerformanceCreate) SetNillableBlocks(i *int) *PlayerPerformanceCreate {
+	if i != nil {
+		ppc.SetBlocks(*i)
+	}
+	return ppc
+}
+
+// SetTurnovers sets the "turnovers" field.
+func (ppc *PlayerPerformanceCreate) SetTurnovers(i int) *PlayerPerformanceCreate {
+	ppc.mutation.SetTurnovers(i)
+	return ppc
+}
+
+// SetNillableTurnovers sets the "turnovers" field if the given value is not nil.
+func (ppc *PlayerPerformanceCreate) SetNillableTurnovers(i *int) *PlayerPerformanceCreate {
+	if i != nil {
+		ppc.SetTurnovers(*i)
+	}
+	return ppc
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by ID.
+func (ppc *PlayerPerformanceCreate) SetPlayerID(id int) *PlayerPerformanceCreate {
+	ppc.mutation.SetPlayerID(id)
+	return ppc
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (ppc *PlayerPerformanceCreate) SetPlayer(p *Player) *PlayerPerformanceCreate {
+	return ppc.SetPlayerID(p.ID)
+}
+
+// SetGameID sets the "game" edge to the Game entity by ID.
+func (ppc *PlayerPerformanceCreate) SetGameID(id int) *PlayerPerformanceCreate {
+	ppc.mutation.SetGameID(id)
+	return ppc
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (ppc *PlayerPerformanceCreate) SetGame(g *Game) *PlayerPerformanceCreate {
+	return ppc.SetGameID(g.ID)
+}
+
+// SetTeamID sets the "team" edge to the Team entity by ID.
+func (ppc *PlayerPerformanceCreate) SetTeamID(id int) *PlayerPerformanceCreate {
+	ppc.mutation.SetTeamID(id)
+	return ppc
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (ppc *PlayerPerformanceCreate) SetTeam(t *Team) *PlayerPerformanceCreate {
+	return ppc.SetTeamID(t.ID)
+}
+
+// Mutation returns the PlayerPerformanceMutation object of the builder.
+func (ppc *PlayerPerformanceCreate) Mutation() *PlayerPerformanceMutation {
+	return ppc.mutation
+}
+
+// Save creates the PlayerPerformance in the database.
+func (ppc *PlayerPerformanceCreate) Save(ctx context.Context) (*PlayerPerformance, error) {
+	var (
+		err  error
+		node *PlayerPerformance
+	)
+	ppc.defaults()
+	if len(ppc.hooks) == 0 {
+		if err = ppc.check(); err != nil {
+			return nil, err
+		}
+		node, err = ppc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PlayerPerformanceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ppc.check(); err != nil {
+				return nil, err
+			}
+			ppc.mutation = mutation
+			node, err = ppc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ppc.hooks) - 1; i >= 0; i-- {
+			mut = ppc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ppc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ppc *PlayerPerformanceCreate) SaveX(ctx context.Context) *PlayerPerformance {
+	v, err := ppc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (ppc *PlayerPerformanceCreate) defaults() {
+	if _, ok := ppc.mutation.Points(); !ok {
+		v := playerperformance.DefaultPoints
+		ppc.mutation.SetPoints(v)
+	}
+	if _, ok := ppc.mutation.Rebounds(); !ok {
+		v := playerperformance.DefaultRebounds
+		ppc.mutation.SetRebounds(v)
+	}
+	if _, ok := ppc.mutation.Assists(); !ok {
+		v := playerperformance.DefaultAssists
+		ppc.mutation.SetAssists(v)
+	}
+	if _, ok := ppc.mutation.Steals(); !ok {
+		v := playerperformance.DefaultSteals
+		ppc.mutation.SetSteals(v)
+	}
+	if _, ok := ppc.mutation.Blocks(); !ok {
+		v := playerperformance.DefaultBlocks
+		ppc.mutation.SetBlocks(v)
+	}
+	if _, ok := ppc.mutation.Turnovers(); !ok {
+		v := playerperformance.DefaultTurnovers
+		ppc.mutation.SetTurnovers(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppc *PlayerPerformanceCreate) check() error {
+	if _, ok := ppc.mutation.Points(); !ok {
+		return &ValidationError{Name: "points", err: errors.New("db: missing required field \"points\"")}
+	}
+	if _, ok := ppc.mutation.Rebounds(); !ok {
+		return &ValidationError{Name: "rebounds", err: errors.New("db: missing required field \"rebounds\"")}
+	}
+	if _, ok := ppc.mutation.Assists(); !ok {
+		return &ValidationError{Name: "assists", err: errors.New("db: missing required field \"assists\"")}
+	}
+	if _, ok := ppc.mutation.Steals(); !ok {
+		return &ValidationError{Name: "steals", err: errors.New("db: missing required field \"steals\"")}
+	}
+	if _, ok := ppc.mutation.Blocks(); !ok {
+		return &ValidationError{Name: "blocks", err: errors.New("db: missing required field \"blocks\"")}
+	}
+	if _, ok := ppc.mutation.Turnovers(); !ok {
+		return &ValidationError{Name: "turnovers", err: errors.New("db: missing required field \"turnovers\"")}
+	}
+	if _, ok := ppc.mutation.PlayerID(); !ok {
+		return &ValidationError{Name: "player", err: errors.New("db: missing required edge \"player\"")}
+	}
+	if _, ok := ppc.mutation.GameID(); !ok {
+		return &ValidationError{Name: "game", err: errors.New("db: missing required edge \"game\"")}
+	}
+	if _, ok := ppc.mutation.TeamID(); !ok {
+		return &ValidationError{Name: "team", err: errors.New("db: missing required edge \"team\"")}
+	}
+	return nil
+}
+
+func (ppc *PlayerPerformanceCreate) sqlSave(ctx context.Context) (*PlayerPerformance, error) {
+	_node, _spec := ppc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ppc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (ppc *PlayerPerformanceCreate) createSpec() (*PlayerPerformance, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PlayerPerformance{config: ppc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: playerperformance.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: playerperformance.FieldID,
+			},
+		}
+	)
+	if value, ok := ppc.mutation.Minutes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldMinutes,
+		})
+		_node.Minutes = &value
+	}
+	if value, ok := ppc.mutation.Points(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldPoints,
+		})
+		_node.Points = value
+	}
+	if value, ok := ppc.mutation.Rebounds(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldRebounds,
+		})
+		_node.Rebounds = value
+	}
+	if value, ok := ppc.mutation.Assists(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldAssists,
+		})
+		_node.Assists = value
+	}
+	if value, ok := ppc.mutation.Steals(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldSteals,
+		})
+		_node.Steals = value
+	}
+	if value, ok := ppc.mutation.Blocks(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldBlocks,
+		})
+		_node.Blocks = value
+	}
+	if value, ok := ppc.mutation.Turnovers(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldTurnovers,
+		})
+		_node.Turnovers = value
+	}
+	if nodes := ppc.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playerperformance.PlayerTable,
+			Columns: []string{playerperformance.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.player_performances = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ppc.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playerperformance.GameTable,
+			Columns: []string{playerperformance.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.game_performances = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ppc.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playerperformance.TeamTable,
+			Columns: []string{playerperformance.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.team_performances = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PlayerPerformanceCreateBulk is the builder for creating many PlayerPerformance entities in bulk.
+type PlayerPerformanceCreateBulk struct {
+	config
+	builders []*PlayerPerformanceCreate
+}
+
+// Save creates the PlayerPerformance entities in the database.
+func (ppcb *PlayerPerformanceCreateBulk) Save(ctx context.Context) ([]*PlayerPerformance, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ppcb.builders))
+	nodes := make([]*PlayerPerformance, len(ppcb.builders))
+	mutators := make([]Mutator, len(ppcb.builders))
+	for i := range ppcb.builders {
+		func(i int, root context.Context) {
+			builder := ppcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlayerPerformanceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ppcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ppcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ppcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppcb *PlayerPerformanceCreateBulk) SaveX(ctx context.Context) []*PlayerPerformance {
+	v, err := ppcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
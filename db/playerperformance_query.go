@@ -0,0 +1,1095 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/game"
+	"github.com/NickDubelman/fantasy-bball/db/player"
+	"github.com/NickDubelman/fantasy-bball/db/playerperformance"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+	"github.com/NickDubelman/fantasy-bball/db/team"
+)
+
+// PlayerPerformanceQuery is the builder for querying PlayerPerformance entities.
+type PlayerPerformanceQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.PlayerPerformance
+	// eager-loading edges.
+	withPlayer *PlayerQuery
+	withGame   *GameQuery
+	withTeam   *TeamQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PlayerPerformanceQuery builder.
+func (ppq *PlayerPerformanceQuery) Where(ps ...predicate.PlayerPerformance) *PlayerPerformanceQuery {
+	ppq.predicates = append(ppq.predicates, ps...)
+	return ppq
+}
+
+// Limit adds a limit step to the query.
+func (ppq *PlayerPerformanceQuery) Limit(limit int) *PlayerPerformanceQuery {
+	ppq.limit = &limit
+	return ppq
+}
+
+// Offset adds an offset step to the query.
+func (ppq *PlayerPerformanceQuery) Offset(offset int) *PlayerPerformanceQuery {
+	ppq.offset = &offset
+	return ppq
+}
+
+// Order adds an order step to the query.
+func (ppq *PlayerPerformanceQuery) Order(o ...OrderFunc) *PlayerPerformanceQuery {
+	ppq.order = append(ppq.order, o...)
+	return ppq
+}
+
+// QueryPlayer chains the current query on the "player" edge.
+func (ppq *PlayerPerformanceQuery) QueryPlayer() *PlayerQuery {
+	query := &PlayerQuery{config: ppq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ppq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ppq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playerperformance.Table, playerperformance.FieldID, selector),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, playerperformance.PlayerTable, playerperformance.PlayerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ppq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGame chains the current query on the "game" edge.
+func (ppq *PlayerPerformanceQuery) QueryGame() *GameQuery {
+	query := &GameQuery{config: ppq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ppq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ppq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playerperformance.Table, playerperformance.FieldID, selector),
+			sqlgraph.To(game.Table, game.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, playerperformance.GameTable, playerperformance.GameColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ppq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTeam chains the current query on the "team" edge.
+func (ppq *PlayerPerformanceQuery) QueryTeam() *TeamQuery {
+	query := &TeamQuery{config: ppq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ppq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ppq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playerperformance.Table, playerperformance.FieldID, selector),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, playerperformance.TeamTable, playerperformance.TeamColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ppq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PlayerPerformance entity from the query.
+// Returns a *NotFoundError when no PlayerPerformance was found.
+func (ppq *PlayerPerformanceQuery) First(ctx context.Context) (*PlayerPerformance, error) {
+	nodes, err := ppq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{playerperformance.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ppq *PlayerPerformanceQuery) FirstX(ctx context.Context) *PlayerPerformance {
+	node, err := ppq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PlayerPerformance ID from the query.
+// Returns a *NotFoundError when no PlayerPerformance ID was found.
+func (ppq *PlayerPerformanceQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ppq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{playerperformance.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ppq *PlayerPerformanceQuery) FirstIDX(ctx context.Context) int {
+	id, err := ppq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PlayerPerformance entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one PlayerPerformance entity is not found.
+// Returns a *NotFoundError when no PlayerPerformance entities are found.
+func (ppq *PlayerPerformanceQuery) Only(ctx context.Context) (*PlayerPerformance, error) {
+	nodes, err := ppq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{playerperformance.Label}
+	default:
+		return nil, &NotSingularError{playerperformance.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ppq *PlayerPerformanceQuery) OnlyX(ctx context.Context) *PlayerPerformance {
+	node, err := ppq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PlayerPerformance ID in the query.
+// Returns a *NotSingularError when exactly one PlayerPerformance ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (ppq *PlayerPerformanceQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ppq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{playerperformance.Label}
+	default:
+		err = &NotSingularError{playerperformance.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ppq *PlayerPerformanceQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ppq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PlayerPerformances.
+func (ppq *PlayerPerformanceQuery) All(ctx context.Context) ([]*PlayerPerformance, error) {
+	if err := ppq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return ppq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ppq *PlayerPerformanceQuery) AllX(ctx context.Context) []*PlayerPerformance {
+	nodes, err := ppq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PlayerPerformance IDs.
+func (ppq *PlayerPerformanceQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := ppq.Select(playerperformance.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ppq *PlayerPerformanceQuery) IDsX(ctx context.Context) []int {
+	ids, err := ppq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ppq *PlayerPerformanceQuery) Count(ctx context.Context) (int, error) {
+	if err := ppq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return ppq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ppq *PlayerPerformanceQuery) CountX(ctx context.Context) int {
+	count, err := ppq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ppq *PlayerPerformanceQuery) Exist(ctx context.Context) (bool, error) {
+	if err := ppq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return ppq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ppq *PlayerPerformanceQuery) ExistX(ctx context.Context) bool {
+	exist, err := ppq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PlayerPerformanceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ppq *PlayerPerformanceQuery) Clone() *PlayerPerformanceQuery {
+	if ppq == nil {
+		return nil
+	}
+	return &PlayerPerformanceQuery{
+		config:     ppq.config,
+		limit:      ppq.limit,
+		offset:     ppq.offset,
+		order:      append([]OrderFunc{}, ppq.order...),
+		predicates: append([]predicate.PlayerPerformance{}, ppq.predicates...),
+		withPlayer: ppq.withPlayer.Clone(),
+		withGame:   ppq.withGame.Clone(),
+		withTeam:   ppq.withTeam.Clone(),
+		// clone intermediate query.
+		sql:  ppq.sql.Clone(),
+		path: ppq.path,
+	}
+}
+
+// WithPlayer tells the query-builder to eager-load the nodes that are connected to
+// the "player" edge. The optional arguments are used to configure the query builder of the edge.
+func (ppq *PlayerPerformanceQuery) WithPlayer(opts ...func(*PlayerQuery)) *PlayerPerformanceQuery {
+	query := &PlayerQuery{config: ppq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	ppq.withPlayer = query
+	return ppq
+}
+
+// WithGame tells the query-builder to eager-load the nodes that are connected to
+// the "game" edge. The optional arguments are used to configure the query builder of the edge.
+func (ppq *PlayerPerformanceQuery) WithGame(opts ...func(*GameQuery)) *PlayerPerformanceQuery {
+	query := &GameQuery{config: ppq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	ppq.withGame = query
+	return ppq
+}
+
+// WithTeam tells the query-builder to eager-load the nodes that are connected to
+// the "team" edge. The optional arguments are used to configure the query builder of the edge.
+func (ppq *PlayerPerformanceQuery) WithTeam(opts ...func(*TeamQuery)) *PlayerPerformanceQuery {
+	query := &TeamQuery{config: ppq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	ppq.withTeam = query
+	return ppq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Minutes int `json:"minutes,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PlayerPerformance.Query().
+//		GroupBy(playerperformance.FieldMinutes).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (ppq *PlayerPerformanceQuery) GroupBy(field string, fields ...string) *PlayerPerformanceGroupBy {
+	group := &PlayerPerformanceGroupBy{config: ppq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ppq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ppq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Minutes int `json:"minutes,omitempty"`
+//	}
+//
+//	client.PlayerPerformance.Query().
+//		Select(playerperformance.FieldMinutes).
+//		Scan(ctx, &v)
+func (ppq *PlayerPerformanceQuery) Select(field string, fields ...string) *PlayerPerformanceSelect {
+	ppq.fields = append([]string{field}, fields...)
+	return &PlayerPerformanceSelect{PlayerPerformanceQuery: ppq}
+}
+
+func (ppq *PlayerPerformanceQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range ppq.fields {
+		if !playerperformance.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if ppq.path != nil {
+		prev, err := ppq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ppq.sql = prev
+	}
+	return nil
+}
+
+func (ppq *PlayerPerformanceQuery) sqlAll(ctx context.Context) ([]*PlayerPerformance, error) {
+	var (
+		nodes       = []*PlayerPerformance{}
+		withFKs     = ppq.withFKs
+		_spec       = ppq.querySpec()
+		loadedTypes = [3]bool{
+			ppq.withPlayer != nil,
+			ppq.withGame != nil,
+			ppq.withTeam != nil,
+		}
+	)
+	if ppq.withPlayer != nil || ppq.withGame != nil || ppq.withTeam != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, playerperformance.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &PlayerPerformance{config: ppq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("db: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ppq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := ppq.withPlayer; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*PlayerPerformance)
+		for i := range nodes {
+			fk := nodes[i].player_performances
+			if fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(player.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "player_performances" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Player = n
+			}
+		}
+	}
+
+	if query := ppq.withGame; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*PlayerPerformance)
+		for i := range nodes {
+			fk := nodes[i].game_performances
+			if fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(game.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "game_performances" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Game = n
+			}
+		}
+	}
+
+	if query := ppq.withTeam; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*PlayerPerformance)
+		for i := range nodes {
+			fk := nodes[i].team_performances
+			if fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(team.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "team_performances" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Team = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (ppq *PlayerPerformanceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ppq.querySpec()
+	return sqlgraph.CountNodes(ctx, ppq.driver, _spec)
+}
+
+func (ppq *PlayerPerformanceQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := ppq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("db: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (ppq *PlayerPerformanceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   playerperformance.Table,
+			Columns: playerperformance.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: playerperformance.FieldID,
+			},
+		},
+		From:   ppq.sql,
+		Unique: true,
+	}
+	if fields := ppq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, playerperformance.FieldID)
+		for i := range fields {
+			if fields[i] != playerperformance.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ppq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ppq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ppq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ppq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, playerperformance.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ppq *PlayerPerformanceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ppq.driver.Dialect())
+	t1 := builder.Table(playerperformance.Table)
+	selector := builder.Select(t1.Columns(playerperformance.Columns...)...).From(t1)
+	if ppq.sql != nil {
+		selector = ppq.sql
+		selector.Select(selector.Columns(playerperformance.Columns...)...)
+	}
+	for _, p := range ppq.predicates {
+		p(selector)
+	}
+	for _, p := range ppq.order {
+		p(selector, playerperformance.ValidColumn)
+	}
+	if offset := ppq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ppq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PlayerPerformanceGroupBy is the group-by builder for PlayerPerformance entities.
+type PlayerPerformanceGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ppgb *PlayerPerformanceGroupBy) Aggregate(fns ...AggregateFunc) *PlayerPerformanceGroupBy {
+	ppgb.fns = append(ppgb.fns, fns...)
+	return ppgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (ppgb *PlayerPerformanceGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ppgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ppgb.sql = query
+	return ppgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ppgb *PlayerPerformanceGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := ppgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ppgb *PlayerPerformanceGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(ppgb.fields) > 1 {
+		return nil, errors.New("db: PlayerPerformanceGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := ppgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ppgb *PlayerPerformanceGroupBy) StringsX(ctx context.Context) []string {
+	v, err := ppgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ppgb *PlayerPerformanceGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ppgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{playerperformance.Label}
+	default:
+		err = fmt.Errorf("db: PlayerPerformanceGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ppgb *PlayerPerformanceGroupBy) StringX(ctx context.Context) string {
+	v, err := ppgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ppgb *PlayerPerformanceGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(ppgb.fields) > 1 {
+		return nil, errors.New("db: PlayerPerformanceGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := ppgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ppgb *PlayerPerformanceGroupBy) IntsX(ctx context.Context) []int {
+	v, err := ppgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ppgb *PlayerPerformanceGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ppgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{playerperformance.Label}
+	default:
+		err = fmt.Errorf("db: PlayerPerformanceGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ppgb *PlayerPerformanceGroupBy) IntX(ctx context.Context) int {
+	v, err := ppgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ppgb *PlayerPerformanceGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ppgb.fields) > 1 {
+		return nil, errors.New("db: PlayerPerformanceGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := ppgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ppgb *PlayerPerformanceGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := ppgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ppgb *PlayerPerformanceGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ppgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{playerperformance.Label}
+	default:
+		err = fmt.Errorf("db: PlayerPerformanceGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ppgb *PlayerPerformanceGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := ppgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ppgb *PlayerPerformanceGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(ppgb.fields) > 1 {
+		return nil, errors.New("db: PlayerPerformanceGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := ppgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ppgb *PlayerPerformanceGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := ppgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ppgb *PlayerPerformanceGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ppgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{playerperformance.Label}
+	default:
+		err = fmt.Errorf("db: PlayerPerformanceGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ppgb *PlayerPerformanceGroupBy) BoolX(ctx context.Context) bool {
+	v, err := ppgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ppgb *PlayerPerformanceGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range ppgb.fields {
+		if !playerperformance.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := ppgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ppgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ppgb *PlayerPerformanceGroupBy) sqlQuery() *sql.Selector {
+	selector := ppgb.sql
+	columns := make([]string, 0, len(ppgb.fields)+len(ppgb.fns))
+	columns = append(columns, ppgb.fields...)
+	for _, fn := range ppgb.fns {
+		columns = append(columns, fn(selector, playerperformance.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(ppgb.fields...)
+}
+
+// PlayerPerformanceSelect is the builder for selecting fields of PlayerPerformance entities.
+type PlayerPerformanceSelect struct {
+	*PlayerPerformanceQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pps *PlayerPerformanceSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := pps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	pps.sql = pps.PlayerPerformanceQuery.sqlQuery(ctx)
+	return pps.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (pps *PlayerPerformanceSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := pps.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (pps *PlayerPerformanceSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(pps.fields) > 1 {
+		return nil, errors.New("db: PlayerPerformanceSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := pps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (pps *PlayerPerformanceSelect) StringsX(ctx context.Context) []string {
+	v, err := pps.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (pps *PlayerPerformanceSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = pps.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{playerperformance.Label}
+	default:
+		err = fmt.Errorf("db: PlayerPerformanceSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (pps *PlayerPerformanceSelect) StringX(ctx context.Context) string {
+	v, err := pps.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (pps *PlayerPerformanceSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(pps.fields) > 1 {
+		return nil, errors.New("db: PlayerPerformanceSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := pps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (pps *PlayerPerformanceSelect) IntsX(ctx context.Context) []int {
+	v, err := pps.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (pps *PlayerPerformanceSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = pps.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{playerperformance.Label}
+	default:
+		err = fmt.Errorf("db: PlayerPerformanceSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (pps *PlayerPerformanceSelect) IntX(ctx context.Context) int {
+	v, err := pps.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (pps *PlayerPerformanceSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(pps.fields) > 1 {
+		return nil, errors.New("db: PlayerPerformanceSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := pps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (pps *PlayerPerformanceSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := pps.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (pps *PlayerPerformanceSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = pps.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{playerperformance.Label}
+	default:
+		err = fmt.Errorf("db: PlayerPerformanceSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (pps *PlayerPerformanceSelect) Float64X(ctx context.Context) float64 {
+	v, err := pps.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (pps *PlayerPerformanceSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(pps.fields) > 1 {
+		return nil, errors.New("db: PlayerPerformanceSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := pps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (pps *PlayerPerformanceSelect) BoolsX(ctx context.Context) []bool {
+	v, err := pps.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (pps *PlayerPerformanceSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = pps.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{playerperformance.Label}
+	default:
+		err = fmt.Errorf("db: PlayerPerformanceSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (pps *PlayerPerformanceSelect) BoolX(ctx context.Context) bool {
+	v, err := pps.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (pps *PlayerPerformanceSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := pps.sqlQuery().Query()
+	if err := pps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (pps *PlayerPerformanceSelect) sqlQuery() sql.Querier {
+	selector := pps.sql
+	selector.Select(selector.Columns(pps.fields...)...)
+	return selector
+}
@@ -0,0 +1,1082 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/game"
+	"github.com/NickDubelman/fantasy-bball/db/player"
+	"github.com/NickDubelman/fantasy-bball/db/playerperformance"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+	"github.com/NickDubelman/fantasy-bball/db/team"
+)
+
+// PlayerPerformanceUpdate is the builder for updating PlayerPerformance entities.
+type PlayerPerformanceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlayerPerformanceMutation
+}
+
+// Where adds a new predicate for the PlayerPerformanceUpdate builder.
+func (ppu *PlayerPerformanceUpdate) Where(ps ...predicate.PlayerPerformance) *PlayerPerformanceUpdate {
+	ppu.mutation.predicates = append(ppu.mutation.predicates, ps...)
+	return ppu
+}
+
+// SetMinutes sets the "minutes" field.
+func (ppu *PlayerPerformanceUpdate) SetMinutes(i int) *PlayerPerformanceUpdate {
+	ppu.mutation.ResetMinutes()
+	ppu.mutation.SetMinutes(i)
+	return ppu
+}
+
+// SetNillableMinutes sets the "minutes" field if the given value is not nil.
+func (ppu *PlayerPerformanceUpdate) SetNillableMinutes(i *int) *PlayerPerformanceUpdate {
+	if i != nil {
+		ppu.SetMinutes(*i)
+	}
+	return ppu
+}
+
+// AddMinutes adds i to the "minutes" field.
+func (ppu *PlayerPerformanceUpdate) AddMinutes(i int) *PlayerPerformanceUpdate {
+	ppu.mutation.AddMinutes(i)
+	return ppu
+}
+
+// ClearMinutes clears the value of the "minutes" field.
+func (ppu *PlayerPerformanceUpdate) ClearMinutes() *PlayerPerformanceUpdate {
+	ppu.mutation.ClearMinutes()
+	return ppu
+}
+
+// SetPoints sets the "points" field.
+func (ppu *PlayerPerformanceUpdate) SetPoints(i int) *PlayerPerformanceUpdate {
+	ppu.mutation.ResetPoints()
+	ppu.mutation.SetPoints(i)
+	return ppu
+}
+
+// SetNillablePoints sets the "points" field if the given value is not nil.
+func (ppu *PlayerPerformanceUpdate) SetNillablePoints(i *int) *PlayerPerformanceUpdate {
+	if i != nil {
+		ppu.SetPoints(*i)
+	}
+	return ppu
+}
+
+// AddPoints adds i to the "points" field.
+func (ppu *PlayerPerformanceUpdate) AddPoints(i int) *PlayerPerformanceUpdate {
+	ppu.mutation.AddPoints(i)
+	return ppu
+}
+
+// SetRebounds sets the "rebounds" field.
+func (ppu *PlayerPerformanceUpdate) SetRebounds(i int) *PlayerPerformanceUpdate {
+	ppu.mutation.ResetRebounds()
+	ppu.mutation.SetRebounds(i)
+	return ppu
+}
+
+// SetNillableRebounds sets the "rebounds" field if the given value is not nil.
+func (ppu *PlayerPerformanceUpdate) SetNillableRebounds(i *int) *PlayerPerformanceUpdate {
+	if i != nil {
+		ppu.SetRebounds(*i)
+	}
+	return ppu
+}
+
+// AddRebounds adds i to the "rebounds" field.
+func (ppu *PlayerPerformanceUpdate) AddRebounds(i int) *PlayerPerformanceUpdate {
+	ppu.mutation.AddRebounds(i)
+	return ppu
+}
+
+// SetAssists sets the "assists" field.
+func (ppu *PlayerPerformanceUpdate) SetAssists(i int) *PlayerPerformanceUpdate {
+	ppu.mutation.ResetAssists()
+	ppu.mutation.SetAssists(i)
+	return ppu
+}
+
+// SetNillableAssists sets the "assists" field if the given value is not nil.
+func (ppu *PlayerPerformanceUpdate) SetNillableAssists(i *int) *PlayerPerformanceUpdate {
+	if i != nil {
+		ppu.SetAssists(*i)
+	}
+	return ppu
+}
+
+// AddAssists adds i to the "assists" field.
+func (ppu *PlayerPerformanceUpdate) AddAssists(i int) *PlayerPerformanceUpdate {
+	ppu.mutation.AddAssists(i)
+	return ppu
+}
+
+// SetSteals sets the "steals" field.
+func (ppu *PlayerPerformanceUpdate) SetSteals(i int) *PlayerPerformanceUpdate {
+	ppu.mutation.ResetSteals()
+	ppu.mutation.SetSteals(i)
+	return ppu
+}
+
+// SetNillableSteals sets the "steals" field if the given value is not nil.
+func (ppu *PlayerPerformanceUpdate) SetNillableSteals(i *int) *PlayerPerformanceUpdate {
+	if i != nil {
+		ppu.SetSteals(*i)
+	}
+	return ppu
+}
+
+// AddSteals adds i to the "steals" field.
+func (ppu *PlayerPerformanceUpdate) AddSteals(i int) *PlayerPerformanceUpdate {
+	ppu.mutation.AddSteals(i)
+	return ppu
+}
+
+// SetBlocks sets the "blocks" field.
+func (ppu *PlayerPerformanceUpdate) SetBlocks(i int) *PlayerPerformanceUpdate {
+	ppu.mutation.ResetBlocks()
+	ppu.mutation.SetBlocks(i)
+	return ppu
+}
+
+// SetNillableBlocks sets the "blocks" field if the given value is not nil.
+func (ppu *PlayerPerformanceUpdate) SetNillableBlocks(i *int) *PlayerPerformanceUpdate {
+	if i != nil {
+		ppu.SetBlocks(*i)
+	}
+	return ppu
+}
+
+// AddBlocks adds i to the "blocks" field.
+func (ppu *PlayerPerformanceUpdate) AddBlocks(i int) *PlayerPerformanceUpdate {
+	ppu.mutation.AddBlocks(i)
+	return ppu
+}
+
+// SetTurnovers sets the "turnovers" field.
+func (ppu *PlayerPerformanceUpdate) SetTurnovers(i int) *PlayerPerformanceUpdate {
+	ppu.mutation.ResetTurnovers()
+	ppu.mutation.SetTurnovers(i)
+	return ppu
+}
+
+// SetNillableTurnovers sets the "turnovers" field if the given value is not nil.
+func (ppu *PlayerPerformanceUpdate) SetNillableTurnovers(i *int) *PlayerPerformanceUpdate {
+	if i != nil {
+		ppu.SetTurnovers(*i)
+	}
+	return ppu
+}
+
+// AddTurnovers adds i to the "turnovers" field.
+func (ppu *PlayerPerformanceUpdate) AddTurnovers(i int) *PlayerPerformanceUpdate {
+	ppu.mutation.AddTurnovers(i)
+	return ppu
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by ID.
+func (ppu *PlayerPerformanceUpdate) SetPlayerID(id int) *PlayerPerformanceUpdate {
+	ppu.mutation.SetPlayerID(id)
+	return ppu
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (ppu *PlayerPerformanceUpdate) SetPlayer(p *Player) *PlayerPerformanceUpdate {
+	return ppu.SetPlayerID(p.ID)
+}
+
+// SetGameID sets the "game" edge to the Game entity by ID.
+func (ppu *PlayerPerformanceUpdate) SetGameID(id int) *PlayerPerformanceUpdate {
+	ppu.mutation.SetGameID(id)
+	return ppu
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (ppu *PlayerPerformanceUpdate) SetGame(g *Game) *PlayerPerformanceUpdate {
+	return ppu.SetGameID(g.ID)
+}
+
+// SetTeamID sets the "team" edge to the Team entity by ID.
+func (ppu *PlayerPerformanceUpdate) SetTeamID(id int) *PlayerPerformanceUpdate {
+	ppu.mutation.SetTeamID(id)
+	return ppu
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (ppu *PlayerPerformanceUpdate) SetTeam(t *Team) *PlayerPerformanceUpdate {
+	return ppu.SetTeamID(t.ID)
+}
+
+// Mutation returns the PlayerPerformanceMutation object of the builder.
+func (ppu *PlayerPerformanceUpdate) Mutation() *PlayerPerformanceMutation {
+	return ppu.mutation
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (ppu *PlayerPerformanceUpdate) ClearPlayer() *PlayerPerformanceUpdate {
+	ppu.mutation.ClearPlayer()
+	return ppu
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (ppu *PlayerPerformanceUpdate) ClearGame() *PlayerPerformanceUpdate {
+	ppu.mutation.ClearGame()
+	return ppu
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (ppu *PlayerPerformanceUpdate) ClearTeam() *PlayerPerformanceUpdate {
+	ppu.mutation.ClearTeam()
+	return ppu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ppu *PlayerPerformanceUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ppu.hooks) == 0 {
+		if err = ppu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = ppu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PlayerPerformanceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ppu.check(); err != nil {
+				return 0, err
+			}
+			ppu.mutation = mutation
+			affected, err = ppu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ppu.hooks) - 1; i >= 0; i-- {
+			mut = ppu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ppu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppu *PlayerPerformanceUpdate) SaveX(ctx context.Context) int {
+	affected, err := ppu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ppu *PlayerPerformanceUpdate) Exec(ctx context.Context) error {
+	_, err := ppu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppu *PlayerPerformanceUpdate) ExecX(ctx context.Context) {
+	if err := ppu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppu *PlayerPerformanceUpdate) check() error {
+	if _, ok := ppu.mutation.PlayerID(); ppu.mutation.PlayerCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"player\"")
+	}
+	if _, ok := ppu.mutation.GameID(); ppu.mutation.GameCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"game\"")
+	}
+	if _, ok := ppu.mutation.TeamID(); ppu.mutation.TeamCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"team\"")
+	}
+	return nil
+}
+
+func (ppu *PlayerPerformanceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   playerperformance.Table,
+			Columns: playerperformance.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: playerperformance.FieldID,
+			},
+		},
+	}
+	if ps := ppu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ppu.mutation.Minutes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldMinutes,
+		})
+	}
+	if value, ok := ppu.mutation.AddedMinutes(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldMinutes,
+		})
+	}
+	if ppu.mutation.MinutesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: playerperformance.FieldMinutes,
+		})
+	}
+	if value, ok := ppu.mutation.Points(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldPoints,
+		})
+	}
+	if value, ok := ppu.mutation.AddedPoints(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldPoints,
+		})
+	}
+	if value, ok := ppu.mutation.Rebounds(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldRebounds,
+		})
+	}
+	if value, ok := ppu.mutation.AddedRebounds(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldRebounds,
+		})
+	}
+	if value, ok := ppu.mutation.Assists(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldAssists,
+		})
+	}
+	if value, ok := ppu.mutation.AddedAssists(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldAssists,
+		})
+	}
+	if value, ok := ppu.mutation.Steals(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldSteals,
+		})
+	}
+	if value, ok := ppu.mutation.AddedSteals(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldSteals,
+		})
+	}
+	if value, ok := ppu.mutation.Blocks(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldBlocks,
+		})
+	}
+	if value, ok := ppu.mutation.AddedBlocks(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldBlocks,
+		})
+	}
+	if value, ok := ppu.mutation.Turnovers(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldTurnovers,
+		})
+	}
+	if value, ok := ppu.mutation.AddedTurnovers(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldTurnovers,
+		})
+	}
+	if ppu.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playerperformance.PlayerTable,
+			Columns: []string{playerperformance.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playerperformance.PlayerTable,
+			Columns: []string{playerperformance.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ppu.mutation.GameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playerperformance.GameTable,
+			Columns: []string{playerperformance.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playerperformance.GameTable,
+			Columns: []string{playerperformance.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ppu.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playerperformance.TeamTable,
+			Columns: []string{playerperformance.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playerperformance.TeamTable,
+			Columns: []string{playerperformance.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ppu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playerperformance.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// PlayerPerformanceUpdateOne is the builder for updating a single PlayerPerformance entity.
+type PlayerPerformanceUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *PlayerPerformanceMutation
+}
+
+// SetMinutes sets the "minutes" field.
+func (ppuo *PlayerPerformanceUpdateOne) SetMinutes(i int) *PlayerPerformanceUpdateOne {
+	ppuo.mutation.ResetMinutes()
+	ppuo.mutation.SetMinutes(i)
+	return ppuo
+}
+
+// SetNillableMinutes sets the "minutes" field if the given value is not nil.
+func (ppuo *PlayerPerformanceUpdateOne) SetNillableMinutes(i *int) *PlayerPerformanceUpdateOne {
+	if i != nil {
+		ppuo.SetMinutes(*i)
+	}
+	return ppuo
+}
+
+// AddMinutes adds i to the "minutes" field.
+func (ppuo *PlayerPerformanceUpdateOne) AddMinutes(i int) *PlayerPerformanceUpdateOne {
+	ppuo.mutation.AddMinutes(i)
+	return ppuo
+}
+
+// ClearMinutes clears the value of the "minutes" field.
+func (ppuo *PlayerPerformanceUpdateOne) ClearMinutes() *PlayerPerformanceUpdateOne {
+	ppuo.mutation.ClearMinutes()
+	return ppuo
+}
+
+// SetPoints sets the "points" field.
+func (ppuo *PlayerPerformanceUpdateOne) SetPoints(i int) *PlayerPerformanceUpdateOne {
+	ppuo.mutation.ResetPoints()
+	ppuo.mutation.SetPoints(i)
+	return ppuo
+}
+
+// SetNillablePoints sets the "points" field if the given value is not nil.
+func (ppuo *PlayerPerformanceUpdateOne) SetNillablePoints(i *int) *PlayerPerformanceUpdateOne {
+	if i != nil {
+		ppuo.SetPoints(*i)
+	}
+	return ppuo
+}
+
+// AddPoints adds i to the "points" field.
+func (ppuo *PlayerPerformanceUpdateOne) AddPoints(i int) *PlayerPerformanceUpdateOne {
+	ppuo.mutation.AddPoints(i)
+	return ppuo
+}
+
+// SetRebounds sets the "rebounds" field.
+func (ppuo *PlayerPerformanceUpdateOne) SetRebounds(i int) *PlayerPerformanceUpdateOne {
+	ppuo.mutation.ResetRebounds()
+	ppuo.mutation.SetRebounds(i)
+	return ppuo
+}
+
+// SetNillableRebounds sets the "rebounds" field if the given value is not nil.
+func (ppuo *PlayerPerformanceUpdateOne) SetNillableRebounds(i *int) *PlayerPerformanceUpdateOne {
+	if i != nil {
+		ppuo.SetRebounds(*i)
+	}
+	return ppuo
+}
+
+// AddRebounds adds i to the "rebounds" field.
+func (ppuo *PlayerPerformanceUpdateOne) AddRebounds(i int) *PlayerPerformanceUpdateOne {
+	ppuo.mutation.AddRebounds(i)
+	return ppuo
+}
+
+// SetAssists sets the "assists" field.
+func (ppuo *PlayerPerformanceUpdateOne) SetAssists(i int) *PlayerPerformanceUpdateOne {
+	ppuo.mutation.ResetAssists()
+	ppuo.mutation.SetAssists(i)
+	return ppuo
+}
+
+// SetNillableAssists sets the "assists" field if the given value is not nil.
+func (ppuo *PlayerPerformanceUpdateOne) SetNillableAssists(i *int) *PlayerPerformanceUpdateOne {
+	if i != nil {
+		ppuo.SetAssists(*i)
+	}
+	return ppuo
+}
+
+// AddAssists adds i to the "assists" field.
+func (ppuo *PlayerPerformanceUpdateOne) AddAssists(i int) *PlayerPerformanceUpdateOne {
+	ppuo.mutation.AddAssists(i)
+	return ppuo
+}
+
+// SetSteals sets the "steals" field.
+func (ppuo *PlayerPerformanceUpdateOne) SetSteals(i int) *PlayerPerformanceUpdateOne {
+	ppuo.mutation.ResetSteals()
+	ppuo.mutation.SetSteals(i)
+	return ppuo
+}
+
+// SetNillableSteals sets the "steals" field if the given value is not nil.
+func (ppuo *PlayerPerformanceUpdateOne) SetNillableSteals(i *int) *PlayerPerformanceUpdateOne {
+	if i != nil {
+		ppuo.SetSteals(*i)
+	}
+	return ppuo
+}
+
+// AddSteals adds i to the "steals" field.
+func (ppuo *PlayerPerformanceUpdateOne) AddSteals(i int) *PlayerPerformanceUpdateOne {
+	ppuo.mutation.AddSteals(i)
+	return ppuo
+}
+
+// SetBlocks sets the "blocks" field.
+func (ppuo *PlayerPerformanceUpdateOne) SetBlocks(i int) *PlayerPerformanceUpdateOne {
+	ppuo.mutation.ResetBlocks()
+	ppuo.mutation.SetBlocks(i)
+	return ppuo
+}
+
+// SetNillableBlocks sets the "blocks" field if the given value is not nil.
+func (ppuo *PlayerPerformanceUpdateOne) SetNillableBlocks(i *int) *PlayerPerformanceUpdateOne {
+	if i != nil {
+		ppuo.SetBlocks(*i)
+	}
+	return ppuo
+}
+
+// AddBlocks adds i to the "blocks" field.
+func (ppuo *PlayerPerformanceUpdateOne) AddBlocks(i int) *PlayerPerformanceUpdateOne {
+	ppuo.mutation.AddBlocks(i)
+	return ppuo
+}
+
+// SetTurnovers sets the "turnovers" field.
+func (ppuo *PlayerPerformanceUpdateOne) SetTurnovers(i int) *PlayerPerformanceUpdateOne {
+	ppuo.mutation.ResetTurnovers()
+	ppuo.mutation.SetTurnovers(i)
+	return ppuo
+}
+
+// SetNillableTurnovers sets the "turnovers" field if the given value is not nil.
+func (ppuo *PlayerPerformanceUpdateOne) SetNillableTurnovers(i *int) *PlayerPerformanceUpdateOne {
+	if i != nil {
+		ppuo.SetTurnovers(*i)
+	}
+	return ppuo
+}
+
+// AddTurnovers adds i to the "turnovers" field.
+func (ppuo *PlayerPerformanceUpdateOne) AddTurnovers(i int) *PlayerPerformanceUpdateOne {
+	ppuo.mutation.AddTurnovers(i)
+	return ppuo
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by ID.
+func (ppuo *PlayerPerformanceUpdateOne) SetPlayerID(id int) *PlayerPerformanceUpdateOne {
+	ppuo.mutation.SetPlayerID(id)
+	return ppuo
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (ppuo *PlayerPerformanceUpdateOne) SetPlayer(p *Player) *PlayerPerformanceUpdateOne {
+	return ppuo.SetPlayerID(p.ID)
+}
+
+// SetGameID sets the "game" edge to the Game entity by ID.
+func (ppuo *PlayerPerformanceUpdateOne) SetGameID(id int) *PlayerPerformanceUpdateOne {
+	ppuo.mutation.SetGameID(id)
+	return ppuo
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (ppuo *PlayerPerformanceUpdateOne) SetGame(g *Game) *PlayerPerformanceUpdateOne {
+	return ppuo.SetGameID(g.ID)
+}
+
+// SetTeamID sets the "team" edge to the Team entity by ID.
+func (ppuo *PlayerPerformanceUpdateOne) SetTeamID(id int) *PlayerPerformanceUpdateOne {
+	ppuo.mutation.SetTeamID(id)
+	return ppuo
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (ppuo *PlayerPerformanceUpdateOne) SetTeam(t *Team) *PlayerPerformanceUpdateOne {
+	return ppuo.SetTeamID(t.ID)
+}
+
+// Mutation returns the PlayerPerformanceMutation object of the builder.
+func (ppuo *PlayerPerformanceUpdateOne) Mutation() *PlayerPerformanceMutation {
+	return ppuo.mutation
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (ppuo *PlayerPerformanceUpdateOne) ClearPlayer() *PlayerPerformanceUpdateOne {
+	ppuo.mutation.ClearPlayer()
+	return ppuo
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (ppuo *PlayerPerformanceUpdateOne) ClearGame() *PlayerPerformanceUpdateOne {
+	ppuo.mutation.ClearGame()
+	return ppuo
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (ppuo *PlayerPerformanceUpdateOne) ClearTeam() *PlayerPerformanceUpdateOne {
+	ppuo.mutation.ClearTeam()
+	return ppuo
+}
+
+// Save executes the query and returns the updated PlayerPerformance entity.
+func (ppuo *PlayerPerformanceUpdateOne) Save(ctx context.Context) (*PlayerPerformance, error) {
+	var (
+		err  error
+		node *PlayerPerformance
+	)
+	if len(ppuo.hooks) == 0 {
+		if err = ppuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = ppuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PlayerPerformanceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ppuo.check(); err != nil {
+				return nil, err
+			}
+			ppuo.mutation = mutation
+			node, err = ppuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ppuo.hooks) - 1; i >= 0; i-- {
+			mut = ppuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ppuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppuo *PlayerPerformanceUpdateOne) SaveX(ctx context.Context) *PlayerPerformance {
+	node, err := ppuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ppuo *PlayerPerformanceUpdateOne) Exec(ctx context.Context) error {
+	_, err := ppuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppuo *PlayerPerformanceUpdateOne) ExecX(ctx context.Context) {
+	if err := ppuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppuo *PlayerPerformanceUpdateOne) check() error {
+	if _, ok := ppuo.mutation.PlayerID(); ppuo.mutation.PlayerCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"player\"")
+	}
+	if _, ok := ppuo.mutation.GameID(); ppuo.mutation.GameCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"game\"")
+	}
+	if _, ok := ppuo.mutation.TeamID(); ppuo.mutation.TeamCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"team\"")
+	}
+	return nil
+}
+
+func (ppuo *PlayerPerformanceUpdateOne) sqlSave(ctx context.Context) (_node *PlayerPerformance, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   playerperformance.Table,
+			Columns: playerperformance.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: playerperformance.FieldID,
+			},
+		},
+	}
+	id, ok := ppuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing PlayerPerformance.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := ppuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ppuo.mutation.Minutes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldMinutes,
+		})
+	}
+	if value, ok := ppuo.mutation.AddedMinutes(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldMinutes,
+		})
+	}
+	if ppuo.mutation.MinutesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: playerperformance.FieldMinutes,
+		})
+	}
+	if value, ok := ppuo.mutation.Points(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldPoints,
+		})
+	}
+	if value, ok := ppuo.mutation.AddedPoints(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldPoints,
+		})
+	}
+	if value, ok := ppuo.mutation.Rebounds(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldRebounds,
+		})
+	}
+	if value, ok := ppuo.mutation.AddedRebounds(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldRebounds,
+		})
+	}
+	if value, ok := ppuo.mutation.Assists(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldAssists,
+		})
+	}
+	if value, ok := ppuo.mutation.AddedAssists(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldAssists,
+		})
+	}
+	if value, ok := ppuo.mutation.Steals(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldSteals,
+		})
+	}
+	if value, ok := ppuo.mutation.AddedSteals(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldSteals,
+		})
+	}
+	if value, ok := ppuo.mutation.Blocks(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldBlocks,
+		})
+	}
+	if value, ok := ppuo.mutation.AddedBlocks(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldBlocks,
+		})
+	}
+	if value, ok := ppuo.mutation.Turnovers(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldTurnovers,
+		})
+	}
+	if value, ok := ppuo.mutation.AddedTurnovers(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: playerperformance.FieldTurnovers,
+		})
+	}
+	if ppuo.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playerperformance.PlayerTable,
+			Columns: []string{playerperformance.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playerperformance.PlayerTable,
+			Columns: []string{playerperformance.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ppuo.mutation.GameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playerperformance.GameTable,
+			Columns: []string{playerperformance.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playerperformance.GameTable,
+			Columns: []string{playerperformance.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ppuo.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playerperformance.TeamTable,
+			Columns: []string{playerperformance.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playerperformance.TeamTable,
+			Columns: []string{playerperformance.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: team.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PlayerPerformance{config: ppuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ppuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playerperformance.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
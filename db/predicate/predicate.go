@@ -6,5 +6,56 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AuthCode is the predicate function for authcode builders.
+type AuthCode func(*sql.Selector)
+
+// Contest is the predicate function for contest builders.
+type Contest func(*sql.Selector)
+
+// ContestDraft is the predicate function for contestdraft builders.
+type ContestDraft func(*sql.Selector)
+
+// ContestDraftPick is the predicate function for contestdraftpick builders.
+type ContestDraftPick func(*sql.Selector)
+
+// ContestEntry is the predicate function for contestentry builders.
+type ContestEntry func(*sql.Selector)
+
+// ContestEntryCorrection is the predicate function for contestentrycorrection builders.
+type ContestEntryCorrection func(*sql.Selector)
+
+// Game is the predicate function for game builders.
+type Game func(*sql.Selector)
+
+// GameResult is the predicate function for gameresult builders.
+type GameResult func(*sql.Selector)
+
+// Identity is the predicate function for identity builders.
+type Identity func(*sql.Selector)
+
+// IngestionRun is the predicate function for ingestionrun builders.
+type IngestionRun func(*sql.Selector)
+
+// Invitation is the predicate function for invitation builders.
+type Invitation func(*sql.Selector)
+
+// League is the predicate function for league builders.
+type League func(*sql.Selector)
+
+// LeagueMembership is the predicate function for leaguemembership builders.
+type LeagueMembership func(*sql.Selector)
+
+// Player is the predicate function for player builders.
+type Player func(*sql.Selector)
+
+// PlayerPerformance is the predicate function for playerperformance builders.
+type PlayerPerformance func(*sql.Selector)
+
+// RefreshToken is the predicate function for refreshtoken builders.
+type RefreshToken func(*sql.Selector)
+
+// Team is the predicate function for team builders.
+type Team func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
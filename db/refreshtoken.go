@@ -0,0 +1,199 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/NickDubelman/fantasy-bball/db/refreshtoken"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+)
+
+// RefreshToken is the model entity for the RefreshToken schema.
+type RefreshToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TokenHash holds the value of the "tokenHash" field.
+	TokenHash string `json:"-"`
+	// Family holds the value of the "family" field.
+	Family string `json:"family,omitempty"`
+	// Created holds the value of the "created" field.
+	Created time.Time `json:"created,omitempty"`
+	// Expires holds the value of the "expires" field.
+	Expires time.Time `json:"expires,omitempty"`
+	// Rotated holds the value of the "rotated" field.
+	Rotated *time.Time `json:"rotated,omitempty"`
+	// Revoked holds the value of the "revoked" field.
+	Revoked *time.Time `json:"revoked,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RefreshTokenQuery when eager-loading is set.
+	Edges               RefreshTokenEdges `json:"edges"`
+	user_refresh_tokens *int
+}
+
+// RefreshTokenEdges holds the relations/edges for other nodes in the graph.
+type RefreshTokenEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RefreshTokenEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// The edge user was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RefreshToken) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case refreshtoken.FieldID:
+			values[i] = &sql.NullInt64{}
+		case refreshtoken.FieldTokenHash, refreshtoken.FieldFamily:
+			values[i] = &sql.NullString{}
+		case refreshtoken.FieldCreated, refreshtoken.FieldExpires, refreshtoken.FieldRotated, refreshtoken.FieldRevoked:
+			values[i] = &sql.NullTime{}
+		case refreshtoken.ForeignKeys[0]: // user_refresh_tokens
+			values[i] = &sql.NullInt64{}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type RefreshToken", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RefreshToken fields.
+func (rt *RefreshToken) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case refreshtoken.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rt.ID = int(value.Int64)
+		case refreshtoken.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tokenHash", values[i])
+			} else if value.Valid {
+				rt.TokenHash = value.String
+			}
+		case refreshtoken.FieldFamily:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field family", values[i])
+			} else if value.Valid {
+				rt.Family = value.String
+			}
+		case refreshtoken.FieldCreated:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created", values[i])
+			} else if value.Valid {
+				rt.Created = value.Time
+			}
+		case refreshtoken.FieldExpires:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires", values[i])
+			} else if value.Valid {
+				rt.Expires = value.Time
+			}
+		case refreshtoken.FieldRotated:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field rotated", values[i])
+			} else if value.Valid {
+				rt.Rotated = new(time.Time)
+				*rt.Rotated = value.Time
+			}
+		case refreshtoken.FieldRevoked:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked", values[i])
+			} else if value.Valid {
+				rt.Revoked = new(time.Time)
+				*rt.Revoked = value.Time
+			}
+		case refreshtoken.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_refresh_tokens", value)
+			} else if value.Valid {
+				rt.user_refresh_tokens = new(int)
+				*rt.user_refresh_tokens = int(value.Int64)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryUser queries the "user" edge of the RefreshToken entity.
+func (rt *RefreshToken) QueryUser() *UserQuery {
+	return (&RefreshTokenClient{config: rt.config}).QueryUser(rt)
+}
+
+// Update returns a builder for updating this RefreshToken.
+// Note that you need to call RefreshToken.Unwrap() before calling this method if this RefreshToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rt *RefreshToken) Update() *RefreshTokenUpdateOne {
+	return (&RefreshTokenClient{config: rt.config}).UpdateOne(rt)
+}
+
+// Unwrap unwraps the RefreshToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rt *RefreshToken) Unwrap() *RefreshToken {
+	tx, ok := rt.config.driver.(*txDriver)
+	if !ok {
+		panic("db: RefreshToken is not a transactional entity")
+	}
+	rt.config.driver = tx.drv
+	return rt
+}
+
+// String implements the fmt.Stringer.
+func (rt *RefreshToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("RefreshToken(")
+	builder.WriteString(fmt.Sprintf("id=%v", rt.ID))
+	builder.WriteString(", tokenHash=<sensitive>")
+	builder.WriteString(", family=")
+	builder.WriteString(rt.Family)
+	builder.WriteString(", created=")
+	builder.WriteString(rt.Created.Format(time.ANSIC))
+	builder.WriteString(", expires=")
+	builder.WriteString(rt.Expires.Format(time.ANSIC))
+	if v := rt.Rotated; v != nil {
+		builder.WriteString(", rotated=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := rt.Revoked; v != nil {
+		builder.WriteString(", revoked=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RefreshTokens is a parsable slice of RefreshToken.
+type RefreshTokens []*RefreshToken
+
+func (rt RefreshTokens) config(cfg config) {
+	for _i := range rt {
+		rt[_i].config = cfg
+	}
+}
@@ -0,0 +1,750 @@
+// Code generated by entc, DO NOT EDIT.
+
+package refreshtoken
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TokenHash applies equality check predicate on the "tokenHash" field. It's identical to TokenHashEQ.
+func TokenHash(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTokenHash), v))
+	})
+}
+
+// Family applies equality check predicate on the "family" field. It's identical to FamilyEQ.
+func Family(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFamily), v))
+	})
+}
+
+// Created applies equality check predicate on the "created" field. It's identical to CreatedEQ.
+func Created(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreated), v))
+	})
+}
+
+// Expires applies equality check predicate on the "expires" field. It's identical to ExpiresEQ.
+func Expires(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpires), v))
+	})
+}
+
+// Rotated applies equality check predicate on the "rotated" field. It's identical to RotatedEQ.
+func Rotated(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRotated), v))
+	})
+}
+
+// Revoked applies equality check predicate on the "revoked" field. It's identical to RevokedEQ.
+func Revoked(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRevoked), v))
+	})
+}
+
+// TokenHashEQ applies the EQ predicate on the "tokenHash" field.
+func TokenHashEQ(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashNEQ applies the NEQ predicate on the "tokenHash" field.
+func TokenHashNEQ(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashIn applies the In predicate on the "tokenHash" field.
+func TokenHashIn(vs ...string) predicate.RefreshToken {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTokenHash), v...))
+	})
+}
+
+// TokenHashNotIn applies the NotIn predicate on the "tokenHash" field.
+func TokenHashNotIn(vs ...string) predicate.RefreshToken {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTokenHash), v...))
+	})
+}
+
+// TokenHashGT applies the GT predicate on the "tokenHash" field.
+func TokenHashGT(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashGTE applies the GTE predicate on the "tokenHash" field.
+func TokenHashGTE(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashLT applies the LT predicate on the "tokenHash" field.
+func TokenHashLT(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashLTE applies the LTE predicate on the "tokenHash" field.
+func TokenHashLTE(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashContains applies the Contains predicate on the "tokenHash" field.
+func TokenHashContains(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashHasPrefix applies the HasPrefix predicate on the "tokenHash" field.
+func TokenHashHasPrefix(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashHasSuffix applies the HasSuffix predicate on the "tokenHash" field.
+func TokenHashHasSuffix(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashEqualFold applies the EqualFold predicate on the "tokenHash" field.
+func TokenHashEqualFold(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTokenHash), v))
+	})
+}
+
+// TokenHashContainsFold applies the ContainsFold predicate on the "tokenHash" field.
+func TokenHashContainsFold(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTokenHash), v))
+	})
+}
+
+// FamilyEQ applies the EQ predicate on the "family" field.
+func FamilyEQ(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFamily), v))
+	})
+}
+
+// FamilyNEQ applies the NEQ predicate on the "family" field.
+func FamilyNEQ(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldFamily), v))
+	})
+}
+
+// FamilyIn applies the In predicate on the "family" field.
+func FamilyIn(vs ...string) predicate.RefreshToken {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldFamily), v...))
+	})
+}
+
+// FamilyNotIn applies the NotIn predicate on the "family" field.
+func FamilyNotIn(vs ...string) predicate.RefreshToken {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldFamily), v...))
+	})
+}
+
+// FamilyGT applies the GT predicate on the "family" field.
+func FamilyGT(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldFamily), v))
+	})
+}
+
+// FamilyGTE applies the GTE predicate on the "family" field.
+func FamilyGTE(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldFamily), v))
+	})
+}
+
+// FamilyLT applies the LT predicate on the "family" field.
+func FamilyLT(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldFamily), v))
+	})
+}
+
+// FamilyLTE applies the LTE predicate on the "family" field.
+func FamilyLTE(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldFamily), v))
+	})
+}
+
+// FamilyContains applies the Contains predicate on the "family" field.
+func FamilyContains(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldFamily), v))
+	})
+}
+
+// FamilyHasPrefix applies the HasPrefix predicate on the "family" field.
+func FamilyHasPrefix(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldFamily), v))
+	})
+}
+
+// FamilyHasSuffix applies the HasSuffix predicate on the "family" field.
+func FamilyHasSuffix(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldFamily), v))
+	})
+}
+
+// FamilyEqualFold applies the EqualFold predicate on the "family" field.
+func FamilyEqualFold(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldFamily), v))
+	})
+}
+
+// FamilyContainsFold applies the ContainsFold predicate on the "family" field.
+func FamilyContainsFold(v string) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldFamily), v))
+	})
+}
+
+// CreatedEQ applies the EQ predicate on the "created" field.
+func CreatedEQ(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedNEQ applies the NEQ predicate on the "created" field.
+func CreatedNEQ(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedIn applies the In predicate on the "created" field.
+func CreatedIn(vs ...time.Time) predicate.RefreshToken {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreated), v...))
+	})
+}
+
+// CreatedNotIn applies the NotIn predicate on the "created" field.
+func CreatedNotIn(vs ...time.Time) predicate.RefreshToken {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreated), v...))
+	})
+}
+
+// CreatedGT applies the GT predicate on the "created" field.
+func CreatedGT(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedGTE applies the GTE predicate on the "created" field.
+func CreatedGTE(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedLT applies the LT predicate on the "created" field.
+func CreatedLT(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedLTE applies the LTE predicate on the "created" field.
+func CreatedLTE(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreated), v))
+	})
+}
+
+// ExpiresEQ applies the EQ predicate on the "expires" field.
+func ExpiresEQ(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresNEQ applies the NEQ predicate on the "expires" field.
+func ExpiresNEQ(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresIn applies the In predicate on the "expires" field.
+func ExpiresIn(vs ...time.Time) predicate.RefreshToken {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExpires), v...))
+	})
+}
+
+// ExpiresNotIn applies the NotIn predicate on the "expires" field.
+func ExpiresNotIn(vs ...time.Time) predicate.RefreshToken {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExpires), v...))
+	})
+}
+
+// ExpiresGT applies the GT predicate on the "expires" field.
+func ExpiresGT(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresGTE applies the GTE predicate on the "expires" field.
+func ExpiresGTE(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresLT applies the LT predicate on the "expires" field.
+func ExpiresLT(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresLTE applies the LTE predicate on the "expires" field.
+func ExpiresLTE(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExpires), v))
+	})
+}
+
+// RotatedEQ applies the EQ predicate on the "rotated" field.
+func RotatedEQ(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRotated), v))
+	})
+}
+
+// RotatedNEQ applies the NEQ predicate on the "rotated" field.
+func RotatedNEQ(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRotated), v))
+	})
+}
+
+// RotatedIn applies the In predicate on the "rotated" field.
+func RotatedIn(vs ...time.Time) predicate.RefreshToken {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRotated), v...))
+	})
+}
+
+// RotatedNotIn applies the NotIn predicate on the "rotated" field.
+func RotatedNotIn(vs ...time.Time) predicate.RefreshToken {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRotated), v...))
+	})
+}
+
+// RotatedGT applies the GT predicate on the "rotated" field.
+func RotatedGT(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRotated), v))
+	})
+}
+
+// RotatedGTE applies the GTE predicate on the "rotated" field.
+func RotatedGTE(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRotated), v))
+	})
+}
+
+// RotatedLT applies the LT predicate on the "rotated" field.
+func RotatedLT(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRotated), v))
+	})
+}
+
+// RotatedLTE applies the LTE predicate on the "rotated" field.
+func RotatedLTE(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRotated), v))
+	})
+}
+
+// RotatedIsNil applies the IsNil predicate on the "rotated" field.
+func RotatedIsNil() predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRotated)))
+	})
+}
+
+// RotatedNotNil applies the NotNil predicate on the "rotated" field.
+func RotatedNotNil() predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRotated)))
+	})
+}
+
+// RevokedEQ applies the EQ predicate on the "revoked" field.
+func RevokedEQ(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRevoked), v))
+	})
+}
+
+// RevokedNEQ applies the NEQ predicate on the "revoked" field.
+func RevokedNEQ(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRevoked), v))
+	})
+}
+
+// RevokedIn applies the In predicate on the "revoked" field.
+func RevokedIn(vs ...time.Time) predicate.RefreshToken {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRevoked), v...))
+	})
+}
+
+// RevokedNotIn applies the NotIn predicate on the "revoked" field.
+func RevokedNotIn(vs ...time.Time) predicate.RefreshToken {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRevoked), v...))
+	})
+}
+
+// RevokedGT applies the GT predicate on the "revoked" field.
+func RevokedGT(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRevoked), v))
+	})
+}
+
+// RevokedGTE applies the GTE predicate on the "revoked" field.
+func RevokedGTE(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRevoked), v))
+	})
+}
+
+// RevokedLT applies the LT predicate on the "revoked" field.
+func RevokedLT(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRevoked), v))
+	})
+}
+
+// RevokedLTE applies the LTE predicate on the "revoked" field.
+func RevokedLTE(v time.Time) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRevoked), v))
+	})
+}
+
+// RevokedIsNil applies the IsNil predicate on the "revoked" field.
+func RevokedIsNil() predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRevoked)))
+	})
+}
+
+// RevokedNotNil applies the NotNil predicate on the "revoked" field.
+func RevokedNotNil() predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRevoked)))
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RefreshToken) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RefreshToken) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RefreshToken) predicate.RefreshToken {
+	return predicate.RefreshToken(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
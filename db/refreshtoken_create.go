@@ -0,0 +1,333 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/refreshtoken"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+)
+
+// RefreshTokenCreate is the builder for creating a RefreshToken entity.
+type RefreshTokenCreate struct {
+	config
+	mutation *RefreshTokenMutation
+	hooks    []Hook
+}
+
+// SetTokenHash sets the "tokenHash" field.
+func (rtc *RefreshTokenCreate) SetTokenHash(s string) *RefreshTokenCreate {
+	rtc.mutation.SetTokenHash(s)
+	return rtc
+}
+
+// SetFamily sets the "family" field.
+func (rtc *RefreshTokenCreate) SetFamily(s string) *RefreshTokenCreate {
+	rtc.mutation.SetFamily(s)
+	return rtc
+}
+
+// SetCreated sets the "created" field.
+func (rtc *RefreshTokenCreate) SetCreated(t time.Time) *RefreshTokenCreate {
+	rtc.mutation.SetCreated(t)
+	return rtc
+}
+
+// SetNillableCreated sets the "created" field if the given value is not nil.
+func (rtc *RefreshTokenCreate) SetNillableCreated(t *time.Time) *RefreshTokenCreate {
+	if t != nil {
+		rtc.SetCreated(*t)
+	}
+	return rtc
+}
+
+// SetExpires sets the "expires" field.
+func (rtc *RefreshTokenCreate) SetExpires(t time.Time) *RefreshTokenCreate {
+	rtc.mutation.SetExpires(t)
+	return rtc
+}
+
+// SetRotated sets the "rotated" field.
+func (rtc *RefreshTokenCreate) SetRotated(t time.Time) *RefreshTokenCreate {
+	rtc.mutation.SetRotated(t)
+	return rtc
+}
+
+// SetNillableRotated sets the "rotated" field if the given value is not nil.
+func (rtc *RefreshTokenCreate) SetNillableRotated(t *time.Time) *RefreshTokenCreate {
+	if t != nil {
+		rtc.SetRotated(*t)
+	}
+	return rtc
+}
+
+// SetRevoked sets the "revoked" field.
+func (rtc *RefreshTokenCreate) SetRevoked(t time.Time) *RefreshTokenCreate {
+	rtc.mutation.SetRevoked(t)
+	return rtc
+}
+
+// SetNillableRevoked sets the "revoked" field if the given value is not nil.
+func (rtc *RefreshTokenCreate) SetNillableRevoked(t *time.Time) *RefreshTokenCreate {
+	if t != nil {
+		rtc.SetRevoked(*t)
+	}
+	return rtc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (rtc *RefreshTokenCreate) SetUserID(id int) *RefreshTokenCreate {
+	rtc.mutation.SetUserID(id)
+	return rtc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rtc *RefreshTokenCreate) SetUser(u *User) *RefreshTokenCreate {
+	return rtc.SetUserID(u.ID)
+}
+
+// Mutation returns the RefreshTokenMutation object of the builder.
+func (rtc *RefreshTokenCreate) Mutation() *RefreshTokenMutation {
+	return rtc.mutation
+}
+
+// Save creates the RefreshToken in the database.
+func (rtc *RefreshTokenCreate) Save(ctx context.Context) (*RefreshToken, error) {
+	var (
+		err  error
+		node *RefreshToken
+	)
+	rtc.defaults()
+	if len(rtc.hooks) == 0 {
+		if err = rtc.check(); err != nil {
+			return nil, err
+		}
+		node, err = rtc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RefreshTokenMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rtc.check(); err != nil {
+				return nil, err
+			}
+			rtc.mutation = mutation
+			node, err = rtc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rtc.hooks) - 1; i >= 0; i-- {
+			mut = rtc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rtc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rtc *RefreshTokenCreate) SaveX(ctx context.Context) *RefreshToken {
+	v, err := rtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (rtc *RefreshTokenCreate) defaults() {
+	if _, ok := rtc.mutation.Created(); !ok {
+		v := refreshtoken.DefaultCreated()
+		rtc.mutation.SetCreated(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtc *RefreshTokenCreate) check() error {
+	if _, ok := rtc.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "tokenHash", err: errors.New("db: missing required field \"tokenHash\"")}
+	}
+	if _, ok := rtc.mutation.Family(); !ok {
+		return &ValidationError{Name: "family", err: errors.New("db: missing required field \"family\"")}
+	}
+	if _, ok := rtc.mutation.Created(); !ok {
+		return &ValidationError{Name: "created", err: errors.New("db: missing required field \"created\"")}
+	}
+	if _, ok := rtc.mutation.Expires(); !ok {
+		return &ValidationError{Name: "expires", err: errors.New("db: missing required field \"expires\"")}
+	}
+	if _, ok := rtc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New("db: missing required edge \"user\"")}
+	}
+	return nil
+}
+
+func (rtc *RefreshTokenCreate) sqlSave(ctx context.Context) (*RefreshToken, error) {
+	_node, _spec := rtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rtc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (rtc *RefreshTokenCreate) createSpec() (*RefreshToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RefreshToken{config: rtc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: refreshtoken.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: refreshtoken.FieldID,
+			},
+		}
+	)
+	if value, ok := rtc.mutation.TokenHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: refreshtoken.FieldTokenHash,
+		})
+		_node.TokenHash = value
+	}
+	if value, ok := rtc.mutation.Family(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: refreshtoken.FieldFamily,
+		})
+		_node.Family = value
+	}
+	if value, ok := rtc.mutation.Created(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: refreshtoken.FieldCreated,
+		})
+		_node.Created = value
+	}
+	if value, ok := rtc.mutation.Expires(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: refreshtoken.FieldExpires,
+		})
+		_node.Expires = value
+	}
+	if value, ok := rtc.mutation.Rotated(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: refreshtoken.FieldRotated,
+		})
+		_node.Rotated = &value
+	}
+	if value, ok := rtc.mutation.Revoked(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: refreshtoken.FieldRevoked,
+		})
+		_node.Revoked = &value
+	}
+	if nodes := rtc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   refreshtoken.UserTable,
+			Columns: []string{refreshtoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_refresh_tokens = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RefreshTokenCreateBulk is the builder for creating many RefreshToken entities in bulk.
+type RefreshTokenCreateBulk struct {
+	config
+	builders []*RefreshTokenCreate
+}
+
+// Save creates the RefreshToken entities in the database.
+func (rtcb *RefreshTokenCreateBulk) Save(ctx context.Context) ([]*RefreshToken, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(rtcb.builders))
+	nodes := make([]*RefreshToken, len(rtcb.builders))
+	mutators := make([]Mutator, len(rtcb.builders))
+	for i := range rtcb.builders {
+		func(i int, root context.Context) {
+			builder := rtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RefreshTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rtcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rtcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtcb *RefreshTokenCreateBulk) SaveX(ctx context.Context) []*RefreshToken {
+	v, err := rtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
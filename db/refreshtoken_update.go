@@ -0,0 +1,479 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+	"github.com/NickDubelman/fantasy-bball/db/refreshtoken"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+)
+
+// RefreshTokenUpdate is the builder for updating RefreshToken entities.
+type RefreshTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RefreshTokenMutation
+}
+
+// Where adds a new predicate for the RefreshTokenUpdate builder.
+func (rtu *RefreshTokenUpdate) Where(ps ...predicate.RefreshToken) *RefreshTokenUpdate {
+	rtu.mutation.predicates = append(rtu.mutation.predicates, ps...)
+	return rtu
+}
+
+// SetRotated sets the "rotated" field.
+func (rtu *RefreshTokenUpdate) SetRotated(t time.Time) *RefreshTokenUpdate {
+	rtu.mutation.SetRotated(t)
+	return rtu
+}
+
+// SetNillableRotated sets the "rotated" field if the given value is not nil.
+func (rtu *RefreshTokenUpdate) SetNillableRotated(t *time.Time) *RefreshTokenUpdate {
+	if t != nil {
+		rtu.SetRotated(*t)
+	}
+	return rtu
+}
+
+// ClearRotated clears the value of the "rotated" field.
+func (rtu *RefreshTokenUpdate) ClearRotated() *RefreshTokenUpdate {
+	rtu.mutation.ClearRotated()
+	return rtu
+}
+
+// SetRevoked sets the "revoked" field.
+func (rtu *RefreshTokenUpdate) SetRevoked(t time.Time) *RefreshTokenUpdate {
+	rtu.mutation.SetRevoked(t)
+	return rtu
+}
+
+// SetNillableRevoked sets the "revoked" field if the given value is not nil.
+func (rtu *RefreshTokenUpdate) SetNillableRevoked(t *time.Time) *RefreshTokenUpdate {
+	if t != nil {
+		rtu.SetRevoked(*t)
+	}
+	return rtu
+}
+
+// ClearRevoked clears the value of the "revoked" field.
+func (rtu *RefreshTokenUpdate) ClearRevoked() *RefreshTokenUpdate {
+	rtu.mutation.ClearRevoked()
+	return rtu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (rtu *RefreshTokenUpdate) SetUserID(id int) *RefreshTokenUpdate {
+	rtu.mutation.SetUserID(id)
+	return rtu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rtu *RefreshTokenUpdate) SetUser(u *User) *RefreshTokenUpdate {
+	return rtu.SetUserID(u.ID)
+}
+
+// Mutation returns the RefreshTokenMutation object of the builder.
+func (rtu *RefreshTokenUpdate) Mutation() *RefreshTokenMutation {
+	return rtu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (rtu *RefreshTokenUpdate) ClearUser() *RefreshTokenUpdate {
+	rtu.mutation.ClearUser()
+	return rtu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rtu *RefreshTokenUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(rtu.hooks) == 0 {
+		if err = rtu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = rtu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RefreshTokenMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rtu.check(); err != nil {
+				return 0, err
+			}
+			rtu.mutation = mutation
+			affected, err = rtu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(rtu.hooks) - 1; i >= 0; i-- {
+			mut = rtu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rtu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtu *RefreshTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := rtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rtu *RefreshTokenUpdate) Exec(ctx context.Context) error {
+	_, err := rtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtu *RefreshTokenUpdate) ExecX(ctx context.Context) {
+	if err := rtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtu *RefreshTokenUpdate) check() error {
+	if _, ok := rtu.mutation.UserID(); rtu.mutation.UserCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"user\"")
+	}
+	return nil
+}
+
+func (rtu *RefreshTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   refreshtoken.Table,
+			Columns: refreshtoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: refreshtoken.FieldID,
+			},
+		},
+	}
+	if ps := rtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rtu.mutation.Rotated(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: refreshtoken.FieldRotated,
+		})
+	}
+	if rtu.mutation.RotatedCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: refreshtoken.FieldRotated,
+		})
+	}
+	if value, ok := rtu.mutation.Revoked(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: refreshtoken.FieldRevoked,
+		})
+	}
+	if rtu.mutation.RevokedCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: refreshtoken.FieldRevoked,
+		})
+	}
+	if rtu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   refreshtoken.UserTable,
+			Columns: []string{refreshtoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rtu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   refreshtoken.UserTable,
+			Columns: []string{refreshtoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{refreshtoken.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// RefreshTokenUpdateOne is the builder for updating a single RefreshToken entity.
+type RefreshTokenUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *RefreshTokenMutation
+}
+
+// SetRotated sets the "rotated" field.
+func (rtuo *RefreshTokenUpdateOne) SetRotated(t time.Time) *RefreshTokenUpdateOne {
+	rtuo.mutation.SetRotated(t)
+	return rtuo
+}
+
+// SetNillableRotated sets the "rotated" field if the given value is not nil.
+func (rtuo *RefreshTokenUpdateOne) SetNillableRotated(t *time.Time) *RefreshTokenUpdateOne {
+	if t != nil {
+		rtuo.SetRotated(*t)
+	}
+	return rtuo
+}
+
+// ClearRotated clears the value of the "rotated" field.
+func (rtuo *RefreshTokenUpdateOne) ClearRotated() *RefreshTokenUpdateOne {
+	rtuo.mutation.ClearRotated()
+	return rtuo
+}
+
+// SetRevoked sets the "revoked" field.
+func (rtuo *RefreshTokenUpdateOne) SetRevoked(t time.Time) *RefreshTokenUpdateOne {
+	rtuo.mutation.SetRevoked(t)
+	return rtuo
+}
+
+// SetNillableRevoked sets the "revoked" field if the given value is not nil.
+func (rtuo *RefreshTokenUpdateOne) SetNillableRevoked(t *time.Time) *RefreshTokenUpdateOne {
+	if t != nil {
+		rtuo.SetRevoked(*t)
+	}
+	return rtuo
+}
+
+// ClearRevoked clears the value of the "revoked" field.
+func (rtuo *RefreshTokenUpdateOne) ClearRevoked() *RefreshTokenUpdateOne {
+	rtuo.mutation.ClearRevoked()
+	return rtuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (rtuo *RefreshTokenUpdateOne) SetUserID(id int) *RefreshTokenUpdateOne {
+	rtuo.mutation.SetUserID(id)
+	return rtuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rtuo *RefreshTokenUpdateOne) SetUser(u *User) *RefreshTokenUpdateOne {
+	return rtuo.SetUserID(u.ID)
+}
+
+// Mutation returns the RefreshTokenMutation object of the builder.
+func (rtuo *RefreshTokenUpdateOne) Mutation() *RefreshTokenMutation {
+	return rtuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (rtuo *RefreshTokenUpdateOne) ClearUser() *RefreshTokenUpdateOne {
+	rtuo.mutation.ClearUser()
+	return rtuo
+}
+
+// Save executes the query and returns the updated RefreshToken entity.
+func (rtuo *RefreshTokenUpdateOne) Save(ctx context.Context) (*RefreshToken, error) {
+	var (
+		err  error
+		node *RefreshToken
+	)
+	if len(rtuo.hooks) == 0 {
+		if err = rtuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = rtuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RefreshTokenMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rtuo.check(); err != nil {
+				return nil, err
+			}
+			rtuo.mutation = mutation
+			node, err = rtuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rtuo.hooks) - 1; i >= 0; i-- {
+			mut = rtuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rtuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtuo *RefreshTokenUpdateOne) SaveX(ctx context.Context) *RefreshToken {
+	node, err := rtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rtuo *RefreshTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := rtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtuo *RefreshTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := rtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtuo *RefreshTokenUpdateOne) check() error {
+	if _, ok := rtuo.mutation.UserID(); rtuo.mutation.UserCleared() && !ok {
+		return errors.New("db: clearing a required unique edge \"user\"")
+	}
+	return nil
+}
+
+func (rtuo *RefreshTokenUpdateOne) sqlSave(ctx context.Context) (_node *RefreshToken, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   refreshtoken.Table,
+			Columns: refreshtoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: refreshtoken.FieldID,
+			},
+		},
+	}
+	id, ok := rtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing RefreshToken.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := rtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rtuo.mutation.Rotated(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: refreshtoken.FieldRotated,
+		})
+	}
+	if rtuo.mutation.RotatedCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: refreshtoken.FieldRotated,
+		})
+	}
+	if value, ok := rtuo.mutation.Revoked(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: refreshtoken.FieldRevoked,
+		})
+	}
+	if rtuo.mutation.RevokedCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: refreshtoken.FieldRevoked,
+		})
+	}
+	if rtuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   refreshtoken.UserTable,
+			Columns: []string{refreshtoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rtuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   refreshtoken.UserTable,
+			Columns: []string{refreshtoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RefreshToken{config: rtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{refreshtoken.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
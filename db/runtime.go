@@ -5,6 +5,19 @@ package db
 import (
 	"time"
 
+	"github.com/NickDubelman/fantasy-bball/db/contestdraft"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraftpick"
+	"github.com/NickDubelman/fantasy-bball/db/contestentry"
+	"github.com/NickDubelman/fantasy-bball/db/contestentrycorrection"
+	"github.com/NickDubelman/fantasy-bball/db/game"
+	"github.com/NickDubelman/fantasy-bball/db/gameresult"
+	"github.com/NickDubelman/fantasy-bball/db/identity"
+	"github.com/NickDubelman/fantasy-bball/db/ingestionrun"
+	"github.com/NickDubelman/fantasy-bball/db/invitation"
+	"github.com/NickDubelman/fantasy-bball/db/league"
+	"github.com/NickDubelman/fantasy-bball/db/leaguemembership"
+	"github.com/NickDubelman/fantasy-bball/db/playerperformance"
+	"github.com/NickDubelman/fantasy-bball/db/refreshtoken"
 	"github.com/NickDubelman/fantasy-bball/db/schema"
 	"github.com/NickDubelman/fantasy-bball/db/user"
 )
@@ -13,6 +26,168 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	contestdraftFields := schema.ContestDraft{}.Fields()
+	_ = contestdraftFields
+	// contestdraftDescRounds is the schema descriptor for rounds field.
+	contestdraftDescRounds := contestdraftFields[0].Descriptor()
+	// contestdraft.RoundsValidator is a validator for the "rounds" field. It is called by the builders before save.
+	contestdraft.RoundsValidator = contestdraftDescRounds.Validators[0].(func(int) error)
+	// contestdraftDescPickTimeLimit is the schema descriptor for pickTimeLimit field.
+	contestdraftDescPickTimeLimit := contestdraftFields[1].Descriptor()
+	// contestdraft.PickTimeLimitValidator is a validator for the "pickTimeLimit" field. It is called by the builders before save.
+	contestdraft.PickTimeLimitValidator = contestdraftDescPickTimeLimit.Validators[0].(func(int) error)
+	contestdraftpickFields := schema.ContestDraftPick{}.Fields()
+	_ = contestdraftpickFields
+	// contestdraftpickDescRound is the schema descriptor for round field.
+	contestdraftpickDescRound := contestdraftpickFields[0].Descriptor()
+	// contestdraftpick.RoundValidator is a validator for the "round" field. It is called by the builders before save.
+	contestdraftpick.RoundValidator = contestdraftpickDescRound.Validators[0].(func(int) error)
+	// contestdraftpickDescCreated is the schema descriptor for created field.
+	contestdraftpickDescCreated := contestdraftpickFields[1].Descriptor()
+	// contestdraftpick.DefaultCreated holds the default value on creation for the created field.
+	contestdraftpick.DefaultCreated = contestdraftpickDescCreated.Default.(func() time.Time)
+	// contestdraftpickDescAuto is the schema descriptor for auto field.
+	contestdraftpickDescAuto := contestdraftpickFields[2].Descriptor()
+	// contestdraftpick.DefaultAuto holds the default value on creation for the auto field.
+	contestdraftpick.DefaultAuto = contestdraftpickDescAuto.Default.(bool)
+	contestentryFields := schema.ContestEntry{}.Fields()
+	_ = contestentryFields
+	// contestentryDescTotalPoints is the schema descriptor for totalPoints field.
+	contestentryDescTotalPoints := contestentryFields[0].Descriptor()
+	// contestentry.DefaultTotalPoints holds the default value on creation for the totalPoints field.
+	contestentry.DefaultTotalPoints = contestentryDescTotalPoints.Default.(int)
+	contestentrycorrectionFields := schema.ContestEntryCorrection{}.Fields()
+	_ = contestentrycorrectionFields
+	// contestentrycorrectionDescCreated is the schema descriptor for created field.
+	contestentrycorrectionDescCreated := contestentrycorrectionFields[2].Descriptor()
+	// contestentrycorrection.DefaultCreated holds the default value on creation for the created field.
+	contestentrycorrection.DefaultCreated = contestentrycorrectionDescCreated.Default.(func() time.Time)
+	gameFields := schema.Game{}.Fields()
+	_ = gameFields
+	// gameDescPostponed is the schema descriptor for postponed field.
+	gameDescPostponed := gameFields[2].Descriptor()
+	// game.DefaultPostponed holds the default value on creation for the postponed field.
+	game.DefaultPostponed = gameDescPostponed.Default.(bool)
+	// gameDescFinal is the schema descriptor for final field.
+	gameDescFinal := gameFields[3].Descriptor()
+	// game.DefaultFinal holds the default value on creation for the final field.
+	game.DefaultFinal = gameDescFinal.Default.(bool)
+	gameresultFields := schema.GameResult{}.Fields()
+	_ = gameresultFields
+	// gameresultDescHomeScore is the schema descriptor for homeScore field.
+	gameresultDescHomeScore := gameresultFields[0].Descriptor()
+	// gameresult.DefaultHomeScore holds the default value on creation for the homeScore field.
+	gameresult.DefaultHomeScore = gameresultDescHomeScore.Default.(int)
+	// gameresult.HomeScoreValidator is a validator for the "homeScore" field. It is called by the builders before save.
+	gameresult.HomeScoreValidator = gameresultDescHomeScore.Validators[0].(func(int) error)
+	// gameresultDescAwayScore is the schema descriptor for awayScore field.
+	gameresultDescAwayScore := gameresultFields[1].Descriptor()
+	// gameresult.DefaultAwayScore holds the default value on creation for the awayScore field.
+	gameresult.DefaultAwayScore = gameresultDescAwayScore.Default.(int)
+	// gameresult.AwayScoreValidator is a validator for the "awayScore" field. It is called by the builders before save.
+	gameresult.AwayScoreValidator = gameresultDescAwayScore.Validators[0].(func(int) error)
+	identityFields := schema.Identity{}.Fields()
+	_ = identityFields
+	// identityDescCreated is the schema descriptor for created field.
+	identityDescCreated := identityFields[3].Descriptor()
+	// identity.DefaultCreated holds the default value on creation for the created field.
+	identity.DefaultCreated = identityDescCreated.Default.(func() time.Time)
+	ingestionrunFields := schema.IngestionRun{}.Fields()
+	_ = ingestionrunFields
+	// ingestionrunDescStarted is the schema descriptor for started field.
+	ingestionrunDescStarted := ingestionrunFields[0].Descriptor()
+	// ingestionrun.DefaultStarted holds the default value on creation for the started field.
+	ingestionrun.DefaultStarted = ingestionrunDescStarted.Default.(func() time.Time)
+	// ingestionrunDescRowsChanged is the schema descriptor for rowsChanged field.
+	ingestionrunDescRowsChanged := ingestionrunFields[2].Descriptor()
+	// ingestionrun.DefaultRowsChanged holds the default value on creation for the rowsChanged field.
+	ingestionrun.DefaultRowsChanged = ingestionrunDescRowsChanged.Default.(int)
+	// ingestionrun.RowsChangedValidator is a validator for the "rowsChanged" field. It is called by the builders before save.
+	ingestionrun.RowsChangedValidator = ingestionrunDescRowsChanged.Validators[0].(func(int) error)
+	invitationFields := schema.Invitation{}.Fields()
+	_ = invitationFields
+	// invitationDescCreated is the schema descriptor for created field.
+	invitationDescCreated := invitationFields[3].Descriptor()
+	// invitation.DefaultCreated holds the default value on creation for the created field.
+	invitation.DefaultCreated = invitationDescCreated.Default.(func() time.Time)
+	leagueFields := schema.League{}.Fields()
+	_ = leagueFields
+	// leagueDescName is the schema descriptor for name field.
+	leagueDescName := leagueFields[0].Descriptor()
+	// league.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	league.NameValidator = leagueDescName.Validators[0].(func(string) error)
+	// leagueDescDescription is the schema descriptor for description field.
+	leagueDescDescription := leagueFields[1].Descriptor()
+	// league.DefaultDescription holds the default value on creation for the description field.
+	league.DefaultDescription = leagueDescDescription.Default.(string)
+	// leagueDescMaxMembers is the schema descriptor for maxMembers field.
+	leagueDescMaxMembers := leagueFields[2].Descriptor()
+	// league.DefaultMaxMembers holds the default value on creation for the maxMembers field.
+	league.DefaultMaxMembers = leagueDescMaxMembers.Default.(int)
+	// league.MaxMembersValidator is a validator for the "maxMembers" field. It is called by the builders before save.
+	league.MaxMembersValidator = leagueDescMaxMembers.Validators[0].(func(int) error)
+	// leagueDescDraftRounds is the schema descriptor for draftRounds field.
+	leagueDescDraftRounds := leagueFields[4].Descriptor()
+	// league.DefaultDraftRounds holds the default value on creation for the draftRounds field.
+	league.DefaultDraftRounds = leagueDescDraftRounds.Default.(int)
+	// league.DraftRoundsValidator is a validator for the "draftRounds" field. It is called by the builders before save.
+	league.DraftRoundsValidator = leagueDescDraftRounds.Validators[0].(func(int) error)
+	// leagueDescPickTimeLimit is the schema descriptor for pickTimeLimit field.
+	leagueDescPickTimeLimit := leagueFields[5].Descriptor()
+	// league.DefaultPickTimeLimit holds the default value on creation for the pickTimeLimit field.
+	league.DefaultPickTimeLimit = leagueDescPickTimeLimit.Default.(int)
+	// league.PickTimeLimitValidator is a validator for the "pickTimeLimit" field. It is called by the builders before save.
+	league.PickTimeLimitValidator = leagueDescPickTimeLimit.Validators[0].(func(int) error)
+	// leagueDescCreated is the schema descriptor for created field.
+	leagueDescCreated := leagueFields[6].Descriptor()
+	// league.DefaultCreated holds the default value on creation for the created field.
+	league.DefaultCreated = leagueDescCreated.Default.(func() time.Time)
+	leaguemembershipFields := schema.LeagueMembership{}.Fields()
+	_ = leaguemembershipFields
+	// leaguemembershipDescIsCommissioner is the schema descriptor for isCommissioner field.
+	leaguemembershipDescIsCommissioner := leaguemembershipFields[0].Descriptor()
+	// leaguemembership.DefaultIsCommissioner holds the default value on creation for the isCommissioner field.
+	leaguemembership.DefaultIsCommissioner = leaguemembershipDescIsCommissioner.Default.(bool)
+	// leaguemembershipDescCanInvite is the schema descriptor for canInvite field.
+	leaguemembershipDescCanInvite := leaguemembershipFields[1].Descriptor()
+	// leaguemembership.DefaultCanInvite holds the default value on creation for the canInvite field.
+	leaguemembership.DefaultCanInvite = leaguemembershipDescCanInvite.Default.(bool)
+	// leaguemembershipDescJoined is the schema descriptor for joined field.
+	leaguemembershipDescJoined := leaguemembershipFields[2].Descriptor()
+	// leaguemembership.DefaultJoined holds the default value on creation for the joined field.
+	leaguemembership.DefaultJoined = leaguemembershipDescJoined.Default.(func() time.Time)
+	playerperformanceFields := schema.PlayerPerformance{}.Fields()
+	_ = playerperformanceFields
+	// playerperformanceDescPoints is the schema descriptor for points field.
+	playerperformanceDescPoints := playerperformanceFields[1].Descriptor()
+	// playerperformance.DefaultPoints holds the default value on creation for the points field.
+	playerperformance.DefaultPoints = playerperformanceDescPoints.Default.(int)
+	// playerperformanceDescRebounds is the schema descriptor for rebounds field.
+	playerperformanceDescRebounds := playerperformanceFields[2].Descriptor()
+	// playerperformance.DefaultRebounds holds the default value on creation for the rebounds field.
+	playerperformance.DefaultRebounds = playerperformanceDescRebounds.Default.(int)
+	// playerperformanceDescAssists is the schema descriptor for assists field.
+	playerperformanceDescAssists := playerperformanceFields[3].Descriptor()
+	// playerperformance.DefaultAssists holds the default value on creation for the assists field.
+	playerperformance.DefaultAssists = playerperformanceDescAssists.Default.(int)
+	// playerperformanceDescSteals is the schema descriptor for steals field.
+	playerperformanceDescSteals := playerperformanceFields[4].Descriptor()
+	// playerperformance.DefaultSteals holds the default value on creation for the steals field.
+	playerperformance.DefaultSteals = playerperformanceDescSteals.Default.(int)
+	// playerperformanceDescBlocks is the schema descriptor for blocks field.
+	playerperformanceDescBlocks := playerperformanceFields[5].Descriptor()
+	// playerperformance.DefaultBlocks holds the default value on creation for the blocks field.
+	playerperformance.DefaultBlocks = playerperformanceDescBlocks.Default.(int)
+	// playerperformanceDescTurnovers is the schema descriptor for turnovers field.
+	playerperformanceDescTurnovers := playerperformanceFields[6].Descriptor()
+	// playerperformance.DefaultTurnovers holds the default value on creation for the turnovers field.
+	playerperformance.DefaultTurnovers = playerperformanceDescTurnovers.Default.(int)
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescCreated is the schema descriptor for created field.
+	refreshtokenDescCreated := refreshtokenFields[2].Descriptor()
+	// refreshtoken.DefaultCreated holds the default value on creation for the created field.
+	refreshtoken.DefaultCreated = refreshtokenDescCreated.Default.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescJoined is the schema descriptor for joined field.
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuthCode holds the schema definition for the AuthCode entity. After logging in, the
+// user's browser is handed a short-lived code instead of their tokens, and the
+// frontend's server exchanges it for the tokens. Each code can only be used once
+type AuthCode struct {
+	ent.Schema
+}
+
+// Fields of the AuthCode.
+func (AuthCode) Fields() []ent.Field {
+	return []ent.Field{
+		// SHA-256 of the code. The code itself is never stored
+		field.String("codeHash").Unique().Immutable().Sensitive(),
+		field.Time("expires").Immutable(),
+	}
+}
+
+// Edges of the AuthCode.
+func (AuthCode) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("authCodes").Unique().Required(),
+	}
+}
+
+// Indexes of the AuthCode.
+func (AuthCode) Indexes() []ent.Index {
+	return []ent.Index{
+		// Cleaning up expired codes
+		index.Fields("expires"),
+	}
+}
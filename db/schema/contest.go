@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Contest holds the schema definition for the Contest entity.
+type Contest struct {
+	ent.Schema
+}
+
+// Fields of the Contest.
+func (Contest) Fields() []ent.Field {
+	return []ent.Field{
+		// The calendar day of the contest's games, stored as midnight UTC
+		field.Time("day").Immutable(),
+
+		// When the contest was settled and its winner decided. nil while the contest
+		// is still open
+		field.Time("closed").Optional().Nillable(),
+	}
+}
+
+// Edges of the Contest.
+func (Contest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("league", League.Type).Ref("contests").Unique().Required(),
+		edge.From("winner", User.Type).Ref("wonContests").Unique(),
+		edge.To("draft", ContestDraft.Type).Unique(),
+		edge.To("entries", ContestEntry.Type),
+	}
+}
+
+// Indexes of the Contest.
+func (Contest) Indexes() []ent.Index {
+	return []ent.Index{
+		// A league can only have one contest per day
+		index.Fields("day").Edges("league").Unique(),
+	}
+}
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ContestDraft holds the schema definition for the ContestDraft entity.
+type ContestDraft struct {
+	ent.Schema
+}
+
+// Fields of the ContestDraft.
+func (ContestDraft) Fields() []ent.Field {
+	return []ent.Field{
+		// Copied from the league when the draft is created, so that changing the
+		// league's settings doesn't affect a draft in progress
+		field.Int("rounds").Positive().Immutable(),
+		field.Int("pickTimeLimit").Positive().Immutable(), // in seconds
+
+		// When the clock starts for the first pick. No picks can be made before then
+		field.Time("started").Immutable(),
+
+		// Set once every user has made all of their picks
+		field.Time("completed").Optional().Nillable(),
+	}
+}
+
+// Edges of the ContestDraft.
+func (ContestDraft) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("contest", Contest.Type).Ref("draft").Unique().Required(),
+		edge.To("picks", ContestDraftPick.Type),
+	}
+}
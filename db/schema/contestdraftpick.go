@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ContestDraftPick holds the schema definition for the ContestDraftPick entity.
+type ContestDraftPick struct {
+	ent.Schema
+}
+
+// Fields of the ContestDraftPick.
+func (ContestDraftPick) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("round").Positive().Immutable(),
+		field.Time("created").Immutable().Default(time.Now),
+
+		// Whether the pick was made automatically because the user ran out of time
+		field.Bool("auto").Immutable().Default(false),
+	}
+}
+
+// Edges of the ContestDraftPick.
+func (ContestDraftPick) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("draft", ContestDraft.Type).Ref("picks").Unique().Required(),
+		edge.From("user", User.Type).Ref("draftPicks").Unique().Required(),
+		edge.From("player", Player.Type).Ref("draftPicks").Unique(),
+	}
+}
+
+// Indexes of the ContestDraftPick.
+func (ContestDraftPick) Indexes() []ent.Index {
+	return []ent.Index{
+		// A user only gets one pick per round of a draft
+		index.Fields("round").Edges("draft", "user").Unique(),
+
+		// A player can only be drafted once per draft
+		index.Edges("draft", "player").Unique(),
+	}
+}
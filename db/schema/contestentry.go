@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ContestEntry holds the schema definition for the ContestEntry entity.
+type ContestEntry struct {
+	ent.Schema
+}
+
+// Fields of the ContestEntry.
+func (ContestEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("totalPoints").Default(0),
+	}
+}
+
+// Edges of the ContestEntry.
+func (ContestEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("contest", Contest.Type).Ref("entries").Unique().Required(),
+		edge.From("user", User.Type).Ref("contestEntries").Unique().Required(),
+
+		// Changes to totalPoints made after the contest was settled
+		edge.To("corrections", ContestEntryCorrection.Type),
+	}
+}
+
+// Indexes of the ContestEntry.
+func (ContestEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		// A user can only enter a contest once
+		index.Edges("contest", "user").Unique(),
+	}
+}
@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ContestEntryCorrection holds the schema definition for the ContestEntryCorrection
+// entity. One is recorded whenever a stat correction changes the total points of an
+// entry in a contest that was already settled, so leagues can see why a result flipped
+type ContestEntryCorrection struct {
+	ent.Schema
+}
+
+// Fields of the ContestEntryCorrection.
+func (ContestEntryCorrection) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("oldTotalPoints").Immutable(),
+		field.Int("newTotalPoints").Immutable(),
+		field.Time("created").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the ContestEntryCorrection.
+func (ContestEntryCorrection) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("entry", ContestEntry.Type).Ref("corrections").Unique().Required(),
+	}
+}
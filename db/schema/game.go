@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Game holds the schema definition for the Game entity.
+type Game struct {
+	ent.Schema
+}
+
+// Fields of the Game.
+func (Game) Fields() []ent.Field {
+	return []ent.Field{
+		// The game's ID in the stats feed we ingest from
+		field.String("externalID").Unique(),
+		field.Time("time"),
+		field.Bool("postponed").Default(false),
+
+		// Set once the game is over. Box scores can still be corrected afterwards
+		field.Bool("final").Default(false),
+	}
+}
+
+// Edges of the Game.
+func (Game) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("homeTeam", Team.Type).Ref("homeGames").Unique().Required(),
+		edge.From("awayTeam", Team.Type).Ref("awayGames").Unique().Required(),
+		edge.To("result", GameResult.Type).Unique(),
+		edge.To("performances", PlayerPerformance.Type),
+	}
+}
+
+// Indexes of the Game.
+func (Game) Indexes() []ent.Index {
+	return []ent.Index{
+		// Games on a given day
+		index.Fields("time"),
+
+		// A team's recent and upcoming games
+		index.Edges("homeTeam").Fields("time"),
+		index.Edges("awayTeam").Fields("time"),
+	}
+}
@@ -0,0 +1,28 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// GameResult holds the schema definition for the GameResult entity.
+type GameResult struct {
+	ent.Schema
+}
+
+// Fields of the GameResult.
+func (GameResult) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("homeScore").NonNegative().Default(0),
+		field.Int("awayScore").NonNegative().Default(0),
+	}
+}
+
+// Edges of the GameResult.
+func (GameResult) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("game", Game.Type).Ref("result").Unique().Required(),
+		edge.From("winner", Team.Type).Ref("wonGames").Unique(),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Identity holds the schema definition for the Identity entity. An identity is an
+// account with a login provider (e.g. Google) that a user can log in with. A user can
+// have one with each provider
+type Identity struct {
+	ent.Schema
+}
+
+// Fields of the Identity.
+func (Identity) Fields() []ent.Field {
+	return []ent.Field{
+		// The name of the provider, as in its login path
+		field.String("provider").Immutable(),
+
+		// The provider's ID for the account, which never changes even if its email does
+		field.String("subject").Immutable(),
+
+		// The account's email when it was last used to log in
+		field.String("email"),
+
+		field.Time("created").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the Identity.
+func (Identity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("identities").Unique().Required(),
+	}
+}
+
+// Indexes of the Identity.
+func (Identity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "subject").Unique(),
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// IngestionRun holds the schema definition for the IngestionRun entity. One is
+// recorded every time we pull data from the stats feed, so operators can see when
+// data was last refreshed and whether anything went wrong
+type IngestionRun struct {
+	ent.Schema
+}
+
+// Fields of the IngestionRun.
+func (IngestionRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("started").Immutable().Default(time.Now),
+		field.Time("finished").Optional().Nillable(), // nil while the run is in progress
+
+		// How many teams, players, games, results and performances were created or
+		// changed
+		field.Int("rowsChanged").NonNegative().Default(0),
+
+		// Everything that went wrong. A run keeps going after most errors, so this
+		// being non-empty doesn't mean that nothing was ingested
+		field.JSON("errors", []string{}).Optional(),
+	}
+}
+
+// Indexes of the IngestionRun.
+func (IngestionRun) Indexes() []ent.Index {
+	return []ent.Index{
+		// The most recent runs
+		index.Fields("started"),
+	}
+}
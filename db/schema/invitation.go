@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Invitation holds the schema definition for the Invitation entity. An invitation is
+// either sent to an email address, in which case only the user with that email can
+// accept it (once), or it is a shareable link that anyone who has it can use to join
+// until it expires or is revoked
+type Invitation struct {
+	ent.Schema
+}
+
+// Fields of the Invitation.
+func (Invitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("email").Optional().Nillable().Immutable(), // nil for links
+
+		// SHA-256 of the link's token, so that a leaked db doesn't leak working links.
+		// nil for email invitations
+		field.String("tokenHash").Optional().Nillable().Immutable().Unique().Sensitive(),
+
+		// Links stay PENDING until they expire or are revoked, since many users can
+		// join through the same link
+		field.Enum("status").
+			Values("PENDING", "ACCEPTED", "DECLINED", "REVOKED").
+			Default("PENDING"),
+
+		field.Time("created").Immutable().Default(time.Now),
+		field.Time("expires").Immutable(),
+	}
+}
+
+// Edges of the Invitation.
+func (Invitation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("league", League.Type).Ref("invitations").Unique().Required(),
+		edge.From("inviter", User.Type).Ref("sentInvitations").Unique().Required(),
+	}
+}
+
+// Indexes of the Invitation.
+func (Invitation) Indexes() []ent.Index {
+	return []ent.Index{
+		// A user's pending invitations
+		index.Fields("email", "status"),
+	}
+}
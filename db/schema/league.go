@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+
+	"github.com/NickDubelman/fantasy-bball/db/schema/schematype"
+)
+
+// League holds the schema definition for the League entity.
+type League struct {
+	ent.Schema
+}
+
+// Fields of the League.
+func (League) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		field.String("description").Default(""),
+		field.Int("maxMembers").Positive().Default(12),
+		field.JSON("statWeights", schematype.StatWeights{}),
+		field.Int("draftRounds").Positive().Default(5),
+		field.Int("pickTimeLimit").Positive().Default(120), // in seconds
+		field.Time("created").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the League.
+func (League) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("memberships", LeagueMembership.Type),
+		edge.To("invitations", Invitation.Type),
+		edge.To("contests", Contest.Type),
+	}
+}
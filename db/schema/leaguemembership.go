@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LeagueMembership holds the schema definition for the LeagueMembership entity. It
+// connects a User to a League and carries the fields of that edge (the member's
+// permissions within the league)
+type LeagueMembership struct {
+	ent.Schema
+}
+
+// Fields of the LeagueMembership.
+func (LeagueMembership) Fields() []ent.Field {
+	return []ent.Field{
+		field.Bool("isCommissioner").Default(false),
+		field.Bool("canInvite").Default(false),
+		field.Time("joined").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the LeagueMembership.
+func (LeagueMembership) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("memberships").Unique().Required(),
+		edge.From("league", League.Type).Ref("memberships").Unique().Required(),
+	}
+}
+
+// Indexes of the LeagueMembership.
+func (LeagueMembership) Indexes() []ent.Index {
+	return []ent.Index{
+		// A user can only be a member of a league once
+		index.Edges("user", "league").Unique(),
+	}
+}
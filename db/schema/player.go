@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Player holds the schema definition for the Player entity.
+type Player struct {
+	ent.Schema
+}
+
+// Fields of the Player.
+func (Player) Fields() []ent.Field {
+	return []ent.Field{
+		// The player's ID in the stats feed we ingest from
+		field.String("externalID").Unique(),
+		field.String("name"),
+	}
+}
+
+// Edges of the Player.
+func (Player) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("team", Team.Type).Ref("players").Unique(), // might not have a team
+		edge.To("performances", PlayerPerformance.Type),
+		edge.To("draftPicks", ContestDraftPick.Type),
+	}
+}
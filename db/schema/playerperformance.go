@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PlayerPerformance holds the schema definition for the PlayerPerformance entity.
+type PlayerPerformance struct {
+	ent.Schema
+}
+
+// Fields of the PlayerPerformance.
+func (PlayerPerformance) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("minutes").Optional().Nillable(), // nil if DNP
+		field.Int("points").Default(0),
+		field.Int("rebounds").Default(0),
+		field.Int("assists").Default(0),
+		field.Int("steals").Default(0),
+		field.Int("blocks").Default(0),
+		field.Int("turnovers").Default(0),
+	}
+}
+
+// Edges of the PlayerPerformance.
+func (PlayerPerformance) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("player", Player.Type).Ref("performances").Unique().Required(),
+		edge.From("game", Game.Type).Ref("performances").Unique().Required(),
+
+		// The team the player played for in this game (players get traded)
+		edge.From("team", Team.Type).Ref("performances").Unique().Required(),
+	}
+}
+
+// Indexes of the PlayerPerformance.
+func (PlayerPerformance) Indexes() []ent.Index {
+	return []ent.Index{
+		// A player only has one performance per game. This is also the key we upsert
+		// box scores from the stats feed by
+		index.Edges("player", "game").Unique(),
+
+		// Performances in a game, by team
+		index.Edges("game", "team"),
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RefreshToken holds the schema definition for the RefreshToken entity. Refresh
+// tokens are rotated every time they are used. All of the tokens descended from the
+// same login form a family, and if a token that was already rotated is used again,
+// someone must have stolen it, so the whole family is revoked
+type RefreshToken struct {
+	ent.Schema
+}
+
+// Fields of the RefreshToken.
+func (RefreshToken) Fields() []ent.Field {
+	return []ent.Field{
+		// SHA-256 of the token. The token itself is never stored
+		field.String("tokenHash").Unique().Immutable().Sensitive(),
+
+		// Shared by every token descended from the same login
+		field.String("family").Immutable(),
+
+		field.Time("created").Immutable().Default(time.Now),
+		field.Time("expires").Immutable(),
+
+		// When the token was used to get a new one. It can't be used again after that
+		field.Time("rotated").Optional().Nillable(),
+
+		// When the token's family was logged out or caught being reused
+		field.Time("revoked").Optional().Nillable(),
+	}
+}
+
+// Edges of the RefreshToken.
+func (RefreshToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("refreshTokens").Unique().Required(),
+	}
+}
+
+// Indexes of the RefreshToken.
+func (RefreshToken) Indexes() []ent.Index {
+	return []ent.Index{
+		// Revoking a family
+		index.Fields("family"),
+
+		// Cleaning up expired tokens
+		index.Fields("expires"),
+	}
+}
@@ -0,0 +1,22 @@
+package schematype
+
+// StatWeights are multipliers for the various stats. A player's fantasy points for a
+// game are the sum of each of their stats multiplied by the league's weight for it
+type StatWeights struct {
+	Points    int `json:"points"`
+	Rebounds  int `json:"rebounds"`
+	Assists   int `json:"assists"`
+	Steals    int `json:"steals"`
+	Blocks    int `json:"blocks"`
+	Turnovers int `json:"turnovers"`
+}
+
+// DefaultStatWeights are the weights a league starts with if none are specified
+var DefaultStatWeights = StatWeights{
+	Points:    1,
+	Rebounds:  1,
+	Assists:   2,
+	Steals:    3,
+	Blocks:    3,
+	Turnovers: -2,
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Team holds the schema definition for the Team entity.
+type Team struct {
+	ent.Schema
+}
+
+// Fields of the Team.
+func (Team) Fields() []ent.Field {
+	return []ent.Field{
+		// The team's ID in the stats feed we ingest from
+		field.String("externalID").Unique(),
+		field.String("shortName"), // ex: LAL
+		field.String("location"),  // ex: Los Angeles
+		field.String("name"),      // ex: Lakers
+	}
+}
+
+// Edges of the Team.
+func (Team) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("players", Player.Type),
+		edge.To("homeGames", Game.Type),
+		edge.To("awayGames", Game.Type),
+		edge.To("wonGames", GameResult.Type),
+		edge.To("performances", PlayerPerformance.Type),
+	}
+}
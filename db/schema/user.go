@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
 
@@ -25,5 +26,16 @@ func (User) Fields() []ent.Field {
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		// The leagues a user belongs to, along with their permissions in each
+		edge.To("memberships", LeagueMembership.Type),
+		edge.To("sentInvitations", Invitation.Type),
+		edge.To("identities", Identity.Type),
+		edge.To("authCodes", AuthCode.Type),
+		edge.To("refreshTokens", RefreshToken.Type),
+
+		edge.To("draftPicks", ContestDraftPick.Type),
+		edge.To("contestEntries", ContestEntry.Type),
+		edge.To("wonContests", Contest.Type),
+	}
 }
@@ -0,0 +1,409 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/game"
+	"github.com/NickDubelman/fantasy-bball/db/gameresult"
+	"github.com/NickDubelman/fantasy-bball/db/player"
+	"github.com/NickDubelman/fantasy-bball/db/playerperformance"
+	"github.com/NickDubelman/fantasy-bball/db/team"
+)
+
+// TeamCreate is the builder for creating a Team entity.
+type TeamCreate struct {
+	config
+	mutation *TeamMutation
+	hooks    []Hook
+}
+
+// SetExternalID sets the "externalID" field.
+func (tc *TeamCreate) SetExternalID(s string) *TeamCreate {
+	tc.mutation.SetExternalID(s)
+	return tc
+}
+
+// SetShortName sets the "shortName" field.
+func (tc *TeamCreate) SetShortName(s string) *TeamCreate {
+	tc.mutation.SetShortName(s)
+	return tc
+}
+
+// SetLocation sets the "location" field.
+func (tc *TeamCreate) SetLocation(s string) *TeamCreate {
+	tc.mutation.SetLocation(s)
+	return tc
+}
+
+// SetName sets the "name" field.
+func (tc *TeamCreate) SetName(s string) *TeamCreate {
+	tc.mutation.SetName(s)
+	return tc
+}
+
+// AddPlayerIDs adds the "players" edge to the Player entity by IDs.
+func (tc *TeamCreate) AddPlayerIDs(ids ...int) *TeamCreate {
+	tc.mutation.AddPlayerIDs(ids...)
+	return tc
+}
+
+// AddPlayers adds the "players" edges to the Player entity.
+func (tc *TeamCreate) AddPlayers(p ...*Player) *TeamCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tc.AddPlayerIDs(ids...)
+}
+
+// AddHomeGameIDs adds the "homeGames" edge to the Game entity by IDs.
+func (tc *TeamCreate) AddHomeGameIDs(ids ...int) *TeamCreate {
+	tc.mutation.AddHomeGameIDs(ids...)
+	return tc
+}
+
+// AddHomeGames adds the "homeGames" edges to the Game entity.
+func (tc *TeamCreate) AddHomeGames(g ...*Game) *TeamCreate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return tc.AddHomeGameIDs(ids...)
+}
+
+// AddAwayGameIDs adds the "awayGames" edge to the Game entity by IDs.
+func (tc *TeamCreate) AddAwayGameIDs(ids ...int) *TeamCreate {
+	tc.mutation.AddAwayGameIDs(ids...)
+	return tc
+}
+
+// AddAwayGames adds the "awayGames" edges to the Game entity.
+func (tc *TeamCreate) AddAwayGames(g ...*Game) *TeamCreate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return tc.AddAwayGameIDs(ids...)
+}
+
+// AddWonGameIDs adds the "wonGames" edge to the GameResult entity by IDs.
+func (tc *TeamCreate) AddWonGameIDs(ids ...int) *TeamCreate {
+	tc.mutation.AddWonGameIDs(ids...)
+	return tc
+}
+
+// AddWonGames adds the "wonGames" edges to the GameResult entity.
+func (tc *TeamCreate) AddWonGames(g ...*GameResult) *TeamCreate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return tc.AddWonGameIDs(ids...)
+}
+
+// AddPerformanceIDs adds the "performances" edge to the PlayerPerformance entity by IDs.
+func (tc *TeamCreate) AddPerformanceIDs(ids ...int) *TeamCreate {
+	tc.mutation.AddPerformanceIDs(ids...)
+	return tc
+}
+
+// AddPerformances adds the "performances" edges to the PlayerPerformance entity.
+func (tc *TeamCreate) AddPerformances(p ...*PlayerPerformance) *TeamCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tc.AddPerformanceIDs(ids...)
+}
+
+// Mutation returns the TeamMutation object of the builder.
+func (tc *TeamCreate) Mutation() *TeamMutation {
+	return tc.mutation
+}
+
+// Save creates the Team in the database.
+func (tc *TeamCreate) Save(ctx context.Context) (*Team, error) {
+	var (
+		err  error
+		node *Team
+	)
+	if len(tc.hooks) == 0 {
+		if err = tc.check(); err != nil {
+			return nil, err
+		}
+		node, err = tc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TeamMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = tc.check(); err != nil {
+				return nil, err
+			}
+			tc.mutation = mutation
+			node, err = tc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(tc.hooks) - 1; i >= 0; i-- {
+			mut = tc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tc *TeamCreate) SaveX(ctx context.Context) *Team {
+	v, err := tc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tc *TeamCreate) check() error {
+	if _, ok := tc.mutation.ExternalID(); !ok {
+		return &ValidationError{Name: "externalID", err: errors.New("db: missing required field \"externalID\"")}
+	}
+	if _, ok := tc.mutation.ShortName(); !ok {
+		return &ValidationError{Name: "shortName", err: errors.New("db: missing required field \"shortName\"")}
+	}
+	if _, ok := tc.mutation.Location(); !ok {
+		return &ValidationError{Name: "location", err: errors.New("db: missing required field \"location\"")}
+	}
+	if _, ok := tc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New("db: missing required field \"name\"")}
+	}
+	return nil
+}
+
+func (tc *TeamCreate) sqlSave(ctx context.Context) (*Team, error) {
+	_node, _spec := tc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (tc *TeamCreate) createSpec() (*Team, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Team{config: tc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: team.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: team.FieldID,
+			},
+		}
+	)
+	if value, ok := tc.mutation.ExternalID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: team.FieldExternalID,
+		})
+		_node.ExternalID = value
+	}
+	if value, ok := tc.mutation.ShortName(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: team.FieldShortName,
+		})
+		_node.ShortName = value
+	}
+	if value, ok := tc.mutation.Location(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: team.FieldLocation,
+		})
+		_node.Location = value
+	}
+	if value, ok := tc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: team.FieldName,
+		})
+		_node.Name = value
+	}
+	if nodes := tc.mutation.PlayersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.PlayersTable,
+			Columns: []string{team.PlayersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.HomeGamesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.HomeGamesTable,
+			Columns: []string{team.HomeGamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.AwayGamesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.AwayGamesTable,
+			Columns: []string{team.AwayGamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.WonGamesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WonGamesTable,
+			Columns: []string{team.WonGamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: gameresult.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.PerformancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.PerformancesTable,
+			Columns: []string{team.PerformancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: playerperformance.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TeamCreateBulk is the builder for creating many Team entities in bulk.
+type TeamCreateBulk struct {
+	config
+	builders []*TeamCreate
+}
+
+// Save creates the Team entities in the database.
+func (tcb *TeamCreateBulk) Save(ctx context.Context) ([]*Team, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
+	nodes := make([]*Team, len(tcb.builders))
+	mutators := make([]Mutator, len(tcb.builders))
+	for i := range tcb.builders {
+		func(i int, root context.Context) {
+			builder := tcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TeamMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcb *TeamCreateBulk) SaveX(ctx context.Context) []*Team {
+	v, err := tcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
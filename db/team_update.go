@@ -0,0 +1,1220 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/game"
+	"github.com/NickDubelman/fantasy-bball/db/gameresult"
+	"github.com/NickDubelman/fantasy-bball/db/player"
+	"github.com/NickDubelman/fantasy-bball/db/playerperformance"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+	"github.com/NickDubelman/fantasy-bball/db/team"
+)
+
+// TeamUpdate is the builder for updating Team entities.
+type TeamUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TeamMutation
+}
+
+// Where adds a new predicate for the TeamUpdate builder.
+func (tu *TeamUpdate) Where(ps ...predicate.Team) *TeamUpdate {
+	tu.mutation.predicates = append(tu.mutation.predicates, ps...)
+	return tu
+}
+
+// SetExternalID sets the "externalID" field.
+func (tu *TeamUpdate) SetExternalID(s string) *TeamUpdate {
+	tu.mutation.SetExternalID(s)
+	return tu
+}
+
+// SetShortName sets the "shortName" field.
+func (tu *TeamUpdate) SetShortName(s string) *TeamUpdate {
+	tu.mutation.SetShortName(s)
+	return tu
+}
+
+// SetLocation sets the "location" field.
+func (tu *TeamUpdate) SetLocation(s string) *TeamUpdate {
+	tu.mutation.SetLocation(s)
+	return tu
+}
+
+// SetName sets the "name" field.
+func (tu *TeamUpdate) SetName(s string) *TeamUpdate {
+	tu.mutation.SetName(s)
+	return tu
+}
+
+// AddPlayerIDs adds the "players" edge to the Player entity by IDs.
+func (tu *TeamUpdate) AddPlayerIDs(ids ...int) *TeamUpdate {
+	tu.mutation.AddPlayerIDs(ids...)
+	return tu
+}
+
+// AddPlayers adds the "players" edges to the Player entity.
+func (tu *TeamUpdate) AddPlayers(p ...*Player) *TeamUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tu.AddPlayerIDs(ids...)
+}
+
+// AddHomeGameIDs adds the "homeGames" edge to the Game entity by IDs.
+func (tu *TeamUpdate) AddHomeGameIDs(ids ...int) *TeamUpdate {
+	tu.mutation.AddHomeGameIDs(ids...)
+	return tu
+}
+
+// AddHomeGames adds the "homeGames" edges to the Game entity.
+func (tu *TeamUpdate) AddHomeGames(g ...*Game) *TeamUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return tu.AddHomeGameIDs(ids...)
+}
+
+// AddAwayGameIDs adds the "awayGames" edge to the Game entity by IDs.
+func (tu *TeamUpdate) AddAwayGameIDs(ids ...int) *TeamUpdate {
+	tu.mutation.AddAwayGameIDs(ids...)
+	return tu
+}
+
+// AddAwayGames adds the "awayGames" edges to the Game entity.
+func (tu *TeamUpdate) AddAwayGames(g ...*Game) *TeamUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return tu.AddAwayGameIDs(ids...)
+}
+
+// AddWonGameIDs adds the "wonGames" edge to the GameResult entity by IDs.
+func (tu *TeamUpdate) AddWonGameIDs(ids ...int) *TeamUpdate {
+	tu.mutation.AddWonGameIDs(ids...)
+	return tu
+}
+
+// AddWonGames adds the "wonGames" edges to the GameResult entity.
+func (tu *TeamUpdate) AddWonGames(g ...*GameResult) *TeamUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return tu.AddWonGameIDs(ids...)
+}
+
+// AddPerformanceIDs adds the "performances" edge to the PlayerPerformance entity by IDs.
+func (tu *TeamUpdate) AddPerformanceIDs(ids ...int) *TeamUpdate {
+	tu.mutation.AddPerformanceIDs(ids...)
+	return tu
+}
+
+// AddPerformances adds the "performances" edges to the PlayerPerformance entity.
+func (tu *TeamUpdate) AddPerformances(p ...*PlayerPerformance) *TeamUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tu.AddPerformanceIDs(ids...)
+}
+
+// Mutation returns the TeamMutation object of the builder.
+func (tu *TeamUpdate) Mutation() *TeamMutation {
+	return tu.mutation
+}
+
+// ClearPlayers clears all "players" edges to the Player entity.
+func (tu *TeamUpdate) ClearPlayers() *TeamUpdate {
+	tu.mutation.ClearPlayers()
+	return tu
+}
+
+// RemovePlayerIDs removes the "players" edge to Player entities by IDs.
+func (tu *TeamUpdate) RemovePlayerIDs(ids ...int) *TeamUpdate {
+	tu.mutation.RemovePlayerIDs(ids...)
+	return tu
+}
+
+// RemovePlayers removes "players" edges to Player entities.
+func (tu *TeamUpdate) RemovePlayers(p ...*Player) *TeamUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tu.RemovePlayerIDs(ids...)
+}
+
+// ClearHomeGames clears all "homeGames" edges to the Game entity.
+func (tu *TeamUpdate) ClearHomeGames() *TeamUpdate {
+	tu.mutation.ClearHomeGames()
+	return tu
+}
+
+// RemoveHomeGameIDs removes the "homeGames" edge to Game entities by IDs.
+func (tu *TeamUpdate) RemoveHomeGameIDs(ids ...int) *TeamUpdate {
+	tu.mutation.RemoveHomeGameIDs(ids...)
+	return tu
+}
+
+// RemoveHomeGames removes "homeGames" edges to Game entities.
+func (tu *TeamUpdate) RemoveHomeGames(g ...*Game) *TeamUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return tu.RemoveHomeGameIDs(ids...)
+}
+
+// ClearAwayGames clears all "awayGames" edges to the Game entity.
+func (tu *TeamUpdate) ClearAwayGames() *TeamUpdate {
+	tu.mutation.ClearAwayGames()
+	return tu
+}
+
+// RemoveAwayGameIDs removes the "awayGames" edge to Game entities by IDs.
+func (tu *TeamUpdate) RemoveAwayGameIDs(ids ...int) *TeamUpdate {
+	tu.mutation.RemoveAwayGameIDs(ids...)
+	return tu
+}
+
+// RemoveAwayGames removes "awayGames" edges to Game entities.
+func (tu *TeamUpdate) RemoveAwayGames(g ...*Game) *TeamUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return tu.RemoveAwayGameIDs(ids...)
+}
+
+// ClearWonGames clears all "wonGames" edges to the GameResult entity.
+func (tu *TeamUpdate) ClearWonGames() *TeamUpdate {
+	tu.mutation.ClearWonGames()
+	return tu
+}
+
+// RemoveWonGameIDs removes the "wonGames" edge to GameResult entities by IDs.
+func (tu *TeamUpdate) RemoveWonGameIDs(ids ...int) *TeamUpdate {
+	tu.mutation.RemoveWonGameIDs(ids...)
+	return tu
+}
+
+// RemoveWonGames removes "wonGames" edges to GameResult entities.
+func (tu *TeamUpdate) RemoveWonGames(g ...*GameResult) *TeamUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return tu.RemoveWonGameIDs(ids...)
+}
+
+// ClearPerformances clears all "performances" edges to the PlayerPerformance entity.
+func (tu *TeamUpdate) ClearPerformances() *TeamUpdate {
+	tu.mutation.ClearPerformances()
+	return tu
+}
+
+// RemovePerformanceIDs removes the "performances" edge to PlayerPerformance entities by IDs.
+func (tu *TeamUpdate) RemovePerformanceIDs(ids ...int) *TeamUpdate {
+	tu.mutation.RemovePerformanceIDs(ids...)
+	return tu
+}
+
+// RemovePerformances removes "performances" edges to PlayerPerformance entities.
+func (tu *TeamUpdate) RemovePerformances(p ...*PlayerPerformance) *TeamUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tu.RemovePerformanceIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tu *TeamUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(tu.hooks) == 0 {
+		affected, err = tu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TeamMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			tu.mutation = mutation
+			affected, err = tu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(tu.hooks) - 1; i >= 0; i-- {
+			mut = tu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tu *TeamUpdate) SaveX(ctx context.Context) int {
+	affected, err := tu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tu *TeamUpdate) Exec(ctx context.Context) error {
+	_, err := tu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tu *TeamUpdate) ExecX(ctx context.Context) {
+	if err := tu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (tu *TeamUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   team.Table,
+			Columns: team.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: team.FieldID,
+			},
+		},
+	}
+	if ps := tu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tu.mutation.ExternalID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: team.FieldExternalID,
+		})
+	}
+	if value, ok := tu.mutation.ShortName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: team.FieldShortName,
+		})
+	}
+	if value, ok := tu.mutation.Location(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: team.FieldLocation,
+		})
+	}
+	if value, ok := tu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: team.FieldName,
+		})
+	}
+	if tu.mutation.PlayersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.PlayersTable,
+			Columns: []string{team.PlayersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedPlayersIDs(); len(nodes) > 0 && !tu.mutation.PlayersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.PlayersTable,
+			Columns: []string{team.PlayersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.PlayersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.PlayersTable,
+			Columns: []string{team.PlayersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.HomeGamesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.HomeGamesTable,
+			Columns: []string{team.HomeGamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedHomeGamesIDs(); len(nodes) > 0 && !tu.mutation.HomeGamesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.HomeGamesTable,
+			Columns: []string{team.HomeGamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.HomeGamesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.HomeGamesTable,
+			Columns: []string{team.HomeGamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.AwayGamesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.AwayGamesTable,
+			Columns: []string{team.AwayGamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedAwayGamesIDs(); len(nodes) > 0 && !tu.mutation.AwayGamesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.AwayGamesTable,
+			Columns: []string{team.AwayGamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.AwayGamesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.AwayGamesTable,
+			Columns: []string{team.AwayGamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.WonGamesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WonGamesTable,
+			Columns: []string{team.WonGamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: gameresult.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedWonGamesIDs(); len(nodes) > 0 && !tu.mutation.WonGamesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WonGamesTable,
+			Columns: []string{team.WonGamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: gameresult.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.WonGamesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WonGamesTable,
+			Columns: []string{team.WonGamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: gameresult.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.PerformancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.PerformancesTable,
+			Columns: []string{team.PerformancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: playerperformance.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedPerformancesIDs(); len(nodes) > 0 && !tu.mutation.PerformancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.PerformancesTable,
+			Columns: []string{team.PerformancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: playerperformance.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.PerformancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.PerformancesTable,
+			Columns: []string{team.PerformancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: playerperformance.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{team.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// TeamUpdateOne is the builder for updating a single Team entity.
+type TeamUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *TeamMutation
+}
+
+// SetExternalID sets the "externalID" field.
+func (tuo *TeamUpdateOne) SetExternalID(s string) *TeamUpdateOne {
+	tuo.mutation.SetExternalID(s)
+	return tuo
+}
+
+// SetShortName sets the "shortName" field.
+func (tuo *TeamUpdateOne) SetShortName(s string) *TeamUpdateOne {
+	tuo.mutation.SetShortName(s)
+	return tuo
+}
+
+// SetLocation sets the "location" field.
+func (tuo *TeamUpdateOne) SetLocation(s string) *TeamUpdateOne {
+	tuo.mutation.SetLocation(s)
+	return tuo
+}
+
+// SetName sets the "name" field.
+func (tuo *TeamUpdateOne) SetName(s string) *TeamUpdateOne {
+	tuo.mutation.SetName(s)
+	return tuo
+}
+
+// AddPlayerIDs adds the "players" edge to the Player entity by IDs.
+func (tuo *TeamUpdateOne) AddPlayerIDs(ids ...int) *TeamUpdateOne {
+	tuo.mutation.AddPlayerIDs(ids...)
+	return tuo
+}
+
+// AddPlayers adds the "players" edges to the Player entity.
+func (tuo *TeamUpdateOne) AddPlayers(p ...*Player) *TeamUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tuo.AddPlayerIDs(ids...)
+}
+
+// AddHomeGameIDs adds the "homeGames" edge to the Game entity by IDs.
+func (tuo *TeamUpdateOne) AddHomeGameIDs(ids ...int) *TeamUpdateOne {
+	tuo.mutation.AddHomeGameIDs(ids...)
+	return tuo
+}
+
+// AddHomeGames adds the "homeGames" edges to the Game entity.
+func (tuo *TeamUpdateOne) AddHomeGames(g ...*Game) *TeamUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return tuo.AddHomeGameIDs(ids...)
+}
+
+// AddAwayGameIDs adds the "awayGames" edge to the Game entity by IDs.
+func (tuo *TeamUpdateOne) AddAwayGameIDs(ids ...int) *TeamUpdateOne {
+	tuo.mutation.AddAwayGameIDs(ids...)
+	return tuo
+}
+
+// AddAwayGames adds the "awayGames" edges to the Game entity.
+func (tuo *TeamUpdateOne) AddAwayGames(g ...*Game) *TeamUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return tuo.AddAwayGameIDs(ids...)
+}
+
+// AddWonGameIDs adds the "wonGames" edge to the GameResult entity by IDs.
+func (tuo *TeamUpdateOne) AddWonGameIDs(ids ...int) *TeamUpdateOne {
+	tuo.mutation.AddWonGameIDs(ids...)
+	return tuo
+}
+
+// AddWonGames adds the "wonGames" edges to the GameResult entity.
+func (tuo *TeamUpdateOne) AddWonGames(g ...*GameResult) *TeamUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return tuo.AddWonGameIDs(ids...)
+}
+
+// AddPerformanceIDs adds the "performances" edge to the PlayerPerformance entity by IDs.
+func (tuo *TeamUpdateOne) AddPerformanceIDs(ids ...int) *TeamUpdateOne {
+	tuo.mutation.AddPerformanceIDs(ids...)
+	return tuo
+}
+
+// AddPerformances adds the "performances" edges to the PlayerPerformance entity.
+func (tuo *TeamUpdateOne) AddPerformances(p ...*PlayerPerformance) *TeamUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tuo.AddPerformanceIDs(ids...)
+}
+
+// Mutation returns the TeamMutation object of the builder.
+func (tuo *TeamUpdateOne) Mutation() *TeamMutation {
+	return tuo.mutation
+}
+
+// ClearPlayers clears all "players" edges to the Player entity.
+func (tuo *TeamUpdateOne) ClearPlayers() *TeamUpdateOne {
+	tuo.mutation.ClearPlayers()
+	return tuo
+}
+
+// RemovePlayerIDs removes the "players" edge to Player entities by IDs.
+func (tuo *TeamUpdateOne) RemovePlayerIDs(ids ...int) *TeamUpdateOne {
+	tuo.mutation.RemovePlayerIDs(ids...)
+	return tuo
+}
+
+// RemovePlayers removes "players" edges to Player entities.
+func (tuo *TeamUpdateOne) RemovePlayers(p ...*Player) *TeamUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tuo.RemovePlayerIDs(ids...)
+}
+
+// ClearHomeGames clears all "homeGames" edges to the Game entity.
+func (tuo *TeamUpdateOne) ClearHomeGames() *TeamUpdateOne {
+	tuo.mutation.ClearHomeGames()
+	return tuo
+}
+
+// RemoveHomeGameIDs removes the "homeGames" edge to Game entities by IDs.
+func (tuo *TeamUpdateOne) RemoveHomeGameIDs(ids ...int) *TeamUpdateOne {
+	tuo.mutation.RemoveHomeGameIDs(ids...)
+	return tuo
+}
+
+// RemoveHomeGames removes "homeGames" edges to Game entities.
+func (tuo *TeamUpdateOne) RemoveHomeGames(g ...*Game) *TeamUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return tuo.RemoveHomeGameIDs(ids...)
+}
+
+// ClearAwayGames clears all "awayGames" edges to the Game entity.
+func (tuo *TeamUpdateOne) ClearAwayGames() *TeamUpdateOne {
+	tuo.mutation.ClearAwayGames()
+	return tuo
+}
+
+// RemoveAwayGameIDs removes the "awayGames" edge to Game entities by IDs.
+func (tuo *TeamUpdateOne) RemoveAwayGameIDs(ids ...int) *TeamUpdateOne {
+	tuo.mutation.RemoveAwayGameIDs(ids...)
+	return tuo
+}
+
+// RemoveAwayGames removes "awayGames" edges to Game entities.
+func (tuo *TeamUpdateOne) RemoveAwayGames(g ...*Game) *TeamUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return tuo.RemoveAwayGameIDs(ids...)
+}
+
+// ClearWonGames clears all "wonGames" edges to the GameResult entity.
+func (tuo *TeamUpdateOne) ClearWonGames() *TeamUpdateOne {
+	tuo.mutation.ClearWonGames()
+	return tuo
+}
+
+// RemoveWonGameIDs removes the "wonGames" edge to GameResult entities by IDs.
+func (tuo *TeamUpdateOne) RemoveWonGameIDs(ids ...int) *TeamUpdateOne {
+	tuo.mutation.RemoveWonGameIDs(ids...)
+	return tuo
+}
+
+// RemoveWonGames removes "wonGames" edges to GameResult entities.
+func (tuo *TeamUpdateOne) RemoveWonGames(g ...*GameResult) *TeamUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return tuo.RemoveWonGameIDs(ids...)
+}
+
+// ClearPerformances clears all "performances" edges to the PlayerPerformance entity.
+func (tuo *TeamUpdateOne) ClearPerformances() *TeamUpdateOne {
+	tuo.mutation.ClearPerformances()
+	return tuo
+}
+
+// RemovePerformanceIDs removes the "performances" edge to PlayerPerformance entities by IDs.
+func (tuo *TeamUpdateOne) RemovePerformanceIDs(ids ...int) *TeamUpdateOne {
+	tuo.mutation.RemovePerformanceIDs(ids...)
+	return tuo
+}
+
+// RemovePerformances removes "performances" edges to PlayerPerformance entities.
+func (tuo *TeamUpdateOne) RemovePerformances(p ...*PlayerPerformance) *TeamUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tuo.RemovePerformanceIDs(ids...)
+}
+
+// Save executes the query and returns the updated Team entity.
+func (tuo *TeamUpdateOne) Save(ctx context.Context) (*Team, error) {
+	var (
+		err  error
+		node *Team
+	)
+	if len(tuo.hooks) == 0 {
+		node, err = tuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TeamMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			tuo.mutation = mutation
+			node, err = tuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(tuo.hooks) - 1; i >= 0; i-- {
+			mut = tuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tuo *TeamUpdateOne) SaveX(ctx context.Context) *Team {
+	node, err := tuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tuo *TeamUpdateOne) Exec(ctx context.Context) error {
+	_, err := tuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tuo *TeamUpdateOne) ExecX(ctx context.Context) {
+	if err := tuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (tuo *TeamUpdateOne) sqlSave(ctx context.Context) (_node *Team, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   team.Table,
+			Columns: team.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: team.FieldID,
+			},
+		},
+	}
+	id, ok := tuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Team.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := tuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tuo.mutation.ExternalID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: team.FieldExternalID,
+		})
+	}
+	if value, ok := tuo.mutation.ShortName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: team.FieldShortName,
+		})
+	}
+	if value, ok := tuo.mutation.Location(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: team.FieldLocation,
+		})
+	}
+	if value, ok := tuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: team.FieldName,
+		})
+	}
+	if tuo.mutation.PlayersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.PlayersTable,
+			Columns: []string{team.PlayersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedPlayersIDs(); len(nodes) > 0 && !tuo.mutation.PlayersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.PlayersTable,
+			Columns: []string{team.PlayersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.PlayersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.PlayersTable,
+			Columns: []string{team.PlayersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.HomeGamesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.HomeGamesTable,
+			Columns: []string{team.HomeGamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedHomeGamesIDs(); len(nodes) > 0 && !tuo.mutation.HomeGamesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.HomeGamesTable,
+			Columns: []string{team.HomeGamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.HomeGamesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.HomeGamesTable,
+			Columns: []string{team.HomeGamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.AwayGamesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.AwayGamesTable,
+			Columns: []string{team.AwayGamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedAwayGamesIDs(); len(nodes) > 0 && !tuo.mutation.AwayGamesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.AwayGamesTable,
+			Columns: []string{team.AwayGamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.AwayGamesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.AwayGamesTable,
+			Columns: []string{team.AwayGamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.WonGamesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WonGamesTable,
+			Columns: []string{team.WonGamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: gameresult.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedWonGamesIDs(); len(nodes) > 0 && !tuo.mutation.WonGamesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WonGamesTable,
+			Columns: []string{team.WonGamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: gameresult.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.WonGamesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WonGamesTable,
+			Columns: []string{team.WonGamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: gameresult.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.PerformancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.PerformancesTable,
+			Columns: []string{team.PerformancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: playerperformance.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedPerformancesIDs(); len(nodes) > 0 && !tuo.mutation.PerformancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.PerformancesTable,
+			Columns: []string{team.PerformancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: playerperformance.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.PerformancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.PerformancesTable,
+			Columns: []string{team.PerformancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: playerperformance.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Team{config: tuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{team.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -12,6 +12,40 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AuthCode is the client for interacting with the AuthCode builders.
+	AuthCode *AuthCodeClient
+	// Contest is the client for interacting with the Contest builders.
+	Contest *ContestClient
+	// ContestDraft is the client for interacting with the ContestDraft builders.
+	ContestDraft *ContestDraftClient
+	// ContestDraftPick is the client for interacting with the ContestDraftPick builders.
+	ContestDraftPick *ContestDraftPickClient
+	// ContestEntry is the client for interacting with the ContestEntry builders.
+	ContestEntry *ContestEntryClient
+	// ContestEntryCorrection is the client for interacting with the ContestEntryCorrection builders.
+	ContestEntryCorrection *ContestEntryCorrectionClient
+	// Game is the client for interacting with the Game builders.
+	Game *GameClient
+	// GameResult is the client for interacting with the GameResult builders.
+	GameResult *GameResultClient
+	// Identity is the client for interacting with the Identity builders.
+	Identity *IdentityClient
+	// IngestionRun is the client for interacting with the IngestionRun builders.
+	IngestionRun *IngestionRunClient
+	// Invitation is the client for interacting with the Invitation builders.
+	Invitation *InvitationClient
+	// League is the client for interacting with the League builders.
+	League *LeagueClient
+	// LeagueMembership is the client for interacting with the LeagueMembership builders.
+	LeagueMembership *LeagueMembershipClient
+	// Player is the client for interacting with the Player builders.
+	Player *PlayerClient
+	// PlayerPerformance is the client for interacting with the PlayerPerformance builders.
+	PlayerPerformance *PlayerPerformanceClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
+	// Team is the client for interacting with the Team builders.
+	Team *TeamClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -149,6 +183,23 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AuthCode = NewAuthCodeClient(tx.config)
+	tx.Contest = NewContestClient(tx.config)
+	tx.ContestDraft = NewContestDraftClient(tx.config)
+	tx.ContestDraftPick = NewContestDraftPickClient(tx.config)
+	tx.ContestEntry = NewContestEntryClient(tx.config)
+	tx.ContestEntryCorrection = NewContestEntryCorrectionClient(tx.config)
+	tx.Game = NewGameClient(tx.config)
+	tx.GameResult = NewGameResultClient(tx.config)
+	tx.Identity = NewIdentityClient(tx.config)
+	tx.IngestionRun = NewIngestionRunClient(tx.config)
+	tx.Invitation = NewInvitationClient(tx.config)
+	tx.League = NewLeagueClient(tx.config)
+	tx.LeagueMembership = NewLeagueMembershipClient(tx.config)
+	tx.Player = NewPlayerClient(tx.config)
+	tx.PlayerPerformance = NewPlayerPerformanceClient(tx.config)
+	tx.RefreshToken = NewRefreshTokenClient(tx.config)
+	tx.Team = NewTeamClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -159,7 +210,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: User.QueryXXX(), the query will be executed
+// applies a query, for example: AuthCode.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
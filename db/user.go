@@ -26,6 +26,104 @@ type User struct {
 	Joined time.Time `json:"joined,omitempty"`
 	// LastActive holds the value of the "lastActive" field.
 	LastActive time.Time `json:"lastActive,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserQuery when eager-loading is set.
+	Edges UserEdges `json:"edges"`
+}
+
+// UserEdges holds the relations/edges for other nodes in the graph.
+type UserEdges struct {
+	// Memberships holds the value of the memberships edge.
+	Memberships []*LeagueMembership `json:"memberships,omitempty"`
+	// SentInvitations holds the value of the sentInvitations edge.
+	SentInvitations []*Invitation `json:"sentInvitations,omitempty"`
+	// Identities holds the value of the identities edge.
+	Identities []*Identity `json:"identities,omitempty"`
+	// AuthCodes holds the value of the authCodes edge.
+	AuthCodes []*AuthCode `json:"authCodes,omitempty"`
+	// RefreshTokens holds the value of the refreshTokens edge.
+	RefreshTokens []*RefreshToken `json:"refreshTokens,omitempty"`
+	// DraftPicks holds the value of the draftPicks edge.
+	DraftPicks []*ContestDraftPick `json:"draftPicks,omitempty"`
+	// ContestEntries holds the value of the contestEntries edge.
+	ContestEntries []*ContestEntry `json:"contestEntries,omitempty"`
+	// WonContests holds the value of the wonContests edge.
+	WonContests []*Contest `json:"wonContests,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [8]bool
+}
+
+// MembershipsOrErr returns the Memberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MembershipsOrErr() ([]*LeagueMembership, error) {
+	if e.loadedTypes[0] {
+		return e.Memberships, nil
+	}
+	return nil, &NotLoadedError{edge: "memberships"}
+}
+
+// SentInvitationsOrErr returns the SentInvitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SentInvitationsOrErr() ([]*Invitation, error) {
+	if e.loadedTypes[1] {
+		return e.SentInvitations, nil
+	}
+	return nil, &NotLoadedError{edge: "sentInvitations"}
+}
+
+// IdentitiesOrErr returns the Identities value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) IdentitiesOrErr() ([]*Identity, error) {
+	if e.loadedTypes[2] {
+		return e.Identities, nil
+	}
+	return nil, &NotLoadedError{edge: "identities"}
+}
+
+// AuthCodesOrErr returns the AuthCodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AuthCodesOrErr() ([]*AuthCode, error) {
+	if e.loadedTypes[3] {
+		return e.AuthCodes, nil
+	}
+	return nil, &NotLoadedError{edge: "authCodes"}
+}
+
+// RefreshTokensOrErr returns the RefreshTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RefreshTokensOrErr() ([]*RefreshToken, error) {
+	if e.loadedTypes[4] {
+		return e.RefreshTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "refreshTokens"}
+}
+
+// DraftPicksOrErr returns the DraftPicks value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) DraftPicksOrErr() ([]*ContestDraftPick, error) {
+	if e.loadedTypes[5] {
+		return e.DraftPicks, nil
+	}
+	return nil, &NotLoadedError{edge: "draftPicks"}
+}
+
+// ContestEntriesOrErr returns the ContestEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ContestEntriesOrErr() ([]*ContestEntry, error) {
+	if e.loadedTypes[6] {
+		return e.ContestEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "contestEntries"}
+}
+
+// WonContestsOrErr returns the WonContests value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) WonContestsOrErr() ([]*Contest, error) {
+	if e.loadedTypes[7] {
+		return e.WonContests, nil
+	}
+	return nil, &NotLoadedError{edge: "wonContests"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -95,6 +193,46 @@ func (u *User) assignValues(columns []string, values []interface{}) error {
 	return nil
 }
 
+// QueryMemberships queries the "memberships" edge of the User entity.
+func (u *User) QueryMemberships() *LeagueMembershipQuery {
+	return (&UserClient{config: u.config}).QueryMemberships(u)
+}
+
+// QuerySentInvitations queries the "sentInvitations" edge of the User entity.
+func (u *User) QuerySentInvitations() *InvitationQuery {
+	return (&UserClient{config: u.config}).QuerySentInvitations(u)
+}
+
+// QueryIdentities queries the "identities" edge of the User entity.
+func (u *User) QueryIdentities() *IdentityQuery {
+	return (&UserClient{config: u.config}).QueryIdentities(u)
+}
+
+// QueryAuthCodes queries the "authCodes" edge of the User entity.
+func (u *User) QueryAuthCodes() *AuthCodeQuery {
+	return (&UserClient{config: u.config}).QueryAuthCodes(u)
+}
+
+// QueryRefreshTokens queries the "refreshTokens" edge of the User entity.
+func (u *User) QueryRefreshTokens() *RefreshTokenQuery {
+	return (&UserClient{config: u.config}).QueryRefreshTokens(u)
+}
+
+// QueryDraftPicks queries the "draftPicks" edge of the User entity.
+func (u *User) QueryDraftPicks() *ContestDraftPickQuery {
+	return (&UserClient{config: u.config}).QueryDraftPicks(u)
+}
+
+// QueryContestEntries queries the "contestEntries" edge of the User entity.
+func (u *User) QueryContestEntries() *ContestEntryQuery {
+	return (&UserClient{config: u.config}).QueryContestEntries(u)
+}
+
+// QueryWonContests queries the "wonContests" edge of the User entity.
+func (u *User) QueryWonContests() *ContestQuery {
+	return (&UserClient{config: u.config}).QueryWonContests(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
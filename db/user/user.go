@@ -21,8 +21,80 @@ const (
 	FieldJoined = "joined"
 	// FieldLastActive holds the string denoting the lastactive field in the database.
 	FieldLastActive = "last_active"
+	// EdgeMemberships holds the string denoting the memberships edge name in mutations.
+	EdgeMemberships = "memberships"
+	// EdgeSentInvitations holds the string denoting the sentinvitations edge name in mutations.
+	EdgeSentInvitations = "sentInvitations"
+	// EdgeIdentities holds the string denoting the identities edge name in mutations.
+	EdgeIdentities = "identities"
+	// EdgeAuthCodes holds the string denoting the authcodes edge name in mutations.
+	EdgeAuthCodes = "authCodes"
+	// EdgeRefreshTokens holds the string denoting the refreshtokens edge name in mutations.
+	EdgeRefreshTokens = "refreshTokens"
+	// EdgeDraftPicks holds the string denoting the draftpicks edge name in mutations.
+	EdgeDraftPicks = "draftPicks"
+	// EdgeContestEntries holds the string denoting the contestentries edge name in mutations.
+	EdgeContestEntries = "contestEntries"
+	// EdgeWonContests holds the string denoting the woncontests edge name in mutations.
+	EdgeWonContests = "wonContests"
 	// Table holds the table name of the user in the database.
 	Table = "users"
+	// MembershipsTable is the table the holds the memberships relation/edge.
+	MembershipsTable = "league_memberships"
+	// MembershipsInverseTable is the table name for the LeagueMembership entity.
+	// It exists in this package in order to avoid circular dependency with the "leaguemembership" package.
+	MembershipsInverseTable = "league_memberships"
+	// MembershipsColumn is the table column denoting the memberships relation/edge.
+	MembershipsColumn = "user_memberships"
+	// SentInvitationsTable is the table the holds the sentInvitations relation/edge.
+	SentInvitationsTable = "invitations"
+	// SentInvitationsInverseTable is the table name for the Invitation entity.
+	// It exists in this package in order to avoid circular dependency with the "invitation" package.
+	SentInvitationsInverseTable = "invitations"
+	// SentInvitationsColumn is the table column denoting the sentInvitations relation/edge.
+	SentInvitationsColumn = "user_sent_invitations"
+	// IdentitiesTable is the table the holds the identities relation/edge.
+	IdentitiesTable = "identities"
+	// IdentitiesInverseTable is the table name for the Identity entity.
+	// It exists in this package in order to avoid circular dependency with the "identity" package.
+	IdentitiesInverseTable = "identities"
+	// IdentitiesColumn is the table column denoting the identities relation/edge.
+	IdentitiesColumn = "user_identities"
+	// AuthCodesTable is the table the holds the authCodes relation/edge.
+	AuthCodesTable = "auth_codes"
+	// AuthCodesInverseTable is the table name for the AuthCode entity.
+	// It exists in this package in order to avoid circular dependency with the "authcode" package.
+	AuthCodesInverseTable = "auth_codes"
+	// AuthCodesColumn is the table column denoting the authCodes relation/edge.
+	AuthCodesColumn = "user_auth_codes"
+	// RefreshTokensTable is the table the holds the refreshTokens relation/edge.
+	RefreshTokensTable = "refresh_tokens"
+	// RefreshTokensInverseTable is the table name for the RefreshToken entity.
+	// It exists in this package in order to avoid circular dependency with the "refreshtoken" package.
+	RefreshTokensInverseTable = "refresh_tokens"
+	// RefreshTokensColumn is the table column denoting the refreshTokens relation/edge.
+	RefreshTokensColumn = "user_refresh_tokens"
+	// DraftPicksTable is the table the holds the draftPicks relation/edge.
+	DraftPicksTable = "contest_draft_picks"
+	// DraftPicksInverseTable is the table name for the ContestDraftPick entity.
+	// It exists in this package in order to avoid circular dependency with the "contestdraftpick" package.
+	DraftPicksInverseTable = "contest_draft_picks"
+	// DraftPicksColumn is the table column denoting the draftPicks relation/edge.
+	DraftPicksColumn = "user_draft_picks"
+	// ContestEntriesTable is the table the holds the contestEntries relation/edge.
+	ContestEntriesTable = "contest_entries"
+	// ContestEntriesInverseTable is the table name for the ContestEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "contestentry" package.
+	ContestEntriesInverseTable = "contest_entries"
+	// ContestEntriesColumn is the table column denoting the contestEntries relation/edge.
+	ContestEntriesColumn = "user_contest_entries"
+	// WonContestsTable is the table the holds the wonContests relation/edge.
+	WonContestsTable = "contests"
+	// WonContestsInverseTable is the table name for the Contest entity.
+	// It exists in this package in order to avoid circular dependency with the "contest" package.
+	WonContestsInverseTable = "contests"
+	// WonContestsColumn is the table column denoting the wonContests relation/edge.
+	WonContestsColumn = "user_won_contests"
 )
 
 // Columns holds all SQL columns for user fields.
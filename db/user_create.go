@@ -10,6 +10,14 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/authcode"
+	"github.com/NickDubelman/fantasy-bball/db/contest"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraftpick"
+	"github.com/NickDubelman/fantasy-bball/db/contestentry"
+	"github.com/NickDubelman/fantasy-bball/db/identity"
+	"github.com/NickDubelman/fantasy-bball/db/invitation"
+	"github.com/NickDubelman/fantasy-bball/db/leaguemembership"
+	"github.com/NickDubelman/fantasy-bball/db/refreshtoken"
 	"github.com/NickDubelman/fantasy-bball/db/user"
 )
 
@@ -74,6 +82,126 @@ func (uc *UserCreate) SetNillableLastActive(t *time.Time) *UserCreate {
 	return uc
 }
 
+// AddMembershipIDs adds the "memberships" edge to the LeagueMembership entity by IDs.
+func (uc *UserCreate) AddMembershipIDs(ids ...int) *UserCreate {
+	uc.mutation.AddMembershipIDs(ids...)
+	return uc
+}
+
+// AddMemberships adds the "memberships" edges to the LeagueMembership entity.
+func (uc *UserCreate) AddMemberships(l ...*LeagueMembership) *UserCreate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uc.AddMembershipIDs(ids...)
+}
+
+// AddSentInvitationIDs adds the "sentInvitations" edge to the Invitation entity by IDs.
+func (uc *UserCreate) AddSentInvitationIDs(ids ...int) *UserCreate {
+	uc.mutation.AddSentInvitationIDs(ids...)
+	return uc
+}
+
+// AddSentInvitations adds the "sentInvitations" edges to the Invitation entity.
+func (uc *UserCreate) AddSentInvitations(i ...*Invitation) *UserCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uc.AddSentInvitationIDs(ids...)
+}
+
+// AddIdentityIDs adds the "identities" edge to the Identity entity by IDs.
+func (uc *UserCreate) AddIdentityIDs(ids ...int) *UserCreate {
+	uc.mutation.AddIdentityIDs(ids...)
+	return uc
+}
+
+// AddIdentities adds the "identities" edges to the Identity entity.
+func (uc *UserCreate) AddIdentities(i ...*Identity) *UserCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uc.AddIdentityIDs(ids...)
+}
+
+// AddAuthCodeIDs adds the "authCodes" edge to the AuthCode entity by IDs.
+func (uc *UserCreate) AddAuthCodeIDs(ids ...int) *UserCreate {
+	uc.mutation.AddAuthCodeIDs(ids...)
+	return uc
+}
+
+// AddAuthCodes adds the "authCodes" edges to the AuthCode entity.
+func (uc *UserCreate) AddAuthCodes(a ...*AuthCode) *UserCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uc.AddAuthCodeIDs(ids...)
+}
+
+// AddRefreshTokenIDs adds the "refreshTokens" edge to the RefreshToken entity by IDs.
+func (uc *UserCreate) AddRefreshTokenIDs(ids ...int) *UserCreate {
+	uc.mutation.AddRefreshTokenIDs(ids...)
+	return uc
+}
+
+// AddRefreshTokens adds the "refreshTokens" edges to the RefreshToken entity.
+func (uc *UserCreate) AddRefreshTokens(r ...*RefreshToken) *UserCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddRefreshTokenIDs(ids...)
+}
+
+// AddDraftPickIDs adds the "draftPicks" edge to the ContestDraftPick entity by IDs.
+func (uc *UserCreate) AddDraftPickIDs(ids ...int) *UserCreate {
+	uc.mutation.AddDraftPickIDs(ids...)
+	return uc
+}
+
+// AddDraftPicks adds the "draftPicks" edges to the ContestDraftPick entity.
+func (uc *UserCreate) AddDraftPicks(c ...*ContestDraftPick) *UserCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uc.AddDraftPickIDs(ids...)
+}
+
+// AddContestEntryIDs adds the "contestEntries" edge to the ContestEntry entity by IDs.
+func (uc *UserCreate) AddContestEntryIDs(ids ...int) *UserCreate {
+	uc.mutation.AddContestEntryIDs(ids...)
+	return uc
+}
+
+// AddContestEntries adds the "contestEntries" edges to the ContestEntry entity.
+func (uc *UserCreate) AddContestEntries(c ...*ContestEntry) *UserCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uc.AddContestEntryIDs(ids...)
+}
+
+// AddWonContestIDs adds the "wonContests" edge to the Contest entity by IDs.
+func (uc *UserCreate) AddWonContestIDs(ids ...int) *UserCreate {
+	uc.mutation.AddWonContestIDs(ids...)
+	return uc
+}
+
+// AddWonContests adds the "wonContests" edges to the Contest entity.
+func (uc *UserCreate) AddWonContests(c ...*Contest) *UserCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uc.AddWonContestIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -211,6 +339,158 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		})
 		_node.LastActive = value
 	}
+	if nodes := uc.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: leaguemembership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.SentInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentInvitationsTable,
+			Columns: []string{user.SentInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invitation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: identity.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.AuthCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuthCodesTable,
+			Columns: []string{user.AuthCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: authcode.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.RefreshTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshTokensTable,
+			Columns: []string{user.RefreshTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: refreshtoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.DraftPicksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DraftPicksTable,
+			Columns: []string{user.DraftPicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraftpick.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ContestEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ContestEntriesTable,
+			Columns: []string{user.ContestEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.WonContestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WonContestsTable,
+			Columns: []string{user.WonContestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contest.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,6 +4,7 @@ package db
 
 import (
 	"context"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"math"
@@ -11,7 +12,15 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/authcode"
+	"github.com/NickDubelman/fantasy-bball/db/contest"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraftpick"
+	"github.com/NickDubelman/fantasy-bball/db/contestentry"
+	"github.com/NickDubelman/fantasy-bball/db/identity"
+	"github.com/NickDubelman/fantasy-bball/db/invitation"
+	"github.com/NickDubelman/fantasy-bball/db/leaguemembership"
 	"github.com/NickDubelman/fantasy-bball/db/predicate"
+	"github.com/NickDubelman/fantasy-bball/db/refreshtoken"
 	"github.com/NickDubelman/fantasy-bball/db/user"
 )
 
@@ -23,6 +32,15 @@ type UserQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.User
+	// eager-loading edges.
+	withMemberships     *LeagueMembershipQuery
+	withSentInvitations *InvitationQuery
+	withIdentities      *IdentityQuery
+	withAuthCodes       *AuthCodeQuery
+	withRefreshTokens   *RefreshTokenQuery
+	withDraftPicks      *ContestDraftPickQuery
+	withContestEntries  *ContestEntryQuery
+	withWonContests     *ContestQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -52,6 +70,182 @@ func (uq *UserQuery) Order(o ...OrderFunc) *UserQuery {
 	return uq
 }
 
+// QueryMemberships chains the current query on the "memberships" edge.
+func (uq *UserQuery) QueryMemberships() *LeagueMembershipQuery {
+	query := &LeagueMembershipQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(leaguemembership.Table, leaguemembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.MembershipsTable, user.MembershipsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySentInvitations chains the current query on the "sentInvitations" edge.
+func (uq *UserQuery) QuerySentInvitations() *InvitationQuery {
+	query := &InvitationQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(invitation.Table, invitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SentInvitationsTable, user.SentInvitationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryIdentities chains the current query on the "identities" edge.
+func (uq *UserQuery) QueryIdentities() *IdentityQuery {
+	query := &IdentityQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(identity.Table, identity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.IdentitiesTable, user.IdentitiesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAuthCodes chains the current query on the "authCodes" edge.
+func (uq *UserQuery) QueryAuthCodes() *AuthCodeQuery {
+	query := &AuthCodeQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(authcode.Table, authcode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AuthCodesTable, user.AuthCodesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRefreshTokens chains the current query on the "refreshTokens" edge.
+func (uq *UserQuery) QueryRefreshTokens() *RefreshTokenQuery {
+	query := &RefreshTokenQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(refreshtoken.Table, refreshtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RefreshTokensTable, user.RefreshTokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDraftPicks chains the current query on the "draftPicks" edge.
+func (uq *UserQuery) QueryDraftPicks() *ContestDraftPickQuery {
+	query := &ContestDraftPickQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(contestdraftpick.Table, contestdraftpick.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.DraftPicksTable, user.DraftPicksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryContestEntries chains the current query on the "contestEntries" edge.
+func (uq *UserQuery) QueryContestEntries() *ContestEntryQuery {
+	query := &ContestEntryQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(contestentry.Table, contestentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ContestEntriesTable, user.ContestEntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWonContests chains the current query on the "wonContests" edge.
+func (uq *UserQuery) QueryWonContests() *ContestQuery {
+	query := &ContestQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(contest.Table, contest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.WonContestsTable, user.WonContestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (uq *UserQuery) First(ctx context.Context) (*User, error) {
@@ -228,17 +422,113 @@ func (uq *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:     uq.config,
-		limit:      uq.limit,
-		offset:     uq.offset,
-		order:      append([]OrderFunc{}, uq.order...),
-		predicates: append([]predicate.User{}, uq.predicates...),
+		config:              uq.config,
+		limit:               uq.limit,
+		offset:              uq.offset,
+		order:               append([]OrderFunc{}, uq.order...),
+		predicates:          append([]predicate.User{}, uq.predicates...),
+		withMemberships:     uq.withMemberships.Clone(),
+		withSentInvitations: uq.withSentInvitations.Clone(),
+		withIdentities:      uq.withIdentities.Clone(),
+		withAuthCodes:       uq.withAuthCodes.Clone(),
+		withRefreshTokens:   uq.withRefreshTokens.Clone(),
+		withDraftPicks:      uq.withDraftPicks.Clone(),
+		withContestEntries:  uq.withContestEntries.Clone(),
+		withWonContests:     uq.withWonContests.Clone(),
 		// clone intermediate query.
 		sql:  uq.sql.Clone(),
 		path: uq.path,
 	}
 }
 
+// WithMemberships tells the query-builder to eager-load the nodes that are connected to
+// the "memberships" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithMemberships(opts ...func(*LeagueMembershipQuery)) *UserQuery {
+	query := &LeagueMembershipQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withMemberships = query
+	return uq
+}
+
+// WithSentInvitations tells the query-builder to eager-load the nodes that are connected to
+// the "sentInvitations" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithSentInvitations(opts ...func(*InvitationQuery)) *UserQuery {
+	query := &InvitationQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withSentInvitations = query
+	return uq
+}
+
+// WithIdentities tells the query-builder to eager-load the nodes that are connected to
+// the "identities" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithIdentities(opts ...func(*IdentityQuery)) *UserQuery {
+	query := &IdentityQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withIdentities = query
+	return uq
+}
+
+// WithAuthCodes tells the query-builder to eager-load the nodes that are connected to
+// the "authCodes" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithAuthCodes(opts ...func(*AuthCodeQuery)) *UserQuery {
+	query := &AuthCodeQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withAuthCodes = query
+	return uq
+}
+
+// WithRefreshTokens tells the query-builder to eager-load the nodes that are connected to
+// the "refreshTokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithRefreshTokens(opts ...func(*RefreshTokenQuery)) *UserQuery {
+	query := &RefreshTokenQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withRefreshTokens = query
+	return uq
+}
+
+// WithDraftPicks tells the query-builder to eager-load the nodes that are connected to
+// the "draftPicks" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithDraftPicks(opts ...func(*ContestDraftPickQuery)) *UserQuery {
+	query := &ContestDraftPickQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withDraftPicks = query
+	return uq
+}
+
+// WithContestEntries tells the query-builder to eager-load the nodes that are connected to
+// the "contestEntries" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithContestEntries(opts ...func(*ContestEntryQuery)) *UserQuery {
+	query := &ContestEntryQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withContestEntries = query
+	return uq
+}
+
+// WithWonContests tells the query-builder to eager-load the nodes that are connected to
+// the "wonContests" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithWonContests(opts ...func(*ContestQuery)) *UserQuery {
+	query := &ContestQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withWonContests = query
+	return uq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -253,7 +543,6 @@ func (uq *UserQuery) Clone() *UserQuery {
 //		GroupBy(user.FieldName).
 //		Aggregate(db.Count()).
 //		Scan(ctx, &v)
-//
 func (uq *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
 	group := &UserGroupBy{config: uq.config}
 	group.fields = append([]string{field}, fields...)
@@ -278,7 +567,6 @@ func (uq *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
 //	client.User.Query().
 //		Select(user.FieldName).
 //		Scan(ctx, &v)
-//
 func (uq *UserQuery) Select(field string, fields ...string) *UserSelect {
 	uq.fields = append([]string{field}, fields...)
 	return &UserSelect{UserQuery: uq}
@@ -302,8 +590,18 @@ func (uq *UserQuery) prepareQuery(ctx context.Context) error {
 
 func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 	var (
-		nodes = []*User{}
-		_spec = uq.querySpec()
+		nodes       = []*User{}
+		_spec       = uq.querySpec()
+		loadedTypes = [8]bool{
+			uq.withMemberships != nil,
+			uq.withSentInvitations != nil,
+			uq.withIdentities != nil,
+			uq.withAuthCodes != nil,
+			uq.withRefreshTokens != nil,
+			uq.withDraftPicks != nil,
+			uq.withContestEntries != nil,
+			uq.withWonContests != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		node := &User{config: uq.config}
@@ -315,6 +613,7 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 			return fmt.Errorf("db: Assign called without calling ScanValues")
 		}
 		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	if err := sqlgraph.QueryNodes(ctx, uq.driver, _spec); err != nil {
@@ -323,6 +622,239 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+
+	if query := uq.withMemberships; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Memberships = []*LeagueMembership{}
+		}
+		query.withFKs = true
+		query.Where(predicate.LeagueMembership(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.MembershipsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_memberships
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_memberships" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_memberships" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Memberships = append(node.Edges.Memberships, n)
+		}
+	}
+
+	if query := uq.withSentInvitations; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.SentInvitations = []*Invitation{}
+		}
+		query.withFKs = true
+		query.Where(predicate.Invitation(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.SentInvitationsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_sent_invitations
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_sent_invitations" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_sent_invitations" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.SentInvitations = append(node.Edges.SentInvitations, n)
+		}
+	}
+
+	if query := uq.withIdentities; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Identities = []*Identity{}
+		}
+		query.withFKs = true
+		query.Where(predicate.Identity(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.IdentitiesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_identities
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_identities" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_identities" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Identities = append(node.Edges.Identities, n)
+		}
+	}
+
+	if query := uq.withAuthCodes; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.AuthCodes = []*AuthCode{}
+		}
+		query.withFKs = true
+		query.Where(predicate.AuthCode(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.AuthCodesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_auth_codes
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_auth_codes" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_auth_codes" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.AuthCodes = append(node.Edges.AuthCodes, n)
+		}
+	}
+
+	if query := uq.withRefreshTokens; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.RefreshTokens = []*RefreshToken{}
+		}
+		query.withFKs = true
+		query.Where(predicate.RefreshToken(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.RefreshTokensColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_refresh_tokens
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_refresh_tokens" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_refresh_tokens" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.RefreshTokens = append(node.Edges.RefreshTokens, n)
+		}
+	}
+
+	if query := uq.withDraftPicks; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.DraftPicks = []*ContestDraftPick{}
+		}
+		query.withFKs = true
+		query.Where(predicate.ContestDraftPick(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.DraftPicksColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_draft_picks
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_draft_picks" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_draft_picks" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.DraftPicks = append(node.Edges.DraftPicks, n)
+		}
+	}
+
+	if query := uq.withContestEntries; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.ContestEntries = []*ContestEntry{}
+		}
+		query.withFKs = true
+		query.Where(predicate.ContestEntry(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.ContestEntriesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_contest_entries
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_contest_entries" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_contest_entries" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.ContestEntries = append(node.Edges.ContestEntries, n)
+		}
+	}
+
+	if query := uq.withWonContests; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.WonContests = []*Contest{}
+		}
+		query.withFKs = true
+		query.Where(predicate.Contest(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.WonContestsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_won_contests
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_won_contests" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_won_contests" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.WonContests = append(node.Edges.WonContests, n)
+		}
+	}
+
 	return nodes, nil
 }
 
@@ -10,7 +10,15 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/NickDubelman/fantasy-bball/db/authcode"
+	"github.com/NickDubelman/fantasy-bball/db/contest"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraftpick"
+	"github.com/NickDubelman/fantasy-bball/db/contestentry"
+	"github.com/NickDubelman/fantasy-bball/db/identity"
+	"github.com/NickDubelman/fantasy-bball/db/invitation"
+	"github.com/NickDubelman/fantasy-bball/db/leaguemembership"
 	"github.com/NickDubelman/fantasy-bball/db/predicate"
+	"github.com/NickDubelman/fantasy-bball/db/refreshtoken"
 	"github.com/NickDubelman/fantasy-bball/db/user"
 )
 
@@ -99,11 +107,299 @@ func (uu *UserUpdate) ClearLastActive() *UserUpdate {
 	return uu
 }
 
+// AddMembershipIDs adds the "memberships" edge to the LeagueMembership entity by IDs.
+func (uu *UserUpdate) AddMembershipIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddMembershipIDs(ids...)
+	return uu
+}
+
+// AddMemberships adds the "memberships" edges to the LeagueMembership entity.
+func (uu *UserUpdate) AddMemberships(l ...*LeagueMembership) *UserUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uu.AddMembershipIDs(ids...)
+}
+
+// AddSentInvitationIDs adds the "sentInvitations" edge to the Invitation entity by IDs.
+func (uu *UserUpdate) AddSentInvitationIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddSentInvitationIDs(ids...)
+	return uu
+}
+
+// AddSentInvitations adds the "sentInvitations" edges to the Invitation entity.
+func (uu *UserUpdate) AddSentInvitations(i ...*Invitation) *UserUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.AddSentInvitationIDs(ids...)
+}
+
+// AddIdentityIDs adds the "identities" edge to the Identity entity by IDs.
+func (uu *UserUpdate) AddIdentityIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddIdentityIDs(ids...)
+	return uu
+}
+
+// AddIdentities adds the "identities" edges to the Identity entity.
+func (uu *UserUpdate) AddIdentities(i ...*Identity) *UserUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.AddIdentityIDs(ids...)
+}
+
+// AddAuthCodeIDs adds the "authCodes" edge to the AuthCode entity by IDs.
+func (uu *UserUpdate) AddAuthCodeIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddAuthCodeIDs(ids...)
+	return uu
+}
+
+// AddAuthCodes adds the "authCodes" edges to the AuthCode entity.
+func (uu *UserUpdate) AddAuthCodes(a ...*AuthCode) *UserUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.AddAuthCodeIDs(ids...)
+}
+
+// AddRefreshTokenIDs adds the "refreshTokens" edge to the RefreshToken entity by IDs.
+func (uu *UserUpdate) AddRefreshTokenIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddRefreshTokenIDs(ids...)
+	return uu
+}
+
+// AddRefreshTokens adds the "refreshTokens" edges to the RefreshToken entity.
+func (uu *UserUpdate) AddRefreshTokens(r ...*RefreshToken) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.AddRefreshTokenIDs(ids...)
+}
+
+// AddDraftPickIDs adds the "draftPicks" edge to the ContestDraftPick entity by IDs.
+func (uu *UserUpdate) AddDraftPickIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddDraftPickIDs(ids...)
+	return uu
+}
+
+// AddDraftPicks adds the "draftPicks" edges to the ContestDraftPick entity.
+func (uu *UserUpdate) AddDraftPicks(c ...*ContestDraftPick) *UserUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.AddDraftPickIDs(ids...)
+}
+
+// AddContestEntryIDs adds the "contestEntries" edge to the ContestEntry entity by IDs.
+func (uu *UserUpdate) AddContestEntryIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddContestEntryIDs(ids...)
+	return uu
+}
+
+// AddContestEntries adds the "contestEntries" edges to the ContestEntry entity.
+func (uu *UserUpdate) AddContestEntries(c ...*ContestEntry) *UserUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.AddContestEntryIDs(ids...)
+}
+
+// AddWonContestIDs adds the "wonContests" edge to the Contest entity by IDs.
+func (uu *UserUpdate) AddWonContestIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddWonContestIDs(ids...)
+	return uu
+}
+
+// AddWonContests adds the "wonContests" edges to the Contest entity.
+func (uu *UserUpdate) AddWonContests(c ...*Contest) *UserUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.AddWonContestIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
 }
 
+// ClearMemberships clears all "memberships" edges to the LeagueMembership entity.
+func (uu *UserUpdate) ClearMemberships() *UserUpdate {
+	uu.mutation.ClearMemberships()
+	return uu
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to LeagueMembership entities by IDs.
+func (uu *UserUpdate) RemoveMembershipIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveMembershipIDs(ids...)
+	return uu
+}
+
+// RemoveMemberships removes "memberships" edges to LeagueMembership entities.
+func (uu *UserUpdate) RemoveMemberships(l ...*LeagueMembership) *UserUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uu.RemoveMembershipIDs(ids...)
+}
+
+// ClearSentInvitations clears all "sentInvitations" edges to the Invitation entity.
+func (uu *UserUpdate) ClearSentInvitations() *UserUpdate {
+	uu.mutation.ClearSentInvitations()
+	return uu
+}
+
+// RemoveSentInvitationIDs removes the "sentInvitations" edge to Invitation entities by IDs.
+func (uu *UserUpdate) RemoveSentInvitationIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveSentInvitationIDs(ids...)
+	return uu
+}
+
+// RemoveSentInvitations removes "sentInvitations" edges to Invitation entities.
+func (uu *UserUpdate) RemoveSentInvitations(i ...*Invitation) *UserUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.RemoveSentInvitationIDs(ids...)
+}
+
+// ClearIdentities clears all "identities" edges to the Identity entity.
+func (uu *UserUpdate) ClearIdentities() *UserUpdate {
+	uu.mutation.ClearIdentities()
+	return uu
+}
+
+// RemoveIdentityIDs removes the "identities" edge to Identity entities by IDs.
+func (uu *UserUpdate) RemoveIdentityIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveIdentityIDs(ids...)
+	return uu
+}
+
+// RemoveIdentities removes "identities" edges to Identity entities.
+func (uu *UserUpdate) RemoveIdentities(i ...*Identity) *UserUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.RemoveIdentityIDs(ids...)
+}
+
+// ClearAuthCodes clears all "authCodes" edges to the AuthCode entity.
+func (uu *UserUpdate) ClearAuthCodes() *UserUpdate {
+	uu.mutation.ClearAuthCodes()
+	return uu
+}
+
+// RemoveAuthCodeIDs removes the "authCodes" edge to AuthCode entities by IDs.
+func (uu *UserUpdate) RemoveAuthCodeIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveAuthCodeIDs(ids...)
+	return uu
+}
+
+// RemoveAuthCodes removes "authCodes" edges to AuthCode entities.
+func (uu *UserUpdate) RemoveAuthCodes(a ...*AuthCode) *UserUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.RemoveAuthCodeIDs(ids...)
+}
+
+// ClearRefreshTokens clears all "refreshTokens" edges to the RefreshToken entity.
+func (uu *UserUpdate) ClearRefreshTokens() *UserUpdate {
+	uu.mutation.ClearRefreshTokens()
+	return uu
+}
+
+// RemoveRefreshTokenIDs removes the "refreshTokens" edge to RefreshToken entities by IDs.
+func (uu *UserUpdate) RemoveRefreshTokenIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveRefreshTokenIDs(ids...)
+	return uu
+}
+
+// RemoveRefreshTokens removes "refreshTokens" edges to RefreshToken entities.
+func (uu *UserUpdate) RemoveRefreshTokens(r ...*RefreshToken) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.RemoveRefreshTokenIDs(ids...)
+}
+
+// ClearDraftPicks clears all "draftPicks" edges to the ContestDraftPick entity.
+func (uu *UserUpdate) ClearDraftPicks() *UserUpdate {
+	uu.mutation.ClearDraftPicks()
+	return uu
+}
+
+// RemoveDraftPickIDs removes the "draftPicks" edge to ContestDraftPick entities by IDs.
+func (uu *UserUpdate) RemoveDraftPickIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveDraftPickIDs(ids...)
+	return uu
+}
+
+// RemoveDraftPicks removes "draftPicks" edges to ContestDraftPick entities.
+func (uu *UserUpdate) RemoveDraftPicks(c ...*ContestDraftPick) *UserUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.RemoveDraftPickIDs(ids...)
+}
+
+// ClearContestEntries clears all "contestEntries" edges to the ContestEntry entity.
+func (uu *UserUpdate) ClearContestEntries() *UserUpdate {
+	uu.mutation.ClearContestEntries()
+	return uu
+}
+
+// RemoveContestEntryIDs removes the "contestEntries" edge to ContestEntry entities by IDs.
+func (uu *UserUpdate) RemoveContestEntryIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveContestEntryIDs(ids...)
+	return uu
+}
+
+// RemoveContestEntries removes "contestEntries" edges to ContestEntry entities.
+func (uu *UserUpdate) RemoveContestEntries(c ...*ContestEntry) *UserUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.RemoveContestEntryIDs(ids...)
+}
+
+// ClearWonContests clears all "wonContests" edges to the Contest entity.
+func (uu *UserUpdate) ClearWonContests() *UserUpdate {
+	uu.mutation.ClearWonContests()
+	return uu
+}
+
+// RemoveWonContestIDs removes the "wonContests" edge to Contest entities by IDs.
+func (uu *UserUpdate) RemoveWonContestIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveWonContestIDs(ids...)
+	return uu
+}
+
+// RemoveWonContests removes "wonContests" edges to Contest entities.
+func (uu *UserUpdate) RemoveWonContests(c ...*Contest) *UserUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.RemoveWonContestIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -226,6 +522,438 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldLastActive,
 		})
 	}
+	if uu.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: leaguemembership.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !uu.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: leaguemembership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: leaguemembership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.SentInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentInvitationsTable,
+			Columns: []string{user.SentInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invitation.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedSentInvitationsIDs(); len(nodes) > 0 && !uu.mutation.SentInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentInvitationsTable,
+			Columns: []string{user.SentInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invitation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.SentInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentInvitationsTable,
+			Columns: []string{user.SentInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invitation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: identity.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedIdentitiesIDs(); len(nodes) > 0 && !uu.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: identity.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: identity.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.AuthCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuthCodesTable,
+			Columns: []string{user.AuthCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: authcode.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedAuthCodesIDs(); len(nodes) > 0 && !uu.mutation.AuthCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuthCodesTable,
+			Columns: []string{user.AuthCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: authcode.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.AuthCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuthCodesTable,
+			Columns: []string{user.AuthCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: authcode.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.RefreshTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshTokensTable,
+			Columns: []string{user.RefreshTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: refreshtoken.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedRefreshTokensIDs(); len(nodes) > 0 && !uu.mutation.RefreshTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshTokensTable,
+			Columns: []string{user.RefreshTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: refreshtoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RefreshTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshTokensTable,
+			Columns: []string{user.RefreshTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: refreshtoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.DraftPicksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DraftPicksTable,
+			Columns: []string{user.DraftPicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraftpick.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedDraftPicksIDs(); len(nodes) > 0 && !uu.mutation.DraftPicksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DraftPicksTable,
+			Columns: []string{user.DraftPicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraftpick.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.DraftPicksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DraftPicksTable,
+			Columns: []string{user.DraftPicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraftpick.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.ContestEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ContestEntriesTable,
+			Columns: []string{user.ContestEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentry.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedContestEntriesIDs(); len(nodes) > 0 && !uu.mutation.ContestEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ContestEntriesTable,
+			Columns: []string{user.ContestEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.ContestEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ContestEntriesTable,
+			Columns: []string{user.ContestEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.WonContestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WonContestsTable,
+			Columns: []string{user.WonContestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contest.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedWonContestsIDs(); len(nodes) > 0 && !uu.mutation.WonContestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WonContestsTable,
+			Columns: []string{user.WonContestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contest.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.WonContestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WonContestsTable,
+			Columns: []string{user.WonContestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contest.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -316,11 +1044,299 @@ func (uuo *UserUpdateOne) ClearLastActive() *UserUpdateOne {
 	return uuo
 }
 
+// AddMembershipIDs adds the "memberships" edge to the LeagueMembership entity by IDs.
+func (uuo *UserUpdateOne) AddMembershipIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddMembershipIDs(ids...)
+	return uuo
+}
+
+// AddMemberships adds the "memberships" edges to the LeagueMembership entity.
+func (uuo *UserUpdateOne) AddMemberships(l ...*LeagueMembership) *UserUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uuo.AddMembershipIDs(ids...)
+}
+
+// AddSentInvitationIDs adds the "sentInvitations" edge to the Invitation entity by IDs.
+func (uuo *UserUpdateOne) AddSentInvitationIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddSentInvitationIDs(ids...)
+	return uuo
+}
+
+// AddSentInvitations adds the "sentInvitations" edges to the Invitation entity.
+func (uuo *UserUpdateOne) AddSentInvitations(i ...*Invitation) *UserUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.AddSentInvitationIDs(ids...)
+}
+
+// AddIdentityIDs adds the "identities" edge to the Identity entity by IDs.
+func (uuo *UserUpdateOne) AddIdentityIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddIdentityIDs(ids...)
+	return uuo
+}
+
+// AddIdentities adds the "identities" edges to the Identity entity.
+func (uuo *UserUpdateOne) AddIdentities(i ...*Identity) *UserUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.AddIdentityIDs(ids...)
+}
+
+// AddAuthCodeIDs adds the "authCodes" edge to the AuthCode entity by IDs.
+func (uuo *UserUpdateOne) AddAuthCodeIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddAuthCodeIDs(ids...)
+	return uuo
+}
+
+// AddAuthCodes adds the "authCodes" edges to the AuthCode entity.
+func (uuo *UserUpdateOne) AddAuthCodes(a ...*AuthCode) *UserUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.AddAuthCodeIDs(ids...)
+}
+
+// AddRefreshTokenIDs adds the "refreshTokens" edge to the RefreshToken entity by IDs.
+func (uuo *UserUpdateOne) AddRefreshTokenIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddRefreshTokenIDs(ids...)
+	return uuo
+}
+
+// AddRefreshTokens adds the "refreshTokens" edges to the RefreshToken entity.
+func (uuo *UserUpdateOne) AddRefreshTokens(r ...*RefreshToken) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddRefreshTokenIDs(ids...)
+}
+
+// AddDraftPickIDs adds the "draftPicks" edge to the ContestDraftPick entity by IDs.
+func (uuo *UserUpdateOne) AddDraftPickIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddDraftPickIDs(ids...)
+	return uuo
+}
+
+// AddDraftPicks adds the "draftPicks" edges to the ContestDraftPick entity.
+func (uuo *UserUpdateOne) AddDraftPicks(c ...*ContestDraftPick) *UserUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddDraftPickIDs(ids...)
+}
+
+// AddContestEntryIDs adds the "contestEntries" edge to the ContestEntry entity by IDs.
+func (uuo *UserUpdateOne) AddContestEntryIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddContestEntryIDs(ids...)
+	return uuo
+}
+
+// AddContestEntries adds the "contestEntries" edges to the ContestEntry entity.
+func (uuo *UserUpdateOne) AddContestEntries(c ...*ContestEntry) *UserUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddContestEntryIDs(ids...)
+}
+
+// AddWonContestIDs adds the "wonContests" edge to the Contest entity by IDs.
+func (uuo *UserUpdateOne) AddWonContestIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddWonContestIDs(ids...)
+	return uuo
+}
+
+// AddWonContests adds the "wonContests" edges to the Contest entity.
+func (uuo *UserUpdateOne) AddWonContests(c ...*Contest) *UserUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddWonContestIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uuo *UserUpdateOne) Mutation() *UserMutation {
 	return uuo.mutation
 }
 
+// ClearMemberships clears all "memberships" edges to the LeagueMembership entity.
+func (uuo *UserUpdateOne) ClearMemberships() *UserUpdateOne {
+	uuo.mutation.ClearMemberships()
+	return uuo
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to LeagueMembership entities by IDs.
+func (uuo *UserUpdateOne) RemoveMembershipIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveMembershipIDs(ids...)
+	return uuo
+}
+
+// RemoveMemberships removes "memberships" edges to LeagueMembership entities.
+func (uuo *UserUpdateOne) RemoveMemberships(l ...*LeagueMembership) *UserUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uuo.RemoveMembershipIDs(ids...)
+}
+
+// ClearSentInvitations clears all "sentInvitations" edges to the Invitation entity.
+func (uuo *UserUpdateOne) ClearSentInvitations() *UserUpdateOne {
+	uuo.mutation.ClearSentInvitations()
+	return uuo
+}
+
+// RemoveSentInvitationIDs removes the "sentInvitations" edge to Invitation entities by IDs.
+func (uuo *UserUpdateOne) RemoveSentInvitationIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveSentInvitationIDs(ids...)
+	return uuo
+}
+
+// RemoveSentInvitations removes "sentInvitations" edges to Invitation entities.
+func (uuo *UserUpdateOne) RemoveSentInvitations(i ...*Invitation) *UserUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.RemoveSentInvitationIDs(ids...)
+}
+
+// ClearIdentities clears all "identities" edges to the Identity entity.
+func (uuo *UserUpdateOne) ClearIdentities() *UserUpdateOne {
+	uuo.mutation.ClearIdentities()
+	return uuo
+}
+
+// RemoveIdentityIDs removes the "identities" edge to Identity entities by IDs.
+func (uuo *UserUpdateOne) RemoveIdentityIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveIdentityIDs(ids...)
+	return uuo
+}
+
+// RemoveIdentities removes "identities" edges to Identity entities.
+func (uuo *UserUpdateOne) RemoveIdentities(i ...*Identity) *UserUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.RemoveIdentityIDs(ids...)
+}
+
+// ClearAuthCodes clears all "authCodes" edges to the AuthCode entity.
+func (uuo *UserUpdateOne) ClearAuthCodes() *UserUpdateOne {
+	uuo.mutation.ClearAuthCodes()
+	return uuo
+}
+
+// RemoveAuthCodeIDs removes the "authCodes" edge to AuthCode entities by IDs.
+func (uuo *UserUpdateOne) RemoveAuthCodeIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveAuthCodeIDs(ids...)
+	return uuo
+}
+
+// RemoveAuthCodes removes "authCodes" edges to AuthCode entities.
+func (uuo *UserUpdateOne) RemoveAuthCodes(a ...*AuthCode) *UserUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.RemoveAuthCodeIDs(ids...)
+}
+
+// ClearRefreshTokens clears all "refreshTokens" edges to the RefreshToken entity.
+func (uuo *UserUpdateOne) ClearRefreshTokens() *UserUpdateOne {
+	uuo.mutation.ClearRefreshTokens()
+	return uuo
+}
+
+// RemoveRefreshTokenIDs removes the "refreshTokens" edge to RefreshToken entities by IDs.
+func (uuo *UserUpdateOne) RemoveRefreshTokenIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveRefreshTokenIDs(ids...)
+	return uuo
+}
+
+// RemoveRefreshTokens removes "refreshTokens" edges to RefreshToken entities.
+func (uuo *UserUpdateOne) RemoveRefreshTokens(r ...*RefreshToken) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveRefreshTokenIDs(ids...)
+}
+
+// ClearDraftPicks clears all "draftPicks" edges to the ContestDraftPick entity.
+func (uuo *UserUpdateOne) ClearDraftPicks() *UserUpdateOne {
+	uuo.mutation.ClearDraftPicks()
+	return uuo
+}
+
+// RemoveDraftPickIDs removes the "draftPicks" edge to ContestDraftPick entities by IDs.
+func (uuo *UserUpdateOne) RemoveDraftPickIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveDraftPickIDs(ids...)
+	return uuo
+}
+
+// RemoveDraftPicks removes "draftPicks" edges to ContestDraftPick entities.
+func (uuo *UserUpdateOne) RemoveDraftPicks(c ...*ContestDraftPick) *UserUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.RemoveDraftPickIDs(ids...)
+}
+
+// ClearContestEntries clears all "contestEntries" edges to the ContestEntry entity.
+func (uuo *UserUpdateOne) ClearContestEntries() *UserUpdateOne {
+	uuo.mutation.ClearContestEntries()
+	return uuo
+}
+
+// RemoveContestEntryIDs removes the "contestEntries" edge to ContestEntry entities by IDs.
+func (uuo *UserUpdateOne) RemoveContestEntryIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveContestEntryIDs(ids...)
+	return uuo
+}
+
+// RemoveContestEntries removes "contestEntries" edges to ContestEntry entities.
+func (uuo *UserUpdateOne) RemoveContestEntries(c ...*ContestEntry) *UserUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.RemoveContestEntryIDs(ids...)
+}
+
+// ClearWonContests clears all "wonContests" edges to the Contest entity.
+func (uuo *UserUpdateOne) ClearWonContests() *UserUpdateOne {
+	uuo.mutation.ClearWonContests()
+	return uuo
+}
+
+// RemoveWonContestIDs removes the "wonContests" edge to Contest entities by IDs.
+func (uuo *UserUpdateOne) RemoveWonContestIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveWonContestIDs(ids...)
+	return uuo
+}
+
+// RemoveWonContests removes "wonContests" edges to Contest entities.
+func (uuo *UserUpdateOne) RemoveWonContests(c ...*Contest) *UserUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.RemoveWonContestIDs(ids...)
+}
+
 // Save executes the query and returns the updated User entity.
 func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
 	var (
@@ -448,6 +1464,438 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			Column: user.FieldLastActive,
 		})
 	}
+	if uuo.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: leaguemembership.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !uuo.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: leaguemembership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: leaguemembership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.SentInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentInvitationsTable,
+			Columns: []string{user.SentInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invitation.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedSentInvitationsIDs(); len(nodes) > 0 && !uuo.mutation.SentInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentInvitationsTable,
+			Columns: []string{user.SentInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invitation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.SentInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentInvitationsTable,
+			Columns: []string{user.SentInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invitation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: identity.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedIdentitiesIDs(); len(nodes) > 0 && !uuo.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: identity.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: identity.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.AuthCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuthCodesTable,
+			Columns: []string{user.AuthCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: authcode.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedAuthCodesIDs(); len(nodes) > 0 && !uuo.mutation.AuthCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuthCodesTable,
+			Columns: []string{user.AuthCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: authcode.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.AuthCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuthCodesTable,
+			Columns: []string{user.AuthCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: authcode.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.RefreshTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshTokensTable,
+			Columns: []string{user.RefreshTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: refreshtoken.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedRefreshTokensIDs(); len(nodes) > 0 && !uuo.mutation.RefreshTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshTokensTable,
+			Columns: []string{user.RefreshTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: refreshtoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RefreshTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshTokensTable,
+			Columns: []string{user.RefreshTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: refreshtoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.DraftPicksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DraftPicksTable,
+			Columns: []string{user.DraftPicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraftpick.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedDraftPicksIDs(); len(nodes) > 0 && !uuo.mutation.DraftPicksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DraftPicksTable,
+			Columns: []string{user.DraftPicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraftpick.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.DraftPicksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DraftPicksTable,
+			Columns: []string{user.DraftPicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestdraftpick.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.ContestEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ContestEntriesTable,
+			Columns: []string{user.ContestEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentry.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedContestEntriesIDs(); len(nodes) > 0 && !uuo.mutation.ContestEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ContestEntriesTable,
+			Columns: []string{user.ContestEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.ContestEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ContestEntriesTable,
+			Columns: []string{user.ContestEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contestentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.WonContestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WonContestsTable,
+			Columns: []string{user.WonContestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contest.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedWonContestsIDs(); len(nodes) > 0 && !uuo.mutation.WonContestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WonContestsTable,
+			Columns: []string{user.WonContestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contest.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.WonContestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WonContestsTable,
+			Columns: []string{user.WonContestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: contest.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,201 @@
+package draft
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraft"
+	"github.com/NickDubelman/fantasy-bball/db/game"
+	"github.com/NickDubelman/fantasy-bball/db/player"
+	"github.com/NickDubelman/fantasy-bball/db/playerperformance"
+	"github.com/NickDubelman/fantasy-bball/db/schema/schematype"
+	"github.com/NickDubelman/fantasy-bball/nba"
+	"github.com/NickDubelman/fantasy-bball/scoring"
+)
+
+const (
+	// rankingGames is how many of a player's most recent games are used to rank them
+	// for auto-picks
+	rankingGames = 10
+
+	// rankingLookback is how far back we look for those games
+	rankingLookback = 60 * 24 * time.Hour
+)
+
+// Deadline returns when the user on the clock runs out of time to make their pick.
+// Each pick's clock starts when the previous pick was made (or when the draft
+// starts, for the first pick), so the clock doesn't run before the draft starts.
+// Since it is derived entirely from persisted timestamps, the clock keeps running
+// while the server is down. ok is false if the draft is complete
+func (d *Draft) Deadline() (deadline time.Time, ok bool) {
+	if d.IsComplete() {
+		return time.Time{}, false
+	}
+
+	start := d.Started
+	if n := len(d.Picks); n > 0 && d.Picks[n-1].Created.After(start) {
+		start = d.Picks[n-1].Created
+	}
+
+	return start.Add(time.Duration(d.PickTimeLimit) * time.Second), true
+}
+
+// Expire makes the automatic picks for every user in the draft whose pick clock ran
+// out before now. Users who timed out get the best available player, as ranked by
+// their recent performances and the league's StatWeights
+func Expire(ctx context.Context, client *db.Client, draftID int, now time.Time) error {
+	return client.WithTx(ctx, func(tx *db.Tx) error {
+		d, err := Load(ctx, tx.Client(), draftID)
+		if err != nil {
+			return err
+		}
+		return d.expire(ctx, tx, now)
+	})
+}
+
+// ExpireAll calls Expire for every draft that isn't complete yet
+func ExpireAll(ctx context.Context, client *db.Client, now time.Time) error {
+	drafts, err := client.ContestDraft.
+		Query().
+		Where(contestdraft.CompletedIsNil()).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range drafts {
+		if err := Expire(ctx, client, id, now); err != nil {
+			log.Printf("expiring picks for draft %d: %v", id, err)
+		}
+	}
+
+	return nil
+}
+
+// RunClock calls ExpireAll every interval, until ctx is done
+func RunClock(ctx context.Context, client *db.Client, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := ExpireAll(ctx, client, now); err != nil {
+				log.Printf("running draft clock: %v", err)
+			}
+		}
+	}
+}
+
+// expire makes the automatic picks for every user whose time ran out before now. A
+// timed out pick is recorded as having been made at its deadline, so that the next
+// user's clock starts from there. It must be called within tx
+func (d *Draft) expire(ctx context.Context, tx *db.Tx, now time.Time) error {
+	for {
+		deadline, ok := d.Deadline()
+		if !ok || now.Before(deadline) {
+			return nil
+		}
+
+		playerID, err := d.bestAvailable(ctx, tx.Client())
+		if err != nil {
+			return err
+		}
+
+		if err := d.record(ctx, tx, playerID, deadline, true); err != nil {
+			return err
+		}
+	}
+}
+
+// bestAvailable returns the ID of the best player that is still available in the
+// draft, or nil if there is no one left
+func (d *Draft) bestAvailable(ctx context.Context, client *db.Client) (*int, error) {
+	candidates, err := client.Player.
+		Query().
+		Where(PlayingOn(d.Day)).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	available := candidates[:0]
+	for _, id := range candidates {
+		if !d.Taken(id) {
+			available = append(available, id)
+		}
+	}
+	if len(available) == 0 {
+		return nil, nil
+	}
+
+	ranks, err := rank(ctx, client, available, d.Day, d.Edges.Contest.Edges.League.StatWeights)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(available, func(i, j int) bool {
+		if ranks[available[i]] != ranks[available[j]] {
+			return ranks[available[i]] > ranks[available[j]]
+		}
+		return available[i] < available[j]
+	})
+
+	return &available[0], nil
+}
+
+// rank returns the average fantasy points that each of the given players scored in
+// their most recent games before day
+func rank(
+	ctx context.Context,
+	client *db.Client,
+	players []int,
+	day time.Time,
+	weights schematype.StatWeights,
+) (map[int]float64, error) {
+	start, _ := nba.DayBounds(day)
+
+	performances, err := client.PlayerPerformance.
+		Query().
+		Where(
+			playerperformance.HasPlayerWith(player.IDIn(players...)),
+			playerperformance.HasGameWith(
+				game.TimeGTE(start.Add(-rankingLookback)),
+				game.TimeLT(start),
+			),
+			playerperformance.MinutesNotNil(),
+		).
+		WithPlayer().
+		WithGame().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Most recent first
+	sort.Slice(performances, func(i, j int) bool {
+		return performances[i].Edges.Game.Time.After(performances[j].Edges.Game.Time)
+	})
+
+	totals := map[int]int{}
+	games := map[int]int{}
+	for _, p := range performances {
+		id := p.Edges.Player.ID
+		if games[id] == rankingGames {
+			continue
+		}
+		totals[id] += scoring.Points(weights, p)
+		games[id]++
+	}
+
+	ranks := map[int]float64{}
+	for id, n := range games {
+		ranks[id] = float64(totals[id]) / float64(n)
+	}
+	return ranks, nil
+}
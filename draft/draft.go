@@ -0,0 +1,413 @@
+// Package draft implements the snake drafts that kick off every Contest. Each member
+// of the league picks one player per round, and the pick order reverses every round
+// (1, 2, 3, 3, 2, 1, 1, 2, 3, ...). The draft order is the order of the contest's
+// entries, which are created alongside the draft by New
+package draft
+
+import (
+	"context"
+	"time"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraft"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraftpick"
+	"github.com/NickDubelman/fantasy-bball/db/contestentry"
+	"github.com/NickDubelman/fantasy-bball/db/game"
+	"github.com/NickDubelman/fantasy-bball/db/leaguemembership"
+	"github.com/NickDubelman/fantasy-bball/db/player"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+	"github.com/NickDubelman/fantasy-bball/db/team"
+	"github.com/NickDubelman/fantasy-bball/nba"
+	"github.com/NickDubelman/fantasy-bball/policy"
+)
+
+// Draft is a snapshot of a ContestDraft: who is drafting, in what order, and the
+// picks that have been made so far
+type Draft struct {
+	*db.ContestDraft
+
+	// Day is the day of the draft's contest (see nba.Day)
+	Day time.Time
+
+	// Order holds the IDs of the drafting users, in first round order
+	Order []int
+
+	// Picks holds the picks made so far, in the order they were made
+	Picks []*db.ContestDraftPick
+}
+
+// New creates the contest for the given league and day, along with its draft and an
+// entry for every member of the league. day must be a calendar day as returned by
+// nba.Day. The entries are created in draft order: the league's members in the order
+// they joined, rotated by the day so that a different member picks first each day.
+// Only the league's commissioners can create contests (see policy.CanEditLeague). It
+// fails with DayInPast or NoGames if there is nothing left to play for that day, and
+// with ContestExists if the league already has a contest that day. The draft starts
+// at draftStarts, or at the time picked by draftStart if it is nil
+func New(
+	ctx context.Context,
+	client *db.Client,
+	userID int,
+	leagueID int,
+	day time.Time,
+	draftStarts *time.Time,
+) (*db.Contest, error) {
+	if err := policy.CanEditLeague(ctx, client, userID, leagueID); err != nil {
+		return nil, err
+	}
+
+	if day.Before(nba.Day(time.Now())) {
+		return nil, DayInPast{}
+	}
+
+	start, end := nba.DayBounds(day)
+	firstGame, err := client.Game.
+		Query().
+		Where(
+			game.TimeGTE(start),
+			game.TimeLT(end),
+			game.Postponed(false),
+		).
+		Order(db.Asc(game.FieldTime)).
+		First(ctx)
+	if db.IsNotFound(err) {
+		return nil, NoGames{}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if draftStarts != nil && !draftStarts.Before(firstGame.Time) {
+		return nil, DraftStartsTooLate{}
+	}
+
+	var c *db.Contest
+
+	err = client.WithTx(ctx, func(tx *db.Tx) error {
+		l, err := tx.League.Get(ctx, leagueID)
+		if err != nil {
+			return err
+		}
+
+		memberships, err := l.
+			QueryMemberships().
+			WithUser().
+			Order(db.Asc(leaguemembership.FieldID)).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		c, err = tx.Contest.
+			Create().
+			SetLeague(l).
+			SetDay(day).
+			Save(ctx)
+		if db.IsConstraintError(err) {
+			return ContestExists{}
+		}
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		started := draftStart(
+			draftStarts,
+			firstGame.Time,
+			l.DraftRounds*len(memberships),
+			l.PickTimeLimit,
+			now,
+		)
+
+		draftCreate := tx.ContestDraft.
+			Create().
+			SetContest(c).
+			SetRounds(l.DraftRounds).
+			SetPickTimeLimit(l.PickTimeLimit).
+			SetStarted(started)
+		if len(memberships) == 0 {
+			draftCreate.SetCompleted(now) // nobody to draft
+		}
+		if _, err := draftCreate.Save(ctx); err != nil {
+			return err
+		}
+
+		n := len(memberships)
+		offset := 0
+		if n > 0 {
+			offset = int(day.Unix()/int64(24*time.Hour/time.Second)) % n
+		}
+
+		entries := make([]*db.ContestEntryCreate, n)
+		for i := range memberships {
+			m := memberships[(i+offset)%n]
+			entries[i] = tx.ContestEntry.
+				Create().
+				SetContest(c).
+				SetUser(m.Edges.User)
+		}
+
+		_, err = tx.ContestEntry.CreateBulk(entries...).Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Unwrap(), nil
+}
+
+// draftStart returns when the clock starts for the first pick of a new draft: at the
+// requested time if there is one, or else early enough for all of the picks to be
+// made before the first game tips off, even if each one takes the full time limit
+// (in seconds). It is never earlier than now
+func draftStart(
+	requested *time.Time,
+	firstGame time.Time,
+	picks int,
+	pickTimeLimit int,
+	now time.Time,
+) time.Time {
+	start := firstGame.Add(-time.Duration(picks*pickTimeLimit) * time.Second)
+	if requested != nil {
+		start = *requested
+	}
+
+	if start.Before(now) {
+		return now
+	}
+	return start
+}
+
+// Load loads the draft with the given ID
+func Load(ctx context.Context, client *db.Client, draftID int) (*Draft, error) {
+	d, err := client.ContestDraft.
+		Query().
+		Where(contestdraft.ID(draftID)).
+		WithContest(func(q *db.ContestQuery) {
+			q.WithLeague()
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := d.Edges.Contest.
+		QueryEntries().
+		WithUser().
+		Order(db.Asc(contestentry.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	picks, err := d.
+		QueryPicks().
+		WithUser().
+		WithPlayer().
+		Order(db.Asc(contestdraftpick.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order := make([]int, len(entries))
+	for i, e := range entries {
+		order[i] = e.Edges.User.ID
+	}
+
+	return &Draft{
+		ContestDraft: d,
+		Day:          d.Edges.Contest.Day,
+		Order:        order,
+		Picks:        picks,
+	}, nil
+}
+
+// Next returns the user who is on the clock and the round they are picking in. ok is
+// false if the draft is complete
+func (d *Draft) Next() (userID, round int, ok bool) {
+	n := len(d.Order)
+	made := len(d.Picks)
+	if n == 0 || made >= n*d.Rounds {
+		return 0, 0, false
+	}
+
+	round = made/n + 1
+	pos := made % n
+	if round%2 == 0 {
+		pos = n - 1 - pos // snake back the other way
+	}
+
+	return d.Order[pos], round, true
+}
+
+// IsComplete returns whether every user has made all of their picks
+func (d *Draft) IsComplete() bool {
+	_, _, ok := d.Next()
+	return !ok
+}
+
+// Taken returns whether the given player has already been picked in the draft
+func (d *Draft) Taken(playerID int) bool {
+	for _, p := range d.Picks {
+		if p.Edges.Player != nil && p.Edges.Player.ID == playerID {
+			return true
+		}
+	}
+	return false
+}
+
+// Pick records userID's pick of playerID. It fails if userID isn't drafting (see
+// policy.CanPick), if the draft hasn't started yet, if it isn't their turn, or if the player has already been taken or
+// isn't playing on the day of the contest. Any users whose pick clock ran out before
+// userID's turn are auto-picked for first (see Expire). The draft is marked as
+// completed once the last pick is made. The updated draft is returned
+func Pick(
+	ctx context.Context,
+	client *db.Client,
+	draftID int,
+	userID int,
+	playerID int,
+) (*Draft, error) {
+	if err := policy.CanPick(ctx, client, userID, draftID); err != nil {
+		return nil, err
+	}
+
+	err := client.WithTx(ctx, func(tx *db.Tx) error {
+		d, err := Load(ctx, tx.Client(), draftID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if now.Before(d.Started) {
+			return NotStarted{}
+		}
+
+		if err := d.expire(ctx, tx, now); err != nil {
+			return err
+		}
+
+		onTheClock, _, ok := d.Next()
+		if !ok {
+			return Complete{}
+		}
+		if onTheClock != userID {
+			return NotYourTurn{}
+		}
+
+		if err := d.checkAvailable(ctx, tx.Client(), playerID); err != nil {
+			return err
+		}
+
+		return d.record(ctx, tx, &playerID, now, false)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Reload the draft outside of the transaction, which is over
+	return Load(ctx, client, draftID)
+}
+
+// record saves the pick of the user on the clock (playerID is nil if there was no
+// one left to pick) as having been made at the given time, and appends it to
+// d.Picks. It must be called within tx
+func (d *Draft) record(
+	ctx context.Context,
+	tx *db.Tx,
+	playerID *int,
+	at time.Time,
+	auto bool,
+) error {
+	userID, round, ok := d.Next()
+	if !ok {
+		return Complete{}
+	}
+
+	pick, err := tx.ContestDraftPick.
+		Create().
+		SetDraftID(d.ID).
+		SetUserID(userID).
+		SetRound(round).
+		SetNillablePlayerID(playerID).
+		SetCreated(at).
+		SetAuto(auto).
+		Save(ctx)
+	if db.IsConstraintError(err) {
+		// Someone else got their pick in first (the unique indexes on picks make sure
+		// that a player or a user's round can only be picked once)
+		return PickConflict{}
+	}
+	if err != nil {
+		return err
+	}
+
+	pick, err = tx.ContestDraftPick.
+		Query().
+		Where(contestdraftpick.ID(pick.ID)).
+		WithUser().
+		WithPlayer().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	d.Picks = append(d.Picks, pick)
+
+	if d.IsComplete() {
+		if err := tx.ContestDraft.UpdateOneID(d.ID).SetCompleted(at).Exec(ctx); err != nil {
+			return err
+		}
+		d.Completed = &at
+	}
+
+	return nil
+}
+
+// checkAvailable returns a PlayerUnavailable error if the given player can't be
+// picked in the draft
+func (d *Draft) checkAvailable(ctx context.Context, client *db.Client, playerID int) error {
+	exists, err := client.Player.Query().Where(player.ID(playerID)).Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return PlayerUnavailable{Reason: "no such player"}
+	}
+
+	if d.Taken(playerID) {
+		return PlayerUnavailable{Reason: "already taken"}
+	}
+
+	playing, err := client.Player.
+		Query().
+		Where(player.ID(playerID), PlayingOn(d.Day)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !playing {
+		return PlayerUnavailable{Reason: "not playing today"}
+	}
+
+	return nil
+}
+
+// PlayingOn returns a predicate matching the players whose team has a game on the
+// given day that hasn't been postponed
+func PlayingOn(day time.Time) predicate.Player {
+	start, end := nba.DayBounds(day)
+	onDay := []predicate.Game{
+		game.TimeGTE(start),
+		game.TimeLT(end),
+		game.Postponed(false),
+	}
+
+	return player.HasTeamWith(
+		team.Or(
+			team.HasHomeGamesWith(onDay...),
+			team.HasAwayGamesWith(onDay...),
+		),
+	)
+}
@@ -0,0 +1,169 @@
+package draft
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/NickDubelman/fantasy-bball/auth"
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/enttest"
+	"github.com/NickDubelman/fantasy-bball/leagues"
+	"github.com/NickDubelman/fantasy-bball/nba"
+)
+
+// draftWithPicks returns a draft between the given users in which the given number
+// of picks have been made
+func draftWithPicks(rounds int, order []int, made int) *Draft {
+	return &Draft{
+		ContestDraft: &db.ContestDraft{Rounds: rounds},
+		Order:        order,
+		Picks:        make([]*db.ContestDraftPick, made),
+	}
+}
+
+func TestNext(t *testing.T) {
+	tests := []struct {
+		name      string
+		rounds    int
+		order     []int
+		made      int
+		wantUser  int
+		wantRound int
+		wantOK    bool
+	}{
+		{name: "first pick", rounds: 3, order: []int{10, 20, 30}, made: 0, wantUser: 10, wantRound: 1, wantOK: true},
+		{name: "end of round 1", rounds: 3, order: []int{10, 20, 30}, made: 2, wantUser: 30, wantRound: 1, wantOK: true},
+		{name: "start of round 2 snakes back", rounds: 3, order: []int{10, 20, 30}, made: 3, wantUser: 30, wantRound: 2, wantOK: true},
+		{name: "middle of round 2", rounds: 3, order: []int{10, 20, 30}, made: 4, wantUser: 20, wantRound: 2, wantOK: true},
+		{name: "end of round 2", rounds: 3, order: []int{10, 20, 30}, made: 5, wantUser: 10, wantRound: 2, wantOK: true},
+		{name: "start of round 3", rounds: 3, order: []int{10, 20, 30}, made: 6, wantUser: 10, wantRound: 3, wantOK: true},
+		{name: "last pick", rounds: 3, order: []int{10, 20, 30}, made: 8, wantUser: 30, wantRound: 3, wantOK: true},
+		{name: "complete", rounds: 3, order: []int{10, 20, 30}, made: 9},
+		{name: "more picks than expected", rounds: 3, order: []int{10, 20, 30}, made: 10},
+		{name: "one drafter", rounds: 2, order: []int{10}, made: 1, wantUser: 10, wantRound: 2, wantOK: true},
+		{name: "two drafters, round 2", rounds: 2, order: []int{10, 20}, made: 2, wantUser: 20, wantRound: 2, wantOK: true},
+		{name: "no drafters", rounds: 3, order: nil, made: 0},
+		{name: "no rounds", rounds: 0, order: []int{10, 20}, made: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := draftWithPicks(tt.rounds, tt.order, tt.made)
+
+			userID, round, ok := d.Next()
+			if userID != tt.wantUser || round != tt.wantRound || ok != tt.wantOK {
+				t.Errorf(
+					"Next() = (%d, %d, %v), want (%d, %d, %v)",
+					userID, round, ok, tt.wantUser, tt.wantRound, tt.wantOK,
+				)
+			}
+			if d.IsComplete() == tt.wantOK {
+				t.Errorf("IsComplete() = %v, want %v", d.IsComplete(), !tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestNewValidation(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:draft?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	commissioner := client.User.Create().SetName("commissioner").SetEmail("c@example.com").SaveX(ctx)
+	member := client.User.Create().SetName("member").SetEmail("m@example.com").SaveX(ctx)
+	name := "Lakers fans"
+	l, err := leagues.Create(ctx, client, commissioner.ID, leagues.Settings{Name: &name})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.LeagueMembership.Create().SetLeague(l).SetUser(member).SaveX(ctx)
+
+	newTeam := func(shortName, location, name string) *db.Team {
+		return client.Team.
+			Create().
+			SetExternalID(shortName).
+			SetShortName(shortName).
+			SetLocation(location).
+			SetName(name).
+			SaveX(ctx)
+	}
+	home := newTeam("LAL", "Los Angeles", "Lakers")
+	away := newTeam("PHX", "Phoenix", "Suns")
+	newGame := func(id string, day time.Time, postponed bool) {
+		start, _ := nba.DayBounds(day)
+		client.Game.
+			Create().
+			SetExternalID(id).
+			SetTime(start.Add(19 * time.Hour)). // 7pm ET
+			SetPostponed(postponed).
+			SetHomeTeam(home).
+			SetAwayTeam(away).
+			SaveX(ctx)
+	}
+
+	today := nba.Day(time.Now())
+	yesterday := today.AddDate(0, 0, -1)
+	tomorrow := today.AddDate(0, 0, 1)
+	postponed := today.AddDate(0, 0, 2)
+	newGame("yesterday", yesterday, false)
+	newGame("tomorrow", tomorrow, false)
+	newGame("postponed", postponed, true)
+
+	start, _ := nba.DayBounds(tomorrow)
+	tipOff := start.Add(19 * time.Hour)
+
+	tests := []struct {
+		name        string
+		userID      int
+		day         time.Time
+		draftStarts *time.Time
+		wantErr     error
+	}{
+		{"day in the past", commissioner.ID, yesterday, nil, DayInPast{}},
+		{"no games", commissioner.ID, today, nil, NoGames{}},
+		{"only postponed games", commissioner.ID, postponed, nil, NoGames{}},
+		{"not a commissioner", member.ID, tomorrow, nil, auth.NotAuthorized{}},
+		{"draft starts after the first game", commissioner.ID, tomorrow, &tipOff, DraftStartsTooLate{}},
+		{"valid", commissioner.ID, tomorrow, nil, nil},
+		{"already exists", commissioner.ID, tomorrow, nil, ContestExists{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(ctx, client, tt.userID, l.ID, tt.day, tt.draftStarts)
+			if err != tt.wantErr {
+				t.Errorf("New() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDraftStart(t *testing.T) {
+	now := time.Date(2021, 1, 5, 17, 0, 0, 0, time.UTC)
+	firstGame := now.Add(3 * time.Hour)
+	at := func(t time.Time) *time.Time { return &t }
+
+	tests := []struct {
+		name      string
+		requested *time.Time
+		picks     int
+		want      time.Time
+	}{
+		{"finishes before the first game", nil, 20, firstGame.Add(-20 * time.Minute)},
+		{"no time left", nil, 200, now},
+		{"requested", at(now.Add(time.Hour)), 20, now.Add(time.Hour)},
+		{"requested in the past", at(now.Add(-time.Hour)), 20, now},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := draftStart(tt.requested, firstGame, tt.picks, 60, now)
+			if !got.Equal(tt.want) {
+				t.Errorf("draftStart() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package draft
+
+import "fmt"
+
+// NotYourTurn is an error for when a user tries to pick while someone else is on the
+// clock
+type NotYourTurn struct{}
+
+func (e NotYourTurn) Error() string {
+	return "it is not your turn to pick"
+}
+
+// Complete is an error for when a user tries to pick in a draft that is already over
+type Complete struct{}
+
+func (e Complete) Error() string {
+	return "the draft is already complete"
+}
+
+// PlayerUnavailable is an error for when a user tries to pick a player that can't be
+// picked
+type PlayerUnavailable struct {
+	Reason string
+}
+
+func (e PlayerUnavailable) Error() string {
+	return fmt.Sprintf("player is unavailable: %s", e.Reason)
+}
+
+// ContestExists is an error for when a league already has a contest on the day a new
+// one is being created for
+type ContestExists struct{}
+
+func (e ContestExists) Error() string {
+	return "the league already has a contest on that day"
+}
+
+// NotStarted is an error for when a user tries to pick before the draft's clock has
+// started
+type NotStarted struct{}
+
+func (e NotStarted) Error() string {
+	return "the draft hasn't started yet"
+}
+
+// DayInPast is an error for when a contest is being created for a day that is already
+// over
+type DayInPast struct{}
+
+func (e DayInPast) Error() string {
+	return "contests can't be created for days in the past"
+}
+
+// NoGames is an error for when a contest is being created for a day without any games
+// to draft players from
+type NoGames struct{}
+
+func (e NoGames) Error() string {
+	return "there are no games on that day"
+}
+
+// DraftStartsTooLate is an error for when a contest's draft is set to start after
+// the contest's first game has already tipped off
+type DraftStartsTooLate struct{}
+
+func (e DraftStartsTooLate) Error() string {
+	return "the draft has to start before the first game tips off"
+}
+
+// PickConflict is an error for when a pick loses a race with another pick, made at the
+// same time, for the same player or the same slot in the draft. The pick can be retried
+type PickConflict struct{}
+
+func (e PickConflict) Error() string {
+	return "pick conflicted with another pick, please try again"
+}
@@ -0,0 +1,63 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/graph/model"
+	"github.com/NickDubelman/fantasy-bball/policy"
+)
+
+// AuthDirective implements the @auth directive, which only lets users with the given
+// role resolve a field (see schema/schema.graphql)
+func AuthDirective(
+	ctx context.Context,
+	obj interface{},
+	next graphql.Resolver,
+	requires model.Role,
+	league *string,
+) (interface{}, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if requires != model.RoleUser {
+		leagueID, err := authLeagueID(ctx, obj, league)
+		if err != nil {
+			return nil, err
+		}
+
+		client, err := dbFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		err = policy.Check(ctx, client, userID, leagueID, policy.Role(requires))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return next(ctx)
+}
+
+// authLeagueID returns the ID of the league that an @auth directive checks the role
+// in: the one whose global ID is in the argument named arg, or the League that the
+// field is on
+func authLeagueID(ctx context.Context, obj interface{}, arg *string) (int, error) {
+	if arg != nil {
+		id, _ := graphql.GetFieldContext(ctx).Args[*arg].(string)
+		return db.DecodeGlobalIDOf("League", id)
+	}
+
+	l, ok := obj.(*db.League)
+	if !ok {
+		field := graphql.GetFieldContext(ctx).Field.Name
+		return 0, fmt.Errorf("@auth on %s needs a league argument", field)
+	}
+	return l.ID, nil
+}
@@ -0,0 +1,58 @@
+package graph
+
+import (
+	"context"
+	"time"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/graph/model"
+	"github.com/NickDubelman/fantasy-bball/graph/pagination"
+	"github.com/NickDubelman/fantasy-bball/nba"
+)
+
+// today returns the current calendar day in the same form as Contest.day
+func today() time.Time {
+	return nba.Day(time.Now())
+}
+
+// calendarDay returns the calendar day of t, in t's own location, in the same form
+// as Contest.day
+func calendarDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// contestConnection runs the given contest query for the requested page
+func contestConnection(
+	ctx context.Context,
+	query *db.ContestQuery,
+	args pagination.Args,
+	order pagination.Order,
+) (*model.ContestConnection, error) {
+	p, err := pagination.New(args, order)
+	if err != nil {
+		return nil, err
+	}
+
+	contests, err := query.
+		Where(p.Where()).
+		Order(p.Order()).
+		Limit(p.Limit()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	info, cursors := p.Page(&contests, func(i int) pagination.Cursor {
+		return pagination.Cursor{ID: contests[i].ID, Value: contests[i].Day}
+	})
+
+	conn := &model.ContestConnection{
+		PageInfo: info,
+		Edges:    make([]*model.ContestEdge, len(contests)),
+	}
+	for i, c := range contests {
+		conn.Edges[i] = &model.ContestEdge{Cursor: cursors[i], Node: c}
+	}
+	return conn, nil
+}
@@ -0,0 +1,208 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"time"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraftpick"
+	"github.com/NickDubelman/fantasy-bball/db/contestentrycorrection"
+	"github.com/NickDubelman/fantasy-bball/draft"
+	"github.com/NickDubelman/fantasy-bball/graph/generated"
+	"github.com/NickDubelman/fantasy-bball/graph/model"
+	"github.com/NickDubelman/fantasy-bball/graph/pagination"
+	"github.com/NickDubelman/fantasy-bball/scoring"
+)
+
+func (r *contestResolver) League(ctx context.Context, obj *db.Contest) (*db.League, error) {
+	return obj.QueryLeague().Only(ctx)
+}
+
+func (r *contestResolver) Winner(ctx context.Context, obj *db.Contest) (*db.User, error) {
+	winner, err := obj.QueryWinner().Only(ctx)
+	if db.IsNotFound(err) {
+		return nil, nil // contest hasn't been decided yet
+	}
+	return winner, err
+}
+
+func (r *contestResolver) Draft(ctx context.Context, obj *db.Contest) (*db.ContestDraft, error) {
+	return obj.QueryDraft().Only(ctx)
+}
+
+func (r *contestResolver) Entries(ctx context.Context, obj *db.Contest, first *int, after *string, last *int, before *string) (*model.ContestEntryConnection, error) {
+	p, err := pagination.New(
+		pagination.Args{First: first, After: after, Last: last, Before: before},
+		pagination.Order{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Entries are paginated in draft order rather than by totalPoints, which changes
+	// between page fetches while the contest is open
+	entries, err := obj.
+		QueryEntries().
+		Where(p.Where()).
+		Order(p.Order()).
+		Limit(p.Limit()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	info, cursors := p.Page(&entries, func(i int) pagination.Cursor {
+		return pagination.Cursor{ID: entries[i].ID}
+	})
+
+	conn := &model.ContestEntryConnection{
+		PageInfo: info,
+		Edges:    make([]*model.ContestEntryEdge, len(entries)),
+	}
+	for i, e := range entries {
+		conn.Edges[i] = &model.ContestEntryEdge{Cursor: cursors[i], Node: e}
+	}
+	return conn, nil
+}
+
+func (r *contestDraftResolver) Picks(ctx context.Context, obj *db.ContestDraft) ([]*db.ContestDraftPick, error) {
+	return obj.
+		QueryPicks().
+		Order(db.Asc(contestdraftpick.FieldRound), db.Asc(contestdraftpick.FieldID)).
+		All(ctx)
+}
+
+func (r *contestDraftResolver) PickDeadline(ctx context.Context, obj *db.ContestDraft) (*time.Time, error) {
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := draft.Load(ctx, client, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline, ok := d.Deadline()
+	if !ok {
+		return nil, nil
+	}
+	return &deadline, nil
+}
+
+func (r *contestDraftPickResolver) Draft(ctx context.Context, obj *db.ContestDraftPick) (*db.ContestDraft, error) {
+	return obj.QueryDraft().Only(ctx)
+}
+
+func (r *contestDraftPickResolver) User(ctx context.Context, obj *db.ContestDraftPick) (*db.User, error) {
+	return obj.QueryUser().Only(ctx)
+}
+
+func (r *contestDraftPickResolver) Player(ctx context.Context, obj *db.ContestDraftPick) (*db.Player, error) {
+	p, err := obj.QueryPlayer().Only(ctx)
+	if db.IsNotFound(err) {
+		return nil, nil
+	}
+	return p, err
+}
+
+func (r *contestEntryResolver) User(ctx context.Context, obj *db.ContestEntry) (*db.User, error) {
+	return obj.QueryUser().Only(ctx)
+}
+
+func (r *contestEntryResolver) Contest(ctx context.Context, obj *db.ContestEntry) (*db.Contest, error) {
+	return obj.QueryContest().Only(ctx)
+}
+
+func (r *contestEntryResolver) Players(ctx context.Context, obj *db.ContestEntry) ([]*db.PlayerPerformance, error) {
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return scoring.EntryPerformances(ctx, client, obj.ID)
+}
+
+func (r *contestEntryResolver) Corrections(ctx context.Context, obj *db.ContestEntry) ([]*db.ContestEntryCorrection, error) {
+	return obj.
+		QueryCorrections().
+		Order(db.Desc(contestentrycorrection.FieldCreated), db.Desc(contestentrycorrection.FieldID)).
+		All(ctx)
+}
+
+func (r *contestEntryCorrectionResolver) Entry(ctx context.Context, obj *db.ContestEntryCorrection) (*db.ContestEntry, error) {
+	return obj.QueryEntry().Only(ctx)
+}
+
+func (r *mutationResolver) CreateContest(ctx context.Context, leagueID string, day time.Time, draftStarts *time.Time) (*db.Contest, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lID, err := db.DecodeGlobalIDOf("League", leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	return draft.New(ctx, client, userID, lID, calendarDay(day), draftStarts)
+}
+
+func (r *mutationResolver) PickPlayer(ctx context.Context, draftID string, playerID string) (*db.ContestDraft, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dID, err := db.DecodeGlobalIDOf("ContestDraft", draftID)
+	if err != nil {
+		return nil, err
+	}
+	pID, err := db.DecodeGlobalIDOf("Player", playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := draft.Pick(ctx, client, dID, userID, pID)
+	if err != nil {
+		return nil, err
+	}
+	return d.ContestDraft, nil
+}
+
+// Contest returns generated.ContestResolver implementation.
+func (r *Resolver) Contest() generated.ContestResolver { return &contestResolver{r} }
+
+// ContestDraft returns generated.ContestDraftResolver implementation.
+func (r *Resolver) ContestDraft() generated.ContestDraftResolver { return &contestDraftResolver{r} }
+
+// ContestDraftPick returns generated.ContestDraftPickResolver implementation.
+func (r *Resolver) ContestDraftPick() generated.ContestDraftPickResolver {
+	return &contestDraftPickResolver{r}
+}
+
+// ContestEntry returns generated.ContestEntryResolver implementation.
+func (r *Resolver) ContestEntry() generated.ContestEntryResolver { return &contestEntryResolver{r} }
+
+// ContestEntryCorrection returns generated.ContestEntryCorrectionResolver implementation.
+func (r *Resolver) ContestEntryCorrection() generated.ContestEntryCorrectionResolver {
+	return &contestEntryCorrectionResolver{r}
+}
+
+type contestResolver struct{ *Resolver }
+type contestDraftResolver struct{ *Resolver }
+type contestDraftPickResolver struct{ *Resolver }
+type contestEntryResolver struct{ *Resolver }
+type contestEntryCorrectionResolver struct{ *Resolver }
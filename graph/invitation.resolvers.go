@@ -0,0 +1,139 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/graph/generated"
+	"github.com/NickDubelman/fantasy-bball/graph/model"
+	"github.com/NickDubelman/fantasy-bball/leagues"
+)
+
+func (r *invitationResolver) League(ctx context.Context, obj *db.Invitation) (*db.League, error) {
+	return obj.QueryLeague().Only(ctx)
+}
+
+func (r *invitationResolver) Inviter(ctx context.Context, obj *db.Invitation) (*db.User, error) {
+	return obj.QueryInviter().Only(ctx)
+}
+
+func (r *mutationResolver) InviteByEmail(ctx context.Context, leagueID string, email string) (*db.Invitation, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lID, err := db.DecodeGlobalIDOf("League", leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	return leagues.InviteByEmail(ctx, client, userID, lID, email)
+}
+
+func (r *mutationResolver) CreateInviteLink(ctx context.Context, leagueID string, expiresIn *int) (*model.InviteLink, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lID, err := db.DecodeGlobalIDOf("League", leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	inv, token, err := leagues.CreateInviteLink(ctx, client, userID, lID, expiresIn)
+	if err != nil {
+		return nil, err
+	}
+	return &model.InviteLink{Invitation: inv, Token: token}, nil
+}
+
+func (r *mutationResolver) AcceptInvitation(ctx context.Context, id string) (*db.League, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitationID, err := db.DecodeGlobalIDOf("Invitation", id)
+	if err != nil {
+		return nil, err
+	}
+
+	return leagues.Accept(ctx, client, userID, invitationID)
+}
+
+func (r *mutationResolver) AcceptInviteLink(ctx context.Context, token string) (*db.League, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return leagues.AcceptLink(ctx, client, userID, token)
+}
+
+func (r *mutationResolver) DeclineInvitation(ctx context.Context, id string) (*db.Invitation, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitationID, err := db.DecodeGlobalIDOf("Invitation", id)
+	if err != nil {
+		return nil, err
+	}
+
+	return leagues.Decline(ctx, client, userID, invitationID)
+}
+
+func (r *mutationResolver) RevokeInvitation(ctx context.Context, id string) (*db.Invitation, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitationID, err := db.DecodeGlobalIDOf("Invitation", id)
+	if err != nil {
+		return nil, err
+	}
+
+	return leagues.Revoke(ctx, client, userID, invitationID)
+}
+
+// Invitation returns generated.InvitationResolver implementation.
+func (r *Resolver) Invitation() generated.InvitationResolver { return &invitationResolver{r} }
+
+type invitationResolver struct{ *Resolver }
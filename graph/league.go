@@ -0,0 +1,39 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/graph/model"
+	"github.com/NickDubelman/fantasy-bball/graph/pagination"
+	"github.com/NickDubelman/fantasy-bball/policy"
+)
+
+// memberEdge returns the LeagueMemberEdge for a membership, which must have been
+// loaded with its user. The cursor is the one the membership has when paginating
+// over League.members
+func memberEdge(m *db.LeagueMembership) *model.LeagueMemberEdge {
+	return &model.LeagueMemberEdge{
+		Cursor:         pagination.Cursor{ID: m.ID}.String(),
+		Node:           m.Edges.User,
+		IsCommissioner: m.IsCommissioner,
+		CanInvite:      m.CanInvite,
+	}
+}
+
+// checkMember returns auth.NotAuthorized unless the user making the request is a
+// member of the league. The resolvers of fields declared with @auth(requires: MEMBER)
+// check too, so that they stay safe if the directive is ever dropped
+func checkMember(ctx context.Context, leagueID int) error {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return policy.Check(ctx, client, userID, leagueID, policy.Member)
+}
@@ -0,0 +1,217 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/contest"
+	"github.com/NickDubelman/fantasy-bball/graph/generated"
+	"github.com/NickDubelman/fantasy-bball/graph/model"
+	"github.com/NickDubelman/fantasy-bball/graph/pagination"
+	"github.com/NickDubelman/fantasy-bball/leagues"
+)
+
+func (r *leagueResolver) Members(ctx context.Context, obj *db.League, first *int, after *string, last *int, before *string) (*model.LeagueMemberConnection, error) {
+	if err := checkMember(ctx, obj.ID); err != nil {
+		return nil, err
+	}
+
+	p, err := pagination.New(
+		pagination.Args{First: first, After: after, Last: last, Before: before},
+		pagination.Order{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Paginate over the memberships, since they carry the edge fields
+	memberships, err := obj.
+		QueryMemberships().
+		WithUser().
+		Where(p.Where()).
+		Order(p.Order()).
+		Limit(p.Limit()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	info, cursors := p.Page(&memberships, func(i int) pagination.Cursor {
+		return pagination.Cursor{ID: memberships[i].ID}
+	})
+
+	conn := &model.LeagueMemberConnection{
+		PageInfo: info,
+		Edges:    make([]*model.LeagueMemberEdge, len(memberships)),
+	}
+	for i, m := range memberships {
+		conn.Edges[i] = memberEdge(m)
+		conn.Edges[i].Cursor = cursors[i]
+	}
+	return conn, nil
+}
+
+func (r *leagueResolver) CurrentContests(ctx context.Context, obj *db.League, first *int, after *string, last *int, before *string) (*model.ContestConnection, error) {
+	if err := checkMember(ctx, obj.ID); err != nil {
+		return nil, err
+	}
+
+	return contestConnection(
+		ctx,
+		obj.QueryContests().Where(contest.DayGTE(today())),
+		pagination.Args{First: first, After: after, Last: last, Before: before},
+		pagination.Order{Field: contest.FieldDay, Type: pagination.Time},
+	)
+}
+
+func (r *leagueResolver) PreviousContests(ctx context.Context, obj *db.League, first *int, after *string, last *int, before *string) (*model.ContestConnection, error) {
+	if err := checkMember(ctx, obj.ID); err != nil {
+		return nil, err
+	}
+
+	return contestConnection(
+		ctx,
+		obj.QueryContests().Where(contest.DayLT(today())),
+		pagination.Args{First: first, After: after, Last: last, Before: before},
+		pagination.Order{Field: contest.FieldDay, Type: pagination.Time, Desc: true},
+	)
+}
+
+func (r *leagueResolver) Invitations(ctx context.Context, obj *db.League) ([]*db.Invitation, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return leagues.LeagueInvitations(ctx, client, userID, obj.ID)
+}
+
+func (r *mutationResolver) CreateLeague(ctx context.Context, input model.CreateLeagueInput) (*db.League, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return leagues.Create(ctx, client, userID, leagues.Settings{
+		Name:          &input.Name,
+		Description:   input.Description,
+		MaxMembers:    input.MaxMembers,
+		StatWeights:   input.StatWeights,
+		DraftRounds:   input.DraftRounds,
+		PickTimeLimit: input.PickTimeLimit,
+	})
+}
+
+func (r *mutationResolver) UpdateLeague(ctx context.Context, id string, input leagues.Settings) (*db.League, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	leagueID, err := db.DecodeGlobalIDOf("League", id)
+	if err != nil {
+		return nil, err
+	}
+
+	return leagues.Update(ctx, client, userID, leagueID, input)
+}
+
+func (r *mutationResolver) LeaveLeague(ctx context.Context, id string) (bool, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	leagueID, err := db.DecodeGlobalIDOf("League", id)
+	if err != nil {
+		return false, err
+	}
+
+	if err := leagues.Leave(ctx, client, userID, leagueID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) RemoveMember(ctx context.Context, leagueID string, userID string) (*db.League, error) {
+	currentUserID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lID, err := db.DecodeGlobalIDOf("League", leagueID)
+	if err != nil {
+		return nil, err
+	}
+	uID, err := db.DecodeGlobalIDOf("User", userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := leagues.RemoveMember(ctx, client, currentUserID, lID, uID); err != nil {
+		return nil, err
+	}
+	return client.League.Get(ctx, lID)
+}
+
+func (r *mutationResolver) SetMemberPermissions(ctx context.Context, leagueID string, userID string, isCommissioner *bool, canInvite *bool) (*model.LeagueMemberEdge, error) {
+	currentUserID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lID, err := db.DecodeGlobalIDOf("League", leagueID)
+	if err != nil {
+		return nil, err
+	}
+	uID, err := db.DecodeGlobalIDOf("User", userID)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := leagues.SetMemberPermissions(
+		ctx, client, currentUserID, lID, uID, isCommissioner, canInvite,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return memberEdge(m), nil
+}
+
+// League returns generated.LeagueResolver implementation.
+func (r *Resolver) League() generated.LeagueResolver { return &leagueResolver{r} }
+
+type leagueResolver struct{ *Resolver }
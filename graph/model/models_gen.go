@@ -0,0 +1,124 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package model
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/schema/schematype"
+	"github.com/NickDubelman/fantasy-bball/graph/pagination"
+)
+
+type ContestConnection struct {
+	PageInfo *pagination.PageInfo `json:"pageInfo"`
+	Edges    []*ContestEdge       `json:"edges"`
+}
+
+type ContestEdge struct {
+	Cursor string      `json:"cursor"`
+	Node   *db.Contest `json:"node"`
+}
+
+type ContestEntryConnection struct {
+	PageInfo *pagination.PageInfo `json:"pageInfo"`
+	Edges    []*ContestEntryEdge  `json:"edges"`
+}
+
+type ContestEntryEdge struct {
+	Cursor string           `json:"cursor"`
+	Node   *db.ContestEntry `json:"node"`
+}
+
+type CreateLeagueInput struct {
+	Name          string                  `json:"name"`
+	Description   *string                 `json:"description"`
+	MaxMembers    *int                    `json:"maxMembers"`
+	StatWeights   *schematype.StatWeights `json:"statWeights"`
+	DraftRounds   *int                    `json:"draftRounds"`
+	PickTimeLimit *int                    `json:"pickTimeLimit"`
+}
+
+type GameConnection struct {
+	PageInfo *pagination.PageInfo `json:"pageInfo"`
+	Edges    []*GameEdge          `json:"edges"`
+}
+
+type GameEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *db.Game `json:"node"`
+}
+
+type InviteLink struct {
+	Invitation *db.Invitation `json:"invitation"`
+	Token      string         `json:"token"`
+}
+
+type LeagueConnection struct {
+	PageInfo *pagination.PageInfo `json:"pageInfo"`
+	Edges    []*LeagueEdge        `json:"edges"`
+}
+
+type LeagueEdge struct {
+	Cursor string     `json:"cursor"`
+	Node   *db.League `json:"node"`
+}
+
+type LeagueMemberConnection struct {
+	PageInfo *pagination.PageInfo `json:"pageInfo"`
+	Edges    []*LeagueMemberEdge  `json:"edges"`
+}
+
+type LeagueMemberEdge struct {
+	Cursor         string   `json:"cursor"`
+	Node           *db.User `json:"node"`
+	IsCommissioner bool     `json:"isCommissioner"`
+	CanInvite      bool     `json:"canInvite"`
+}
+
+type Role string
+
+const (
+	RoleUser         Role = "USER"
+	RoleMember       Role = "MEMBER"
+	RoleInviter      Role = "INVITER"
+	RoleCommissioner Role = "COMMISSIONER"
+)
+
+var AllRole = []Role{
+	RoleUser,
+	RoleMember,
+	RoleInviter,
+	RoleCommissioner,
+}
+
+func (e Role) IsValid() bool {
+	switch e {
+	case RoleUser, RoleMember, RoleInviter, RoleCommissioner:
+		return true
+	}
+	return false
+}
+
+func (e Role) String() string {
+	return string(e)
+}
+
+func (e *Role) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Role(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Role", str)
+	}
+	return nil
+}
+
+func (e Role) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
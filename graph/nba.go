@@ -0,0 +1,73 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/game"
+	"github.com/NickDubelman/fantasy-bball/db/gameresult"
+	"github.com/NickDubelman/fantasy-bball/db/playerperformance"
+	"github.com/NickDubelman/fantasy-bball/db/team"
+	"github.com/NickDubelman/fantasy-bball/graph/model"
+	"github.com/NickDubelman/fantasy-bball/graph/pagination"
+)
+
+// recentPerformancesLimit is how many games back Player.recentPerformances goes
+const recentPerformancesLimit = 10
+
+// teamPerformances returns the performances of a team's players in the game that
+// result belongs to
+func teamPerformances(
+	ctx context.Context,
+	result *db.GameResult,
+	teamID int,
+) ([]*db.PlayerPerformance, error) {
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.PlayerPerformance.
+		Query().
+		Where(
+			playerperformance.HasGameWith(game.HasResultWith(gameresult.ID(result.ID))),
+			playerperformance.HasTeamWith(team.ID(teamID)),
+		).
+		Order(db.Asc(playerperformance.FieldID)).
+		All(ctx)
+}
+
+// gameConnection runs the given game query for the requested page
+func gameConnection(
+	ctx context.Context,
+	query *db.GameQuery,
+	args pagination.Args,
+	order pagination.Order,
+) (*model.GameConnection, error) {
+	p, err := pagination.New(args, order)
+	if err != nil {
+		return nil, err
+	}
+
+	games, err := query.
+		Where(p.Where()).
+		Order(p.Order()).
+		Limit(p.Limit()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	info, cursors := p.Page(&games, func(i int) pagination.Cursor {
+		return pagination.Cursor{ID: games[i].ID, Value: games[i].Time}
+	})
+
+	conn := &model.GameConnection{
+		PageInfo: info,
+		Edges:    make([]*model.GameEdge, len(games)),
+	}
+	for i, g := range games {
+		conn.Edges[i] = &model.GameEdge{Cursor: cursors[i], Node: g}
+	}
+	return conn, nil
+}
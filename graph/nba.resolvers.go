@@ -0,0 +1,185 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/game"
+	"github.com/NickDubelman/fantasy-bball/db/player"
+	"github.com/NickDubelman/fantasy-bball/db/playerperformance"
+	"github.com/NickDubelman/fantasy-bball/db/team"
+	"github.com/NickDubelman/fantasy-bball/graph/generated"
+	"github.com/NickDubelman/fantasy-bball/graph/model"
+	"github.com/NickDubelman/fantasy-bball/graph/pagination"
+)
+
+func (r *gameResolver) HomeTeam(ctx context.Context, obj *db.Game) (*db.Team, error) {
+	return obj.QueryHomeTeam().Only(ctx)
+}
+
+func (r *gameResolver) AwayTeam(ctx context.Context, obj *db.Game) (*db.Team, error) {
+	return obj.QueryAwayTeam().Only(ctx)
+}
+
+func (r *gameResolver) Result(ctx context.Context, obj *db.Game) (*db.GameResult, error) {
+	result, err := obj.QueryResult().Only(ctx)
+	if db.IsNotFound(err) {
+		return nil, nil // game hasn't been played yet
+	}
+	return result, err
+}
+
+func (r *gameResultResolver) Winner(ctx context.Context, obj *db.GameResult) (*db.Team, error) {
+	winner, err := obj.QueryWinner().Only(ctx)
+	if db.IsNotFound(err) {
+		return nil, nil
+	}
+	return winner, err
+}
+
+func (r *gameResultResolver) HomeTeamPerformances(ctx context.Context, obj *db.GameResult) ([]*db.PlayerPerformance, error) {
+	homeTeam, err := obj.QueryGame().QueryHomeTeam().OnlyID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return teamPerformances(ctx, obj, homeTeam)
+}
+
+func (r *gameResultResolver) AwayTeamPerformances(ctx context.Context, obj *db.GameResult) ([]*db.PlayerPerformance, error) {
+	awayTeam, err := obj.QueryGame().QueryAwayTeam().OnlyID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return teamPerformances(ctx, obj, awayTeam)
+}
+
+func (r *playerResolver) RecentPerformances(ctx context.Context, obj *db.Player) ([]*db.PlayerPerformance, error) {
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the player's most recent games first, so that we don't have to load every
+	// performance they have ever had
+	games, err := client.Game.
+		Query().
+		Where(
+			game.HasPerformancesWith(playerperformance.HasPlayerWith(player.ID(obj.ID))),
+			game.TimeLT(time.Now()),
+		).
+		Order(db.Desc(game.FieldTime)).
+		Limit(recentPerformancesLimit).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	performances, err := obj.
+		QueryPerformances().
+		Where(playerperformance.HasGameWith(game.IDIn(games...))).
+		WithGame().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(performances, func(i, j int) bool {
+		return performances[i].Edges.Game.Time.After(performances[j].Edges.Game.Time)
+	})
+	return performances, nil
+}
+
+func (r *playerResolver) Team(ctx context.Context, obj *db.Player) (*db.Team, error) {
+	t, err := obj.QueryTeam().Only(ctx)
+	if db.IsNotFound(err) {
+		return nil, nil // player might not have a team
+	}
+	return t, err
+}
+
+func (r *playerPerformanceResolver) Player(ctx context.Context, obj *db.PlayerPerformance) (*db.Player, error) {
+	return obj.QueryPlayer().Only(ctx)
+}
+
+func (r *playerPerformanceResolver) Game(ctx context.Context, obj *db.PlayerPerformance) (*db.Game, error) {
+	return obj.QueryGame().Only(ctx)
+}
+
+func (r *teamResolver) RecentGames(ctx context.Context, obj *db.Team, first *int, after *string, last *int, before *string) (*model.GameConnection, error) {
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return gameConnection(
+		ctx,
+		client.Game.
+			Query().
+			Where(
+				game.Or(
+					game.HasHomeTeamWith(team.ID(obj.ID)),
+					game.HasAwayTeamWith(team.ID(obj.ID)),
+				),
+				game.TimeLT(time.Now()),
+			),
+		pagination.Args{First: first, After: after, Last: last, Before: before},
+		pagination.Order{Field: game.FieldTime, Type: pagination.Time, Desc: true},
+	)
+}
+
+func (r *teamResolver) UpcomingGames(ctx context.Context, obj *db.Team, first *int, after *string, last *int, before *string) (*model.GameConnection, error) {
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return gameConnection(
+		ctx,
+		client.Game.
+			Query().
+			Where(
+				game.Or(
+					game.HasHomeTeamWith(team.ID(obj.ID)),
+					game.HasAwayTeamWith(team.ID(obj.ID)),
+				),
+				game.TimeGTE(time.Now()),
+			),
+		pagination.Args{First: first, After: after, Last: last, Before: before},
+		pagination.Order{Field: game.FieldTime, Type: pagination.Time},
+	)
+}
+
+func (r *teamResolver) Players(ctx context.Context, obj *db.Team) ([]*db.Player, error) {
+	return obj.
+		QueryPlayers().
+		Order(db.Asc(player.FieldName)).
+		All(ctx)
+}
+
+// Game returns generated.GameResolver implementation.
+func (r *Resolver) Game() generated.GameResolver { return &gameResolver{r} }
+
+// GameResult returns generated.GameResultResolver implementation.
+func (r *Resolver) GameResult() generated.GameResultResolver { return &gameResultResolver{r} }
+
+// Player returns generated.PlayerResolver implementation.
+func (r *Resolver) Player() generated.PlayerResolver { return &playerResolver{r} }
+
+// PlayerPerformance returns generated.PlayerPerformanceResolver implementation.
+func (r *Resolver) PlayerPerformance() generated.PlayerPerformanceResolver {
+	return &playerPerformanceResolver{r}
+}
+
+// Team returns generated.TeamResolver implementation.
+func (r *Resolver) Team() generated.TeamResolver { return &teamResolver{r} }
+
+type gameResolver struct{ *Resolver }
+type gameResultResolver struct{ *Resolver }
+type playerResolver struct{ *Resolver }
+type playerPerformanceResolver struct{ *Resolver }
+type teamResolver struct{ *Resolver }
@@ -0,0 +1,88 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NickDubelman/fantasy-bball/auth"
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/graph/generated"
+	"github.com/NickDubelman/fantasy-bball/policy"
+)
+
+// nodeTypes are the names of the GraphQL types that implement the Node interface.
+// Not every ent entity is exposed as a Node (e.g. LeagueMembership), so global IDs
+// of other types must not be resolved by Query.node
+var nodeTypes = func() map[string]bool {
+	schema := generated.NewExecutableSchema(generated.Config{}).Schema()
+
+	types := map[string]bool{}
+	for _, def := range schema.GetPossibleTypes(schema.Types["Node"]) {
+		types[def.Name] = true
+	}
+	return types
+}()
+
+// node returns the node with the given global ID, or nil if there is no such node or
+// the user isn't allowed to see it. Both look the same, so that global IDs can't be
+// guessed to find out what exists
+func node(ctx context.Context, client *db.Client, userID int, id string) (db.Noder, error) {
+	typ, _, err := db.DecodeGlobalID(id)
+	if err != nil {
+		return nil, err
+	}
+	if !nodeTypes[typ] {
+		return nil, fmt.Errorf("invalid global id %q", id)
+	}
+
+	n, err := client.Noder(ctx, id)
+	if db.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = authorizeNode(ctx, client, userID, n)
+	if _, ok := err.(auth.NotAuthorized); ok {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// authorizeNode returns auth.NotAuthorized unless the user can see the node. NBA data
+// is public, everything that belongs to a league is only visible to its members, and
+// invitations and users follow their own policies
+func authorizeNode(ctx context.Context, client *db.Client, userID int, n db.Noder) error {
+	member := func(q *db.LeagueQuery) error {
+		leagueID, err := q.OnlyID(ctx)
+		if err != nil {
+			return err
+		}
+		return policy.Check(ctx, client, userID, leagueID, policy.Member)
+	}
+
+	switch n := n.(type) {
+	case *db.League:
+		return policy.Check(ctx, client, userID, n.ID, policy.Member)
+	case *db.Contest:
+		return member(n.QueryLeague())
+	case *db.ContestDraft:
+		return member(n.QueryContest().QueryLeague())
+	case *db.ContestEntry:
+		return member(n.QueryContest().QueryLeague())
+	case *db.ContestEntryCorrection:
+		return member(n.QueryEntry().QueryContest().QueryLeague())
+	case *db.Invitation:
+		return policy.CanSeeInvitation(ctx, client, userID, n.ID)
+	case *db.User:
+		return policy.CanSeeUser(ctx, client, userID, n.ID)
+	case *db.Player, *db.Team, *db.Game, *db.GameResult, *db.PlayerPerformance:
+		return nil
+	default:
+		return auth.NotAuthorized{} // nobody gets to see a type without a policy
+	}
+}
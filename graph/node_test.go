@@ -0,0 +1,231 @@
+package graph
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	gqlclient "github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/dgrijalva/jwt-go"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/NickDubelman/fantasy-bball/auth"
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/enttest"
+	"github.com/NickDubelman/fantasy-bball/draft"
+	"github.com/NickDubelman/fantasy-bball/graph/generated"
+	"github.com/NickDubelman/fantasy-bball/leagues"
+	"github.com/NickDubelman/fantasy-bball/nba"
+)
+
+func TestNodeAuthorization(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:node?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	newUser := func(name string) *db.User {
+		return client.User.Create().SetName(name).SetEmail(name + "@example.com").SaveX(ctx)
+	}
+	commissioner := newUser("commissioner")
+	member := newUser("member")
+	invitee := newUser("invitee")
+	outsider := newUser("outsider")
+
+	name := "Lakers fans"
+	l, err := leagues.Create(ctx, client, commissioner.ID, leagues.Settings{Name: &name})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.LeagueMembership.Create().SetLeague(l).SetUser(member).SaveX(ctx)
+
+	inv, err := leagues.InviteByEmail(ctx, client, commissioner.ID, l.ID, "Invitee@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	team := client.Team.
+		Create().
+		SetExternalID("1610612747").
+		SetShortName("LAL").
+		SetLocation("Los Angeles").
+		SetName("Lakers").
+		SaveX(ctx)
+	opponent := client.Team.
+		Create().
+		SetExternalID("1610612756").
+		SetShortName("PHX").
+		SetLocation("Phoenix").
+		SetName("Suns").
+		SaveX(ctx)
+
+	// Contests need a game to draft from
+	day := nba.Day(time.Now()).AddDate(0, 0, 1)
+	start, _ := nba.DayBounds(day)
+	client.Game.
+		Create().
+		SetExternalID("0022000090").
+		SetTime(start.Add(19 * time.Hour)).
+		SetHomeTeam(team).
+		SetAwayTeam(opponent).
+		SaveX(ctx)
+
+	c, err := draft.New(ctx, client, commissioner.ID, l.ID, day, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contestDraft := c.QueryDraft().OnlyX(ctx)
+	entry := c.QueryEntries().FirstX(ctx)
+
+	tests := []struct {
+		name    string
+		viewer  *db.User
+		id      string
+		visible bool
+	}{
+		{"member sees league", member, l.GlobalID(), true},
+		{"outsider doesn't see league", outsider, l.GlobalID(), false},
+		{"member sees contest", member, c.GlobalID(), true},
+		{"outsider doesn't see contest", outsider, c.GlobalID(), false},
+		{"outsider doesn't see draft", outsider, contestDraft.GlobalID(), false},
+		{"member sees entry", member, entry.GlobalID(), true},
+		{"outsider doesn't see entry", outsider, entry.GlobalID(), false},
+		{"commissioner sees invitation", commissioner, inv.GlobalID(), true},
+		{"invitee sees invitation", invitee, inv.GlobalID(), true},
+		{"member who can't invite doesn't see invitation", member, inv.GlobalID(), false},
+		{"outsider doesn't see invitation", outsider, inv.GlobalID(), false},
+		{"user sees themselves", outsider, outsider.GlobalID(), true},
+		{"member sees other member", member, commissioner.GlobalID(), true},
+		{"outsider doesn't see member", outsider, commissioner.GlobalID(), false},
+		{"invitee doesn't see inviter", invitee, commissioner.GlobalID(), false},
+		{"outsider sees team", outsider, team.GlobalID(), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := node(ctx, client, tt.viewer.ID, tt.id)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if visible := n != nil; visible != tt.visible {
+				t.Errorf("node() = %v, want visible = %v", n, tt.visible)
+			}
+		})
+	}
+}
+
+func TestNestedAuthorization(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:nested?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	newUser := func(name string) *db.User {
+		return client.User.Create().SetName(name).SetEmail(name + "@example.com").SaveX(ctx)
+	}
+	a, b, c := newUser("a"), newUser("b"), newUser("c")
+	invitee := newUser("invitee")
+
+	// A shares the first league with B, but not the second one
+	newLeague := func(name string, commissioner, member *db.User) *db.League {
+		l, err := leagues.Create(ctx, client, commissioner.ID, leagues.Settings{Name: &name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		client.LeagueMembership.Create().SetLeague(l).SetUser(member).SaveX(ctx)
+		return l
+	}
+	newLeague("shared", a, b)
+	private := newLeague("private", b, c)
+
+	inv, err := leagues.InviteByEmail(ctx, client, b.ID, private.ID, invitee.Email)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gql := gqlclient.New(handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
+		Resolvers:  &Resolver{},
+		Directives: generated.DirectiveRoot{Auth: AuthDirective},
+	})))
+	as := func(viewer *db.User) gqlclient.Option {
+		return func(r *gqlclient.Request) {
+			ctx := db.NewContext(r.HTTP.Context(), client)
+			ctx = auth.NewContext(ctx, auth.UserInfo{
+				StandardClaims: jwt.StandardClaims{Subject: strconv.Itoa(viewer.ID)},
+			})
+			r.HTTP = r.HTTP.WithContext(ctx)
+		}
+	}
+
+	t.Run("other user's leagues", func(t *testing.T) {
+		var resp struct {
+			Node struct {
+				Leagues struct {
+					Edges []struct {
+						Node struct {
+							Name    string
+							Members struct {
+								Edges []struct{ Node struct{ Email string } }
+							}
+						}
+					}
+				}
+			}
+		}
+		query := `query($id: ID!) {
+			node(id: $id) {
+				... on User {
+					leagues { edges { node { name members { edges { node { email } } } } } }
+				}
+			}
+		}`
+		if err := gql.Post(query, &resp, gqlclient.Var("id", b.GlobalID()), as(a)); err != nil {
+			t.Fatal(err)
+		}
+
+		var names, emails []string
+		for _, l := range resp.Node.Leagues.Edges {
+			names = append(names, l.Node.Name)
+			for _, m := range l.Node.Members.Edges {
+				emails = append(emails, m.Node.Email)
+			}
+		}
+		sort.Strings(emails)
+		if !reflect.DeepEqual(names, []string{"shared"}) {
+			t.Errorf("leagues = %v, want only the shared league", names)
+		}
+		if !reflect.DeepEqual(emails, []string{a.Email, b.Email}) {
+			t.Errorf("member emails = %v, want only the shared league's members", emails)
+		}
+	})
+
+	t.Run("invitee can't see members", func(t *testing.T) {
+		var resp struct {
+			Node struct {
+				League struct {
+					Name    string
+					Members *struct {
+						Edges []struct{ Node struct{ Email string } }
+					}
+				}
+			}
+		}
+		query := `query($id: ID!) {
+			node(id: $id) {
+				... on Invitation {
+					league { name members { edges { node { email } } } }
+				}
+			}
+		}`
+		err := gql.Post(query, &resp, gqlclient.Var("id", inv.GlobalID()), as(invitee))
+		if err == nil {
+			t.Error("Post() error = nil, want not authorized")
+		}
+		if resp.Node.League.Members != nil {
+			t.Errorf("members = %+v, want none", resp.Node.League.Members)
+		}
+	})
+}
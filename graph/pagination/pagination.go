@@ -0,0 +1,282 @@
+// Package pagination implements Relay style cursor pagination on top of the ent
+// query builders. A Paginator is created from a connection field's arguments and
+// the order of the connection, and is then applied to a query with its Where, Order
+// and Limit methods. Once the query has run, Page turns the rows that came back into
+// the page that was asked for, along with its PageInfo and cursors
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// DefaultLimit is the page size used when neither first nor last is given
+	DefaultLimit = 50
+
+	// MaxLimit is the largest page size a client can ask for
+	MaxLimit = 100
+
+	idColumn = "id"
+)
+
+// Args are the pagination arguments of a connection field, as defined by the Relay
+// connection spec
+type Args struct {
+	First  *int
+	After  *string
+	Last   *int
+	Before *string
+}
+
+// Order describes how the nodes of a connection are sorted. Field is the column the
+// nodes are sorted by, and Type is the type of its values; ties are broken by ID so
+// that every node has a distinct position. An empty Field sorts by ID alone
+type Order struct {
+	Field string
+	Type  ValueType
+	Desc  bool
+}
+
+// ValueType is the type of the values of an Order's Field. Cursors are decoded with
+// it, so that clients can't choose what their values are decoded as
+type ValueType int
+
+// Types of Order fields
+const (
+	Int ValueType = iota + 1
+	Time
+)
+
+// Cursor is the position of a node within a connection: the value of the node's
+// Order.Field (nil when sorting by ID alone) and the node's ID
+type Cursor struct {
+	ID    int         `json:"id"`
+	Value interface{} `json:"value,omitempty"` // an int or a time.Time
+}
+
+// String encodes the cursor into the opaque form that is handed to clients. It is
+// base64 encoded JSON, with times in RFC 3339
+func (c Cursor) String() string {
+	b, err := json.Marshal(c)
+	if err != nil {
+		panic(fmt.Errorf("encoding cursor: %w", err))
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor decodes a cursor that was previously returned by Cursor.String for a
+// connection with the given order
+func DecodeCursor(s string, order Order) (Cursor, error) {
+	invalid := fmt.Errorf("invalid cursor %q", s)
+
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, invalid
+	}
+
+	var raw struct {
+		ID    int             `json:"id"`
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return Cursor{}, invalid
+	}
+
+	c := Cursor{ID: raw.ID}
+	if order.Field == "" {
+		if raw.Value != nil {
+			return Cursor{}, invalid
+		}
+		return c, nil
+	}
+	if raw.Value == nil {
+		return Cursor{}, invalid
+	}
+
+	switch order.Type {
+	case Int:
+		var v int
+		err = json.Unmarshal(raw.Value, &v)
+		c.Value = v
+	case Time:
+		var v time.Time
+		err = json.Unmarshal(raw.Value, &v)
+		c.Value = v
+	default:
+		return Cursor{}, fmt.Errorf("order on %s has no value type", order.Field)
+	}
+	if err != nil {
+		return Cursor{}, invalid
+	}
+
+	return c, nil
+}
+
+// PageInfo contains generic pagination info mandated by the Relay connection spec
+type PageInfo struct {
+	HasPreviousPage bool   `json:"hasPreviousPage"`
+	HasNextPage     bool   `json:"hasNextPage"`
+	StartCursor     string `json:"startCursor"`
+	EndCursor       string `json:"endCursor"`
+}
+
+// Paginator applies a set of pagination arguments to a query
+type Paginator struct {
+	order  Order
+	after  *Cursor
+	before *Cursor
+	limit  int
+
+	// backward is set when paginating with last/before. The query is then run in
+	// reverse order and the result is flipped back around by Page
+	backward bool
+}
+
+// New validates the given arguments and returns a Paginator for a connection with
+// the given order
+func New(args Args, order Order) (*Paginator, error) {
+	p := &Paginator{order: order, limit: DefaultLimit}
+
+	if args.First != nil && args.Last != nil {
+		return nil, fmt.Errorf("passing both first and last is not supported")
+	}
+
+	if args.First != nil {
+		if *args.First < 0 || *args.First > MaxLimit {
+			return nil, fmt.Errorf("first must be between 0 and %d", MaxLimit)
+		}
+		p.limit = *args.First
+	}
+
+	if args.Last != nil {
+		if *args.Last < 0 || *args.Last > MaxLimit {
+			return nil, fmt.Errorf("last must be between 0 and %d", MaxLimit)
+		}
+		p.limit = *args.Last
+		p.backward = true
+	}
+
+	if args.After != nil {
+		c, err := DecodeCursor(*args.After, order)
+		if err != nil {
+			return nil, err
+		}
+		p.after = &c
+	}
+
+	if args.Before != nil {
+		c, err := DecodeCursor(*args.Before, order)
+		if err != nil {
+			return nil, err
+		}
+		p.before = &c
+	}
+
+	return p, nil
+}
+
+// Where returns a predicate that limits a query to the nodes between the after and
+// before cursors. It can be passed to the Where method of any ent query
+func (p *Paginator) Where() func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		if p.after != nil {
+			s.Where(p.beyond(s, *p.after, !p.order.Desc))
+		}
+		if p.before != nil {
+			s.Where(p.beyond(s, *p.before, p.order.Desc))
+		}
+	}
+}
+
+// beyond returns a predicate matching the nodes that come after c when forward is
+// set, and the nodes that come before c otherwise
+func (p *Paginator) beyond(s *sql.Selector, c Cursor, forward bool) *sql.Predicate {
+	cmp := sql.LT
+	if forward {
+		cmp = sql.GT
+	}
+
+	if p.order.Field == "" {
+		return cmp(s.C(idColumn), c.ID)
+	}
+
+	return sql.Or(
+		cmp(s.C(p.order.Field), c.Value),
+		sql.And(
+			sql.EQ(s.C(p.order.Field), c.Value),
+			cmp(s.C(idColumn), c.ID),
+		),
+	)
+}
+
+// Order returns the ordering of the query. It can be passed to the Order method of
+// any ent query
+func (p *Paginator) Order() func(*sql.Selector, func(string) bool) {
+	desc := p.order.Desc != p.backward
+
+	return func(s *sql.Selector, _ func(string) bool) {
+		direction := sql.Asc
+		if desc {
+			direction = sql.Desc
+		}
+
+		if p.order.Field != "" {
+			s.OrderBy(direction(s.C(p.order.Field)))
+		}
+		s.OrderBy(direction(s.C(idColumn)))
+	}
+}
+
+// Limit returns the number of nodes the query should fetch. One more node than was
+// asked for is fetched, so that we know whether there is another page
+func (p *Paginator) Limit() int {
+	return p.limit + 1
+}
+
+// Page takes a pointer to the slice of nodes returned by the query, trims it down to
+// the requested page and puts it in the connection's order. cursor is called with
+// the index of each node of the trimmed slice and should return the node's Cursor.
+// The PageInfo of the page and the encoded cursor of each node are returned
+func (p *Paginator) Page(
+	nodes interface{},
+	cursor func(i int) Cursor,
+) (*PageInfo, []string) {
+	v := reflect.ValueOf(nodes).Elem()
+
+	hasMore := v.Len() > p.limit
+	if hasMore {
+		v.Set(v.Slice(0, p.limit))
+	}
+
+	info := &PageInfo{}
+	if p.backward {
+		swap := reflect.Swapper(v.Interface())
+		for i, j := 0, v.Len()-1; i < j; i, j = i+1, j-1 {
+			swap(i, j)
+		}
+
+		info.HasPreviousPage = hasMore
+		info.HasNextPage = p.before != nil
+	} else {
+		info.HasNextPage = hasMore
+		info.HasPreviousPage = p.after != nil
+	}
+
+	cursors := make([]string, v.Len())
+	for i := range cursors {
+		cursors[i] = cursor(i).String()
+	}
+
+	if len(cursors) > 0 {
+		info.StartCursor = cursors[0]
+		info.EndCursor = cursors[len(cursors)-1]
+	}
+
+	return info, cursors
+}
@@ -0,0 +1,343 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"reflect"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+func intPtr(i int) *int { return &i }
+
+func strPtr(s string) *string { return &s }
+
+func encode(json string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(json))
+}
+
+var (
+	byID   = Order{}
+	byTime = Order{Field: "time", Type: Time}
+	byInt  = Order{Field: "total", Type: Int, Desc: true}
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	day := time.Date(2021, 1, 5, 19, 30, 0, 0, time.FixedZone("ET", -5*60*60))
+
+	tests := []struct {
+		name   string
+		cursor Cursor
+		order  Order
+	}{
+		{"id", Cursor{ID: 7}, byID},
+		{"time", Cursor{ID: 7, Value: day}, byTime},
+		{"int", Cursor{ID: 7, Value: -3}, byInt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeCursor(tt.cursor.String(), tt.order)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got.ID != tt.cursor.ID {
+				t.Errorf("ID = %d, want %d", got.ID, tt.cursor.ID)
+			}
+			if want, ok := tt.cursor.Value.(time.Time); ok {
+				if !got.Value.(time.Time).Equal(want) {
+					t.Errorf("Value = %v, want %v", got.Value, want)
+				}
+			} else if got.Value != tt.cursor.Value {
+				t.Errorf("Value = %v, want %v", got.Value, tt.cursor.Value)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor string
+		order  Order
+	}{
+		{"not base64", "!!!", byID},
+		{"not JSON", encode("garbage"), byID},
+		{"value without a field", encode(`{"id":1,"value":3}`), byID},
+		{"missing value", encode(`{"id":1}`), byInt},
+		{"string for an int", encode(`{"id":1,"value":"3"}`), byInt},
+		{"object for an int", encode(`{"id":1,"value":{"a":1}}`), byInt},
+		{"int for a time", encode(`{"id":1,"value":3}`), byTime},
+		{"not RFC 3339", encode(`{"id":1,"value":"Jan 5 2021"}`), byTime},
+		{"order without a type", encode(`{"id":1,"value":3}`), Order{Field: "x"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodeCursor(tt.cursor, tt.order); err == nil {
+				t.Error("DecodeCursor() succeeded, want an error")
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         Args
+		wantErr      bool
+		wantLimit    int
+		wantBackward bool
+	}{
+		{name: "defaults", wantLimit: DefaultLimit},
+		{name: "first", args: Args{First: intPtr(10)}, wantLimit: 10},
+		{name: "first zero", args: Args{First: intPtr(0)}, wantLimit: 0},
+		{name: "first max", args: Args{First: intPtr(MaxLimit)}, wantLimit: MaxLimit},
+		{
+			name:         "last",
+			args:         Args{Last: intPtr(5)},
+			wantLimit:    5,
+			wantBackward: true,
+		},
+		{name: "first too big", args: Args{First: intPtr(MaxLimit + 1)}, wantErr: true},
+		{name: "first negative", args: Args{First: intPtr(-1)}, wantErr: true},
+		{name: "last too big", args: Args{Last: intPtr(MaxLimit + 1)}, wantErr: true},
+		{name: "first and last", args: Args{First: intPtr(1), Last: intPtr(1)}, wantErr: true},
+		{name: "bad after", args: Args{After: strPtr("!!!")}, wantErr: true},
+		{name: "bad before", args: Args{Before: strPtr("!!!")}, wantErr: true},
+		{
+			name:      "after and before",
+			args:      Args{After: strPtr(Cursor{ID: 1}.String()), Before: strPtr(Cursor{ID: 9}.String())},
+			wantLimit: DefaultLimit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := New(tt.args, byID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if p.limit != tt.wantLimit {
+				t.Errorf("limit = %d, want %d", p.limit, tt.wantLimit)
+			}
+			if p.Limit() != tt.wantLimit+1 {
+				t.Errorf("Limit() = %d, want %d", p.Limit(), tt.wantLimit+1)
+			}
+			if p.backward != tt.wantBackward {
+				t.Errorf("backward = %v, want %v", p.backward, tt.wantBackward)
+			}
+		})
+	}
+}
+
+func TestWhere(t *testing.T) {
+	after := strPtr(Cursor{ID: 3, Value: 10}.String())
+	before := strPtr(Cursor{ID: 8, Value: 20}.String())
+
+	tests := []struct {
+		name      string
+		args      Args
+		order     Order
+		wantWhere string
+		wantArgs  []interface{}
+		wantOrder string
+	}{
+		{
+			name:      "no cursors",
+			order:     byID,
+			wantOrder: "`t`.`id` ASC",
+		},
+		{
+			name:      "after by ID",
+			args:      Args{After: strPtr(Cursor{ID: 3}.String())},
+			order:     byID,
+			wantWhere: "`t`.`id` > ?",
+			wantArgs:  []interface{}{3},
+			wantOrder: "`t`.`id` ASC",
+		},
+		{
+			name:      "before by ID, backward",
+			args:      Args{Last: intPtr(2), Before: strPtr(Cursor{ID: 3}.String())},
+			order:     byID,
+			wantWhere: "`t`.`id` < ?",
+			wantArgs:  []interface{}{3},
+			wantOrder: "`t`.`id` DESC",
+		},
+		{
+			name:      "after, descending",
+			args:      Args{After: after},
+			order:     byInt,
+			wantWhere: "`t`.`total` < ? OR (`t`.`total` = ? AND `t`.`id` < ?)",
+			wantArgs:  []interface{}{10, 10, 3},
+			wantOrder: "`t`.`total` DESC, `t`.`id` DESC",
+		},
+		{
+			name:      "before, descending, backward",
+			args:      Args{Last: intPtr(2), Before: before},
+			order:     byInt,
+			wantWhere: "`t`.`total` > ? OR (`t`.`total` = ? AND `t`.`id` > ?)",
+			wantArgs:  []interface{}{20, 20, 8},
+			wantOrder: "`t`.`total` ASC, `t`.`id` ASC",
+		},
+		{
+			name:  "after and before",
+			args:  Args{After: after, Before: before},
+			order: Order{Field: "total", Type: Int},
+			wantWhere: "(`t`.`total` > ? OR (`t`.`total` = ? AND `t`.`id` > ?)) AND " +
+				"(`t`.`total` < ? OR (`t`.`total` = ? AND `t`.`id` < ?))",
+			wantArgs:  []interface{}{10, 10, 3, 20, 20, 8},
+			wantOrder: "`t`.`total` ASC, `t`.`id` ASC",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := New(tt.args, tt.order)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			s := sql.Select("*").From(sql.Table("t"))
+			p.Where()(s)
+			p.Order()(s, nil)
+			query, args := s.Query()
+
+			wantQuery := "SELECT * FROM `t`"
+			if tt.wantWhere != "" {
+				wantQuery += " WHERE " + tt.wantWhere
+			}
+			wantQuery += " ORDER BY " + tt.wantOrder
+			if query != wantQuery {
+				t.Errorf("query = %s\nwant    %s", query, wantQuery)
+			}
+			if len(args) != 0 || len(tt.wantArgs) != 0 {
+				if !reflect.DeepEqual(args, tt.wantArgs) {
+					t.Errorf("args = %v, want %v", args, tt.wantArgs)
+				}
+			}
+		})
+	}
+}
+
+func TestPage(t *testing.T) {
+	cursor := strPtr(Cursor{ID: 100}.String())
+
+	tests := []struct {
+		name string
+		args Args
+
+		// IDs returned by the query, in the order it returned them
+		rows []int
+
+		wantIDs         []int
+		wantHasPrevious bool
+		wantHasNext     bool
+	}{
+		{
+			name:    "first page, more after",
+			args:    Args{First: intPtr(2)},
+			rows:    []int{1, 2, 3},
+			wantIDs: []int{1, 2}, wantHasNext: true,
+		},
+		{
+			name:    "exactly one page",
+			args:    Args{First: intPtr(3)},
+			rows:    []int{1, 2, 3},
+			wantIDs: []int{1, 2, 3},
+		},
+		{
+			name:    "after, last page",
+			args:    Args{First: intPtr(2), After: cursor},
+			rows:    []int{4},
+			wantIDs: []int{4}, wantHasPrevious: true,
+		},
+		{
+			name:    "after, more after",
+			args:    Args{First: intPtr(1), After: cursor},
+			rows:    []int{4, 5},
+			wantIDs: []int{4}, wantHasPrevious: true, wantHasNext: true,
+		},
+		{
+			name:    "last, more before",
+			args:    Args{Last: intPtr(2)},
+			rows:    []int{9, 8, 7},
+			wantIDs: []int{8, 9}, wantHasPrevious: true,
+		},
+		{
+			name:    "last, exactly one page",
+			args:    Args{Last: intPtr(3)},
+			rows:    []int{9, 8, 7},
+			wantIDs: []int{7, 8, 9},
+		},
+		{
+			name:    "before, first page",
+			args:    Args{Last: intPtr(2), Before: cursor},
+			rows:    []int{2, 1},
+			wantIDs: []int{1, 2}, wantHasNext: true,
+		},
+		{
+			name:    "empty",
+			args:    Args{First: intPtr(2)},
+			rows:    []int{},
+			wantIDs: []int{},
+		},
+		{
+			name:    "first zero",
+			args:    Args{First: intPtr(0)},
+			rows:    []int{1},
+			wantIDs: []int{}, wantHasNext: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := New(tt.args, byID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(tt.rows) > p.Limit() {
+				t.Fatalf("the query can't return %d rows with a limit of %d", len(tt.rows), p.Limit())
+			}
+
+			nodes := append([]int{}, tt.rows...)
+			info, cursors := p.Page(&nodes, func(i int) Cursor {
+				return Cursor{ID: nodes[i]}
+			})
+
+			if !reflect.DeepEqual(nodes, tt.wantIDs) {
+				t.Errorf("nodes = %v, want %v", nodes, tt.wantIDs)
+			}
+			if info.HasPreviousPage != tt.wantHasPrevious {
+				t.Errorf("HasPreviousPage = %v, want %v", info.HasPreviousPage, tt.wantHasPrevious)
+			}
+			if info.HasNextPage != tt.wantHasNext {
+				t.Errorf("HasNextPage = %v, want %v", info.HasNextPage, tt.wantHasNext)
+			}
+
+			if len(cursors) != len(tt.wantIDs) {
+				t.Fatalf("got %d cursors, want %d", len(cursors), len(tt.wantIDs))
+			}
+			for i, id := range tt.wantIDs {
+				c, err := DecodeCursor(cursors[i], byID)
+				if err != nil || c.ID != id {
+					t.Errorf("cursor %d = %v (%v), want ID %d", i, c, err, id)
+				}
+			}
+
+			if len(cursors) == 0 {
+				if info.StartCursor != "" || info.EndCursor != "" {
+					t.Error("empty page has start or end cursors")
+				}
+			} else if info.StartCursor != cursors[0] || info.EndCursor != cursors[len(cursors)-1] {
+				t.Error("start and end cursors don't match the first and last nodes")
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NickDubelman/fantasy-bball/auth"
+	"github.com/NickDubelman/fantasy-bball/db"
+)
+
+// Resolver is the root resolver for the GraphQL API. Its dependencies (the db client
+// and the user making the request) are attached to the request context, so there is
+// nothing to inject here yet
+type Resolver struct{}
+
+// dbFromContext returns the db client attached to the request context
+func dbFromContext(ctx context.Context) (*db.Client, error) {
+	client := db.FromContext(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("could not retrieve db client from context")
+	}
+	return client, nil
+}
+
+// userIDFromContext returns the ID of the user making the request
+func userIDFromContext(ctx context.Context) (int, error) {
+	u, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return u.ID(), nil
+}
@@ -0,0 +1,38 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/graph/generated"
+)
+
+func (r *mutationResolver) Root(ctx context.Context) (*bool, error) {
+	return nil, nil
+}
+
+func (r *queryResolver) Node(ctx context.Context, id string) (db.Noder, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return node(ctx, client, userID, id)
+}
+
+// Mutation returns generated.MutationResolver implementation.
+func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
+
+// Query returns generated.QueryResolver implementation.
+func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
+
+type mutationResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
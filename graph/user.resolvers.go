@@ -0,0 +1,109 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"github.com/NickDubelman/fantasy-bball/auth"
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/league"
+	"github.com/NickDubelman/fantasy-bball/db/leaguemembership"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+	"github.com/NickDubelman/fantasy-bball/graph/generated"
+	"github.com/NickDubelman/fantasy-bball/graph/model"
+	"github.com/NickDubelman/fantasy-bball/graph/pagination"
+	"github.com/NickDubelman/fantasy-bball/leagues"
+)
+
+func (r *mutationResolver) SignOutEverywhere(ctx context.Context) (bool, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if err := auth.SignOutEverywhere(ctx, client, userID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *userResolver) Leagues(ctx context.Context, obj *db.User, first *int, after *string, last *int, before *string) (*model.LeagueConnection, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := pagination.New(
+		pagination.Args{First: first, After: after, Last: last, Before: before},
+		pagination.Order{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Other users' leagues are limited to the ones shared with the current user
+	leagues, err := client.League.
+		Query().
+		Where(
+			league.HasMembershipsWith(
+				leaguemembership.HasUserWith(user.ID(obj.ID)),
+			),
+			league.HasMembershipsWith(
+				leaguemembership.HasUserWith(user.ID(userID)),
+			),
+			p.Where(),
+		).
+		Order(p.Order()).
+		Limit(p.Limit()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	info, cursors := p.Page(&leagues, func(i int) pagination.Cursor {
+		return pagination.Cursor{ID: leagues[i].ID}
+	})
+
+	conn := &model.LeagueConnection{
+		PageInfo: info,
+		Edges:    make([]*model.LeagueEdge, len(leagues)),
+	}
+	for i, l := range leagues {
+		conn.Edges[i] = &model.LeagueEdge{Cursor: cursors[i], Node: l}
+	}
+	return conn, nil
+}
+
+func (r *userResolver) Invitations(ctx context.Context, obj *db.User) ([]*db.Invitation, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if obj.ID != userID {
+		return nil, auth.NotAuthorized{}
+	}
+
+	client, err := dbFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return leagues.UserInvitations(ctx, client, userID)
+}
+
+// User returns generated.UserResolver implementation.
+func (r *Resolver) User() generated.UserResolver { return &userResolver{r} }
+
+type userResolver struct{ *Resolver }
@@ -0,0 +1,251 @@
+// Package ingest pulls NBA data from a stats.Provider into the database. Everything is
+// upserted by external ID, so running it again only changes what changed at the source
+package ingest
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/contest"
+	"github.com/NickDubelman/fantasy-bball/db/game"
+	"github.com/NickDubelman/fantasy-bball/db/player"
+	"github.com/NickDubelman/fantasy-bball/nba"
+	"github.com/NickDubelman/fantasy-bball/scoring"
+	"github.com/NickDubelman/fantasy-bball/settlement"
+	"github.com/NickDubelman/fantasy-bball/stats"
+)
+
+const (
+	// lookback is how many days before today we ingest box scores for. The NBA keeps
+	// correcting box scores for a day or two after games end
+	lookback = 2
+
+	// lookahead is how many days after today we ingest the schedule for, so that
+	// drafts know who is playing
+	lookahead = 1
+)
+
+// Ingest pulls the rosters, and the schedules and box scores of the days around now,
+// from the provider and upserts them. The run is recorded as an IngestionRun, which is
+// returned. Errors with individual teams or games are recorded on the run rather than
+// stopping it
+func Ingest(ctx context.Context, client *db.Client, provider stats.Provider, now time.Time) (*db.IngestionRun, error) {
+	run, err := client.IngestionRun.Create().Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	in := &ingester{client: client, provider: provider}
+
+	today := nba.Day(now)
+	in.rosters(ctx, today)
+	for i := -lookback; i <= lookahead; i++ {
+		day := today.AddDate(0, 0, i)
+
+		in.schedule(ctx, day)
+		if !day.After(today) {
+			in.boxScores(ctx, day)
+		}
+	}
+
+	return run.
+		Update().
+		SetFinished(time.Now()).
+		SetRowsChanged(in.changed).
+		SetErrors(in.errors).
+		Save(ctx)
+}
+
+// Run calls Ingest right away and then every interval, until ctx is done
+func Run(ctx context.Context, client *db.Client, provider stats.Provider, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		run, err := Ingest(ctx, client, provider, time.Now())
+		if err != nil {
+			log.Printf("ingesting stats: %v", err)
+		} else if len(run.Errors) > 0 {
+			log.Printf("ingesting stats: run %d had %d errors", run.ID, len(run.Errors))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ingester keeps track of a single run
+type ingester struct {
+	client   *db.Client
+	provider stats.Provider
+	changed  int
+	errors   []string
+}
+
+// fail records an error on the run
+func (in *ingester) fail(format string, args ...interface{}) {
+	in.errors = append(in.errors, fmt.Sprintf(format, args...))
+}
+
+// withTx calls fn in a transaction. The rows it changed are only counted if the
+// transaction commits
+func (in *ingester) withTx(ctx context.Context, fn func(b *batch) error) error {
+	b := &batch{}
+	err := in.client.WithTx(ctx, func(tx *db.Tx) error {
+		b.Tx = tx
+		return fn(b)
+	})
+	if err != nil {
+		return err
+	}
+
+	in.changed += b.changed
+	return nil
+}
+
+// rosters upserts every team and its players. Players who aren't on any roster
+// anymore are left without a team
+func (in *ingester) rosters(ctx context.Context, day time.Time) {
+	rosters, err := in.provider.Rosters(ctx, day)
+	if err != nil {
+		in.fail("getting rosters: %v", err)
+		return
+	}
+	if len(rosters) == 0 {
+		return // no data, rather than every player being released
+	}
+
+	var rostered []string
+	for _, r := range rosters {
+		err := in.withTx(ctx, func(b *batch) error {
+			t, err := b.upsertTeam(ctx, r.Team)
+			if err != nil {
+				return err
+			}
+
+			for _, p := range r.Players {
+				if err := b.upsertPlayer(ctx, p, t.ID); err != nil {
+					return fmt.Errorf("player %q: %w", p.ID, err)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			in.fail("team %q: %v", r.ID, err)
+		}
+
+		for _, p := range r.Players {
+			rostered = append(rostered, p.ID)
+		}
+	}
+
+	err = in.withTx(ctx, func(b *batch) error {
+		n, err := b.Player.
+			Update().
+			Where(player.HasTeam(), player.ExternalIDNotIn(rostered...)).
+			ClearTeam().
+			Save(ctx)
+		b.changed += n
+		return err
+	})
+	if err != nil {
+		in.fail("releasing players: %v", err)
+	}
+}
+
+// schedule upserts the games on the given day
+func (in *ingester) schedule(ctx context.Context, day time.Time) {
+	games, err := in.provider.Schedule(ctx, day)
+	if err != nil {
+		in.fail("getting schedule for %s: %v", day.Format("2006-01-02"), err)
+		return
+	}
+
+	for _, g := range games {
+		err := in.withTx(ctx, func(b *batch) error {
+			return b.upsertGame(ctx, g)
+		})
+		if err != nil {
+			in.fail("game %q: %v", g.ID, err)
+		}
+	}
+}
+
+// boxScores upserts the results of, and the performances in, the games on the given
+// day
+func (in *ingester) boxScores(ctx context.Context, day time.Time) {
+	boxScores, err := in.provider.BoxScores(ctx, day)
+	if err != nil {
+		in.fail("getting box scores for %s: %v", day.Format("2006-01-02"), err)
+		return
+	}
+
+	performancesChanged := false
+	for _, bs := range boxScores {
+		err := in.withTx(ctx, func(b *batch) error {
+			g, err := b.Game.
+				Query().
+				Where(game.ExternalID(bs.GameID)).
+				WithHomeTeam().
+				WithAwayTeam().
+				WithResult(func(q *db.GameResultQuery) { q.WithWinner() }).
+				Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := b.upsertResult(ctx, g, bs); err != nil {
+				return err
+			}
+
+			for _, p := range bs.Performances {
+				if err := b.upsertPerformance(ctx, g.ID, p); err != nil {
+					return fmt.Errorf("player %q: %w", p.PlayerID, err)
+				}
+			}
+
+			performancesChanged = performancesChanged || b.performancesChanged
+			return nil
+		})
+		if err != nil {
+			in.fail("box score for game %q: %v", bs.GameID, err)
+		}
+	}
+
+	if performancesChanged {
+		in.rescore(ctx, day)
+	}
+}
+
+// rescore brings the contests on the given day up to date with its box scores. Open
+// contests get their live totals updated, and contests that were already settled are
+// settled again so that stat corrections are reflected in their totals and winners
+func (in *ingester) rescore(ctx context.Context, day time.Time) {
+	contests, err := in.client.Contest.
+		Query().
+		Where(contest.Day(day)).
+		All(ctx)
+	if err != nil {
+		in.fail("getting contests for %s: %v", day.Format("2006-01-02"), err)
+		return
+	}
+
+	for _, c := range contests {
+		if c.Closed == nil {
+			if _, err := scoring.UpdateContest(ctx, in.client, c.ID); err != nil {
+				in.fail("updating totals of contest %d: %v", c.ID, err)
+			}
+			continue
+		}
+
+		if _, err := settlement.Settle(ctx, in.client, c.ID); err != nil {
+			in.fail("re-settling contest %d: %v", c.ID, err)
+		}
+	}
+}
@@ -0,0 +1,274 @@
+package ingest
+
+import (
+	"context"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/game"
+	"github.com/NickDubelman/fantasy-bball/db/player"
+	"github.com/NickDubelman/fantasy-bball/db/playerperformance"
+	"github.com/NickDubelman/fantasy-bball/db/team"
+	"github.com/NickDubelman/fantasy-bball/stats"
+)
+
+// batch is a transaction that counts the rows it creates or changes. Rows that are
+// already up to date are left alone and aren't counted
+type batch struct {
+	*db.Tx
+	changed int
+
+	// Whether any performances were created or changed
+	performancesChanged bool
+}
+
+func (b *batch) upsertTeam(ctx context.Context, t stats.Team) (*db.Team, error) {
+	existing, err := b.Team.Query().Where(team.ExternalID(t.ID)).Only(ctx)
+	if db.IsNotFound(err) {
+		b.changed++
+		return b.Team.
+			Create().
+			SetExternalID(t.ID).
+			SetShortName(t.ShortName).
+			SetLocation(t.Location).
+			SetName(t.Name).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if existing.ShortName == t.ShortName &&
+		existing.Location == t.Location &&
+		existing.Name == t.Name {
+		return existing, nil
+	}
+
+	b.changed++
+	return existing.
+		Update().
+		SetShortName(t.ShortName).
+		SetLocation(t.Location).
+		SetName(t.Name).
+		Save(ctx)
+}
+
+func (b *batch) upsertPlayer(ctx context.Context, p stats.Player, teamID int) error {
+	existing, err := b.Player.
+		Query().
+		Where(player.ExternalID(p.ID)).
+		WithTeam().
+		Only(ctx)
+	if db.IsNotFound(err) {
+		b.changed++
+		_, err := b.Player.
+			Create().
+			SetExternalID(p.ID).
+			SetName(p.Name).
+			SetTeamID(teamID).
+			Save(ctx)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if existing.Name == p.Name &&
+		existing.Edges.Team != nil && existing.Edges.Team.ID == teamID {
+		return nil
+	}
+
+	b.changed++
+	return existing.
+		Update().
+		SetName(p.Name).
+		SetTeamID(teamID).
+		Exec(ctx)
+}
+
+func (b *batch) upsertGame(ctx context.Context, g stats.Game) error {
+	homeTeamID, err := b.teamID(ctx, g.HomeTeamID)
+	if err != nil {
+		return err
+	}
+	awayTeamID, err := b.teamID(ctx, g.AwayTeamID)
+	if err != nil {
+		return err
+	}
+
+	existing, err := b.Game.
+		Query().
+		Where(game.ExternalID(g.ID)).
+		WithHomeTeam().
+		WithAwayTeam().
+		Only(ctx)
+	if db.IsNotFound(err) {
+		b.changed++
+		_, err := b.Game.
+			Create().
+			SetExternalID(g.ID).
+			SetTime(g.Time).
+			SetPostponed(g.Postponed).
+			SetHomeTeamID(homeTeamID).
+			SetAwayTeamID(awayTeamID).
+			Save(ctx)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if existing.Time.Equal(g.Time) &&
+		existing.Postponed == g.Postponed &&
+		existing.Edges.HomeTeam.ID == homeTeamID &&
+		existing.Edges.AwayTeam.ID == awayTeamID {
+		return nil
+	}
+
+	b.changed++
+	return existing.
+		Update().
+		SetTime(g.Time).
+		SetPostponed(g.Postponed).
+		SetHomeTeamID(homeTeamID).
+		SetAwayTeamID(awayTeamID).
+		Exec(ctx)
+}
+
+// upsertResult upserts the result of the given game, which must have been loaded
+// with its teams, its result and the result's winner. It also marks the game final
+// once the box score says it is over
+func (b *batch) upsertResult(ctx context.Context, g *db.Game, bs stats.BoxScore) error {
+	if g.Final != bs.Final {
+		b.changed++
+		if err := g.Update().SetFinal(bs.Final).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Nobody has won until the game is over
+	var winnerID *int
+	if bs.Final && bs.HomeScore != bs.AwayScore {
+		winnerID = &g.Edges.HomeTeam.ID
+		if bs.AwayScore > bs.HomeScore {
+			winnerID = &g.Edges.AwayTeam.ID
+		}
+	}
+
+	existing := g.Edges.Result
+	if existing == nil {
+		b.changed++
+		_, err := b.GameResult.
+			Create().
+			SetGameID(g.ID).
+			SetHomeScore(bs.HomeScore).
+			SetAwayScore(bs.AwayScore).
+			SetNillableWinnerID(winnerID).
+			Save(ctx)
+		return err
+	}
+
+	var existingWinnerID *int
+	if existing.Edges.Winner != nil {
+		existingWinnerID = &existing.Edges.Winner.ID
+	}
+
+	if existing.HomeScore == bs.HomeScore &&
+		existing.AwayScore == bs.AwayScore &&
+		equalIntPtrs(existingWinnerID, winnerID) {
+		return nil
+	}
+
+	b.changed++
+	update := existing.
+		Update().
+		SetHomeScore(bs.HomeScore).
+		SetAwayScore(bs.AwayScore)
+	if winnerID != nil {
+		update.SetWinnerID(*winnerID)
+	} else {
+		update.ClearWinner()
+	}
+	return update.Exec(ctx)
+}
+
+func (b *batch) upsertPerformance(ctx context.Context, gameID int, p stats.Performance) error {
+	playerID, err := b.Player.Query().Where(player.ExternalID(p.PlayerID)).OnlyID(ctx)
+	if err != nil {
+		return err
+	}
+	teamID, err := b.teamID(ctx, p.TeamID)
+	if err != nil {
+		return err
+	}
+
+	existing, err := b.PlayerPerformance.
+		Query().
+		Where(
+			playerperformance.HasPlayerWith(player.ID(playerID)),
+			playerperformance.HasGameWith(game.ID(gameID)),
+		).
+		WithTeam().
+		Only(ctx)
+	if db.IsNotFound(err) {
+		b.changed++
+		b.performancesChanged = true
+		_, err := b.PlayerPerformance.
+			Create().
+			SetPlayerID(playerID).
+			SetGameID(gameID).
+			SetTeamID(teamID).
+			SetNillableMinutes(p.Minutes).
+			SetPoints(p.Points).
+			SetRebounds(p.Rebounds).
+			SetAssists(p.Assists).
+			SetSteals(p.Steals).
+			SetBlocks(p.Blocks).
+			SetTurnovers(p.Turnovers).
+			Save(ctx)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if existing.Edges.Team.ID == teamID &&
+		equalIntPtrs(existing.Minutes, p.Minutes) &&
+		existing.Points == p.Points &&
+		existing.Rebounds == p.Rebounds &&
+		existing.Assists == p.Assists &&
+		existing.Steals == p.Steals &&
+		existing.Blocks == p.Blocks &&
+		existing.Turnovers == p.Turnovers {
+		return nil
+	}
+
+	b.changed++
+	b.performancesChanged = true
+	update := existing.
+		Update().
+		SetTeamID(teamID).
+		SetPoints(p.Points).
+		SetRebounds(p.Rebounds).
+		SetAssists(p.Assists).
+		SetSteals(p.Steals).
+		SetBlocks(p.Blocks).
+		SetTurnovers(p.Turnovers)
+	if p.Minutes != nil {
+		update.SetMinutes(*p.Minutes)
+	} else {
+		update.ClearMinutes()
+	}
+	return update.Exec(ctx)
+}
+
+// teamID returns the ID of the team with the given external ID
+func (b *batch) teamID(ctx context.Context, externalID string) (int, error) {
+	return b.Team.Query().Where(team.ExternalID(externalID)).OnlyID(ctx)
+}
+
+func equalIntPtrs(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
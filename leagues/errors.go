@@ -0,0 +1,62 @@
+package leagues
+
+import (
+	"fmt"
+	"time"
+)
+
+// Full is an error for when a league already has as many members as it allows
+type Full struct{}
+
+func (e Full) Error() string {
+	return "league is full"
+}
+
+// NotMember is an error for when a user isn't a member of a league
+type NotMember struct{}
+
+func (e NotMember) Error() string {
+	return "user is not a member of the league"
+}
+
+// LastCommissioner is an error for when a change would leave a league that still has
+// members without a commissioner
+type LastCommissioner struct{}
+
+func (e LastCommissioner) Error() string {
+	return "league must have a commissioner: make someone else a commissioner first"
+}
+
+// LastMember is an error for when a commissioner tries to remove the league's last
+// member, which is themselves. Leaving the league deletes it instead
+type LastMember struct{}
+
+func (e LastMember) Error() string {
+	return "the last member can't be removed: leave the league to delete it instead"
+}
+
+// AlreadyMember is an error for when a user tries to join a league they are already
+// a member of
+type AlreadyMember struct{}
+
+func (e AlreadyMember) Error() string {
+	return "user is already a member of the league"
+}
+
+// InvitationClosed is an error for when an invitation can't be used anymore because
+// it was already accepted, declined or revoked, or because it expired
+type InvitationClosed struct {
+	Reason string
+}
+
+func (e InvitationClosed) Error() string {
+	return "invitation can't be used: " + e.Reason
+}
+
+// InvalidExpiry is an error for when an invite link is set to expire too soon or too
+// far in the future
+type InvalidExpiry struct{}
+
+func (e InvalidExpiry) Error() string {
+	return fmt.Sprintf("invite links must expire in 1 to %d hours", MaxInvitationDuration/time.Hour)
+}
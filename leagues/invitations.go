@@ -0,0 +1,332 @@
+package leagues
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/NickDubelman/fantasy-bball/auth"
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/invitation"
+	"github.com/NickDubelman/fantasy-bball/db/league"
+	"github.com/NickDubelman/fantasy-bball/db/leaguemembership"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+	"github.com/NickDubelman/fantasy-bball/policy"
+)
+
+const (
+	// InvitationDuration is how long invitations are valid for, unless a different
+	// duration is given for an invite link
+	InvitationDuration = 7 * 24 * time.Hour
+
+	// MaxInvitationDuration is the longest an invite link can be valid for
+	MaxInvitationDuration = 30 * 24 * time.Hour
+)
+
+// InviteByEmail invites whoever has the given email address to the league. The
+// invitee sees it among their pending invitations once they log in with that email.
+// Only members who can invite (and commissioners) can send invitations. It fails with
+// AlreadyMember if the email belongs to a member of the league. If the email already
+// has a pending invitation to the league, that one is returned instead
+func InviteByEmail(ctx context.Context, client *db.Client, userID, leagueID int, email string) (*db.Invitation, error) {
+	if err := policy.CanInvite(ctx, client, userID, leagueID); err != nil {
+		return nil, err
+	}
+
+	email = strings.TrimSpace(email)
+
+	member, err := client.LeagueMembership.
+		Query().
+		Where(
+			leaguemembership.HasLeagueWith(league.ID(leagueID)),
+			leaguemembership.HasUserWith(user.EmailEqualFold(email)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if member {
+		return nil, AlreadyMember{}
+	}
+
+	existing, err := client.Invitation.
+		Query().
+		Where(
+			invitation.HasLeagueWith(league.ID(leagueID)),
+			invitation.EmailEqualFold(email),
+			invitation.StatusEQ(invitation.StatusPENDING),
+			invitation.ExpiresGT(time.Now()),
+		).
+		First(ctx)
+	if err == nil {
+		return existing, nil
+	}
+	if !db.IsNotFound(err) {
+		return nil, err
+	}
+
+	return client.Invitation.
+		Create().
+		SetLeagueID(leagueID).
+		SetInviterID(userID).
+		SetEmail(email).
+		SetExpires(time.Now().Add(InvitationDuration)).
+		Save(ctx)
+}
+
+// CreateInviteLink creates an invitation that anyone can use to join the league until
+// it expires after the given number of hours (InvitationDuration if nil) or is
+// revoked. It fails with InvalidExpiry unless expiresIn is between 1 hour and
+// MaxInvitationDuration. The returned token is what goes in the link. Only its hash
+// is stored, so it can't be retrieved again later. Only members who can invite (and
+// commissioners) can create links
+func CreateInviteLink(
+	ctx context.Context,
+	client *db.Client,
+	userID, leagueID int,
+	expiresIn *int,
+) (inv *db.Invitation, token string, err error) {
+	if err := policy.CanInvite(ctx, client, userID, leagueID); err != nil {
+		return nil, "", err
+	}
+
+	duration := InvitationDuration
+	if expiresIn != nil {
+		// Checked before converting, so that huge values can't overflow
+		if *expiresIn < 1 || *expiresIn > int(MaxInvitationDuration/time.Hour) {
+			return nil, "", InvalidExpiry{}
+		}
+		duration = time.Duration(*expiresIn) * time.Hour
+	}
+
+	token, err = auth.RandomToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	inv, err = client.Invitation.
+		Create().
+		SetLeagueID(leagueID).
+		SetInviterID(userID).
+		SetTokenHash(auth.HashToken(token)).
+		SetExpires(time.Now().Add(duration)).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return inv, token, nil
+}
+
+// Accept accepts an email invitation on behalf of the user, who must have the email
+// address it was sent to. The user joins the league, as long as it isn't full
+func Accept(ctx context.Context, client *db.Client, userID, invitationID int) (*db.League, error) {
+	var leagueID int
+
+	err := client.WithTx(ctx, func(tx *db.Tx) error {
+		inv, err := invitee(ctx, tx.Client(), userID, invitationID)
+		if err != nil {
+			return err
+		}
+
+		leagueID = inv.Edges.League.ID
+		if err := join(ctx, tx, leagueID, userID); err != nil {
+			return err
+		}
+
+		return setStatus(ctx, tx.Client(), inv.ID, invitation.StatusACCEPTED)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return client.League.Get(ctx, leagueID)
+}
+
+// AcceptLink joins the user to the league of the invite link with the given token,
+// as long as it isn't full
+func AcceptLink(ctx context.Context, client *db.Client, userID int, token string) (*db.League, error) {
+	var leagueID int
+
+	err := client.WithTx(ctx, func(tx *db.Tx) error {
+		inv, err := tx.Invitation.
+			Query().
+			Where(invitation.TokenHash(auth.HashToken(token))).
+			WithLeague().
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		if err := checkOpen(inv); err != nil {
+			return err
+		}
+
+		leagueID = inv.Edges.League.ID
+		return join(ctx, tx, leagueID, userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return client.League.Get(ctx, leagueID)
+}
+
+// Decline declines an email invitation on behalf of the user, who must have the
+// email address it was sent to
+func Decline(ctx context.Context, client *db.Client, userID, invitationID int) (*db.Invitation, error) {
+	inv, err := invitee(ctx, client, userID, invitationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setStatus(ctx, client, inv.ID, invitation.StatusDECLINED); err != nil {
+		return nil, err
+	}
+	return client.Invitation.Get(ctx, invitationID)
+}
+
+// Revoke revokes an invitation so that it can't be used anymore. Only the user who
+// sent it and the league's commissioners can revoke an invitation
+func Revoke(ctx context.Context, client *db.Client, userID, invitationID int) (*db.Invitation, error) {
+	if err := policy.CanRevokeInvitation(ctx, client, userID, invitationID); err != nil {
+		return nil, err
+	}
+
+	err := client.WithTx(ctx, func(tx *db.Tx) error {
+		return setStatus(ctx, tx.Client(), invitationID, invitation.StatusREVOKED)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Invitation.Get(ctx, invitationID)
+}
+
+// LeagueInvitations returns the league's pending invitations, newest first. Only
+// members who can invite (and commissioners) can see them
+func LeagueInvitations(ctx context.Context, client *db.Client, userID, leagueID int) ([]*db.Invitation, error) {
+	if err := policy.CanInvite(ctx, client, userID, leagueID); err != nil {
+		return nil, err
+	}
+
+	return client.Invitation.
+		Query().
+		Where(
+			invitation.HasLeagueWith(league.ID(leagueID)),
+			invitation.StatusEQ(invitation.StatusPENDING),
+			invitation.ExpiresGT(time.Now()),
+		).
+		Order(db.Desc(invitation.FieldCreated)).
+		All(ctx)
+}
+
+// UserInvitations returns the pending invitations sent to the user's email, newest
+// first
+func UserInvitations(ctx context.Context, client *db.Client, userID int) ([]*db.Invitation, error) {
+	u, err := client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Invitation.
+		Query().
+		Where(
+			invitation.EmailEqualFold(u.Email),
+			invitation.StatusEQ(invitation.StatusPENDING),
+			invitation.ExpiresGT(time.Now()),
+		).
+		Order(db.Desc(invitation.FieldCreated)).
+		All(ctx)
+}
+
+// invitee returns the open email invitation with the given ID, with its league
+// loaded. It returns NotAuthorized unless the invitation was sent to the user's email
+func invitee(ctx context.Context, client *db.Client, userID, invitationID int) (*db.Invitation, error) {
+	u, err := client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	inv, err := client.Invitation.
+		Query().
+		Where(invitation.ID(invitationID)).
+		WithLeague().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if inv.Email == nil || !strings.EqualFold(*inv.Email, u.Email) {
+		return nil, auth.NotAuthorized{}
+	}
+	if err := checkOpen(inv); err != nil {
+		return nil, err
+	}
+
+	return inv, nil
+}
+
+// checkOpen returns InvitationClosed if the invitation can't be used anymore
+func checkOpen(inv *db.Invitation) error {
+	if inv.Status != invitation.StatusPENDING {
+		return InvitationClosed{Reason: "it was already " + strings.ToLower(inv.Status.String())}
+	}
+	if time.Now().After(inv.Expires) {
+		return InvitationClosed{Reason: "it expired"}
+	}
+	return nil
+}
+
+// setStatus moves a pending invitation to the given status. Only pending invitations
+// are updated, so when an invitation is accepted, declined and revoked at the same
+// time, only one of them wins and the others fail with InvitationClosed
+func setStatus(ctx context.Context, client *db.Client, invitationID int, status invitation.Status) error {
+	n, err := client.Invitation.
+		Update().
+		Where(
+			invitation.ID(invitationID),
+			invitation.StatusEQ(invitation.StatusPENDING),
+		).
+		SetStatus(status).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return nil
+	}
+
+	inv, err := client.Invitation.Get(ctx, invitationID)
+	if err != nil {
+		return err
+	}
+	return InvitationClosed{Reason: "it was already " + strings.ToLower(inv.Status.String())}
+}
+
+// join makes the user a member of the league. The league is locked while its
+// members are counted, so maxMembers holds even when several users join at once
+func join(ctx context.Context, tx *db.Tx, leagueID, userID int) error {
+	members, err := lockMembers(ctx, tx, leagueID)
+	if err != nil {
+		return err
+	}
+
+	if find(members, userID) != nil {
+		return AlreadyMember{}
+	}
+
+	l, err := tx.League.Get(ctx, leagueID)
+	if err != nil {
+		return err
+	}
+	if len(members) >= l.MaxMembers {
+		return Full{}
+	}
+
+	_, err = tx.LeagueMembership.
+		Create().
+		SetUserID(userID).
+		SetLeagueID(leagueID).
+		Save(ctx)
+	return err
+}
@@ -0,0 +1,101 @@
+package leagues
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/enttest"
+	"github.com/NickDubelman/fantasy-bball/db/invitation"
+)
+
+func TestCreateInviteLinkExpiry(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:links?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	u := client.User.Create().SetName("commissioner").SetEmail("c@example.com").SaveX(ctx)
+	name := "Lakers fans"
+	l, err := Create(ctx, client, u.ID, Settings{Name: &name})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hours := func(h int) *int { return &h }
+	tests := []struct {
+		name      string
+		expiresIn *int
+		wantErr   error
+	}{
+		{"default", nil, nil},
+		{"an hour", hours(1), nil},
+		{"30 days", hours(720), nil},
+		{"zero", hours(0), InvalidExpiry{}},
+		{"negative", hours(-1), InvalidExpiry{}},
+		{"too long", hours(721), InvalidExpiry{}},
+		{"overflows", hours(math.MaxInt64), InvalidExpiry{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := CreateInviteLink(ctx, client, u.ID, l.ID, tt.expiresIn)
+			if err != tt.wantErr {
+				t.Errorf("CreateInviteLink() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRevoke(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:revoke?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	u := client.User.Create().SetName("commissioner").SetEmail("c@example.com").SaveX(ctx)
+	name := "Lakers fans"
+	l, err := Create(ctx, client, u.ID, Settings{Name: &name})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newInvitation := func(status invitation.Status) *db.Invitation {
+		inv, err := InviteByEmail(ctx, client, u.ID, l.ID, status.String()+"@example.com")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return inv.Update().SetStatus(status).SaveX(ctx)
+	}
+
+	tests := []struct {
+		name    string
+		status  invitation.Status
+		wantErr error
+	}{
+		{"pending", invitation.StatusPENDING, nil},
+		{"accepted", invitation.StatusACCEPTED, InvitationClosed{Reason: "it was already accepted"}},
+		{"revoked", invitation.StatusREVOKED, InvitationClosed{Reason: "it was already revoked"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := newInvitation(tt.status)
+
+			_, err := Revoke(ctx, client, u.ID, inv.ID)
+			if err != tt.wantErr {
+				t.Errorf("Revoke() error = %v, want %v", err, tt.wantErr)
+			}
+
+			// The invitation is only revoked if it was still pending
+			want := tt.status
+			if tt.status == invitation.StatusPENDING {
+				want = invitation.StatusREVOKED
+			}
+			if got := client.Invitation.GetX(ctx, inv.ID).Status; got != want {
+				t.Errorf("status = %v, want %v", got, want)
+			}
+		})
+	}
+}
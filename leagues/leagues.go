@@ -0,0 +1,335 @@
+// Package leagues creates leagues and manages their members. Every function takes the
+// ID of the user making the change and checks that they are allowed to make it (see
+// package policy): members can leave, but only commissioners can change a league's
+// settings or its members
+package leagues
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/contest"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraft"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraftpick"
+	"github.com/NickDubelman/fantasy-bball/db/contestentry"
+	"github.com/NickDubelman/fantasy-bball/db/contestentrycorrection"
+	"github.com/NickDubelman/fantasy-bball/db/invitation"
+	"github.com/NickDubelman/fantasy-bball/db/league"
+	"github.com/NickDubelman/fantasy-bball/db/leaguemembership"
+	"github.com/NickDubelman/fantasy-bball/db/schema/schematype"
+	"github.com/NickDubelman/fantasy-bball/policy"
+)
+
+// Settings are the settings of a league. nil fields are left as they are (or get
+// their defaults, when creating a league)
+type Settings struct {
+	Name          *string
+	Description   *string
+	MaxMembers    *int
+	StatWeights   *schematype.StatWeights
+	DraftRounds   *int
+	PickTimeLimit *int // in seconds
+}
+
+// Create creates a league with the given settings. The user creating it becomes its
+// first member and commissioner
+func Create(ctx context.Context, client *db.Client, userID int, s Settings) (*db.League, error) {
+	var l *db.League
+
+	err := client.WithTx(ctx, func(tx *db.Tx) error {
+		weights := schematype.DefaultStatWeights
+		if s.StatWeights != nil {
+			weights = *s.StatWeights
+		}
+
+		create := tx.League.Create().SetStatWeights(weights)
+		if s.Name != nil {
+			create.SetName(*s.Name)
+		}
+		if s.Description != nil {
+			create.SetDescription(*s.Description)
+		}
+		if s.MaxMembers != nil {
+			create.SetMaxMembers(*s.MaxMembers)
+		}
+		if s.DraftRounds != nil {
+			create.SetDraftRounds(*s.DraftRounds)
+		}
+		if s.PickTimeLimit != nil {
+			create.SetPickTimeLimit(*s.PickTimeLimit)
+		}
+
+		var err error
+		if l, err = create.Save(ctx); err != nil {
+			return err
+		}
+
+		_, err = tx.LeagueMembership.
+			Create().
+			SetUserID(userID).
+			SetLeague(l).
+			SetIsCommissioner(true).
+			SetCanInvite(true).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return l.Unwrap(), nil
+}
+
+// Update changes the settings of a league. Only commissioners can update a league.
+// maxMembers can't be lowered below the league's current number of members
+func Update(ctx context.Context, client *db.Client, userID, leagueID int, s Settings) (*db.League, error) {
+	err := client.WithTx(ctx, func(tx *db.Tx) error {
+		if err := policy.CanEditLeague(ctx, tx.Client(), userID, leagueID); err != nil {
+			return err
+		}
+
+		update := tx.League.UpdateOneID(leagueID)
+		if s.Name != nil {
+			update.SetName(*s.Name)
+		}
+		if s.Description != nil {
+			update.SetDescription(*s.Description)
+		}
+		if s.MaxMembers != nil {
+			members, err := lockMembers(ctx, tx, leagueID)
+			if err != nil {
+				return err
+			}
+			if *s.MaxMembers < len(members) {
+				return fmt.Errorf(
+					"maxMembers can't be lower than the league's %d members", len(members),
+				)
+			}
+			update.SetMaxMembers(*s.MaxMembers)
+		}
+		if s.StatWeights != nil {
+			update.SetStatWeights(*s.StatWeights)
+		}
+		if s.DraftRounds != nil {
+			update.SetDraftRounds(*s.DraftRounds)
+		}
+		if s.PickTimeLimit != nil {
+			update.SetPickTimeLimit(*s.PickTimeLimit)
+		}
+
+		return update.Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return client.League.Get(ctx, leagueID)
+}
+
+// Leave removes the user from the league. The last commissioner can't leave while
+// there are other members, since nobody would be left to manage the league. When the
+// last member leaves, the league is deleted along with its contests
+func Leave(ctx context.Context, client *db.Client, userID, leagueID int) error {
+	return client.WithTx(ctx, func(tx *db.Tx) error {
+		left, err := removeMember(ctx, tx, leagueID, userID)
+		if err != nil {
+			return err
+		}
+
+		// Nobody could see or manage the league anymore
+		if left == 0 {
+			return deleteLeague(ctx, tx, leagueID)
+		}
+		return nil
+	})
+}
+
+// RemoveMember removes a member from the league. Only commissioners can remove
+// members. The last member can't be removed, they have to leave instead (see Leave)
+func RemoveMember(ctx context.Context, client *db.Client, userID, leagueID, memberID int) error {
+	return client.WithTx(ctx, func(tx *db.Tx) error {
+		if err := policy.CanEditLeague(ctx, tx.Client(), userID, leagueID); err != nil {
+			return err
+		}
+
+		left, err := removeMember(ctx, tx, leagueID, memberID)
+		if err != nil {
+			return err
+		}
+		if left == 0 {
+			return LastMember{}
+		}
+		return nil
+	})
+}
+
+// SetMemberPermissions changes a member's permissions within the league. nil
+// permissions are left as they are. Only commissioners can change permissions. The
+// updated membership is returned with its user loaded
+func SetMemberPermissions(
+	ctx context.Context,
+	client *db.Client,
+	userID, leagueID, memberID int,
+	isCommissioner, canInvite *bool,
+) (*db.LeagueMembership, error) {
+	var membershipID int
+
+	err := client.WithTx(ctx, func(tx *db.Tx) error {
+		if err := policy.CanEditLeague(ctx, tx.Client(), userID, leagueID); err != nil {
+			return err
+		}
+
+		members, err := lockMembers(ctx, tx, leagueID)
+		if err != nil {
+			return err
+		}
+
+		m := find(members, memberID)
+		if m == nil {
+			return NotMember{}
+		}
+		membershipID = m.ID
+
+		update := m.Update()
+		if isCommissioner != nil {
+			if !*isCommissioner && isLastCommissioner(members, m) {
+				return LastCommissioner{}
+			}
+			update.SetIsCommissioner(*isCommissioner)
+		}
+		if canInvite != nil {
+			update.SetCanInvite(*canInvite)
+		}
+
+		return update.Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return client.LeagueMembership.
+		Query().
+		Where(leaguemembership.ID(membershipID)).
+		WithUser().
+		Only(ctx)
+}
+
+// lockMembers returns the league's memberships, with their users loaded. The league
+// is locked until the transaction ends, so that concurrent changes to its members
+// (e.g. two users joining at once) can't both go through based on the same count
+func lockMembers(ctx context.Context, tx *db.Tx, leagueID int) ([]*db.LeagueMembership, error) {
+	// Updating the league's row locks it. The update itself doesn't change anything
+	err := tx.League.
+		Update().
+		Where(league.ID(leagueID)).
+		AddMaxMembers(0).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.LeagueMembership.
+		Query().
+		Where(leaguemembership.HasLeagueWith(league.ID(leagueID))).
+		WithUser().
+		All(ctx)
+}
+
+// removeMember deletes the user's membership of the league, and returns how many
+// members the league has left
+func removeMember(ctx context.Context, tx *db.Tx, leagueID, memberID int) (int, error) {
+	members, err := lockMembers(ctx, tx, leagueID)
+	if err != nil {
+		return 0, err
+	}
+
+	m := find(members, memberID)
+	if m == nil {
+		return 0, NotMember{}
+	}
+	if len(members) > 1 && isLastCommissioner(members, m) {
+		return 0, LastCommissioner{}
+	}
+
+	if err := tx.LeagueMembership.DeleteOne(m).Exec(ctx); err != nil {
+		return 0, err
+	}
+	return len(members) - 1, nil
+}
+
+// deleteLeague deletes a league that has no members left, along with everything that
+// belongs to it. Rows are deleted before the rows they reference
+func deleteLeague(ctx context.Context, tx *db.Tx, leagueID int) error {
+	inLeague := contest.HasLeagueWith(league.ID(leagueID))
+
+	_, err := tx.ContestDraftPick.
+		Delete().
+		Where(contestdraftpick.HasDraftWith(contestdraft.HasContestWith(inLeague))).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ContestDraft.
+		Delete().
+		Where(contestdraft.HasContestWith(inLeague)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ContestEntryCorrection.
+		Delete().
+		Where(contestentrycorrection.HasEntryWith(contestentry.HasContestWith(inLeague))).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ContestEntry.
+		Delete().
+		Where(contestentry.HasContestWith(inLeague)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Contest.Delete().Where(inLeague).Exec(ctx); err != nil {
+		return err
+	}
+
+	_, err = tx.Invitation.
+		Delete().
+		Where(invitation.HasLeagueWith(league.ID(leagueID))).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return tx.League.DeleteOneID(leagueID).Exec(ctx)
+}
+
+// find returns the membership of the given user, or nil
+func find(members []*db.LeagueMembership, userID int) *db.LeagueMembership {
+	for _, m := range members {
+		if m.Edges.User.ID == userID {
+			return m
+		}
+	}
+	return nil
+}
+
+// isLastCommissioner returns whether the given member is the league's only
+// commissioner
+func isLastCommissioner(members []*db.LeagueMembership, m *db.LeagueMembership) bool {
+	if !m.IsCommissioner {
+		return false
+	}
+	for _, other := range members {
+		if other.IsCommissioner && other.ID != m.ID {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,84 @@
+package leagues
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/NickDubelman/fantasy-bball/db/enttest"
+)
+
+func TestLeave(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:leave?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	commissioner := client.User.Create().SetName("commissioner").SetEmail("c@example.com").SaveX(ctx)
+	member := client.User.Create().SetName("member").SetEmail("m@example.com").SaveX(ctx)
+
+	name := "Lakers fans"
+	l, err := Create(ctx, client, commissioner.ID, Settings{Name: &name})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.LeagueMembership.Create().SetLeague(l).SetUser(member).SaveX(ctx)
+
+	if _, err := InviteByEmail(ctx, client, commissioner.ID, l.ID, "invitee@example.com"); err != nil {
+		t.Fatal(err)
+	}
+
+	c := client.Contest.Create().SetLeague(l).SetDay(time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC)).SaveX(ctx)
+	d := client.ContestDraft.
+		Create().
+		SetContest(c).
+		SetRounds(l.DraftRounds).
+		SetPickTimeLimit(l.PickTimeLimit).
+		SetStarted(time.Now()).
+		SaveX(ctx)
+	client.ContestDraftPick.Create().SetDraft(d).SetUser(commissioner).SetRound(1).SaveX(ctx)
+	e := client.ContestEntry.Create().SetContest(c).SetUser(commissioner).SaveX(ctx)
+	client.ContestEntryCorrection.Create().SetEntry(e).SetOldTotalPoints(10).SetNewTotalPoints(12).SaveX(ctx)
+	client.ContestEntry.Create().SetContest(c).SetUser(member).SaveX(ctx)
+
+	if err := Leave(ctx, client, commissioner.ID, l.ID); err != (LastCommissioner{}) {
+		t.Errorf("Leave() by the last commissioner error = %v, want LastCommissioner", err)
+	}
+
+	if err := Leave(ctx, client, member.ID, l.ID); err != nil {
+		t.Fatal(err)
+	}
+	if !client.League.Query().ExistX(ctx) {
+		t.Fatal("league was deleted while it still had a member")
+	}
+
+	if err := RemoveMember(ctx, client, commissioner.ID, l.ID, commissioner.ID); err != (LastMember{}) {
+		t.Errorf("RemoveMember() of the last member error = %v, want LastMember", err)
+	}
+
+	// The last member leaving deletes the league and everything in it
+	if err := Leave(ctx, client, commissioner.ID, l.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	counts := map[string]int{
+		"leagues":           client.League.Query().CountX(ctx),
+		"memberships":       client.LeagueMembership.Query().CountX(ctx),
+		"invitations":       client.Invitation.Query().CountX(ctx),
+		"contests":          client.Contest.Query().CountX(ctx),
+		"drafts":            client.ContestDraft.Query().CountX(ctx),
+		"picks":             client.ContestDraftPick.Query().CountX(ctx),
+		"entries":           client.ContestEntry.Query().CountX(ctx),
+		"entry corrections": client.ContestEntryCorrection.Query().CountX(ctx),
+	}
+	for table, n := range counts {
+		if n != 0 {
+			t.Errorf("%d %s left, want none", n, table)
+		}
+	}
+
+	if users := client.User.Query().CountX(ctx); users != 2 {
+		t.Errorf("%d users left, want both", users)
+	}
+}
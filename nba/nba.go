@@ -0,0 +1,34 @@
+package nba
+
+import (
+	"time"
+	_ "time/tzdata" // so that Location is available on hosts without tz data
+)
+
+// Location is the time zone that NBA game days are defined in. A game that tips off
+// at 10:30pm ET is part of that day's slate, even though it is the next day in UTC
+var Location = mustLoadLocation("America/New_York")
+
+// Day returns the calendar day that t falls on in Location, as midnight UTC. This is
+// the form that Contest.day is stored in
+func Day(t time.Time) time.Time {
+	y, m, d := t.In(Location).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// DayBounds returns the half-open interval [start, end) of instants that belong to
+// the given calendar day (see Day)
+func DayBounds(day time.Time) (start, end time.Time) {
+	y, m, d := day.Date()
+	start = time.Date(y, m, d, 0, 0, 0, 0, Location)
+	end = time.Date(y, m, d+1, 0, 0, 0, 0, Location)
+	return start, end
+}
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
@@ -0,0 +1,166 @@
+// Package policy decides who is allowed to do what. Users get roles in each league
+// they are a member of, and everything else follows from those roles. Checks return
+// auth.NotAuthorized when the user isn't allowed, so that callers can return them as
+// they are.
+//
+// GraphQL fields declare the role they need with the @auth directive (see
+// schema/schema.graphql), which calls Check. The packages doing the work call the
+// same checks, so that they are safe to use outside of the API too
+package policy
+
+import (
+	"context"
+	"strings"
+
+	"github.com/NickDubelman/fantasy-bball/auth"
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/contest"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraft"
+	"github.com/NickDubelman/fantasy-bball/db/contestentry"
+	"github.com/NickDubelman/fantasy-bball/db/invitation"
+	"github.com/NickDubelman/fantasy-bball/db/league"
+	"github.com/NickDubelman/fantasy-bball/db/leaguemembership"
+	"github.com/NickDubelman/fantasy-bball/db/predicate"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+)
+
+// Role is what a user is allowed to do in a league. Each role includes the ones
+// before it
+type Role string
+
+// Roles in a league
+const (
+	Member       Role = "MEMBER"       // can see the league and play in its contests
+	Inviter      Role = "INVITER"      // can also invite others to the league
+	Commissioner Role = "COMMISSIONER" // can also change the league and its members
+)
+
+// Check returns NotAuthorized unless the user has the role in the league
+func Check(ctx context.Context, client *db.Client, userID, leagueID int, role Role) error {
+	predicates := []predicate.LeagueMembership{
+		leaguemembership.HasUserWith(user.ID(userID)),
+		leaguemembership.HasLeagueWith(league.ID(leagueID)),
+	}
+
+	switch role {
+	case Member:
+	case Inviter:
+		predicates = append(predicates, leaguemembership.Or(
+			leaguemembership.CanInvite(true),
+			leaguemembership.IsCommissioner(true),
+		))
+	case Commissioner:
+		predicates = append(predicates, leaguemembership.IsCommissioner(true))
+	default:
+		return auth.NotAuthorized{} // nobody has a role we don't know about
+	}
+
+	ok, err := client.LeagueMembership.Query().Where(predicates...).Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return auth.NotAuthorized{}
+	}
+	return nil
+}
+
+// CanEditLeague returns NotAuthorized unless the user can change the league's
+// settings and members
+func CanEditLeague(ctx context.Context, client *db.Client, userID, leagueID int) error {
+	return Check(ctx, client, userID, leagueID, Commissioner)
+}
+
+// CanInvite returns NotAuthorized unless the user can invite others to the league and
+// see its invitations
+func CanInvite(ctx context.Context, client *db.Client, userID, leagueID int) error {
+	return Check(ctx, client, userID, leagueID, Inviter)
+}
+
+// CanSeeInvitation returns NotAuthorized unless the invitation was sent to the user's
+// email, or the user can invite others to its league
+func CanSeeInvitation(ctx context.Context, client *db.Client, userID, invitationID int) error {
+	inv, err := client.Invitation.
+		Query().
+		Where(invitation.ID(invitationID)).
+		WithLeague().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	u, err := client.User.Get(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if inv.Email != nil && strings.EqualFold(*inv.Email, u.Email) {
+		return nil
+	}
+	return CanInvite(ctx, client, userID, inv.Edges.League.ID)
+}
+
+// CanSeeUser returns NotAuthorized unless the other user is the user themselves or a
+// member of one of the user's leagues
+func CanSeeUser(ctx context.Context, client *db.Client, userID, otherID int) error {
+	if userID == otherID {
+		return nil
+	}
+
+	ok, err := client.LeagueMembership.
+		Query().
+		Where(
+			leaguemembership.HasUserWith(user.ID(otherID)),
+			leaguemembership.HasLeagueWith(
+				league.HasMembershipsWith(leaguemembership.HasUserWith(user.ID(userID))),
+			),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return auth.NotAuthorized{}
+	}
+	return nil
+}
+
+// CanRevokeInvitation returns NotAuthorized unless the user sent the invitation or is
+// a commissioner of its league
+func CanRevokeInvitation(ctx context.Context, client *db.Client, userID, invitationID int) error {
+	inv, err := client.Invitation.
+		Query().
+		Where(invitation.ID(invitationID)).
+		WithLeague().
+		WithInviter().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	if inv.Edges.Inviter.ID == userID {
+		return nil
+	}
+	return CanEditLeague(ctx, client, userID, inv.Edges.League.ID)
+}
+
+// CanPick returns NotAuthorized unless the user is drafting in the draft. Whether it
+// is their turn is up to the draft
+func CanPick(ctx context.Context, client *db.Client, userID, draftID int) error {
+	ok, err := client.ContestEntry.
+		Query().
+		Where(
+			contestentry.HasUserWith(user.ID(userID)),
+			contestentry.HasContestWith(
+				contest.HasDraftWith(contestdraft.ID(draftID)),
+			),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return auth.NotAuthorized{}
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+// Package scoring computes fantasy points. It is the single source of truth for how
+// many points a performance, a player or a contest entry is worth, and is used by the
+// GraphQL resolvers, the draft's auto-pick rankings, live contest totals and contest
+// settlement alike
+package scoring
+
+import (
+	"context"
+	"sort"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/contest"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraft"
+	"github.com/NickDubelman/fantasy-bball/db/contestdraftpick"
+	"github.com/NickDubelman/fantasy-bball/db/contestentry"
+	"github.com/NickDubelman/fantasy-bball/db/game"
+	"github.com/NickDubelman/fantasy-bball/db/player"
+	"github.com/NickDubelman/fantasy-bball/db/playerperformance"
+	"github.com/NickDubelman/fantasy-bball/db/schema/schematype"
+	"github.com/NickDubelman/fantasy-bball/db/user"
+	"github.com/NickDubelman/fantasy-bball/nba"
+)
+
+// Points returns the fantasy points that a performance is worth under the given
+// weights. A player who did not play scores 0 (all of their stats are 0)
+func Points(w schematype.StatWeights, p *db.PlayerPerformance) int {
+	return p.Points*w.Points +
+		p.Rebounds*w.Rebounds +
+		p.Assists*w.Assists +
+		p.Steals*w.Steals +
+		p.Blocks*w.Blocks +
+		p.Turnovers*w.Turnovers
+}
+
+// Total returns the fantasy points that a set of performances is worth under the
+// given weights. This is how a ContestEntry's totalPoints is computed
+func Total(w schematype.StatWeights, performances []*db.PlayerPerformance) int {
+	total := 0
+	for _, p := range performances {
+		total += Points(w, p)
+	}
+	return total
+}
+
+// ByPlayer returns the fantasy points that each player scored across the given
+// performances, keyed by player ID. The performances must have their Player edge
+// loaded
+func ByPlayer(
+	w schematype.StatWeights,
+	performances []*db.PlayerPerformance,
+) map[int]int {
+	points := map[int]int{}
+	for _, p := range performances {
+		points[p.Edges.Player.ID] += Points(w, p)
+	}
+	return points
+}
+
+// EntryPerformances returns the performances that count towards a contest entry: the
+// performances of the players that the entry's user drafted, in the games on the day
+// of the entry's contest. They are ordered by ID and have their Player edge loaded
+func EntryPerformances(
+	ctx context.Context,
+	client *db.Client,
+	entryID int,
+) ([]*db.PlayerPerformance, error) {
+	e, err := client.ContestEntry.
+		Query().
+		Where(contestentry.ID(entryID)).
+		WithContest().
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := nba.DayBounds(e.Edges.Contest.Day)
+
+	return client.PlayerPerformance.
+		Query().
+		Where(
+			playerperformance.HasPlayerWith(
+				player.HasDraftPicksWith(
+					contestdraftpick.HasUserWith(user.ID(e.Edges.User.ID)),
+					contestdraftpick.HasDraftWith(
+						contestdraft.HasContestWith(contest.ID(e.Edges.Contest.ID)),
+					),
+				),
+			),
+			playerperformance.HasGameWith(game.TimeGTE(start), game.TimeLT(end)),
+		).
+		WithPlayer().
+		Order(db.Asc(playerperformance.FieldID)).
+		All(ctx)
+}
+
+// EntryScore is what a contest entry scored
+type EntryScore struct {
+	// Total is the entry's total points
+	Total int
+
+	// Best is the most points that any one of the entry's players scored, or 0 if
+	// none of them played
+	Best int
+}
+
+// ScoreContest scores every entry in a contest, using the weights of the contest's
+// league. The scores are keyed by entry ID
+func ScoreContest(
+	ctx context.Context,
+	client *db.Client,
+	contestID int,
+) (map[int]EntryScore, error) {
+	c, err := client.Contest.
+		Query().
+		Where(contest.ID(contestID)).
+		WithLeague().
+		WithEntries().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	weights := c.Edges.League.StatWeights
+
+	scores := map[int]EntryScore{}
+	for _, e := range c.Edges.Entries {
+		performances, err := EntryPerformances(ctx, client, e.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		score := EntryScore{Total: Total(weights, performances)}
+		first := true
+		for _, points := range ByPlayer(weights, performances) {
+			if first || points > score.Best {
+				score.Best = points
+			}
+			first = false
+		}
+		scores[e.ID] = score
+	}
+	return scores, nil
+}
+
+// UpdateContest recomputes the total points of every entry in a contest and saves
+// the ones that changed. The IDs of the entries that changed are returned in order
+func UpdateContest(ctx context.Context, client *db.Client, contestID int) ([]int, error) {
+	scores, err := ScoreContest(ctx, client, contestID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := client.ContestEntry.
+		Query().
+		Where(contestentry.IDIn(entryIDs(scores)...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var changed []int
+	for _, e := range entries {
+		total := scores[e.ID].Total
+		if e.TotalPoints == total {
+			continue
+		}
+
+		err := client.ContestEntry.
+			UpdateOneID(e.ID).
+			SetTotalPoints(total).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+		changed = append(changed, e.ID)
+	}
+
+	sort.Ints(changed)
+	return changed, nil
+}
+
+func entryIDs(scores map[int]EntryScore) []int {
+	ids := make([]int, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
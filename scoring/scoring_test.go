@@ -0,0 +1,102 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/schema/schematype"
+)
+
+func performance(playerID, pts, reb, ast, stl, blk, tov int) *db.PlayerPerformance {
+	return &db.PlayerPerformance{
+		Points:    pts,
+		Rebounds:  reb,
+		Assists:   ast,
+		Steals:    stl,
+		Blocks:    blk,
+		Turnovers: tov,
+		Edges: db.PlayerPerformanceEdges{
+			Player: &db.Player{ID: playerID},
+		},
+	}
+}
+
+func TestPoints(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights schematype.StatWeights
+		perf    *db.PlayerPerformance
+		want    int
+	}{
+		{
+			name:    "default weights",
+			weights: schematype.DefaultStatWeights,
+			perf:    performance(1, 30, 10, 8, 2, 1, 4),
+			want:    30 + 10 + 16 + 6 + 3 - 8,
+		},
+		{
+			name:    "points only",
+			weights: schematype.StatWeights{Points: 1},
+			perf:    performance(1, 30, 10, 8, 2, 1, 4),
+			want:    30,
+		},
+		{
+			name:    "did not play",
+			weights: schematype.DefaultStatWeights,
+			perf:    performance(1, 0, 0, 0, 0, 0, 0),
+			want:    0,
+		},
+		{
+			name:    "turnovers can make a performance negative",
+			weights: schematype.DefaultStatWeights,
+			perf:    performance(1, 0, 0, 0, 0, 0, 3),
+			want:    -6,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Points(tt.weights, tt.perf); got != tt.want {
+				t.Errorf("Points() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTotal(t *testing.T) {
+	w := schematype.StatWeights{Points: 1, Rebounds: 2}
+	performances := []*db.PlayerPerformance{
+		performance(1, 10, 5, 0, 0, 0, 0),
+		performance(2, 20, 1, 0, 0, 0, 0),
+		performance(3, 0, 0, 0, 0, 0, 0),
+	}
+
+	if got, want := Total(w, performances), 20+22; got != want {
+		t.Errorf("Total() = %d, want %d", got, want)
+	}
+
+	if got := Total(w, nil); got != 0 {
+		t.Errorf("Total(nil) = %d, want 0", got)
+	}
+}
+
+func TestByPlayer(t *testing.T) {
+	w := schematype.StatWeights{Points: 1, Assists: 2}
+	performances := []*db.PlayerPerformance{
+		performance(1, 10, 0, 1, 0, 0, 0),
+		performance(2, 20, 0, 0, 0, 0, 0),
+		performance(1, 5, 0, 0, 0, 0, 0), // same player, another game
+	}
+
+	got := ByPlayer(w, performances)
+	want := map[int]int{1: 17, 2: 20}
+
+	if len(got) != len(want) {
+		t.Fatalf("ByPlayer() = %v, want %v", got, want)
+	}
+	for id, points := range want {
+		if got[id] != points {
+			t.Errorf("ByPlayer()[%d] = %d, want %d", id, got[id], points)
+		}
+	}
+}
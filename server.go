@@ -1,18 +1,31 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"os"
 
 	"github.com/NickDubelman/fantasy-bball/api"
+	"github.com/NickDubelman/fantasy-bball/config"
+	"github.com/NickDubelman/fantasy-bball/ingest"
+	"github.com/NickDubelman/fantasy-bball/stats"
 )
 
 func main() {
-	router, err := api.Load()
+	router, client, err := api.Load()
 	if err != nil {
 		fmt.Printf("Unable to start server: %s\n", err.Error())
 		os.Exit(1)
 	}
 
+	// Keep NBA data up to date in the background
+	if c := config.Get().Stats; c.Dir != "" {
+		provider := stats.NewFileProvider(c.Dir)
+		go ingest.Run(context.Background(), client, provider, c.Interval)
+	} else {
+		log.Println("BBALL_STATS_DIR is not set, not ingesting NBA data")
+	}
+
 	router.Run()
 }
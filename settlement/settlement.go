@@ -0,0 +1,243 @@
+// Package settlement decides the winners of contests. A contest is settled once its
+// draft is complete, the schedule of its day has been ingested, and every game on its
+// day is either final or postponed. Settling scores every entry (see the scoring
+// package), saves the totals and picks the winner using the following tiebreak policy:
+//
+//  1. The entry with the most total points wins
+//  2. If entries are tied on points, the one with the best single player
+//     performance wins
+//  3. If they are still tied, the entry that picked later in the first round of the
+//     draft wins, since it had the worse draft slot
+//
+// Settling is idempotent: it can be re-run at any time (e.g. after a stat correction)
+// and will update the totals and the winner to match the current box scores. Changes
+// to the totals of a contest that was already settled are recorded as
+// ContestEntryCorrections
+package settlement
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/NickDubelman/fantasy-bball/db"
+	"github.com/NickDubelman/fantasy-bball/db/contest"
+	"github.com/NickDubelman/fantasy-bball/db/contestentry"
+	"github.com/NickDubelman/fantasy-bball/db/game"
+	"github.com/NickDubelman/fantasy-bball/nba"
+	"github.com/NickDubelman/fantasy-bball/scoring"
+)
+
+// NotReady is an error for when a contest can't be settled yet
+type NotReady struct {
+	Reason string
+}
+
+func (e NotReady) Error() string {
+	return "contest is not ready to be settled: " + e.Reason
+}
+
+// Result is the outcome of settling a contest
+type Result struct {
+	// Totals holds the total points of each entry, keyed by entry ID
+	Totals map[int]int
+
+	// Winner is the ID of the winning user, or nil if the contest had no entries
+	Winner *int
+}
+
+// Settle scores every entry of the given contest, saves their totals, sets the
+// contest's winner and marks it closed. It returns a NotReady error if the contest's
+// draft isn't complete or some of its games aren't over yet
+func Settle(ctx context.Context, client *db.Client, contestID int) (*Result, error) {
+	var result *Result
+
+	err := client.WithTx(ctx, func(tx *db.Tx) error {
+		c, err := tx.Contest.
+			Query().
+			Where(contest.ID(contestID)).
+			WithDraft().
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := checkReady(ctx, tx.Client(), c); err != nil {
+			return err
+		}
+
+		scores, err := scoring.ScoreContest(ctx, tx.Client(), c.ID)
+		if err != nil {
+			return err
+		}
+
+		entries, err := c.
+			QueryEntries().
+			WithUser().
+			Order(db.Asc(contestentry.FieldID)). // draft order
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		result = &Result{Totals: map[int]int{}}
+
+		ordered := make([]scoring.EntryScore, len(entries))
+		for i, e := range entries {
+			ordered[i] = scores[e.ID]
+		}
+
+		var winner *db.ContestEntry
+		if i := winnerIndex(ordered); i >= 0 {
+			winner = entries[i]
+		}
+
+		for _, e := range entries {
+			total := scores[e.ID].Total
+			result.Totals[e.ID] = total
+
+			if e.TotalPoints == total {
+				continue
+			}
+
+			err = tx.ContestEntry.
+				UpdateOneID(e.ID).
+				SetTotalPoints(total).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Once a contest is settled, its totals only change because of stat
+			// corrections, which we keep a record of
+			if c.Closed != nil {
+				_, err := tx.ContestEntryCorrection.
+					Create().
+					SetEntryID(e.ID).
+					SetOldTotalPoints(e.TotalPoints).
+					SetNewTotalPoints(total).
+					Save(ctx)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		update := tx.Contest.UpdateOneID(c.ID)
+		if winner != nil {
+			result.Winner = &winner.Edges.User.ID
+			update.SetWinner(winner.Edges.User)
+		} else {
+			update.ClearWinner()
+		}
+		if c.Closed == nil {
+			update.SetClosed(time.Now())
+		}
+
+		return update.Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// SettleAll settles every open contest that is ready to be settled
+func SettleAll(ctx context.Context, client *db.Client) error {
+	contests, err := client.Contest.
+		Query().
+		Where(contest.ClosedIsNil()).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range contests {
+		_, err := Settle(ctx, client, id)
+		if _, notReady := err.(NotReady); notReady {
+			continue
+		}
+		if err != nil {
+			log.Printf("settling contest %d: %v", id, err)
+		}
+	}
+
+	return nil
+}
+
+// Run calls SettleAll every interval, until ctx is done
+func Run(ctx context.Context, client *db.Client, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := SettleAll(ctx, client); err != nil {
+				log.Printf("settling contests: %v", err)
+			}
+		}
+	}
+}
+
+// checkReady returns a NotReady error if the contest can't be settled yet
+func checkReady(ctx context.Context, client *db.Client, c *db.Contest) error {
+	if d := c.Edges.Draft; d == nil || d.Completed == nil {
+		return NotReady{Reason: "draft is not complete"}
+	}
+
+	start, end := nba.DayBounds(c.Day)
+	if time.Now().Before(start) {
+		return NotReady{Reason: "contest day hasn't started"}
+	}
+
+	// No games at all most likely means the day's schedule hasn't been ingested yet,
+	// rather than that there is nothing to wait for
+	scheduled, err := client.Game.
+		Query().
+		Where(game.TimeGTE(start), game.TimeLT(end)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !scheduled {
+		return NotReady{Reason: "there are no games on the contest's day"}
+	}
+
+	pending, err := client.Game.
+		Query().
+		Where(
+			game.TimeGTE(start),
+			game.TimeLT(end),
+			game.Final(false),
+			game.Postponed(false),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if pending {
+		return NotReady{Reason: "some games are not over yet"}
+	}
+
+	return nil
+}
+
+// winnerIndex returns the index of the winning entry according to the tiebreak policy
+// (see the package documentation), or -1 if there are no entries. The scores must be
+// in draft order
+func winnerIndex(scores []scoring.EntryScore) int {
+	winner := -1
+	for i, s := range scores {
+		// Later entries win full ties, since they had a worse draft slot
+		if winner < 0 ||
+			s.Total > scores[winner].Total ||
+			(s.Total == scores[winner].Total && s.Best >= scores[winner].Best) {
+			winner = i
+		}
+	}
+	return winner
+}
@@ -0,0 +1,55 @@
+package settlement
+
+import (
+	"testing"
+
+	"github.com/NickDubelman/fantasy-bball/scoring"
+)
+
+func TestWinnerIndex(t *testing.T) {
+	tests := []struct {
+		name   string
+		scores []scoring.EntryScore
+		want   int
+	}{
+		{name: "no entries", want: -1},
+		{
+			name:   "one entry",
+			scores: []scoring.EntryScore{{Total: 10, Best: 10}},
+			want:   0,
+		},
+		{
+			name:   "most points",
+			scores: []scoring.EntryScore{{Total: 50, Best: 20}, {Total: 60, Best: 15}, {Total: 40, Best: 40}},
+			want:   1,
+		},
+		{
+			name:   "tied on points, best performance",
+			scores: []scoring.EntryScore{{Total: 60, Best: 30}, {Total: 60, Best: 25}, {Total: 50, Best: 50}},
+			want:   0,
+		},
+		{
+			name:   "full tie, later entry",
+			scores: []scoring.EntryScore{{Total: 60, Best: 30}, {Total: 60, Best: 30}, {Total: 50, Best: 30}},
+			want:   1,
+		},
+		{
+			name:   "nobody played",
+			scores: []scoring.EntryScore{{}, {}, {}},
+			want:   2,
+		},
+		{
+			name:   "negative totals",
+			scores: []scoring.EntryScore{{Total: -4, Best: -1}, {Total: -2, Best: -2}},
+			want:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := winnerIndex(tt.scores); got != tt.want {
+				t.Errorf("winnerIndex() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
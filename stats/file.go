@@ -0,0 +1,187 @@
+package stats
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// FileProvider is a Provider that reads fixtures from a local directory, so that
+// everything can be run and tested offline. The directory is laid out like so:
+//
+//	rosters.json             []Roster, used for days that don't have their own
+//	2021-01-05/
+//	    rosters.json         []Roster (optional)
+//	    schedule.json        []Game
+//	    boxscores.json       []BoxScore
+//	    performances.csv     more Performances (optional, see below)
+//
+// Stat lines can be given inline in boxscores.json and/or in performances.csv, which
+// is easier to edit by hand. Its first row is a header with the columns gameID,
+// playerID, teamID, minutes, points, rebounds, assists, steals, blocks and turnovers
+// (in any order). An empty minutes column means DNP. Every row's game must have a box
+// score in boxscores.json.
+//
+// A missing file means there is no data: a day without a directory has no games
+type FileProvider struct {
+	Dir string
+}
+
+var _ Provider = (*FileProvider)(nil)
+
+// NewFileProvider returns a FileProvider that reads fixtures from dir
+func NewFileProvider(dir string) *FileProvider {
+	return &FileProvider{Dir: dir}
+}
+
+// Schedule returns the games in the day's schedule.json
+func (p *FileProvider) Schedule(ctx context.Context, day time.Time) ([]Game, error) {
+	var games []Game
+	if err := p.readJSON(p.dayPath(day, "schedule.json"), &games); err != nil {
+		return nil, err
+	}
+	return games, nil
+}
+
+// Rosters returns the rosters in the day's rosters.json, or the top-level one if the
+// day doesn't have one
+func (p *FileProvider) Rosters(ctx context.Context, day time.Time) ([]Roster, error) {
+	path := p.dayPath(day, "rosters.json")
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		path = filepath.Join(p.Dir, "rosters.json")
+	}
+
+	var rosters []Roster
+	if err := p.readJSON(path, &rosters); err != nil {
+		return nil, err
+	}
+	return rosters, nil
+}
+
+// BoxScores returns the box scores in the day's boxscores.json, along with the stat
+// lines in its performances.csv
+func (p *FileProvider) BoxScores(ctx context.Context, day time.Time) ([]BoxScore, error) {
+	var boxScores []BoxScore
+	if err := p.readJSON(p.dayPath(day, "boxscores.json"), &boxScores); err != nil {
+		return nil, err
+	}
+
+	path := p.dayPath(day, "performances.csv")
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return boxScores, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	games := map[string]*BoxScore{}
+	for i := range boxScores {
+		games[boxScores[i].GameID] = &boxScores[i]
+	}
+
+	err = readPerformancesCSV(f, func(gameID string, perf Performance) error {
+		b, ok := games[gameID]
+		if !ok {
+			return fmt.Errorf("no box score for game %q", gameID)
+		}
+		b.Performances = append(b.Performances, perf)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return boxScores, nil
+}
+
+func (p *FileProvider) dayPath(day time.Time, name string) string {
+	return filepath.Join(p.Dir, day.Format("2006-01-02"), name)
+}
+
+// readJSON decodes the file at path into v. v is left untouched if the file doesn't
+// exist
+func (p *FileProvider) readJSON(path string, v interface{}) error {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := json.NewDecoder(f).Decode(v); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// performanceColumns are the columns of performances.csv
+var performanceColumns = []string{
+	"gameID", "playerID", "teamID", "minutes",
+	"points", "rebounds", "assists", "steals", "blocks", "turnovers",
+}
+
+// readPerformancesCSV calls fn with every row of a performances.csv file
+func readPerformancesCSV(r io.Reader, fn func(gameID string, perf Performance) error) error {
+	rows := csv.NewReader(r)
+
+	header, err := rows.Read()
+	if err != nil {
+		return err
+	}
+
+	index := map[string]int{}
+	for i, name := range header {
+		index[name] = i
+	}
+	for _, name := range performanceColumns {
+		if _, ok := index[name]; !ok {
+			return fmt.Errorf("missing column %q", name)
+		}
+	}
+
+	for line := 2; ; line++ {
+		row, err := rows.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		col := func(name string) string { return row[index[name]] }
+
+		// Parse the stat columns, in the same order as the fields they go in
+		perf := Performance{PlayerID: col("playerID"), TeamID: col("teamID")}
+		stats := []*int{
+			&perf.Points, &perf.Rebounds, &perf.Assists,
+			&perf.Steals, &perf.Blocks, &perf.Turnovers,
+		}
+		for i, name := range performanceColumns[4:] {
+			if *stats[i], err = strconv.Atoi(col(name)); err != nil {
+				return fmt.Errorf("line %d: %s: %w", line, name, err)
+			}
+		}
+
+		if minutes := col("minutes"); minutes != "" {
+			m, err := strconv.Atoi(minutes)
+			if err != nil {
+				return fmt.Errorf("line %d: minutes: %w", line, err)
+			}
+			perf.Minutes = &m
+		}
+
+		if err := fn(col("gameID"), perf); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package stats
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var fixtureDay = time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC)
+
+func minutes(m int) *int { return &m }
+
+func TestFileProviderFixtures(t *testing.T) {
+	ctx := context.Background()
+	p := NewFileProvider("fixtures")
+
+	games, err := p.Schedule(ctx, fixtureDay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantGames := []Game{{
+		ID:         "0022000090",
+		Time:       time.Date(2021, 1, 6, 3, 0, 0, 0, time.UTC),
+		HomeTeamID: "1610612747",
+		AwayTeamID: "1610612756",
+	}}
+	if len(games) != 1 || !games[0].Time.Equal(wantGames[0].Time) {
+		t.Fatalf("Schedule() = %+v, want %+v", games, wantGames)
+	}
+	games[0].Time = wantGames[0].Time
+	if !reflect.DeepEqual(games, wantGames) {
+		t.Errorf("Schedule() = %+v, want %+v", games, wantGames)
+	}
+
+	// The day doesn't have its own rosters, so the top-level ones are used
+	rosters, err := p.Rosters(ctx, fixtureDay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rosters) != 2 || rosters[0].ID != "1610612747" || rosters[1].ID != "1610612756" {
+		t.Errorf("Rosters() = %+v, want the LAL and PHX rosters", rosters)
+	}
+
+	boxScores, err := p.BoxScores(ctx, fixtureDay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(boxScores) != 1 {
+		t.Fatalf("BoxScores() returned %d box scores, want 1", len(boxScores))
+	}
+
+	bs := boxScores[0]
+	if bs.GameID != "0022000090" || bs.HomeScore != 112 || bs.AwayScore != 108 || !bs.Final {
+		t.Errorf("box score = %+v, want LAL 112, PHX 108, final", bs)
+	}
+
+	// Inline stat lines come first, followed by the ones in performances.csv
+	wantPerformances := []Performance{
+		{
+			PlayerID: "2544", TeamID: "1610612747", Minutes: minutes(36),
+			Points: 28, Rebounds: 8, Assists: 10, Steals: 1, Blocks: 1, Turnovers: 4,
+		},
+		{
+			PlayerID: "203076", TeamID: "1610612747", Minutes: minutes(34),
+			Points: 24, Rebounds: 11, Assists: 3, Steals: 2, Blocks: 3, Turnovers: 2,
+		},
+		{
+			PlayerID: "1626164", TeamID: "1610612756", Minutes: minutes(37),
+			Points: 30, Rebounds: 4, Assists: 6, Steals: 1, Turnovers: 3,
+		},
+		{PlayerID: "101108", TeamID: "1610612756"}, // DNP
+	}
+	if !reflect.DeepEqual(bs.Performances, wantPerformances) {
+		t.Errorf("performances = %+v, want %+v", bs.Performances, wantPerformances)
+	}
+}
+
+func TestFileProviderMissingDay(t *testing.T) {
+	ctx := context.Background()
+	p := NewFileProvider("fixtures")
+	day := fixtureDay.AddDate(0, 0, 1)
+
+	games, err := p.Schedule(ctx, day)
+	if err != nil || len(games) != 0 {
+		t.Errorf("Schedule() = %v, %v, want no games", games, err)
+	}
+
+	boxScores, err := p.BoxScores(ctx, day)
+	if err != nil || len(boxScores) != 0 {
+		t.Errorf("BoxScores() = %v, %v, want no box scores", boxScores, err)
+	}
+}
+
+func TestFileProviderInvalidCSV(t *testing.T) {
+	const header = "gameID,playerID,teamID,minutes,points,rebounds,assists,steals,blocks,turnovers\n"
+
+	tests := []struct {
+		name    string
+		csv     string
+		wantErr string
+	}{
+		{
+			name:    "game without a box score",
+			csv:     header + "0022000091,2544,1610612747,36,28,8,10,1,1,4\n",
+			wantErr: `line 2: no box score for game "0022000091"`,
+		},
+		{
+			name:    "missing column",
+			csv:     "gameID,playerID,teamID,minutes,points\n",
+			wantErr: `missing column "rebounds"`,
+		},
+		{
+			name:    "bad stat",
+			csv:     header + "0022000090,2544,1610612747,36,lots,8,10,1,1,4\n",
+			wantErr: "line 2: points",
+		},
+		{
+			name:    "bad minutes",
+			csv:     header + "0022000090,2544,1610612747,DNP,0,0,0,0,0,0\n",
+			wantErr: "line 2: minutes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			dayDir := filepath.Join(dir, fixtureDay.Format("2006-01-02"))
+			if err := os.Mkdir(dayDir, 0755); err != nil {
+				t.Fatal(err)
+			}
+
+			boxScores := `[{"gameID": "0022000090", "final": true}]`
+			files := map[string]string{
+				"boxscores.json":   boxScores,
+				"performances.csv": tt.csv,
+			}
+			for name, contents := range files {
+				if err := os.WriteFile(filepath.Join(dayDir, name), []byte(contents), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			_, err := NewFileProvider(dir).BoxScores(context.Background(), fixtureDay)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("BoxScores() error = %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
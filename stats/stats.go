@@ -0,0 +1,74 @@
+// Package stats gets NBA data (teams, players, games and box scores) from an outside
+// source. Everything is keyed by the source's own IDs, which are stored as the
+// externalID of the corresponding entities so that data can be upserted idempotently
+package stats
+
+import (
+	"context"
+	"time"
+)
+
+// Provider is a source of NBA data. Days are calendar days as returned by nba.Day
+type Provider interface {
+	// Schedule returns the games on the given day, including postponed ones
+	Schedule(ctx context.Context, day time.Time) ([]Game, error)
+
+	// Rosters returns every team and the players on it as of the given day
+	Rosters(ctx context.Context, day time.Time) ([]Roster, error)
+
+	// BoxScores returns the box scores of the games on the given day that have
+	// started. Games that haven't started yet are left out
+	BoxScores(ctx context.Context, day time.Time) ([]BoxScore, error)
+}
+
+// Team is an NBA team
+type Team struct {
+	ID        string `json:"id"`
+	ShortName string `json:"shortName"` // e.g. LAL
+	Location  string `json:"location"`  // e.g. Los Angeles
+	Name      string `json:"name"`      // e.g. Lakers
+}
+
+// Player is an NBA player
+type Player struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Roster is a team and the players currently on it
+type Roster struct {
+	Team
+	Players []Player `json:"players"`
+}
+
+// Game is a scheduled NBA game
+type Game struct {
+	ID         string    `json:"id"`
+	Time       time.Time `json:"time"`
+	HomeTeamID string    `json:"homeTeamID"`
+	AwayTeamID string    `json:"awayTeamID"`
+	Postponed  bool      `json:"postponed"`
+}
+
+// BoxScore is the score of a game and the stat lines of everyone who was on either
+// team's roster for it
+type BoxScore struct {
+	GameID       string        `json:"gameID"`
+	HomeScore    int           `json:"homeScore"`
+	AwayScore    int           `json:"awayScore"`
+	Final        bool          `json:"final"` // the game is over
+	Performances []Performance `json:"performances"`
+}
+
+// Performance is a player's stat line in a game
+type Performance struct {
+	PlayerID  string `json:"playerID"`
+	TeamID    string `json:"teamID"`
+	Minutes   *int   `json:"minutes"` // nil if DNP
+	Points    int    `json:"points"`
+	Rebounds  int    `json:"rebounds"`
+	Assists   int    `json:"assists"`
+	Steals    int    `json:"steals"`
+	Blocks    int    `json:"blocks"`
+	Turnovers int    `json:"turnovers"`
+}